@@ -82,6 +82,37 @@ func NewKeyedTransactor(key *ecdsa.PrivateKey) *TransactOpts {
 	}
 }
 
+// NewKeyedFeePayerSigner is a utility method to easily create a fee payer signer
+// from a single private key, to be set to TransactOpts.FeePayerSigner.
+func NewKeyedFeePayerSigner(key *ecdsa.PrivateKey) FeePayerSignerFn {
+	keyAddr := crypto.PubkeyToAddress(key.PublicKey)
+	return func(signer types.Signer, feePayer common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if feePayer != keyAddr {
+			return nil, errors.New("not authorized to sign as this fee payer")
+		}
+		return types.SignTxAsFeePayer(tx, signer, key)
+	}
+}
+
+// NewKeyStoreFeePayerSigner is a utility method to easily create a fee payer signer
+// from a decrypted key from a keystore, to be set to TransactOpts.FeePayerSigner.
+func NewKeyStoreFeePayerSigner(keystore *keystore.KeyStore, account accounts.Account) FeePayerSignerFn {
+	return func(signer types.Signer, feePayer common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if feePayer != account.Address {
+			return nil, errors.New("not authorized to sign as this fee payer")
+		}
+		hash, err := signer.HashFeePayer(tx)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := keystore.SignHash(account, hash.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return tx.WithFeePayerSignature(signer, signature)
+	}
+}
+
 // TODO-Kaia: clef related code
 /*
 // NewClefTransactor is a utility method to easily create a transaction signer
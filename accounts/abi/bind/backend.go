@@ -45,6 +45,18 @@ var (
 	// This error is returned by WaitDeployed if contract creation leaves an
 	// empty contract behind.
 	ErrNoCodeAfterDeploy = errors.New("no contract code after deployment")
+
+	// ErrInvalidFeeRatio is returned by transact operations in the fee payer mode
+	// if the fee ratio is out of [1, 99].
+	ErrInvalidFeeRatio = errors.New("fee ratio must be in [1, 99]")
+
+	// ErrNoFeePayer is returned by transact operations if the fee ratio is set
+	// without the fee payer.
+	ErrNoFeePayer = errors.New("fee ratio is set without the fee payer")
+
+	// ErrNoFeePayerSigner is returned by transact operations in the fee payer mode
+	// if the fee payer signer is not given and the transaction is to be sent.
+	ErrNoFeePayerSigner = errors.New("no fee payer signer to send the fee-delegated transaction")
 )
 
 // ContractCaller defines the methods needed to allow operating with contract on a read
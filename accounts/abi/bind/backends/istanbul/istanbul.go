@@ -0,0 +1,244 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package istanbul provides the Istanbul engine for the simulated backend,
+// which seals the blocks with the keys of the validators.
+package istanbul
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus"
+	"github.com/kaiachain/kaia/consensus/istanbul"
+	istanbulBackend "github.com/kaiachain/kaia/consensus/istanbul/backend"
+	istanbulCore "github.com/kaiachain/kaia/consensus/istanbul/core"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/crypto/bls"
+	"github.com/kaiachain/kaia/crypto/sha3"
+	"github.com/kaiachain/kaia/governance"
+	reward_impl "github.com/kaiachain/kaia/kaiax/reward/impl"
+	staking_impl "github.com/kaiachain/kaia/kaiax/staking/impl"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var (
+	errNoKey            = errors.New("no validator key is given")
+	errNoIstanbulConfig = errors.New("the chain config has no istanbul config")
+	errNoGovConfig      = errors.New("the chain config has no governance config")
+)
+
+// WithIstanbul makes the simulated chain run the Istanbul engine with the
+// validators of the keys. The first validator proposes and seals every block
+// and all the validators sign the committed seals, so the blocks pass the
+// verification of a real node. The randao fields are signed by the BLS keys
+// derived from the keys.
+//
+// The staking info and the rewards are calculated by the kaiax modules, so
+// with the weighted random proposer policy, WithStaking must list the
+// validators of the keys.
+func WithIstanbul(keys ...*ecdsa.PrivateKey) backends.SimulatedBackendOption {
+	return backends.WithSealingEngine(func(db database.DBManager, config *params.ChainConfig) (backends.SealingEngine, error) {
+		return newEngine(db, config, keys)
+	})
+}
+
+// engine seals the blocks of the simulated chain with the Istanbul engine.
+type engine struct {
+	consensus.Istanbul
+
+	db         database.DBManager
+	config     *params.ChainConfig
+	keys       []*ecdsa.PrivateKey
+	validators []common.Address
+	gov        *governance.MixedEngine
+
+	mStaking *staking_impl.StakingModule
+	mReward  *reward_impl.RewardModule
+}
+
+func newEngine(db database.DBManager, config *params.ChainConfig, keys []*ecdsa.PrivateKey) (*engine, error) {
+	if len(keys) == 0 {
+		return nil, errNoKey
+	}
+	if config.Istanbul == nil {
+		return nil, errNoIstanbulConfig
+	}
+	if config.Governance == nil {
+		return nil, errNoGovConfig
+	}
+
+	var (
+		validators = make([]common.Address, len(keys))
+		pubkeys    = make(blsPubkeys, len(keys))
+	)
+	for i, key := range keys {
+		blsKey, err := bls.DeriveFromECDSA(key)
+		if err != nil {
+			return nil, err
+		}
+		validators[i] = crypto.PubkeyToAddress(key.PublicKey)
+		pubkeys[validators[i]] = blsKey.PublicKey()
+	}
+	blsKey, _ := bls.DeriveFromECDSA(keys[0])
+
+	gov := governance.NewMixedEngine(config, db)
+	e := &engine{
+		Istanbul: istanbulBackend.New(&istanbulBackend.BackendOpts{
+			IstanbulConfig: &istanbul.Config{
+				Timeout:        istanbul.DefaultConfig.Timeout,
+				BlockPeriod:    0, // the blocks are committed on demand
+				ProposerPolicy: istanbul.ProposerPolicy(config.Istanbul.ProposerPolicy),
+				Epoch:          config.Istanbul.Epoch,
+				SubGroupSize:   config.Istanbul.SubGroupSize,
+			},
+			Rewardbase:        validators[0],
+			PrivateKey:        keys[0],
+			BlsSecretKey:      blsKey,
+			DB:                db,
+			Governance:        gov,
+			BlsPubkeyProvider: pubkeys,
+			NodeType:          common.CONSENSUSNODE,
+		}),
+		db:         db,
+		config:     config,
+		keys:       keys,
+		validators: validators,
+		gov:        gov,
+		mStaking:   staking_impl.NewStakingModule(),
+		mReward:    reward_impl.NewRewardModule(),
+	}
+	gov.SetNodeAddress(validators[0])
+	return e, nil
+}
+
+// SetupGenesis lists the validators in the extra data of the genesis block.
+func (e *engine) SetupGenesis(genesis *blockchain.Genesis) {
+	extra, _ := rlp.EncodeToBytes(&types.IstanbulExtra{
+		Validators:    e.validators,
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	})
+	genesis.ExtraData = append(make([]byte, types.IstanbulExtraVanity), extra...)
+	genesis.BlockScore = common.Big1
+}
+
+// Attach sets up the governance and the kaiax modules on the chain as a
+// consensus node does. The consensus core is not started since the blocks are
+// sealed by SealBlock.
+func (e *engine) Attach(chain *blockchain.BlockChain) error {
+	e.gov.SetBlockchain(chain)
+	e.Istanbul.SetChain(chain)
+
+	err := errors.Join(
+		e.mStaking.Init(&staking_impl.InitOpts{
+			ChainKv:     e.db.GetMiscDB(),
+			ChainConfig: e.config,
+			Chain:       chain,
+		}),
+		e.mReward.Init(&reward_impl.InitOpts{
+			ChainKv:       e.db.GetMiscDB(),
+			ChainConfig:   e.config,
+			Chain:         chain,
+			GovModule:     e.gov,
+			StakingModule: e.mStaking,
+		}),
+	)
+	if err != nil {
+		return err
+	}
+	chain.RegisterRewindableModule(e.mStaking, e.mReward)
+	chain.RegisterExecutionModule(e.mStaking, e.mReward)
+	e.Istanbul.RegisterStakingModule(e.mStaking)
+	e.Istanbul.RegisterConsensusModule(e.mReward)
+	return errors.Join(e.mStaking.Start(), e.mReward.Start())
+}
+
+// Close stops the kaiax modules.
+func (e *engine) Close() error {
+	e.mReward.Stop()
+	e.mStaking.Stop()
+	return nil
+}
+
+// SealBlock writes the proposer seal of the first validator and the committed
+// seals of all the validators.
+func (e *engine) SealBlock(chain consensus.ChainReader, block *types.Block) (*types.Block, error) {
+	header := block.Header()
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proposer seal signs the header without the seals.
+	if extra.Seal, err = crypto.Sign(crypto.Keccak256(sigHash(header).Bytes()), e.keys[0]); err != nil {
+		return nil, err
+	}
+	if err := writeExtra(header, extra); err != nil {
+		return nil, err
+	}
+
+	// The committed seals sign the header with the proposer seal.
+	hash := crypto.Keccak256(istanbulCore.PrepareCommittedSeal(header.Hash()))
+	extra.CommittedSeal = make([][]byte, len(e.keys))
+	for i, key := range e.keys {
+		if extra.CommittedSeal[i], err = crypto.Sign(hash, key); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeExtra(header, extra); err != nil {
+		return nil, err
+	}
+	return block.WithSeal(header), nil
+}
+
+// sigHash returns the hash the proposer seal signs, as the Istanbul engine does.
+func sigHash(header *types.Header) (hash common.Hash) {
+	hasher := sha3.NewKeccak256()
+	rlp.Encode(hasher, types.IstanbulFilteredHeader(header, false))
+	hasher.Sum(hash[:0])
+	return hash
+}
+
+func writeExtra(header *types.Header, extra *types.IstanbulExtra) error {
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.IstanbulExtraVanity:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
+// blsPubkeys provides the BLS public keys of the validators, which are not
+// registered to the KIP-113 contract of the simulated chain.
+type blsPubkeys map[common.Address]bls.PublicKey
+
+func (p blsPubkeys) GetBlsPubkey(chain consensus.ChainReader, proposer common.Address, num *big.Int) (bls.PublicKey, error) {
+	if pk, ok := p[proposer]; ok {
+		return pk, nil
+	}
+	return nil, fmt.Errorf("no BLS public key of %s", proposer.Hex())
+}
+
+func (p blsPubkeys) ResetBlsCache() {}
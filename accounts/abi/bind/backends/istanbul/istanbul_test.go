@@ -0,0 +1,164 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package istanbul
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus"
+	"github.com/kaiachain/kaia/consensus/istanbul"
+	testcontract "github.com/kaiachain/kaia/contracts/contracts/testing/reward"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *params.ChainConfig {
+	config := &params.ChainConfig{
+		ChainID:                  big.NewInt(1000),
+		IstanbulCompatibleBlock:  common.Big0,
+		LondonCompatibleBlock:    common.Big0,
+		EthTxTypeCompatibleBlock: common.Big0,
+		MagmaCompatibleBlock:     common.Big0,
+		KoreCompatibleBlock:      common.Big0,
+		ShanghaiCompatibleBlock:  common.Big0,
+		CancunCompatibleBlock:    common.Big0,
+		RandaoCompatibleBlock:    big.NewInt(2),
+		Istanbul: &params.IstanbulConfig{
+			Epoch:          30,
+			ProposerPolicy: uint64(istanbul.WeightedRandom),
+			SubGroupSize:   22,
+		},
+		Governance: params.GetDefaultGovernanceConfig(),
+	}
+	config.Governance.GovernanceMode = "none"
+	return config
+}
+
+func TestWithIstanbul(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		ctx    = context.Background()
+		config = testConfig()
+		keys   = make([]*ecdsa.PrivateKey, 4)
+		vals   = make([]backends.SimulatedValidator, len(keys))
+		addrs  = make([]common.Address, len(keys))
+
+		key, _ = crypto.GenerateKey()
+		from   = crypto.PubkeyToAddress(key.PublicKey)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+		vals[i] = backends.SimulatedValidator{
+			NodeId:          addrs[i],
+			StakingContract: common.BigToAddress(big.NewInt(int64(0xa0 + i))),
+			RewardAddr:      common.BigToAddress(big.NewInt(int64(0xb0 + i))),
+			StakingAmount:   new(big.Int).Mul(big.NewInt(5_000_000), big.NewInt(params.KAIA)),
+		}
+	}
+
+	sim, err := backends.NewSimulatedBackendWithOptions(
+		blockchain.GenesisAlloc{from: {Balance: big.NewInt(params.KAIA)}},
+		backends.WithChainConfig(config),
+		backends.WithStaking(backends.SimulatedStaking{
+			Validators:      vals,
+			AddressBookCode: common.FromHex(testcontract.AddressBookMockBinRuntime),
+		}),
+		WithIstanbul(keys...),
+	)
+	require.NoError(t, err)
+	defer sim.Close()
+
+	// The time of the blocks follows the clock of the engine.
+	assert.Error(t, sim.AdjustTime(time.Hour))
+
+	gasPrice, err := sim.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+	tx := types.NewTransaction(0, common.HexToAddress("0xbeef"), common.Big1, params.TxGas, gasPrice, nil)
+	tx, err = types.SignTx(tx, types.LatestSignerForChainID(config.ChainID), key)
+	require.NoError(t, err)
+	require.NoError(t, sim.SendTransaction(ctx, tx))
+	sim.Commit()
+	sim.Commit()
+	sim.Commit()
+
+	receipt, err := sim.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	chain := sim.BlockChain()
+	assert.Equal(t, uint64(3), chain.CurrentBlock().NumberU64())
+	for num := uint64(1); num <= 3; num++ {
+		header := chain.GetHeaderByNumber(num)
+
+		// The blocks are sealed by the first validator and committed by all.
+		author, err := chain.Engine().Author(header)
+		assert.NoError(t, err)
+		assert.Equal(t, addrs[0], author)
+		extra, err := types.ExtractIstanbulExtra(header)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, addrs, extra.Validators)
+		assert.Len(t, extra.CommittedSeal, len(keys))
+		assert.NoError(t, chain.Engine().VerifyHeader(chain, header, true))
+
+		// The randao fields are signed from the randao hardfork.
+		if num < 2 {
+			assert.Nil(t, header.RandomReveal)
+		} else {
+			assert.Len(t, header.RandomReveal, 96)
+		}
+	}
+}
+
+func TestWithIstanbul_Errors(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	key, _ := crypto.GenerateKey()
+
+	_, err := backends.NewSimulatedBackendWithOptions(nil, backends.WithChainConfig(testConfig()), WithIstanbul())
+	assert.ErrorIs(t, err, errNoKey)
+
+	config := testConfig()
+	config.Istanbul = nil
+	_, err = backends.NewSimulatedBackendWithOptions(nil, backends.WithChainConfig(config), WithIstanbul(key))
+	assert.ErrorIs(t, err, errNoIstanbulConfig)
+
+	_, err = backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithChainConfig(testConfig()),
+		backends.WithGovernanceParams(1, map[string]interface{}{"governance.unitprice": uint64(1)}),
+		WithIstanbul(key),
+	)
+	assert.Error(t, err)
+
+	_, err = backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithChainConfig(testConfig()),
+		backends.WithFinalizeHook(func(consensus.ChainReader, *types.Header, *state.StateDB) error { return nil }),
+		WithIstanbul(key),
+	)
+	assert.Error(t, err)
+}
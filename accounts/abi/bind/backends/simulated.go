@@ -39,6 +39,7 @@ import (
 	"github.com/kaiachain/kaia/blockchain/vm"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/common/math"
+	"github.com/kaiachain/kaia/consensus"
 	"github.com/kaiachain/kaia/consensus/gxhash"
 	"github.com/kaiachain/kaia/event"
 	"github.com/kaiachain/kaia/networks/rpc"
@@ -72,22 +73,34 @@ type SimulatedBackend struct {
 	events *filters.EventSystem // Event system for filtering log events live
 
 	config *params.ChainConfig
+	engine consensus.Engine
+
+	govParams []scheduledGovParams // Governance parameters scheduled by NewSimulatedBackendWithOptions
+	staking   *SimulatedStaking    // Staking info seeded by NewSimulatedBackendWithOptions
+	sealer    SealingEngine        // Engine sealing the blocks, set by WithSealingEngine
 }
 
 // NewSimulatedBackendWithDatabase creates a new binding backend based on the given database
 // and uses a simulated blockchain for testing purposes.
 func NewSimulatedBackendWithDatabase(database database.DBManager, alloc blockchain.GenesisAlloc, cfg *params.ChainConfig) *SimulatedBackend {
-	genesis := blockchain.Genesis{Config: cfg, Alloc: alloc}
+	backend := newSimulatedBackend(database, &blockchain.Genesis{Config: cfg, Alloc: alloc}, gxhash.NewFaker())
+	backend.rollback()
+	return backend
+}
+
+// newSimulatedBackend creates the backend running the chain from the genesis.
+// The caller must generate the pending block by rollback.
+func newSimulatedBackend(database database.DBManager, genesis *blockchain.Genesis, engine consensus.Engine) *SimulatedBackend {
 	genesis.MustCommit(database)
-	blockchain, _ := blockchain.NewBlockChain(database, nil, genesis.Config, gxhash.NewFaker(), vm.Config{})
+	blockchain, _ := blockchain.NewBlockChain(database, nil, genesis.Config, engine, vm.Config{})
 
 	backend := &SimulatedBackend{
 		database:   database,
 		blockchain: blockchain,
 		config:     genesis.Config,
+		engine:     engine,
 		events:     filters.NewEventSystem(new(event.TypeMux), &filterBackend{database, blockchain}, false),
 	}
-	backend.rollback()
 	return backend
 }
 
@@ -107,6 +120,11 @@ func NewSimulatedBackend(alloc blockchain.GenesisAlloc) *SimulatedBackend {
 
 // Close terminates the underlying blockchain's update loop.
 func (b *SimulatedBackend) Close() error {
+	if b.sealer != nil {
+		if err := b.sealer.Close(); err != nil {
+			return err
+		}
+	}
 	b.blockchain.Stop()
 	return nil
 }
@@ -117,7 +135,14 @@ func (b *SimulatedBackend) Commit() {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	if _, err := b.blockchain.InsertChain([]*types.Block{b.pendingBlock}); err != nil {
+	block := b.pendingBlock
+	if b.sealer != nil {
+		var err error
+		if block, err = b.sealer.SealBlock(b.blockchain, block); err != nil {
+			panic(err) // This cannot happen unless the simulator is wrong, fail in that case
+		}
+	}
+	if _, err := b.blockchain.InsertChain([]*types.Block{block}); err != nil {
 		panic(err) // This cannot happen unless the simulator is wrong, fail in that case
 	}
 	b.rollback()
@@ -132,7 +157,7 @@ func (b *SimulatedBackend) Rollback() {
 }
 
 func (b *SimulatedBackend) rollback() {
-	blocks, _ := blockchain.GenerateChain(b.config, b.blockchain.CurrentBlock(), gxhash.NewFaker(), b.database, 1, func(int, *blockchain.BlockGen) {})
+	blocks, _ := blockchain.GenerateChain(b.config, b.blockchain.CurrentBlock(), b.engine, b.database, 1, b.prepareHeader)
 	stateDB, _ := b.blockchain.State()
 
 	b.pendingBlock = blocks[0]
@@ -412,6 +437,8 @@ func (b *SimulatedBackend) SuggestGasPrice(_ context.Context) (*big.Int, error)
 	pending := b.pendingBlock
 	if b.config.IsMagmaForkEnabled(pending.Number()) {
 		return new(big.Int).Mul(pending.Header().BaseFee, big.NewInt(2)), nil
+	} else if b.govParams != nil {
+		return new(big.Int).SetUint64(b.govParamsAt(pending.NumberU64()).UnitPrice()), nil
 	} else {
 		return new(big.Int).SetUint64(b.config.UnitPrice), nil
 	}
@@ -531,7 +558,8 @@ func (b *SimulatedBackend) SendTransaction(_ context.Context, tx *types.Transact
 	}
 
 	// Include tx in chain.
-	blocks, _ := blockchain.GenerateChain(b.config, block, gxhash.NewFaker(), b.database, 1, func(number int, block *blockchain.BlockGen) {
+	blocks, _ := blockchain.GenerateChain(b.config, block, b.engine, b.database, 1, func(number int, block *blockchain.BlockGen) {
+		b.prepareHeader(number, block)
 		for _, tx := range b.pendingBlock.Transactions() {
 			block.AddTxWithChain(b.blockchain, tx)
 		}
@@ -653,8 +681,12 @@ func (b *SimulatedBackend) AdjustTime(adjustment time.Duration) error {
 	if len(b.pendingBlock.Transactions()) != 0 {
 		return errors.New("Could not adjust time on non-empty block")
 	}
+	if b.sealer != nil {
+		return errors.New("Could not adjust time with the sealing engine")
+	}
 
-	blocks, _ := blockchain.GenerateChain(b.config, b.blockchain.CurrentBlock(), gxhash.NewFaker(), b.database, 1, func(number int, block *blockchain.BlockGen) {
+	blocks, _ := blockchain.GenerateChain(b.config, b.blockchain.CurrentBlock(), b.engine, b.database, 1, func(number int, block *blockchain.BlockGen) {
+		b.prepareHeader(number, block)
 		block.OffsetTime(int64(adjustment.Seconds()))
 	})
 	stateDB, _ := b.blockchain.State()
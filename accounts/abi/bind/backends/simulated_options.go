@@ -0,0 +1,433 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package backends
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus"
+	"github.com/kaiachain/kaia/consensus/gxhash"
+	"github.com/kaiachain/kaia/consensus/misc"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var (
+	errNoKIP71Config      = errors.New("magma hardfork is scheduled without the kip71 parameters")
+	errNoValidator        = errors.New("no validator is given")
+	errAddressBookInAlloc = errors.New("the AddressBook address is already allocated")
+	errNoAddressBookCode  = errors.New("no AddressBook code is given")
+
+	errHookWithSealing      = errors.New("the finalize hooks cannot run with the sealing engine")
+	errGovParamsWithSealing = errors.New("the governance parameters cannot change after block 0 with the sealing engine")
+)
+
+// SimulatedBackendOption configures the simulated backend made by
+// NewSimulatedBackendWithOptions.
+type SimulatedBackendOption func(*simulatedOptions)
+
+// FinalizeHook is called when a block of the simulated chain is finalized,
+// before the state root is calculated. It emulates the state changes the
+// Istanbul engine makes in the Finalize step, e.g. installing the system
+// contracts on the hardfork blocks. See system.ApplyHardforkChanges.
+type FinalizeHook func(chain consensus.ChainReader, header *types.Header, state *state.StateDB) error
+
+// SealingEngine is a consensus engine that seals the blocks of the simulated
+// chain, so the chain verifies them as a real node does. The Istanbul engine
+// is provided by the istanbul subpackage, which cannot be imported here
+// because the engine imports this package.
+type SealingEngine interface {
+	consensus.Engine
+
+	// SetupGenesis fills the fields of the genesis block the engine verifies,
+	// e.g. the validators in the extra data of the Istanbul engine.
+	SetupGenesis(genesis *blockchain.Genesis)
+
+	// Attach runs the engine on the simulated chain once it is created.
+	Attach(chain *blockchain.BlockChain) error
+
+	// Close stops the engine when the simulated backend is closed.
+	Close() error
+
+	// SealBlock returns the block with the seals the engine verifies.
+	SealBlock(chain consensus.ChainReader, block *types.Block) (*types.Block, error)
+}
+
+// NewSealingEngineFunc creates the sealing engine of the simulated chain
+// stored in the database. The config can be modified before the genesis
+// block is written.
+type NewSealingEngineFunc func(db database.DBManager, config *params.ChainConfig) (SealingEngine, error)
+
+// SimulatedValidator is a validator seeded into the AddressBook of the
+// simulated chain with the balance of its staking contract.
+type SimulatedValidator struct {
+	NodeId          common.Address
+	StakingContract common.Address
+	RewardAddr      common.Address
+	StakingAmount   *big.Int // in kei
+}
+
+// SimulatedStaking is the staking info seeded into the simulated chain.
+// The validators are listed in the header extra of the generated blocks.
+type SimulatedStaking struct {
+	Validators []SimulatedValidator
+	KIFAddr    common.Address // stored as the PoC contract of the AddressBook
+	KEFAddr    common.Address // stored as the KIR contract of the AddressBook
+
+	// The runtime code of the AddressBook, e.g. AddressBookMockBinRuntime in
+	// contracts/testing/reward, which cannot be imported here because its tests
+	// import this package.
+	AddressBookCode []byte
+}
+
+type scheduledGovParams struct {
+	num  uint64
+	pset *params.GovParamSet
+}
+
+type simulatedOptions struct {
+	database    database.DBManager
+	chainConfig *params.ChainConfig
+	govParams   map[uint64]map[string]interface{}
+	staking     *SimulatedStaking
+	hooks       []FinalizeHook
+	newSealer   NewSealingEngineFunc
+}
+
+// WithDatabase makes the simulated backend store the chain in the database
+// instead of a new in-memory one.
+func WithDatabase(db database.DBManager) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		o.database = db
+	}
+}
+
+// WithChainConfig makes the simulated backend run the chain config, e.g. the
+// one with the hardfork blocks scheduled. The config is copied.
+func WithChainConfig(config *params.ChainConfig) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		o.chainConfig = config.Copy()
+	}
+}
+
+// WithGovernanceParams changes the governance parameters from the block num on,
+// as if they were voted on and took effect at the block. The parameters are
+// given by their names, e.g. "kip71.lowerboundbasefee" or "governance.unitprice".
+// The parameters given for block 0 are written into the chain config.
+func WithGovernanceParams(num uint64, items map[string]interface{}) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		if o.govParams[num] == nil {
+			o.govParams[num] = make(map[string]interface{})
+		}
+		for name, value := range items {
+			o.govParams[num][name] = value
+		}
+	}
+}
+
+// WithStaking seeds the AddressBook system contract and the staking contract
+// balances so that the staking info is available from the genesis block.
+// Without a sealing engine, the header extra of the generated blocks lists
+// the validators and the proposer takes turns, but the blocks are neither
+// sealed nor verified.
+func WithStaking(staking SimulatedStaking) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		o.staking = &staking
+	}
+}
+
+// WithFinalizeHook adds the hook called when a block is finalized.
+func WithFinalizeHook(hook FinalizeHook) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		o.hooks = append(o.hooks, hook)
+	}
+}
+
+// WithSealingEngine makes the simulated chain run the sealing engine instead
+// of the gxhash faker, so the blocks are prepared, finalized and sealed by the
+// engine and verified on commit. The engine finalizes the blocks itself, so
+// no finalize hook can be added, and the governance parameters can only be
+// given for block 0. The time of the blocks cannot be adjusted either.
+func WithSealingEngine(newSealer NewSealingEngineFunc) SimulatedBackendOption {
+	return func(o *simulatedOptions) {
+		o.newSealer = newSealer
+	}
+}
+
+// NewSimulatedBackendWithOptions creates a new binding backend using a simulated
+// blockchain configured by the options. Unless a sealing engine is given, the
+// chain runs the gxhash faker engine, so no consensus rule is enforced, but the
+// generated headers are filled as the options demand: the base fee is
+// calculated by the governance parameters of each block, and with staking the
+// header extra lists the validators and the randao fields are set. The hooks
+// emulate the state changes of the Finalize step.
+func NewSimulatedBackendWithOptions(alloc blockchain.GenesisAlloc, opts ...SimulatedBackendOption) (*SimulatedBackend, error) {
+	o := &simulatedOptions{
+		govParams: make(map[uint64]map[string]interface{}),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.database == nil {
+		o.database = database.NewMemoryDBManager()
+	}
+	if o.chainConfig == nil {
+		o.chainConfig = params.AllGxhashProtocolChanges.Copy()
+	}
+	if err := o.chainConfig.CheckConfigForkOrder(); err != nil {
+		return nil, err
+	}
+
+	schedule, err := newGovParamsSchedule(o.chainConfig, o.govParams)
+	if err != nil {
+		return nil, err
+	}
+	if o.staking != nil {
+		if alloc, err = allocStaking(alloc, o.staking); err != nil {
+			return nil, err
+		}
+	}
+
+	genesis := &blockchain.Genesis{Config: o.chainConfig, Alloc: alloc}
+	if o.newSealer == nil {
+		backend := newSimulatedBackend(o.database, genesis, &simulatedEngine{
+			Engine: gxhash.NewFullFaker(), // the headers are not verified
+			hooks:  o.hooks,
+		})
+		backend.govParams = schedule
+		backend.staking = o.staking
+		backend.rollback()
+		return backend, nil
+	}
+
+	if len(o.hooks) > 0 {
+		return nil, errHookWithSealing
+	}
+	if len(schedule) > 1 {
+		return nil, errGovParamsWithSealing
+	}
+	sealer, err := o.newSealer(o.database, o.chainConfig)
+	if err != nil {
+		return nil, err
+	}
+	sealer.SetupGenesis(genesis)
+	backend := newSimulatedBackend(o.database, genesis, sealer)
+	if err := sealer.Attach(backend.blockchain); err != nil {
+		backend.blockchain.Stop()
+		return nil, err
+	}
+	backend.govParams = schedule
+	backend.staking = o.staking
+	backend.sealer = sealer
+	backend.rollback()
+	return backend, nil
+}
+
+// newGovParamsSchedule returns the governance parameters in effect from each
+// block number in ascending order. The parameters of block 0 are merged into
+// the chain config.
+func newGovParamsSchedule(config *params.ChainConfig, items map[uint64]map[string]interface{}) ([]scheduledGovParams, error) {
+	base, err := params.NewGovParamSetChainConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid governance parameters in the chain config: %w", err)
+	}
+	nums := make([]uint64, 0, len(items))
+	for num := range items {
+		nums = append(nums, num)
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	schedule := []scheduledGovParams{{num: 0, pset: base}}
+	for _, num := range nums {
+		update, err := params.NewGovParamSetStrMap(items[num])
+		if err != nil {
+			return nil, fmt.Errorf("invalid governance parameters at block %d: %w", num, err)
+		}
+		last := schedule[len(schedule)-1]
+		merged := params.NewGovParamSetMerged(last.pset, update)
+		if num == last.num {
+			schedule[len(schedule)-1].pset = merged
+		} else {
+			schedule = append(schedule, scheduledGovParams{num: num, pset: merged})
+		}
+	}
+
+	if _, ok := items[0]; ok {
+		genesis := schedule[0].pset
+		config.UnitPrice = genesis.UnitPrice()
+		config.Governance = genesis.ToGovernanceConfig()
+	}
+	if config.MagmaCompatibleBlock != nil && (config.Governance == nil || config.Governance.KIP71 == nil) {
+		return nil, errNoKIP71Config
+	}
+	return schedule, nil
+}
+
+// allocStaking returns a copy of the alloc with the AddressBook storing the
+// validators and the staking contracts holding the staking amounts.
+func allocStaking(alloc blockchain.GenesisAlloc, staking *SimulatedStaking) (blockchain.GenesisAlloc, error) {
+	if len(staking.Validators) == 0 {
+		return nil, errNoValidator
+	}
+	if len(staking.AddressBookCode) == 0 {
+		return nil, errNoAddressBookCode
+	}
+	if _, ok := alloc[params.AddressBookAddr]; ok {
+		return nil, errAddressBookInAlloc
+	}
+
+	// The storage layout of AddressBook.sol:
+	//   slot 2: pocContractAddress, slot 3: kirContractAddress,
+	//   slot 4: spareContractAddress, isActivated and isConstructed packed,
+	//   slot 5: cnIndexMap, slots 6-8: cnNodeIdList, cnStakingContractList, cnRewardAddressList.
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(2)): common.BytesToHash(staking.KIFAddr.Bytes()),
+		common.BigToHash(big.NewInt(3)): common.BytesToHash(staking.KEFAddr.Bytes()),
+		common.BigToHash(big.NewInt(4)): common.BigToHash(new(big.Int).Or(
+			new(big.Int).Lsh(common.Big1, 160), new(big.Int).Lsh(common.Big1, 168))),
+	}
+	lists := []func(SimulatedValidator) common.Address{
+		func(v SimulatedValidator) common.Address { return v.NodeId },
+		func(v SimulatedValidator) common.Address { return v.StakingContract },
+		func(v SimulatedValidator) common.Address { return v.RewardAddr },
+	}
+	for i, field := range lists {
+		slot := common.BigToHash(big.NewInt(int64(6 + i)))
+		storage[slot] = common.BigToHash(big.NewInt(int64(len(staking.Validators))))
+		elems := new(big.Int).SetBytes(crypto.Keccak256(slot.Bytes()))
+		for j, v := range staking.Validators {
+			elem := new(big.Int).Add(elems, big.NewInt(int64(j)))
+			storage[common.BigToHash(elem)] = common.BytesToHash(field(v).Bytes())
+		}
+	}
+	for i, v := range staking.Validators {
+		key := crypto.Keccak256Hash(common.LeftPadBytes(v.NodeId.Bytes(), 32), common.BigToHash(big.NewInt(5)).Bytes())
+		storage[key] = common.BigToHash(big.NewInt(int64(i)))
+	}
+
+	merged := make(blockchain.GenesisAlloc, len(alloc)+len(staking.Validators)+1)
+	for addr, account := range alloc {
+		merged[addr] = account
+	}
+	merged[params.AddressBookAddr] = blockchain.GenesisAccount{
+		Code:    staking.AddressBookCode,
+		Storage: storage,
+		Balance: common.Big0,
+	}
+	for _, v := range staking.Validators {
+		account := merged[v.StakingContract]
+		balance := new(big.Int)
+		if account.Balance != nil {
+			balance.Set(account.Balance)
+		}
+		if v.StakingAmount != nil {
+			balance.Add(balance, v.StakingAmount)
+		}
+		account.Balance = balance
+		merged[v.StakingContract] = account
+	}
+	return merged, nil
+}
+
+// simulatedEngine runs the hooks before finalizing a block with the wrapped engine.
+type simulatedEngine struct {
+	consensus.Engine
+	hooks []FinalizeHook
+}
+
+func (e *simulatedEngine) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, receipts []*types.Receipt) (*types.Block, error) {
+	for _, hook := range e.hooks {
+		if err := hook(chain, header, state); err != nil {
+			return nil, err
+		}
+	}
+	return e.Engine.Finalize(chain, header, state, txs, receipts)
+}
+
+// govParamsAt returns the governance parameters in effect at the block.
+func (b *SimulatedBackend) govParamsAt(num uint64) *params.GovParamSet {
+	i := sort.Search(len(b.govParams), func(i int) bool { return b.govParams[i].num > num })
+	return b.govParams[i-1].pset
+}
+
+// prepareHeader fills the header fields of the block being generated that the
+// options depend on. It does nothing unless the backend is made by
+// NewSimulatedBackendWithOptions. With a sealing engine, the engine prepares
+// the header except for the base fee.
+func (b *SimulatedBackend) prepareHeader(i int, block *blockchain.BlockGen) {
+	if b.govParams == nil {
+		return
+	}
+	var (
+		config = b.config
+		num    = block.Number()
+		parent = block.PrevBlock(i - 1).Header()
+	)
+	if config.IsMagmaForkEnabled(num) {
+		block.SetBaseFee(misc.NextMagmaBlockBaseFee(parent, b.govParamsAt(num.Uint64()).ToKIP71Config()))
+	}
+	if b.sealer != nil {
+		if err := block.Prepare(); err != nil {
+			panic(err) // This cannot happen unless the simulator is wrong, fail in that case
+		}
+		return
+	}
+	if b.staking == nil {
+		return
+	}
+
+	// The proposer takes turns in the order of the validators.
+	validators := make([]common.Address, len(b.staking.Validators))
+	for i, v := range b.staking.Validators {
+		validators[i] = v.NodeId
+	}
+	proposer := b.staking.Validators[num.Uint64()%uint64(len(validators))]
+	extra, _ := rlp.EncodeToBytes(&types.IstanbulExtra{
+		Validators:    validators,
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	})
+	block.SetExtra(append(make([]byte, types.IstanbulExtraVanity), extra...))
+	block.SetRewardbase(proposer.RewardAddr)
+	block.SetBlockScore(common.Big1)
+
+	// The random reveal is not a valid BLS signature since the validators have
+	// no BLS keys here, but the mix hash is accumulated as the engine does.
+	if config.IsRandaoForkEnabled(num) {
+		randomReveal := make([]byte, len(params.ZeroRandomReveal))
+		copy(randomReveal, crypto.Keccak256(proposer.NodeId.Bytes(), common.BigToHash(num).Bytes()))
+		prevMixHash := parent.MixHash
+		if config.IsRandaoForkBlockParent(parent.Number) || len(prevMixHash) != len(params.ZeroMixHash) {
+			prevMixHash = params.ZeroMixHash
+		}
+		revealHash := crypto.Keccak256(randomReveal)
+		mixHash := make([]byte, len(prevMixHash))
+		for i := range mixHash {
+			mixHash[i] = prevMixHash[i] ^ revealHash[i]
+		}
+		block.SetRandao(randomReveal, mixHash)
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
+	testcontract "github.com/kaiachain/kaia/contracts/contracts/testing/reward"
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/params"
 	"github.com/kaiachain/kaia/storage/database"
@@ -1104,3 +1105,221 @@ func TestSimulatedBackend_CallContractRevert(t *testing.T) {
 		sim.Commit()
 	}
 }
+
+func TestSimulatedBackend_FeeDelegatedTransact(t *testing.T) {
+	var (
+		bgCtx        = context.Background()
+		senderKey, _ = crypto.GenerateKey()
+		feePayerKey  = testKey
+		senderAddr   = crypto.PubkeyToAddress(senderKey.PublicKey)
+		feePayerAddr = crypto.PubkeyToAddress(feePayerKey.PublicKey)
+	)
+	sim := NewSimulatedBackendWithGasPrice(blockchain.GenesisAlloc{
+		feePayerAddr: {Balance: new(big.Int).Mul(big.NewInt(params.KAIA), big.NewInt(100))},
+	}, params.DefaultUnitPrice)
+	defer sim.Close()
+
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	assert.NoError(t, err)
+
+	// The sender without any balance deploys and executes the contract in the fee payer mode.
+	auth := bind.NewKeyedTransactor(senderKey)
+	auth.FeePayer = feePayerAddr
+	auth.FeePayerSigner = bind.NewKeyedFeePayerSigner(feePayerKey)
+
+	feePayerBalance, _ := sim.BalanceAt(bgCtx, feePayerAddr, nil)
+	contractAddr, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(abiBin), sim)
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedSmartContractDeploy, tx.Type())
+	sim.Commit()
+	receipt, _ := sim.TransactionReceipt(bgCtx, tx.Hash())
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	code, _ := sim.CodeAt(bgCtx, contractAddr, nil)
+	assert.Equal(t, common.FromHex(deployedCode), code)
+
+	tx, err = contract.Transact(auth, "receive", []byte("X"))
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedSmartContractExecution, tx.Type())
+	sim.Commit()
+	receipt, _ = sim.TransactionReceipt(bgCtx, tx.Hash())
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	assert.Len(t, receipt.Logs, 2) // received and receivedAddr
+
+	balance, _ := sim.BalanceAt(bgCtx, senderAddr, nil)
+	assert.Zero(t, balance.Sign())
+	balance, _ = sim.BalanceAt(bgCtx, feePayerAddr, nil)
+	assert.Equal(t, -1, balance.Cmp(feePayerBalance))
+
+	// The fee ratio must be valid, and requires the fee payer.
+	noFeePayer := *auth
+	noFeePayer.FeePayer = common.Address{}
+	noFeePayer.FeeRatio = 30
+	_, err = contract.Transact(&noFeePayer, "receive", []byte("X"))
+	assert.ErrorIs(t, err, bind.ErrNoFeePayer)
+
+	auth.FeeRatio = 100
+	_, err = contract.Transact(auth, "receive", []byte("X"))
+	assert.ErrorIs(t, err, bind.ErrInvalidFeeRatio)
+
+	// Without the fee payer signer, the transaction can't be sent.
+	auth.FeeRatio = 30
+	auth.FeePayerSigner = nil
+	_, err = contract.Transact(auth, "receive", []byte("X"))
+	assert.ErrorIs(t, err, bind.ErrNoFeePayerSigner)
+
+	// With NoSend, the transaction signed by the sender is returned unsent to be
+	// handed over to the fee payer.
+	auth.NoSend = true
+	tx, err = contract.Transact(auth, "receive", []byte("X"))
+	assert.NoError(t, err)
+	assert.Equal(t, types.TxTypeFeeDelegatedSmartContractExecutionWithRatio, tx.Type())
+	nonce, _ := sim.PendingNonceAt(bgCtx, senderAddr)
+	assert.Equal(t, uint64(2), nonce)
+
+	// The fee payer signer rejects the transaction of another fee payer.
+	signer := types.LatestSignerForChainID(params.AllGxhashProtocolChanges.ChainID)
+	_, err = bind.NewKeyedFeePayerSigner(senderKey)(signer, feePayerAddr, tx)
+	assert.Error(t, err)
+
+	// The fee payer co-signs the transaction handed over.
+	tx, err = bind.NewKeyedFeePayerSigner(feePayerKey)(signer, feePayerAddr, tx)
+	assert.NoError(t, err)
+	from, err := types.Sender(signer, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, senderAddr, from)
+	txFeePayer, err := types.SenderFeePayer(signer, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, feePayerAddr, txFeePayer)
+}
+
+func TestSimulatedBackend_Options(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		from   = crypto.PubkeyToAddress(key.PublicKey)
+		ctx    = context.Background()
+		gkei   = uint64(params.Gkei)
+
+		config = params.AllGxhashProtocolChanges.Copy()
+		vals   = []SimulatedValidator{
+			{common.HexToAddress("0xa0"), common.HexToAddress("0xa1"), common.HexToAddress("0xa2"), big.NewInt(params.KAIA)},
+			{common.HexToAddress("0xb0"), common.HexToAddress("0xb1"), common.HexToAddress("0xb2"), big.NewInt(params.KAIA)},
+		}
+	)
+	config.ChainID = big.NewInt(1000)
+	config.IstanbulCompatibleBlock = common.Big0
+	config.LondonCompatibleBlock = common.Big0
+	config.EthTxTypeCompatibleBlock = common.Big0
+	config.MagmaCompatibleBlock = big.NewInt(2)
+	config.KoreCompatibleBlock = big.NewInt(2)
+	config.ShanghaiCompatibleBlock = big.NewInt(2)
+	config.CancunCompatibleBlock = big.NewInt(2)
+	config.RandaoCompatibleBlock = big.NewInt(3)
+
+	sim, err := NewSimulatedBackendWithOptions(
+		blockchain.GenesisAlloc{from: {Balance: big.NewInt(params.KAIA)}},
+		WithChainConfig(config),
+		WithGovernanceParams(0, map[string]interface{}{
+			"governance.unitprice":            25 * gkei,
+			"kip71.lowerboundbasefee":         25 * gkei,
+			"kip71.upperboundbasefee":         750 * gkei,
+			"kip71.gastarget":                 uint64(30000000),
+			"kip71.maxblockgasusedforbasefee": uint64(60000000),
+			"kip71.basefeedenominator":        uint64(20),
+		}),
+		WithGovernanceParams(1, map[string]interface{}{"governance.unitprice": 50 * gkei}),
+		WithGovernanceParams(4, map[string]interface{}{"kip71.lowerboundbasefee": 50 * gkei}),
+		WithStaking(SimulatedStaking{Validators: vals, AddressBookCode: common.FromHex(testcontract.AddressBookMockBinRuntime)}),
+	)
+	assert.NoError(t, err)
+	defer sim.Close()
+
+	// The unit price voted on takes effect before the magma hardfork.
+	gasPrice, err := sim.SuggestGasPrice(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).SetUint64(50*gkei), gasPrice)
+	sim.Commit()
+
+	// Transactions are executed with the base fee after the magma hardfork.
+	gasPrice, err = sim.SuggestGasPrice(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).SetUint64(50*gkei), gasPrice)
+	tx := types.NewTransaction(0, common.HexToAddress("0xbeef"), common.Big1, params.TxGas, gasPrice, nil)
+	tx, err = types.SignTx(tx, types.LatestSignerForChainID(config.ChainID), key)
+	assert.NoError(t, err)
+	assert.NoError(t, sim.SendTransaction(ctx, tx))
+	sim.Commit()
+	receipt, err := sim.TransactionReceipt(ctx, tx.Hash())
+	assert.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	sim.Commit()
+	sim.Commit()
+
+	var prevMixHash []byte
+	for num := int64(1); num <= 4; num++ {
+		header, err := sim.HeaderByNumber(ctx, big.NewInt(num))
+		assert.NoError(t, err)
+
+		// The base fee follows the lower bound changed at block 4.
+		switch num {
+		case 1:
+			assert.Nil(t, header.BaseFee)
+		case 2, 3:
+			assert.Equal(t, new(big.Int).SetUint64(25*gkei), header.BaseFee)
+		case 4:
+			assert.Equal(t, new(big.Int).SetUint64(50*gkei), header.BaseFee)
+		}
+
+		// The headers carry the council and the proposer.
+		extra, err := types.ExtractIstanbulExtra(header)
+		assert.NoError(t, err)
+		assert.Equal(t, []common.Address{vals[0].NodeId, vals[1].NodeId}, extra.Validators)
+		assert.Equal(t, vals[num%2].RewardAddr, header.Rewardbase)
+		assert.Equal(t, common.Big1, header.BlockScore)
+
+		// The randao fields are set from the randao hardfork.
+		if num < 3 {
+			assert.Nil(t, header.RandomReveal)
+			assert.Nil(t, header.MixHash)
+			prevMixHash = params.ZeroMixHash
+			continue
+		}
+		assert.Len(t, header.RandomReveal, 96)
+		revealHash := crypto.Keccak256(header.RandomReveal)
+		for i := range revealHash {
+			revealHash[i] ^= prevMixHash[i]
+		}
+		assert.Equal(t, revealHash, header.MixHash)
+		prevMixHash = header.MixHash
+	}
+
+	// The staking contracts hold the staking amounts.
+	for _, v := range vals {
+		balance, err := sim.BalanceAt(ctx, v.StakingContract, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, v.StakingAmount, balance)
+	}
+}
+
+func TestSimulatedBackend_OptionsErrors(t *testing.T) {
+	_, err := NewSimulatedBackendWithOptions(nil, WithGovernanceParams(1, map[string]interface{}{"no.such.param": 1}))
+	assert.Error(t, err)
+
+	config := params.AllGxhashProtocolChanges.Copy()
+	config.IstanbulCompatibleBlock = common.Big0
+	config.LondonCompatibleBlock = common.Big0
+	config.EthTxTypeCompatibleBlock = common.Big0
+	config.MagmaCompatibleBlock = common.Big1
+	_, err = NewSimulatedBackendWithOptions(nil, WithChainConfig(config))
+	assert.ErrorIs(t, err, errNoKIP71Config)
+
+	config = params.AllGxhashProtocolChanges.Copy()
+	config.RandaoCompatibleBlock = common.Big1
+	_, err = NewSimulatedBackendWithOptions(nil, WithChainConfig(config))
+	assert.Error(t, err)
+
+	_, err = NewSimulatedBackendWithOptions(nil, WithStaking(SimulatedStaking{}))
+	assert.ErrorIs(t, err, errNoValidator)
+
+	_, err = NewSimulatedBackendWithOptions(nil, WithStaking(SimulatedStaking{Validators: []SimulatedValidator{{}}}))
+	assert.ErrorIs(t, err, errNoAddressBookCode)
+}
@@ -36,12 +36,17 @@ import (
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/params"
 )
 
 // SignerFn is a signer function callback when a contract requires a method to
 // sign the transaction before submission.
 type SignerFn func(types.Signer, common.Address, *types.Transaction) (*types.Transaction, error)
 
+// FeePayerSignerFn is a signer function callback when a fee-delegated transaction
+// requires the signature of the fee payer before submission.
+type FeePayerSignerFn func(types.Signer, common.Address, *types.Transaction) (*types.Transaction, error)
+
 // CallOpts is the collection of options to fine tune a contract call request.
 type CallOpts struct {
 	Pending     bool            // Whether to operate on the pending state or the last known one
@@ -61,7 +66,15 @@ type TransactOpts struct {
 	GasPrice *big.Int // Gas price to use for the transaction execution (nil = gas price oracle)
 	GasLimit uint64   // Gas limit to set for the transaction execution (0 = estimate)
 
+	// The fee payer mode, where the fee payer pays the transaction fee on behalf of the sender.
+	// The contract deployment and execution are made by the fee-delegated transaction types.
+	FeePayer       common.Address   // Kaia account paying the transaction fee (zero = the sender pays)
+	FeeRatio       uint8            // Percentage of the fee paid by the fee payer, in [1, 99] with FeePayer (0 = all)
+	FeePayerSigner FeePayerSignerFn // Method to sign as the fee payer (mandatory in the fee payer mode unless NoSend)
+
 	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
+
+	NoSend bool // Do all transact steps but do not send the transaction, e.g. to hand it over to the fee payer
 }
 
 // FilterOpts is the collection of options to fine tune filtering for events
@@ -244,6 +257,12 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if opts == nil {
 		return nil, errors.New("nil transactOpts")
 	}
+	if opts.FeeRatio != 0 && !opts.isFeeDelegated() {
+		return nil, ErrNoFeePayer
+	}
+	if opts.isFeeDelegated() && opts.FeePayerSigner == nil && !opts.NoSend {
+		return nil, ErrNoFeePayerSigner
+	}
 
 	// Ensure a valid value field and resolve the account nonce
 	value := opts.Value
@@ -279,14 +298,26 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 		}
 		// If the contract surely has code (or code is not needed), estimate the transaction
 		msg := kaia.CallMsg{From: opts.From, To: contract, GasPrice: gasPrice, Value: value, Data: input}
+		if opts.isFeeDelegated() {
+			// The sender may have no balance to pay the fee.
+			msg.GasPrice = nil
+		}
 		gasLimit, err = c.transactor.EstimateGas(ensureContext(opts.Context), msg)
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas needed: %v", err)
 		}
+		if opts.isFeeDelegated() {
+			gasLimit += opts.feeDelegationGas() + payloadGasMargin(input)
+		}
 	}
 	// Create the transaction, sign it and schedule it for execution
 	var rawTx *types.Transaction
-	if contract == nil {
+	if opts.isFeeDelegated() {
+		rawTx, err = c.newFeeDelegatedTx(opts, contract, nonce, value, gasLimit, gasPrice, input)
+		if err != nil {
+			return nil, err
+		}
+	} else if contract == nil {
 		rawTx = types.NewContractCreation(nonce, value, gasLimit, gasPrice, input)
 	} else {
 		rawTx = types.NewTransaction(nonce, c.address, value, gasLimit, gasPrice, input)
@@ -305,12 +336,83 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if err != nil {
 		return nil, err
 	}
+	if opts.isFeeDelegated() && opts.FeePayerSigner != nil {
+		signedTx, err = opts.FeePayerSigner(signer, opts.FeePayer, signedTx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.NoSend {
+		return signedTx, nil
+	}
 	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx); err != nil {
 		return nil, err
 	}
 	return signedTx, nil
 }
 
+// newFeeDelegatedTx creates the fee-delegated transaction deploying or executing
+// the contract, of which the fee is paid by the fee payer of opts.
+func (c *BoundContract) newFeeDelegatedTx(opts *TransactOpts, contract *common.Address, nonce uint64, value *big.Int, gasLimit uint64, gasPrice *big.Int, input []byte) (*types.Transaction, error) {
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    nonce,
+		types.TxValueKeyFrom:     opts.From,
+		types.TxValueKeyAmount:   value,
+		types.TxValueKeyGasLimit: gasLimit,
+		types.TxValueKeyGasPrice: gasPrice,
+		types.TxValueKeyData:     input,
+		types.TxValueKeyFeePayer: opts.FeePayer,
+	}
+	var txType types.TxType
+	if contract == nil {
+		txType = types.TxTypeSmartContractDeploy
+		values[types.TxValueKeyTo] = (*common.Address)(nil)
+		values[types.TxValueKeyHumanReadable] = false
+		values[types.TxValueKeyCodeFormat] = params.CodeFormatEVM
+	} else {
+		txType = types.TxTypeSmartContractExecution
+		values[types.TxValueKeyTo] = c.address
+	}
+	if opts.FeeRatio != 0 {
+		ratio := types.FeeRatio(opts.FeeRatio)
+		if !ratio.IsValid() {
+			return nil, ErrInvalidFeeRatio
+		}
+		txType += types.TxType(types.TxFeeDelegationWithRatioBitMask)
+		values[types.TxValueKeyFeeRatioOfFeePayer] = ratio
+	} else {
+		txType += types.TxType(types.TxFeeDelegationBitMask)
+	}
+	return types.NewTransactionWithMap(txType, values)
+}
+
+func (opts *TransactOpts) isFeeDelegated() bool {
+	return opts.FeePayer != (common.Address{})
+}
+
+// feeDelegationGas returns the intrinsic gas of the fee delegation, which isn't
+// counted by the gas estimation of the call.
+func (opts *TransactOpts) feeDelegationGas() uint64 {
+	if opts.FeeRatio != 0 {
+		return params.TxGasFeeDelegatedWithRatio
+	}
+	return params.TxGasFeeDelegated
+}
+
+// payloadGasMargin returns the upper bound of the payload gas of a Kaia transaction
+// type exceeding the one counted by the gas estimation of the call. The former is
+// a flat 100 gas per byte before the Prague hardfork.
+func payloadGasMargin(input []byte) uint64 {
+	var nz uint64
+	for _, b := range input {
+		if b != 0 {
+			nz++
+		}
+	}
+	z := uint64(len(input)) - nz
+	return nz*(params.TxDataGas-params.TxDataNonZeroGasEIP2028) + z*(params.TxDataGas-params.TxDataZeroGas)
+}
+
 // FilterLogs filters contract logs for past blocks, returning the necessary
 // channels to construct a strongly typed bound iterator on top of them.
 func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) (chan types.Log, event.Subscription, error) {
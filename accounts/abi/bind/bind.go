@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"go/format"
+	"go/token"
 	"regexp"
 	"strings"
 	"text/template"
@@ -106,7 +107,7 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			normalized.Inputs = make([]abi.Argument, len(original.Inputs))
 			copy(normalized.Inputs, original.Inputs)
 			for j, input := range normalized.Inputs {
-				if input.Name == "" {
+				if input.Name == "" || isKeyWord(input.Name) {
 					normalized.Inputs[j].Name = fmt.Sprintf("arg%d", j)
 				}
 				if hasStruct(input.Type) {
@@ -149,7 +150,7 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			normalized.Inputs = make([]abi.Argument, len(original.Inputs))
 			copy(normalized.Inputs, original.Inputs)
 			for j, input := range normalized.Inputs {
-				if input.Name == "" {
+				if input.Name == "" || isKeyWord(input.Name) {
 					normalized.Inputs[j].Name = fmt.Sprintf("arg%d", j)
 				}
 				if hasStruct(input.Type) {
@@ -539,6 +540,11 @@ var methodNormalizer = map[Lang]func(string) string{
 // capitalise makes a camel-case string which starts with an upper case character.
 var capitalise = abi.ToCamelCase
 
+// isKeyWord returns whether a string is a Go keyword, which cannot be used as an argument name.
+func isKeyWord(arg string) bool {
+	return token.IsKeyword(arg)
+}
+
 // decapitalise makes a camel-case string which starts with a lower case character.
 func decapitalise(input string) string {
 	if len(input) == 0 {
@@ -329,6 +329,8 @@ func (api *EthereumAPI) FeeHistory(ctx context.Context, blockCount DecimalOrHex,
 // - highestBlock:  block number of the highest block header this node has received from peers
 // - pulledStates:  number of state entries processed until now
 // - knownStates:   number of known state entries that still need to be pulled
+// In case of snap sync, the numbers of the downloaded accounts, storage slots and bytecodes, their sizes,
+// and the numbers of the healed and pending trie nodes and bytecodes are also returned.
 func (api *EthereumAPI) Syncing() (interface{}, error) {
 	return api.publicKaiaAPI.Syncing()
 }
@@ -435,7 +437,7 @@ func doGetProof(ctx context.Context, b Backend, address common.Address, storageK
 
 	// if we have a storageTrie, (which means the account exists), we can update the storagehash
 	if len(keys) > 0 {
-		storageTrie, err := statedb.NewTrie(contractStorageRoot, state.Database().TrieDB(), nil)
+		storageTrie, err := statedb.NewTrie(contractStorageRoot, state.Database().TrieDB(), &statedb.TrieOpts{Owner: crypto.Keccak256Hash(address.Bytes()), StateRoot: header.Root})
 		if err != nil {
 			return nil, err
 		}
@@ -1015,7 +1017,7 @@ func (api *EthereumAPI) GetTransactionReceipt(ctx context.Context, hash common.H
 	tx, blockHash, blockNumber, index, receipt := txpoolAPI.GetTxLookupInfoAndReceipt(ctx, hash)
 
 	if tx == nil {
-		return nil, nil
+		return nil, txHistoryPrunedError(txpoolAPI, hash)
 	}
 	receipts := txpoolAPI.GetBlockReceipts(ctx, blockHash)
 	cumulativeGasUsed := uint64(0)
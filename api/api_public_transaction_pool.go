@@ -30,6 +30,7 @@ import (
 	"math/big"
 
 	"github.com/kaiachain/kaia/accounts"
+	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/common/hexutil"
@@ -258,6 +259,11 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceiptBySenderTxHash(ctx conte
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
 func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
 	tx, blockHash, blockNumber, index, receipt := s.b.GetTxLookupInfoAndReceipt(ctx, hash)
+	if tx == nil {
+		if err := txHistoryPrunedError(s.b, hash); err != nil {
+			return nil, err
+		}
+	}
 	return s.getTransactionReceipt(ctx, tx, blockHash, blockNumber, index, receipt)
 }
 
@@ -267,6 +273,22 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceiptInCache(ctx context.Cont
 	return s.getTransactionReceipt(ctx, tx, blockHash, blockNumber, index, receipt)
 }
 
+// txHistoryPrunedError returns a HistoryPrunedError if the transaction may have been
+// included in a block below the history tail. History expiry deletes the tx lookup
+// entries along with the bodies, so a transaction that is neither looked up in a
+// retained block nor in the txpool can't be told apart from a pruned one.
+func txHistoryPrunedError(b Backend, hash common.Hash) error {
+	tail := b.ChainDB().ReadHistoryTail()
+	if tail == 0 {
+		return nil
+	}
+	blockHash, blockNumber, _ := b.ChainDB().ReadTxLookupEntry(hash)
+	if (!common.EmptyHash(blockHash) && blockNumber >= tail) || b.GetPoolTransaction(hash) != nil {
+		return nil
+	}
+	return &blockchain.HistoryPrunedError{Tail: tail}
+}
+
 // getTransactionReceipt returns the transaction receipt for the given transaction hash.
 func (s *PublicTransactionPoolAPI) getTransactionReceipt(ctx context.Context, tx *types.Transaction, blockHash common.Hash,
 	blockNumber uint64, index uint64, receipt *types.Receipt,
@@ -12,11 +12,13 @@ import (
 	"github.com/kaiachain/kaia/accounts/keystore"
 	mock_accounts "github.com/kaiachain/kaia/accounts/mocks"
 	mock_api "github.com/kaiachain/kaia/api/mocks"
+	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/common/hexutil"
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -339,3 +341,33 @@ func testTxTypeSupport_unnecessaryFieldValues(t *testing.T, api PublicTransactio
 		assert.Equal(t, "json:\"feeRatio\" is not a field of "+(*args.TypeInt).String(), err.Error())
 	}
 }
+
+func TestTxHistoryPrunedError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockBackend := mock_api.NewMockBackend(mockCtrl)
+
+	db := database.NewMemoryDBManager()
+	mockBackend.EXPECT().ChainDB().Return(db).AnyTimes()
+	mockBackend.EXPECT().GetPoolTransaction(gomock.Any()).Return(nil).AnyTimes()
+
+	newTx := func(nonce uint64) *types.Transaction {
+		return types.NewTransaction(nonce, common.Address{}, common.Big0, 21000, common.Big0, nil)
+	}
+	prunedTx, retainedTx, unknownTx := newTx(0), newTx(1), newTx(2)
+	db.WriteTxLookupEntries(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(3)}).WithBody([]*types.Transaction{prunedTx}))
+	db.WriteTxLookupEntries(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}).WithBody([]*types.Transaction{retainedTx}))
+
+	// Nothing is reported before history expiry has run.
+	assert.Nil(t, txHistoryPrunedError(mockBackend, prunedTx.Hash()))
+
+	db.WriteHistoryTail(10)
+	assert.Equal(t, &blockchain.HistoryPrunedError{Tail: 10}, txHistoryPrunedError(mockBackend, prunedTx.Hash()))
+	assert.Nil(t, txHistoryPrunedError(mockBackend, retainedTx.Hash()))
+
+	// The lookup entries of the pruned transactions are deleted, hence unknown
+	// transactions are reported as possibly pruned.
+	db.DeleteTxLookupEntry(prunedTx.Hash())
+	assert.Equal(t, &blockchain.HistoryPrunedError{Tail: 10}, txHistoryPrunedError(mockBackend, prunedTx.Hash()))
+	assert.Equal(t, &blockchain.HistoryPrunedError{Tail: 10}, txHistoryPrunedError(mockBackend, unknownTx.Hash()))
+}
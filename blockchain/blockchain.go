@@ -135,6 +135,8 @@ type CacheConfig struct {
 	TrieNodeCacheConfig  *statedb.TrieNodeCacheConfig // Configures trie node cache
 	SnapshotCacheSize    int                          // Memory allowance (MB) to use for caching snapshot entries in memory
 	SnapshotAsyncGen     bool                         // Enables snapshot data generation asynchronously
+	HistoryRetention     uint64                       // Number of recent blocks whose bodies and receipts are kept. If zero, the history is not expired by age.
+	HistoryExpiryBlock   uint64                       // Block number before which bodies and receipts are deleted. If zero, the history is not expired at a fixed block.
 }
 
 // gcBlock is used for priority queue for GC.
@@ -219,6 +221,7 @@ type BlockChain struct {
 
 	// kaiax modules
 	rewindableModules []kaiax.RewindableModule
+	executionModules  []kaiax.ExecutionModule
 }
 
 // prefetchTx is used to prefetch transactions, when fetcher works.
@@ -366,6 +369,7 @@ func NewBlockChain(db database.DBManager, cacheConfig *CacheConfig, chainConfig
 	go bc.update()
 	bc.gcCachedNodeLoop()
 	bc.pruneTrieNodeLoop()
+	bc.historyExpiryLoop()
 	bc.restartStateMigration()
 
 	if cacheConfig.TrieNodeCacheConfig.DumpPeriodically() {
@@ -530,6 +534,11 @@ func (bc *BlockChain) SetHead(head uint64) error {
 				lastPruned, head)
 		}
 	}
+	// Likewise, the blocks behind the history tail cannot be re-executed.
+	if tail := bc.db.ReadHistoryTail(); head < tail {
+		return fmt.Errorf("[SetHead] Cannot rewind to a history-expired block number. historyTail=%d targetHead=%d",
+			tail, head)
+	}
 	_, err := bc.setHeadBeyondRoot(head, common.Hash{}, false)
 	return err
 }
@@ -569,7 +578,7 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 					if root != (common.Hash{}) && !beyondRoot && newHeadBlock.Root() == root {
 						beyondRoot, rootNumber = true, newHeadBlock.NumberU64()
 					}
-					if _, err := state.New(newHeadBlock.Root(), bc.stateCache, bc.snaps, nil); err != nil {
+					if !bc.hasRewoundState(newHeadBlock.Root()) {
 						// Rewound state missing, rolled back to the parent block, reset to genesis
 						logger.Trace("Block state missing, rewinding further", "number", newHeadBlock.NumberU64(), "hash", newHeadBlock.Hash())
 						parent := bc.GetBlock(newHeadBlock.ParentHash(), newHeadBlock.NumberU64()-1)
@@ -938,6 +947,25 @@ func (bc *BlockChain) HasBlock(hash common.Hash, number uint64) bool {
 	return bc.db.HasBlock(hash, number)
 }
 
+// hasRewoundState checks if the state of the given root is available to rewind the
+// chain to. In the path-based scheme, the persisted state is rolled back to the root
+// if possible. The other states in memory are not used, as they may not descend from
+// the persisted state which the following states are committed onto.
+func (bc *BlockChain) hasRewoundState(root common.Hash) bool {
+	if triedb := bc.stateCache.TrieDB(); triedb.Scheme() == database.PathScheme {
+		if triedb.Recoverable(root) {
+			if err := triedb.Recover(root); err != nil {
+				logger.Error("Failed to roll back the persisted state", "root", root, "err", err)
+			}
+		}
+		if triedb.DiskRoot() != root {
+			return false
+		}
+	}
+	_, err := state.New(root, bc.stateCache, bc.snaps, nil)
+	return err == nil
+}
+
 // HasState checks if state trie is fully present in the database or not.
 func (bc *BlockChain) HasState(hash common.Hash) bool {
 	_, err := bc.stateCache.OpenTrie(hash, nil)
@@ -1126,7 +1154,8 @@ func (bc *BlockChain) Stop() {
 		if err := triedb.Commit(recent.Root(), true, number); err != nil {
 			logger.Error("Failed to commit recent state trie", "err", err)
 		}
-		if snapBase != (common.Hash{}) {
+		// The path-based scheme persists only the latest state, and it isn't used with snapshot.
+		if snapBase != (common.Hash{}) && triedb.Scheme() != database.PathScheme {
 			logger.Info("Writing snapshot state to disk", "root", snapBase)
 			if err := triedb.Commit(snapBase, true, number); err != nil {
 				logger.Error("Failed to commit recent state trie", "err", err)
@@ -1389,6 +1418,14 @@ func (bc *BlockChain) writeStateTrie(block *types.Block, state *state.StateDB) e
 	trieDB := bc.stateCache.TrieDB()
 	trieDB.UpdateMetricNodes()
 
+	// Add the state transition of the block in the path-based scheme. It's persisted
+	// when the state is committed.
+	if parent := bc.GetHeader(block.ParentHash(), block.NumberU64()-1); parent != nil {
+		if err := trieDB.Update(root, parent.Root, block.NumberU64()); err != nil {
+			return err
+		}
+	}
+
 	// If we're running an archive node, always flush
 	if bc.isArchiveMode() {
 		if err := trieDB.Commit(root, false, block.NumberU64()); err != nil {
@@ -1588,6 +1625,12 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 		return status, err
 	}
 
+	for _, module := range bc.executionModules {
+		if err := module.PostInsertBlock(block); err != nil {
+			logger.Error("Failed to execute PostInsertBlock", "blockNumber", block.NumberU64(), "err", err)
+		}
+	}
+
 	// Publish the committed block to the redis cache of stateDB.
 	// The cache uses the block to distinguish the latest state.
 	if bc.cacheConfig.TrieNodeCacheConfig.RedisPublishBlockEnable {
@@ -2753,6 +2796,12 @@ func (bc *BlockChain) SaveTrieNodeCacheToDisk() error {
 	return nil
 }
 
+// LoadTrieNodeCacheFromDisk warms up the trie node cache with the cache dump in the given directory.
+// The dump is saved by SaveTrieNodeCacheToDisk, usually on another node.
+func (bc *BlockChain) LoadTrieNodeCacheFromDisk(filePath string) error {
+	return bc.stateCache.TrieDB().LoadTrieNodeCacheFromFile(filePath)
+}
+
 // ApplyTransaction attempts to apply a transaction to the given state database
 // and uses the input parameters for its environment. It returns the receipt
 // for the transaction, gas used and an error if the transaction failed,
@@ -2819,6 +2868,10 @@ func (bc *BlockChain) RewindableModules() []kaiax.RewindableModule {
 	return bc.rewindableModules
 }
 
+func (bc *BlockChain) RegisterExecutionModule(modules ...kaiax.ExecutionModule) {
+	bc.executionModules = append(bc.executionModules, modules...)
+}
+
 func GetInternalTxTrace(tracer vm.Tracer) (*vm.InternalTxTrace, error) {
 	var (
 		internalTxTrace *vm.InternalTxTrace
@@ -72,6 +72,31 @@ func (b *BlockGen) SetGovData(data []byte) {
 	b.header.Governance = data
 }
 
+// SetBlockScore sets the blockscore field of the generated block.
+func (b *BlockGen) SetBlockScore(blockScore *big.Int) {
+	b.header.BlockScore = new(big.Int).Set(blockScore)
+}
+
+// SetBaseFee sets the base fee field of the generated block. It must be called
+// before adding transactions since the base fee is used to execute them.
+func (b *BlockGen) SetBaseFee(baseFee *big.Int) {
+	b.header.BaseFee = new(big.Int).Set(baseFee)
+}
+
+// SetRandao sets the randao fields of the generated block.
+func (b *BlockGen) SetRandao(randomReveal, mixHash []byte) {
+	b.header.RandomReveal = common.CopyBytes(randomReveal)
+	b.header.MixHash = common.CopyBytes(mixHash)
+}
+
+// Prepare runs the Prepare step of the consensus engine on the generated block,
+// e.g. to fill the Istanbul header fields. The header fields set before are
+// overwritten if the engine sets them too. It must be called before adding
+// transactions.
+func (b *BlockGen) Prepare() error {
+	return b.engine.Prepare(b.chainReader, b.header)
+}
+
 // AddTx adds a transaction to the generated block.
 // In gxhash, arbitrary address is used as a block author's address.
 //
@@ -0,0 +1,148 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	// MinHistoryRetention is the minimum number of recent blocks whose bodies and receipts
+	// are kept under history expiry. Recent bodies are needed to regenerate the states
+	// between the persisted tries and to handle short reorgs.
+	MinHistoryRetention = 8 * DefaultBlockInterval
+
+	historyExpiryInterval = time.Minute // Interval between two history expiry passes
+	historyExpiryBatch    = 10000       // Number of blocks pruned before the history tail is advanced again
+
+	// historyPrunedErrorCode is the JSON-RPC error code for requests to expired history.
+	// It is the same code as go-ethereum uses for the data removed by EIP-4444.
+	historyPrunedErrorCode = 4444
+)
+
+var historyTailGauge = metrics.NewRegisteredGauge("chain/history/tail", nil)
+
+// HistoryPrunedError is returned when the requested block body or receipts
+// have been deleted by history expiry.
+type HistoryPrunedError struct {
+	Tail uint64 // The lowest block number whose body and receipts are retained
+}
+
+func (e *HistoryPrunedError) Error() string {
+	return fmt.Sprintf("history pruned: bodies and receipts before block %d are not available", e.Tail)
+}
+
+// ErrorCode returns the JSON-RPC error code for expired history.
+func (e *HistoryPrunedError) ErrorCode() int {
+	return historyPrunedErrorCode
+}
+
+// ErrorData returns the history tail so that clients can look for an archive elsewhere.
+func (e *HistoryPrunedError) ErrorData() interface{} {
+	return map[string]uint64{"historyTail": e.Tail}
+}
+
+// IsHistoryExpiryEnabled returns true if block bodies and receipts are deleted after a while.
+func (bc *BlockChain) IsHistoryExpiryEnabled() bool {
+	return bc.cacheConfig.HistoryRetention != 0 || bc.cacheConfig.HistoryExpiryBlock != 0
+}
+
+// HistoryTail returns the lowest block number whose body and receipts are retained.
+// Zero means that the whole history is available.
+func (bc *BlockChain) HistoryTail() uint64 {
+	return bc.db.ReadHistoryTail()
+}
+
+// historyExpiryTarget returns the new history tail for the given head block number.
+func (bc *BlockChain) historyExpiryTarget(head uint64) uint64 {
+	target := bc.cacheConfig.HistoryExpiryBlock
+	if retention := bc.cacheConfig.HistoryRetention; retention != 0 && head > retention && head-retention > target {
+		target = head - retention
+	}
+	// Never expire the recent blocks regardless of the configuration.
+	if head <= MinHistoryRetention {
+		return 0
+	}
+	if limit := head - MinHistoryRetention; target > limit {
+		target = limit
+	}
+	return target
+}
+
+// pruneHistory deletes the bodies, receipts and tx lookup entries of the canonical blocks
+// in [tail, target). The genesis block is always kept. The history tail is advanced before
+// the data is deleted, so that the retained range is never over-advertised after a crash.
+func (bc *BlockChain) pruneHistory(target uint64) {
+	tail := bc.db.ReadHistoryTail()
+	if tail == 0 {
+		tail = 1
+	}
+	for tail < target {
+		end := tail + historyExpiryBatch
+		if end > target {
+			end = target
+		}
+
+		start := time.Now()
+		bc.db.WriteHistoryTail(end)
+		historyTailGauge.Update(int64(end))
+		for num := tail; num < end; num++ {
+			hash := bc.db.ReadCanonicalHash(num)
+			if hash == (common.Hash{}) {
+				continue
+			}
+			bc.db.DeleteBlockHistory(hash, num)
+		}
+		logger.Info("Expired block history", "from", tail, "to", end-1, "elapsed", time.Since(start))
+		tail = end
+
+		select {
+		case <-bc.quit:
+			return
+		default:
+		}
+	}
+}
+
+// historyExpiryLoop periodically deletes the block history behind the configured retention.
+func (bc *BlockChain) historyExpiryLoop() {
+	if !bc.IsHistoryExpiryEnabled() {
+		return
+	}
+	logger.Info("History expiry is enabled", "retention", bc.cacheConfig.HistoryRetention,
+		"expiryBlock", bc.cacheConfig.HistoryExpiryBlock, "tail", bc.HistoryTail())
+
+	bc.wg.Add(1)
+	go func() {
+		defer bc.wg.Done()
+		ticker := time.NewTicker(historyExpiryInterval)
+		defer ticker.Stop()
+		for {
+			bc.pruneHistory(bc.historyExpiryTarget(bc.CurrentBlock().NumberU64()))
+
+			select {
+			case <-ticker.C:
+			case <-bc.quit:
+				return
+			}
+		}
+	}()
+}
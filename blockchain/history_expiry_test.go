@@ -0,0 +1,118 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/vm"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus/gxhash"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryExpiryTarget(t *testing.T) {
+	testcases := []struct {
+		retention   uint64
+		expiryBlock uint64
+		head        uint64
+		expected    uint64
+	}{
+		{0, 0, 100000, 0},
+		{MinHistoryRetention, 0, MinHistoryRetention, 0},
+		{MinHistoryRetention, 0, 100000, 100000 - MinHistoryRetention},
+		{50000, 0, 100000, 50000},
+		{0, 30000, 100000, 30000},
+		{50000, 30000, 100000, 50000}, // the higher cutoff wins
+		{50000, 80000, 100000, 80000},
+		{0, 100000, 100000, 100000 - MinHistoryRetention}, // recent blocks are always kept
+	}
+	for _, tc := range testcases {
+		bc := &BlockChain{cacheConfig: &CacheConfig{HistoryRetention: tc.retention, HistoryExpiryBlock: tc.expiryBlock}}
+		assert.Equal(t, tc.expected, bc.historyExpiryTarget(tc.head), tc)
+	}
+}
+
+func TestPruneHistory(t *testing.T) {
+	var (
+		db      = database.NewMemoryDBManager()
+		key1, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr1   = crypto.PubkeyToAddress(key1.PublicKey)
+		addr2   = common.HexToAddress("0xaaaa")
+
+		gspec = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  GenesisAlloc{addr1: {Balance: big.NewInt(10000000000000)}},
+		}
+		genesis = gspec.MustCommit(db)
+		signer  = types.LatestSignerForChainID(gspec.Config.ChainID)
+		engine  = gxhash.NewFaker()
+
+		numBlocks = 10
+		tail      = uint64(6) // Blocks 1..5 are expired, blocks 6..10 are kept.
+	)
+
+	blockchain, _ := NewBlockChain(db, nil, gspec.Config, engine, vm.Config{})
+	defer blockchain.Stop()
+
+	chain, _ := GenerateChain(gspec.Config, genesis, engine, db, numBlocks, func(i int, gen *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(
+			gen.TxNonce(addr1), addr2, common.Big1, 21000, common.Big1, nil), signer, key1)
+		gen.AddTx(tx)
+	})
+	_, err := blockchain.InsertChain(chain)
+	require.NoError(t, err)
+	assert.Zero(t, blockchain.HistoryTail())
+
+	blockchain.pruneHistory(tail)
+	assert.Equal(t, tail, blockchain.HistoryTail())
+
+	for _, block := range chain {
+		num, hash, txHash := block.NumberU64(), block.Hash(), block.Transactions()[0].Hash()
+
+		// Headers and the canonical index are always kept.
+		assert.Equal(t, hash, db.ReadCanonicalHash(num), num)
+		assert.NotNil(t, db.ReadHeader(hash, num), num)
+
+		lookupHash, _, _ := db.ReadTxLookupEntry(txHash)
+		if num < tail {
+			assert.False(t, db.HasBody(hash, num), num)
+			assert.Nil(t, db.ReadReceipts(hash, num), num)
+			assert.Equal(t, common.Hash{}, lookupHash, num)
+		} else {
+			assert.True(t, db.HasBody(hash, num), num)
+			assert.NotNil(t, db.ReadReceipts(hash, num), num)
+			assert.Equal(t, hash, lookupHash, num)
+		}
+	}
+
+	// The genesis block is always kept.
+	assert.True(t, db.HasBody(genesis.Hash(), 0))
+
+	// Pruning again with a lower target is a no-op.
+	blockchain.pruneHistory(tail - 1)
+	assert.Equal(t, tail, blockchain.HistoryTail())
+
+	// The blocks behind the tail cannot become the head again.
+	assert.Error(t, blockchain.SetHead(tail-1))
+}
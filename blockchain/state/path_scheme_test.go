@@ -0,0 +1,175 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/kaiachain/kaia/storage/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updatePathSchemeState sets the balances of 100 accounts and the storage of every
+// 10th account, with the values multiplied by the given factor.
+func updatePathSchemeState(t *testing.T, stateDB *StateDB, factor int64) common.Hash {
+	for i := int64(1); i <= 100; i++ {
+		addr := common.BigToAddress(big.NewInt(i))
+		if i%10 == 0 && !stateDB.Exist(addr) {
+			stateDB.CreateSmartContractAccount(addr, params.CodeFormatEVM, params.Rules{})
+		}
+		stateDB.SetBalance(addr, big.NewInt(i*factor))
+		if i%10 == 0 {
+			for j := int64(1); j <= 20; j++ {
+				stateDB.SetState(addr, common.BigToHash(big.NewInt(j)), common.BigToHash(big.NewInt(i*j*factor)))
+			}
+		}
+	}
+	root, err := stateDB.Commit(false)
+	require.NoError(t, err)
+	return root
+}
+
+// checkPathSchemeState checks the state of the given root written by updatePathSchemeState
+// with a new trie database, so that all the nodes are read from the persistent database.
+func checkPathSchemeState(t *testing.T, diskdb database.DBManager, root common.Hash, factor int64) {
+	stateDB, err := New(root, NewDatabase(diskdb), nil, nil)
+	require.NoError(t, err)
+	for i := int64(1); i <= 100; i++ {
+		addr := common.BigToAddress(big.NewInt(i))
+		assert.Equal(t, big.NewInt(i*factor), stateDB.GetBalance(addr))
+		if i%10 == 0 {
+			for j := int64(1); j <= 20; j++ {
+				assert.Equal(t, common.BigToHash(big.NewInt(i*j*factor)), stateDB.GetState(addr, common.BigToHash(big.NewInt(j))))
+			}
+		}
+	}
+	assert.NoError(t, stateDB.Error())
+}
+
+func TestPathSchemeCommit(t *testing.T) {
+	diskdb := database.NewMemoryDBManager()
+	diskdb.WriteStateScheme(database.PathScheme)
+
+	db := NewDatabase(diskdb)
+	require.Equal(t, database.PathScheme, db.TrieDB().Scheme())
+	stateDB, err := New(common.Hash{}, db, nil, nil)
+	require.NoError(t, err)
+	root1 := updatePathSchemeState(t, stateDB, 1)
+	require.NoError(t, db.TrieDB().Commit(root1, false, 1))
+	checkPathSchemeState(t, diskdb, root1, 1)
+
+	// The nodes of the next state overwrite the nodes at the same paths.
+	stateDB, err = New(root1, db, nil, nil)
+	require.NoError(t, err)
+	root2 := updatePathSchemeState(t, stateDB, 2)
+	require.NoError(t, db.TrieDB().Commit(root2, false, 2))
+	checkPathSchemeState(t, diskdb, root2, 2)
+	assert.Equal(t, root2, db.TrieDB().DiskRoot())
+
+	// Only the persisted state can be read, and the previous one can be recovered.
+	_, err = New(root1, NewDatabase(diskdb), nil, nil)
+	assert.Error(t, err)
+	triedb := statedb.NewDatabase(diskdb)
+	require.True(t, triedb.Recoverable(root1))
+	require.NoError(t, triedb.Recover(root1))
+	assert.Equal(t, root1, triedb.DiskRoot())
+	checkPathSchemeState(t, diskdb, root1, 1)
+}
+
+func TestPathSchemeUpdate(t *testing.T) {
+	diskdb := database.NewMemoryDBManager()
+	diskdb.WriteStateScheme(database.PathScheme)
+
+	db := NewDatabase(diskdb)
+	triedb := db.TrieDB()
+	stateDB, err := New(common.Hash{}, db, nil, nil)
+	require.NoError(t, err)
+	root1 := updatePathSchemeState(t, stateDB, 1)
+	require.NoError(t, triedb.Commit(root1, false, 1))
+
+	// Block 2 updates every account, and block 3 clears the storage of an account
+	// and destructs another account.
+	stateDB, err = New(root1, db, nil, nil)
+	require.NoError(t, err)
+	root2 := updatePathSchemeState(t, stateDB, 2)
+	require.NoError(t, triedb.Update(root2, root1, 2))
+
+	cleared, destructed := common.BigToAddress(big.NewInt(10)), common.BigToAddress(big.NewInt(20))
+	stateDB, err = New(root2, db, nil, nil)
+	require.NoError(t, err)
+	for j := int64(1); j <= 20; j++ {
+		stateDB.SetState(cleared, common.BigToHash(big.NewInt(j)), common.Hash{})
+	}
+	stateDB.SelfDestruct(destructed)
+	root3, err := stateDB.Commit(true)
+	require.NoError(t, err)
+	require.NoError(t, triedb.Update(root3, root2, 3))
+
+	// The states are read through the diff layers once dropped from the memory.
+	triedb.Dereference(root2)
+	triedb.Dereference(root3)
+	stateDB, err = New(root2, db, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(400)), stateDB.GetState(destructed, common.BigToHash(big.NewInt(10))))
+	assert.Equal(t, root1, triedb.DiskRoot())
+
+	// The removed paths are deleted from the disk.
+	require.NoError(t, triedb.Commit(root3, false, 3))
+	assert.Equal(t, root3, triedb.DiskRoot())
+	for _, addr := range []common.Address{cleared, destructed} {
+		owner := crypto.Keccak256Hash(addr.Bytes())
+		assert.Empty(t, diskdb.ReadStorageTrieNode(owner, nil), addr)
+	}
+	stateDB, err = New(root3, NewDatabase(diskdb), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(20), stateDB.GetBalance(cleared))
+	assert.Equal(t, common.Hash{}, stateDB.GetState(cleared, common.BigToHash(big.NewInt(1))))
+	assert.False(t, stateDB.Exist(destructed))
+	assert.Equal(t, big.NewInt(60), stateDB.GetBalance(common.BigToAddress(big.NewInt(30))))
+	assert.NoError(t, stateDB.Error())
+}
+
+func TestConvertToPathScheme(t *testing.T) {
+	diskdb := database.NewMemoryDBManager()
+	db := NewDatabase(diskdb)
+	require.Equal(t, database.HashScheme, db.TrieDB().Scheme())
+	stateDB, err := New(common.Hash{}, db, nil, nil)
+	require.NoError(t, err)
+	root := updatePathSchemeState(t, stateDB, 1)
+	require.NoError(t, db.TrieDB().Commit(root, false, 0))
+
+	stats, err := statedb.ConvertToPathScheme(diskdb, root)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), stats.Accounts)
+	assert.Equal(t, uint64(10), stats.StorageTries)
+	assert.Equal(t, database.PathScheme, diskdb.ReadStateScheme())
+
+	triedb := statedb.NewDatabase(diskdb)
+	assert.Equal(t, database.PathScheme, triedb.Scheme())
+	assert.Equal(t, root, triedb.DiskRoot())
+	checkPathSchemeState(t, diskdb, root, 1)
+
+	// The conversion is done only once.
+	_, err = statedb.ConvertToPathScheme(diskdb, root)
+	assert.ErrorIs(t, err, statedb.ErrAlreadyPathScheme)
+}
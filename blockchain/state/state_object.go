@@ -37,6 +37,7 @@ import (
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/kerrors"
 	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/statedb"
 )
 
 var emptyCodeHash = crypto.Keccak256(nil)
@@ -169,7 +170,12 @@ func (s *stateObject) touch() {
 }
 
 func (s *stateObject) openStorageTrie(hash common.ExtHash, db Database) (Trie, error) {
-	return db.OpenStorageTrie(hash, s.db.trieOpts)
+	var opts statedb.TrieOpts
+	if s.db.trieOpts != nil {
+		opts = *s.db.trieOpts
+	}
+	opts.Owner, opts.StateRoot = s.addrHash, s.db.root
+	return db.OpenStorageTrie(hash, &opts)
 }
 
 func (s *stateObject) getStorageTrie(db Database) Trie {
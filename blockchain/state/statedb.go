@@ -65,6 +65,7 @@ type StateDB struct {
 	db       Database
 	trie     Trie
 	trieOpts *statedb.TrieOpts
+	root     common.Hash // The state root which the state was opened with
 
 	snaps         *snapshot.Tree
 	snap          snapshot.Snapshot
@@ -132,6 +133,7 @@ func New(root common.Hash, db Database, snaps *snapshot.Tree, opts *statedb.Trie
 		db:                       db,
 		trie:                     tr,
 		trieOpts:                 opts,
+		root:                     root,
 		snaps:                    snaps,
 		stateObjects:             make(map[common.Address]*stateObject),
 		stateObjectsDirtyStorage: make(map[common.Address]struct{}),
@@ -184,6 +186,7 @@ func (s *StateDB) Reset(root common.Hash) error {
 		return err
 	}
 	s.trie = tr
+	s.root = root
 	s.stateObjects = make(map[common.Address]*stateObject)
 	s.stateObjectsDirty = make(map[common.Address]struct{})
 	s.thash = common.Hash{}
@@ -834,6 +837,7 @@ func (s *StateDB) Copy() *StateDB {
 	state := &StateDB{
 		db:                s.db,
 		trie:              s.db.CopyTrie(s.trie),
+		root:              s.root,
 		stateObjects:      make(map[common.Address]*stateObject, len(s.journal.dirties)),
 		stateObjectsDirty: make(map[common.Address]struct{}, len(s.journal.dirties)),
 		refund:            s.refund,
@@ -31,6 +31,7 @@ import (
 	"github.com/kaiachain/kaia/contracts/contracts/testing/reward"
 	testcontract "github.com/kaiachain/kaia/contracts/contracts/testing/system_contracts"
 	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
 )
 
 var (
@@ -57,7 +58,7 @@ var (
 
 	// Some system contracts are allocated at special addresses.
 	MainnetCreditAddr = common.HexToAddress("0x0000000000000000000000000000000000000000")
-	AddressBookAddr   = common.HexToAddress("0x0000000000000000000000000000000000000400")
+	AddressBookAddr   = params.AddressBookAddr
 	RegistryAddr      = common.HexToAddress("0x0000000000000000000000000000000000000401")
 	MultiCallAddr     = common.HexToAddress("0x0000000000000000000000000000000000000402")
 	// The following addresses are only used for testing.
@@ -86,6 +87,7 @@ var (
 	ErrRebalanceIncorrectBlock   = errors.New("cannot find a proper target block number")
 	ErrRebalanceNotEnoughBalance = errors.New("the sum of zeroed balances are less than the sum of allocated balances")
 	ErrRebalanceBadStatus        = errors.New("rebalance contract is not in proper status")
+	ErrRebalanceUnknownContract  = errors.New("not a treasury rebalance contract")
 	ErrKip113BadResult           = errors.New("KIP113 call returned bad data")
 	ErrKip113BadPop              = errors.New("KIP113 PoP verification failed")
 )
@@ -22,4 +22,10 @@ System contracts are smart contracts that affects the protocol.
 
 - Registry: Stores the canonical system contract addresses.
 
+Besides the changes hardcoded at hardforks, system contracts can be installed or upgraded
+by listing them in params.ChainConfig.SystemContractUpgrades. Each upgrade sets the code,
+storage slots or the proxy implementation of an address at the end of the given block,
+and registers the address to the Registry if a name is given.
+The schedule of a network can be checked with `kcn system-contracts plan`.
+
 */
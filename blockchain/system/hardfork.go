@@ -0,0 +1,68 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package system
+
+import (
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/consensus"
+	"github.com/kaiachain/kaia/params"
+)
+
+// ApplyHardforkChanges applies the state changes scheduled at the hardfork blocks:
+// the treasury rebalancing, the Registry installation, the Mainnet credit
+// contract replacement and the configured system contract upgrades. It is
+// called by the consensus engine when finalizing a block, and can be given to
+// backends.WithFinalizeHook so that a simulated chain follows the same
+// hardfork schedule.
+func ApplyHardforkChanges(chain consensus.ChainReader, header *types.Header, state *state.StateDB) error {
+	config := chain.Config()
+
+	// RebalanceTreasury can modify the global state (state),
+	// so the existing state db should be used to apply the rebalancing result.
+	// Only on the KIP-103 or KIP-160 hardfork block, the following logic should be executed
+	if config.IsKIP160ForkBlock(header.Number) || config.IsKIP103ForkBlock(header.Number) {
+		rebalanceResult, err := RebalanceTreasury(state, chain, header)
+		if err != nil {
+			logger.Error("failed to execute treasury rebalancing. State not changed", "err", err)
+		} else {
+			// Leave the memo in the log for later contract finalization
+			isKIP103 := config.IsKIP103ForkBlock(header.Number) // because memo format differs between KIP-103 and KIP-160
+			logger.Info("successfully executed treasury rebalancing", "memo", string(rebalanceResult.Memo(isKIP103)))
+		}
+	}
+
+	// The Registry contract are installed at RandaoCompatibleBlock with a KIP113 record
+	if config.IsRandaoForkBlock(header.Number) {
+		if err := InstallRegistry(state, config.RandaoRegistry); err != nil {
+			return err
+		}
+	}
+
+	// Replace the Mainnet credit contract
+	if config.IsKaiaForkBlockParent(header.Number) {
+		if config.ChainID.Uint64() == params.MainnetNetworkId && state.GetCode(MainnetCreditAddr) != nil {
+			if err := state.SetCode(MainnetCreditAddr, MainnetCreditV2Code); err != nil {
+				return err
+			}
+			logger.Info("Replaced CypressCredit with CypressCreditV2", "blockNum", header.Number.Uint64())
+		}
+	}
+
+	// Install or upgrade the system contracts scheduled in the chain config
+	return ApplySystemContractUpgrades(config, header.Number, state)
+}
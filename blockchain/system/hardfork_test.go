@@ -0,0 +1,187 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package system
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a simulated backend with ApplyHardforkChanges installs the Registry
+// at the randao hardfork block as the consensus engine does.
+func TestApplyHardforkChanges_Registry(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		ctx        = context.Background()
+		kip113Addr = common.HexToAddress("0xaaaa")
+		owner      = common.HexToAddress("0xffff")
+		config     = params.AllGxhashProtocolChanges.Copy()
+	)
+	config.IstanbulCompatibleBlock = common.Big0
+	config.LondonCompatibleBlock = common.Big0
+	config.EthTxTypeCompatibleBlock = common.Big0
+	config.MagmaCompatibleBlock = common.Big0
+	config.KoreCompatibleBlock = common.Big0
+	config.ShanghaiCompatibleBlock = common.Big0
+	config.CancunCompatibleBlock = common.Big0
+	config.RandaoCompatibleBlock = big.NewInt(2)
+	config.RandaoRegistry = &params.RegistryConfig{
+		Records: map[string]common.Address{Kip113Name: kip113Addr},
+		Owner:   owner,
+	}
+	config.Governance = params.GetDefaultGovernanceConfig()
+
+	backend, err := backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithChainConfig(config),
+		backends.WithFinalizeHook(ApplyHardforkChanges),
+	)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	backend.Commit()
+	code, err := backend.CodeAt(ctx, RegistryAddr, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, code)
+
+	backend.Commit()
+	code, err = backend.CodeAt(ctx, RegistryAddr, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, RegistryCode, code)
+
+	addr, err := ReadActiveAddressFromRegistry(backend, Kip113Name, big.NewInt(2))
+	assert.NoError(t, err)
+	assert.Equal(t, kip113Addr, addr)
+}
+
+// Test that the system contract upgrades in the chain config are applied and registered to the Registry.
+func TestApplyHardforkChanges_SystemContractUpgrades(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		ctx        = context.Background()
+		kip113Addr = common.HexToAddress("0xaaaa")
+		proxyAddr  = common.HexToAddress("0xbbbb")
+		logicAddr1 = common.HexToAddress("0xcccc")
+		logicAddr2 = common.HexToAddress("0xdddd")
+		otherAddr  = common.HexToAddress("0xeeee")
+		owner      = common.HexToAddress("0xffff")
+		config     = params.AllGxhashProtocolChanges.Copy()
+		implSlot   = common.BytesToHash(ImplementationSlot)
+	)
+	config.IstanbulCompatibleBlock = common.Big0
+	config.LondonCompatibleBlock = common.Big0
+	config.EthTxTypeCompatibleBlock = common.Big0
+	config.MagmaCompatibleBlock = common.Big0
+	config.KoreCompatibleBlock = common.Big0
+	config.ShanghaiCompatibleBlock = common.Big0
+	config.CancunCompatibleBlock = common.Big0
+	config.RandaoCompatibleBlock = big.NewInt(2)
+	config.RandaoRegistry = &params.RegistryConfig{
+		Records: map[string]common.Address{Kip113Name: kip113Addr},
+		Owner:   owner,
+	}
+	config.SystemContractUpgrades = []*params.SystemContractUpgrade{
+		{ // Install a new proxy and replace the KIP113 record
+			Block:          big.NewInt(3),
+			Name:           Kip113Name,
+			Address:        proxyAddr,
+			Code:           ERC1967ProxyCode,
+			Storage:        map[common.Hash]common.Hash{{}: common.BigToHash(common.Big1)},
+			Implementation: &logicAddr1,
+		},
+		{ // Install a contract under a new name
+			Block:   big.NewInt(3),
+			Name:    "TestContract",
+			Address: otherAddr,
+			Code:    MultiCallCode,
+		},
+		{ // Upgrade the proxy without registration
+			Block:          big.NewInt(4),
+			Address:        proxyAddr,
+			Implementation: &logicAddr2,
+		},
+	}
+	config.Governance = params.GetDefaultGovernanceConfig()
+
+	backend, err := backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithChainConfig(config),
+		backends.WithFinalizeHook(ApplyHardforkChanges),
+	)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	// Block 1, 2: Registry installed
+	backend.Commit()
+	backend.Commit()
+	code, err := backend.CodeAt(ctx, proxyAddr, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, code)
+
+	// Block 3: Installed and registered
+	backend.Commit()
+	code, err = backend.CodeAt(ctx, proxyAddr, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, ERC1967ProxyCode, code)
+	code, err = backend.CodeAt(ctx, otherAddr, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, MultiCallCode, code)
+
+	value, err := backend.StorageAt(ctx, proxyAddr, implSlot, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BytesToHash(logicAddr1.Bytes()).Bytes(), value)
+	value, err = backend.StorageAt(ctx, proxyAddr, common.Hash{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BigToHash(common.Big1).Bytes(), value)
+
+	records, err := ReadAllRecordsFromRegistry(backend, Kip113Name, big.NewInt(3))
+	assert.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, kip113Addr, records[0].Addr)
+	assert.Equal(t, proxyAddr, records[1].Addr)
+	assert.Equal(t, big.NewInt(4), records[1].Activation)
+
+	caller, err := GetRegistryCaller(backend, big.NewInt(3))
+	require.NoError(t, err)
+	names, err := caller.GetAllNames(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{Kip113Name, "TestContract"}, names)
+
+	// The new record is active from the next block.
+	addr, err := ReadActiveAddressFromRegistry(backend, Kip113Name, big.NewInt(3))
+	assert.NoError(t, err)
+	assert.Equal(t, kip113Addr, addr)
+
+	// Block 4: Proxy upgraded
+	backend.Commit()
+	value, err = backend.StorageAt(ctx, proxyAddr, implSlot, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, common.BytesToHash(logicAddr2.Bytes()).Bytes(), value)
+
+	addr, err = ReadActiveAddressFromRegistry(backend, Kip113Name, big.NewInt(4))
+	assert.NoError(t, err)
+	assert.Equal(t, proxyAddr, addr)
+	addr, err = ReadActiveAddressFromRegistry(backend, "TestContract", big.NewInt(4))
+	assert.NoError(t, err)
+	assert.Equal(t, otherAddr, addr)
+}
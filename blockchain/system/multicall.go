@@ -41,7 +41,10 @@ type ContractCallerForMultiCall struct {
 }
 
 func (caller *ContractCallerForMultiCall) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
-	return MultiCallCode, nil
+	if contract == MultiCallAddr {
+		return MultiCallCode, nil
+	}
+	return caller.state.GetCode(contract), nil
 }
 
 // CallContract injects a multicall contract code into the state and executes the call.
@@ -76,6 +79,12 @@ func (caller *ContractCallerForMultiCall) CallContract(ctx context.Context, call
 
 // NewMultiCallContractCaller creates a new instance of ContractCaller for MultiCall contract.
 func NewMultiCallContractCaller(state *state.StateDB, chain backends.BlockChainForCaller, header *types.Header) (*multicall.MultiCallContractCaller, error) {
-	c := &ContractCallerForMultiCall{state.Copy(), chain, header} // Copy the state to prevent the original state from being modified.
-	return multicall.NewMultiCallContractCaller(MultiCallAddr, c)
+	return multicall.NewMultiCallContractCaller(MultiCallAddr, NewContractCallerForMultiCall(state, chain, header))
+}
+
+// NewContractCallerForMultiCall creates a ContractCaller that executes the calls to any contract
+// on a copy of the state with the MultiCall contract injected, so that many contracts can be read
+// at a block without opening the state for each call.
+func NewContractCallerForMultiCall(state *state.StateDB, chain backends.BlockChainForCaller, header *types.Header) *ContractCallerForMultiCall {
+	return &ContractCallerForMultiCall{state.Copy(), chain, header} // Copy the state to prevent the original state from being modified.
 }
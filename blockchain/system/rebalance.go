@@ -19,10 +19,12 @@
 package system
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"math/big"
+	"sort"
 
 	"github.com/kaiachain/kaia"
 	"github.com/kaiachain/kaia/accounts/abi/bind"
@@ -62,7 +64,13 @@ type Kip103ContractCaller struct {
 // NewKip103ContractCaller creates a new instance of TreasuryRebalanceCaller.
 func NewKip103ContractCaller(state *state.StateDB, chain backends.BlockChainForCaller, header *types.Header,
 ) (*Kip103ContractCaller, error) {
-	caller, err := rebalance.NewTreasuryRebalanceCaller(chain.Config().Kip103ContractAddress,
+	return newKip103ContractCallerAt(chain.Config().Kip103ContractAddress, state, chain, header)
+}
+
+// newKip103ContractCallerAt creates a Kip103ContractCaller of the contract at the given address.
+func newKip103ContractCallerAt(contractAddr common.Address, state *state.StateDB, chain backends.BlockChainForCaller, header *types.Header,
+) (*Kip103ContractCaller, error) {
+	caller, err := rebalance.NewTreasuryRebalanceCaller(contractAddr,
 		&Kip103ContractCaller{state: state, chain: chain, header: header},
 	)
 	if err != nil {
@@ -272,37 +280,67 @@ func RebalanceTreasury(state *state.StateDB, chain backends.BlockChainForCaller,
 		return nil, err
 	}
 
-	// Retrieve 1) Get Zeroed
-	if err = result.fillZeroed(caller, state); err != nil {
+	if err = result.fill(caller, state); err != nil {
 		return result, err
 	}
+	if errs := result.validate(caller, header.Number, isKIP103, false); len(errs) > 0 {
+		return result, errs[0]
+	}
+	result.execute(state)
+	return result, nil
+}
 
-	// Retrieve 2) Get Allocated
-	if err = result.fillAllocated(caller, state); err != nil {
-		return result, err
+// fill retrieves the zeroeds and allocateds from the contract along with their current balances.
+func (result *rebalanceResult) fill(caller RebalanceCaller, state *state.StateDB) error {
+	// Retrieve 1) Get Zeroed
+	if err := result.fillZeroed(caller, state); err != nil {
+		return err
 	}
 
+	// Retrieve 2) Get Allocated
+	return result.fillAllocated(caller, state)
+}
+
+// validate checks whether the rebalance can be executed at the block `number`.
+// If `all` is false, it stops at the first failed validation. Otherwise, it returns all the failures.
+func (result *rebalanceResult) validate(caller RebalanceCaller, number *big.Int, isKIP103 bool, all bool) []error {
+	var errs []error
+
 	// Validation 1) Check the target block number
-	if blockNum, err := caller.RebalanceBlockNumber(nil); err != nil || blockNum.Cmp(header.Number) != 0 {
-		return result, ErrRebalanceIncorrectBlock
+	if blockNum, err := caller.RebalanceBlockNumber(nil); err != nil || blockNum.Cmp(number) != 0 {
+		if errs = append(errs, ErrRebalanceIncorrectBlock); !all {
+			return errs
+		}
 	}
 
 	// Validation 2) Check whether status is approved. It should be 2 meaning approved
 	if status, err := caller.Status(nil); err != nil || status != 2 {
-		return result, ErrRebalanceBadStatus
+		if errs = append(errs, ErrRebalanceBadStatus); !all {
+			return errs
+		}
 	}
 
 	// Validation 3) Check approvals from zeroeds
-	if err = caller.CheckZeroedsApproved(nil); err != nil {
-		return result, err
+	if err := caller.CheckZeroedsApproved(nil); err != nil {
+		if errs = append(errs, err); !all {
+			return errs
+		}
 	}
 
 	// Validation 4) Check the total balance of zeroeds are bigger than the distributing amount
 	totalZeroedAmount := result.totalZeroedBalance()
 	totalAllocatedAmount := result.totalAllocatedBalance()
 	if isKIP103 && totalZeroedAmount.Cmp(totalAllocatedAmount) < 0 {
-		return result, ErrRebalanceNotEnoughBalance
+		errs = append(errs, ErrRebalanceNotEnoughBalance)
 	}
+	return errs
+}
+
+// execute clears the balances of zeroeds and sets the balances of allocateds.
+// The difference between the removed and the allocated amounts is recorded as burnt.
+func (result *rebalanceResult) execute(state *state.StateDB) {
+	totalZeroedAmount := result.totalZeroedBalance()
+	totalAllocatedAmount := result.totalAllocatedBalance()
 
 	// Execution 1) Clear all balances of zeroeds
 	for addr := range result.Before.Zeroed {
@@ -322,5 +360,127 @@ func RebalanceTreasury(state *state.StateDB, chain backends.BlockChainForCaller,
 	remainder := new(big.Int).Sub(totalZeroedAmount, totalAllocatedAmount)
 	result.Burnt.Add(result.Burnt, remainder)
 	result.Success = true
-	return result, nil
+}
+
+// RebalanceAccount is the balance change of an account by the treasury rebalance.
+type RebalanceAccount struct {
+	Address common.Address `json:"address"`
+	Before  *big.Int       `json:"before"`
+	After   *big.Int       `json:"after"`
+}
+
+// RebalanceSimulation is the outcome of a treasury rebalance dry-run.
+type RebalanceSimulation struct {
+	Contract             common.Address     `json:"contract"`
+	Kind                 string             `json:"kind"`        // "kip103" or "kip160"
+	BlockNumber          uint64             `json:"blockNumber"` // The block number the rebalance is simulated at.
+	RebalanceBlockNumber *big.Int           `json:"rebalanceBlockNumber"`
+	Status               uint8              `json:"status"`
+	Zeroed               []RebalanceAccount `json:"zeroed"`
+	Allocated            []RebalanceAccount `json:"allocated"`
+	TotalZeroed          *big.Int           `json:"totalZeroed"`
+	TotalAllocated       *big.Int           `json:"totalAllocated"`
+	Burnt                *big.Int           `json:"burnt"`
+	Success              bool               `json:"success"`
+	Errors               []string           `json:"errors"` // The validations that would fail. Empty if Success.
+	Memo                 json.RawMessage    `json:"memo"`
+}
+
+// SimulateRebalanceTreasury runs the treasury rebalance of the given contract on top of the state
+// of the `parent` block, as if the next block were the rebalance block. The given state is not modified.
+// Unlike RebalanceTreasury, all failed validations are reported and the rebalance is executed regardless,
+// so that the resulting balances and memo can be examined before the hardfork.
+func SimulateRebalanceTreasury(state *state.StateDB, chain backends.BlockChainForCaller, parent *types.Header, contractAddr common.Address) (*RebalanceSimulation, error) {
+	var (
+		statedb = state.Copy()
+		header  = types.CopyHeader(parent)
+		result  = newRebalanceReceipt()
+	)
+	header.ParentHash = parent.Hash()
+	header.Number = new(big.Int).Add(parent.Number, common.Big1)
+
+	caller, isKIP103, err := newRebalanceCallerAt(contractAddr, statedb, chain, header)
+	if err != nil {
+		return nil, err
+	}
+	if err := result.fill(caller, statedb); err != nil {
+		return nil, err
+	}
+
+	sim := &RebalanceSimulation{
+		Contract:       contractAddr,
+		Kind:           "kip160",
+		BlockNumber:    header.Number.Uint64(),
+		TotalZeroed:    result.totalZeroedBalance(),
+		TotalAllocated: result.totalAllocatedBalance(),
+		Errors:         []string{},
+	}
+	if isKIP103 {
+		sim.Kind = "kip103"
+	}
+	if sim.RebalanceBlockNumber, err = caller.RebalanceBlockNumber(nil); err != nil {
+		return nil, err
+	}
+	if sim.Status, err = caller.Status(nil); err != nil {
+		return nil, err
+	}
+
+	errs := result.validate(caller, header.Number, isKIP103, true)
+	for _, err := range errs {
+		sim.Errors = append(sim.Errors, err.Error())
+	}
+	result.execute(statedb)
+	result.Success = len(errs) == 0
+
+	sim.Zeroed = sortedRebalanceAccounts(result.Before.Zeroed, result.After.Zeroed)
+	sim.Allocated = sortedRebalanceAccounts(result.Before.Allocated, result.After.Allocated)
+	sim.Burnt = result.Burnt
+	sim.Success = result.Success
+	sim.Memo = result.Memo(isKIP103)
+	return sim, nil
+}
+
+// newRebalanceCallerAt creates a RebalanceCaller of the contract that reads the given state.
+// The contract kind is taken from the chain config if configured, or detected from its interface otherwise.
+func newRebalanceCallerAt(contractAddr common.Address, state *state.StateDB, chain backends.BlockChainForCaller, header *types.Header) (RebalanceCaller, bool, error) {
+	if len(state.GetCode(contractAddr)) == 0 {
+		return nil, false, ErrRebalanceUnknownContract
+	}
+
+	var (
+		config   = chain.Config()
+		isKIP103 = config.Kip103ContractAddress == contractAddr
+		isKIP160 = config.Kip160ContractAddress == contractAddr
+		backend  = &Kip103ContractCaller{state: state, chain: chain, header: header} // The bind.ContractCaller reading the given state
+	)
+
+	if !isKIP103 {
+		caller, err := rebalance.NewTreasuryRebalanceV2Caller(contractAddr, backend)
+		if err != nil {
+			return nil, false, err
+		}
+		if _, err := caller.GetZeroedCount(nil); err == nil || isKIP160 {
+			return caller, false, nil
+		}
+	}
+
+	caller, err := newKip103ContractCallerAt(contractAddr, state, chain, header)
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := caller.GetZeroedCount(nil); err != nil {
+		return nil, false, ErrRebalanceUnknownContract
+	}
+	return caller, true, nil
+}
+
+func sortedRebalanceAccounts(before, after map[common.Address]*big.Int) []RebalanceAccount {
+	accounts := make([]RebalanceAccount, 0, len(before))
+	for addr, balance := range before {
+		accounts = append(accounts, RebalanceAccount{Address: addr, Before: balance, After: after[addr]})
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Address.Bytes(), accounts[j].Address.Bytes()) < 0
+	})
+	return accounts
 }
@@ -256,3 +256,103 @@ func rebalanceTreasury(t *testing.T, sender *bind.TransactOpts, config *params.C
 		}
 	}
 }
+
+func TestSimulateRebalanceTreasury(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		senderKey, _     = crypto.GenerateKey()
+		sender           = bind.NewKeyedTransactor(senderKey)
+		rebalanceAddress = common.HexToAddress("0x1030")
+
+		zeroedAddrs    = []common.Address{common.HexToAddress("0xaa00"), common.HexToAddress("0xaa11")}
+		allocatedAddrs = []common.Address{common.HexToAddress("0xbb00"), common.HexToAddress("0xbb11")}
+
+		alloc = blockchain.GenesisAlloc{
+			sender.From:       {Balance: big.NewInt(params.KAIA)},
+			zeroedAddrs[0]:    {Balance: big.NewInt(4_000_000)},
+			zeroedAddrs[1]:    {Balance: big.NewInt(2_000_000)},
+			allocatedAddrs[0]: {Balance: big.NewInt(1_000)},
+		}
+	)
+
+	testCases := []struct {
+		name             string
+		code             []byte
+		rebalanceBlock   *big.Int
+		status           uint8
+		allocatedAmounts []*big.Int
+
+		expectKind  string
+		expectErrs  []string
+		expectBurnt *big.Int
+	}{
+		{"kip103 ok", Kip103MockCode, big.NewInt(2), EnumRebalanceStatus_Approved, []*big.Int{big.NewInt(3_000_000), big.NewInt(2_000_000)},
+			"kip103", []string{}, big.NewInt(1_001_000)},
+		{"kip160 ok", Kip160MockCode, big.NewInt(2), EnumRebalanceStatus_Approved, []*big.Int{big.NewInt(3_000_000), big.NewInt(2_000_000)},
+			"kip160", []string{}, big.NewInt(1_001_000)},
+		{"kip103 not ready", Kip103MockCode, big.NewInt(100), EnumRebalanceStatus_Registered, []*big.Int{big.NewInt(5_000_000), big.NewInt(2_000_000)},
+			"kip103", []string{ErrRebalanceIncorrectBlock.Error(), ErrRebalanceBadStatus.Error(), ErrRebalanceNotEnoughBalance.Error()}, big.NewInt(-999_000)},
+		{"kip160 not ready", Kip160MockCode, big.NewInt(100), EnumRebalanceStatus_Registered, []*big.Int{big.NewInt(5_000_000), big.NewInt(2_000_000)},
+			"kip160", []string{ErrRebalanceIncorrectBlock.Error(), ErrRebalanceBadStatus.Error()}, big.NewInt(-999_000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesisAlloc := blockchain.GenesisAlloc{rebalanceAddress: {Code: tc.code, Balance: common.Big0}}
+			for addr, account := range alloc {
+				genesisAlloc[addr] = account
+			}
+			var (
+				db      = database.NewMemoryDBManager()
+				backend = backends.NewSimulatedBackendWithDatabase(db, genesisAlloc, &params.ChainConfig{})
+				chain   = backend.BlockChain()
+				err     error
+			)
+
+			// Set the contract at block 1, and simulate the rebalance at block 2.
+			if tc.expectKind == "kip160" {
+				contract, _ := system_contracts.NewTreasuryRebalanceMockV2Transactor(rebalanceAddress, backend)
+				_, err = contract.TestSetAll(sender, zeroedAddrs, allocatedAddrs, tc.allocatedAmounts, tc.rebalanceBlock, tc.status)
+			} else {
+				contract, _ := system_contracts.NewTreasuryRebalanceMockTransactor(rebalanceAddress, backend)
+				_, err = contract.TestSetAll(sender, zeroedAddrs, allocatedAddrs, tc.allocatedAmounts, tc.rebalanceBlock, tc.status)
+			}
+			assert.Nil(t, err)
+			backend.Commit()
+
+			state, err := chain.State()
+			assert.Nil(t, err)
+			sim, err := SimulateRebalanceTreasury(state, chain, chain.CurrentHeader(), rebalanceAddress)
+			assert.Nil(t, err)
+
+			assert.Equal(t, tc.expectKind, sim.Kind)
+			assert.Equal(t, uint64(2), sim.BlockNumber)
+			assert.Equal(t, tc.rebalanceBlock, sim.RebalanceBlockNumber)
+			assert.Equal(t, tc.status, sim.Status)
+			assert.Equal(t, tc.expectErrs, sim.Errors)
+			assert.Equal(t, len(tc.expectErrs) == 0, sim.Success)
+			assert.Equal(t, tc.expectBurnt, sim.Burnt)
+			assert.NotEmpty(t, sim.Memo)
+
+			assert.Equal(t, []RebalanceAccount{
+				{zeroedAddrs[0], big.NewInt(4_000_000), big.NewInt(0)},
+				{zeroedAddrs[1], big.NewInt(2_000_000), big.NewInt(0)},
+			}, sim.Zeroed)
+			assert.Equal(t, []RebalanceAccount{
+				{allocatedAddrs[0], big.NewInt(1_000), tc.allocatedAmounts[0]},
+				{allocatedAddrs[1], big.NewInt(0), tc.allocatedAmounts[1]},
+			}, sim.Allocated)
+
+			// The given state must not be modified.
+			assert.Equal(t, big.NewInt(4_000_000), state.GetBalance(zeroedAddrs[0]))
+			assert.Equal(t, big.NewInt(1_000), state.GetBalance(allocatedAddrs[0]))
+		})
+	}
+
+	// Not a rebalance contract
+	backend := backends.NewSimulatedBackendWithDatabase(database.NewMemoryDBManager(), alloc, &params.ChainConfig{})
+	state, err := backend.BlockChain().State()
+	assert.Nil(t, err)
+	_, err = SimulateRebalanceTreasury(state, backend.BlockChain(), backend.BlockChain().CurrentHeader(), rebalanceAddress)
+	assert.Equal(t, ErrRebalanceUnknownContract, err)
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package system
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/params"
+)
+
+// ApplySystemContractUpgrades applies the system contract upgrades scheduled at the block num
+// in params.ChainConfig.SystemContractUpgrades.
+func ApplySystemContractUpgrades(config *params.ChainConfig, num *big.Int, state *state.StateDB) error {
+	for _, upgrade := range config.SystemContractUpgradesAt(num) {
+		if err := applySystemContractUpgrade(state, upgrade, num); err != nil {
+			return err
+		}
+		logger.Info("Applied system contract upgrade", "blockNum", num.Uint64(), "name", upgrade.Name, "address", upgrade.Address.Hex())
+	}
+	return nil
+}
+
+func applySystemContractUpgrade(state *state.StateDB, upgrade *params.SystemContractUpgrade, num *big.Int) error {
+	addr := upgrade.Address
+
+	if len(upgrade.Code) > 0 {
+		if err := state.SetCode(addr, upgrade.Code); err != nil {
+			return err
+		}
+	}
+	for key, value := range upgrade.Storage {
+		state.SetState(addr, key, value)
+	}
+	if upgrade.Implementation != nil {
+		for key, value := range AllocProxy(*upgrade.Implementation) {
+			state.SetState(addr, key, value)
+		}
+	}
+	if upgrade.Name != "" {
+		// Activate from the next block, because the upgrade is applied at the end of the block.
+		activation := new(big.Int).Add(num, common.Big1)
+		if err := RegisterToRegistry(state, upgrade.Name, addr, activation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterToRegistry adds a record to the Registry by directly modifying its storage,
+// following the same rule as Registry.register(): if the last record of the name is not
+// activated yet, it is replaced. Otherwise, a new record is appended.
+func RegisterToRegistry(state *state.StateDB, name string, addr common.Address, activation *big.Int) error {
+	if len(state.GetCode(RegistryAddr)) == 0 {
+		return ErrRegistryNotInstalled
+	}
+
+	// slot[0]: mapping(string => Record[]) records;
+	arraySlot := calcMappingSlot(0, name, 0)
+	length := state.GetState(RegistryAddr, arraySlot).Big()
+
+	index := int(length.Int64())
+	if index > 0 {
+		lastActivation := state.GetState(RegistryAddr, calcArraySlot(arraySlot, 2, index-1, 1)).Big()
+		if lastActivation.Cmp(activation) >= 0 {
+			index-- // The last record is still pending when the new one activates; overwrite it.
+		}
+	} else {
+		// slot[1]: string[] names;
+		namesLen := int(state.GetState(RegistryAddr, lpad32(1)).Big().Int64())
+		nameSlot := calcArraySlot(1, 1, namesLen, 0)
+		for k, v := range allocDynamicData(nameSlot, []byte(name)) {
+			state.SetState(RegistryAddr, k, v)
+		}
+		state.SetState(RegistryAddr, lpad32(1), lpad32(namesLen+1))
+	}
+
+	state.SetState(RegistryAddr, calcArraySlot(arraySlot, 2, index, 0), lpad32(addr))
+	state.SetState(RegistryAddr, calcArraySlot(arraySlot, 2, index, 1), lpad32(activation))
+	if index == int(length.Int64()) {
+		state.SetState(RegistryAddr, arraySlot, lpad32(index+1))
+	}
+	return nil
+}
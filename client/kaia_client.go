@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/kaiachain/kaia"
 	"github.com/kaiachain/kaia/api"
@@ -40,10 +41,22 @@ import (
 
 // TODO-Kaia Needs to separate APIs along with each namespaces.
 
+// RPCClient is the connection to the RPC servers used by Client. Both rpc.Client
+// and MultiClient implement it.
+type RPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+	KaiaSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error)
+	SetHeader(key, value string)
+	Close()
+}
+
 // Client defines typed wrappers for the Kaia RPC API.
 type Client struct {
-	c       *rpc.Client
+	c       RPCClient
 	chainID *big.Int
+
+	pollInterval time.Duration // Interval of polling the filters if notifications are unsupported
 }
 
 // Dial connects a client to the given URL.
@@ -61,7 +74,13 @@ func DialContext(ctx context.Context, rawurl string) (*Client, error) {
 
 // NewClient creates a client that uses the given RPC client.
 func NewClient(c *rpc.Client) *Client {
-	return &Client{c, nil}
+	return NewClientWithRPC(c)
+}
+
+// NewClientWithRPC creates a client that uses the given RPC connection, e.g.
+// a MultiClient spreading the requests over several endpoints.
+func NewClientWithRPC(c RPCClient) *Client {
+	return &Client{c: c, pollInterval: defaultPollInterval}
 }
 
 func (ec *Client) Close() {
@@ -276,6 +295,19 @@ type rpcProgress struct {
 	HighestBlock  hexutil.Uint64
 	PulledStates  hexutil.Uint64
 	KnownStates   hexutil.Uint64
+
+	SyncedAccounts      hexutil.Uint64
+	SyncedAccountBytes  hexutil.Uint64
+	SyncedBytecodes     hexutil.Uint64
+	SyncedBytecodeBytes hexutil.Uint64
+	SyncedStorage       hexutil.Uint64
+	SyncedStorageBytes  hexutil.Uint64
+	HealedTrienodes     hexutil.Uint64
+	HealedTrienodeBytes hexutil.Uint64
+	HealedBytecodes     hexutil.Uint64
+	HealedBytecodeBytes hexutil.Uint64
+	HealingTrienodes    hexutil.Uint64
+	HealingBytecode     hexutil.Uint64
 }
 
 // SyncProgress retrieves the current progress of the sync algorithm. If there's
@@ -300,13 +332,33 @@ func (ec *Client) SyncProgress(ctx context.Context) (*kaia.SyncProgress, error)
 		HighestBlock:  uint64(progress.HighestBlock),
 		PulledStates:  uint64(progress.PulledStates),
 		KnownStates:   uint64(progress.KnownStates),
+
+		SyncedAccounts:      uint64(progress.SyncedAccounts),
+		SyncedAccountBytes:  uint64(progress.SyncedAccountBytes),
+		SyncedBytecodes:     uint64(progress.SyncedBytecodes),
+		SyncedBytecodeBytes: uint64(progress.SyncedBytecodeBytes),
+		SyncedStorage:       uint64(progress.SyncedStorage),
+		SyncedStorageBytes:  uint64(progress.SyncedStorageBytes),
+		HealedTrienodes:     uint64(progress.HealedTrienodes),
+		HealedTrienodeBytes: uint64(progress.HealedTrienodeBytes),
+		HealedBytecodes:     uint64(progress.HealedBytecodes),
+		HealedBytecodeBytes: uint64(progress.HealedBytecodeBytes),
+		HealingTrienodes:    uint64(progress.HealingTrienodes),
+		HealingBytecode:     uint64(progress.HealingBytecode),
 	}, nil
 }
 
 // SubscribeNewHead subscribes to notifications about the current blockchain head
-// on the given channel.
+// on the given channel. Over HTTP, it polls a block filter instead.
 func (ec *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (kaia.Subscription, error) {
-	return ec.c.KaiaSubscribe(ctx, ch, "newHeads")
+	sub, err := ec.c.KaiaSubscribe(ctx, ch, "newHeads")
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return ec.subscribeByPolling(ctx, &headPoller{ec: ec, ch: ch})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
 }
 
 // State Access
@@ -366,8 +418,16 @@ func (ec *Client) FilterLogs(ctx context.Context, q kaia.FilterQuery) ([]types.L
 }
 
 // SubscribeFilterLogs subscribes to the results of a streaming filter query.
+// Over HTTP, it polls a log filter instead.
 func (ec *Client) SubscribeFilterLogs(ctx context.Context, q kaia.FilterQuery, ch chan<- types.Log) (kaia.Subscription, error) {
-	return ec.c.KaiaSubscribe(ctx, ch, "logs", toFilterArg(q))
+	sub, err := ec.c.KaiaSubscribe(ctx, ch, "logs", toFilterArg(q))
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return ec.subscribeByPolling(ctx, &logPoller{ec: ec, q: q, ch: ch})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
 }
 
 func toFilterArg(q kaia.FilterQuery) interface{} {
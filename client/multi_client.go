@@ -0,0 +1,529 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/networks/rpc"
+)
+
+var (
+	errNoEndpoint        = errors.New("no endpoint is given")
+	errNoHealthyEndpoint = errors.New("no healthy endpoint")
+)
+
+// MultiClientConfig is the configuration of MultiClient.
+type MultiClientConfig struct {
+	// MaxBlockLag is the number of blocks an endpoint may fall behind the best
+	// head of the endpoints before it is dropped from the rotation.
+	MaxBlockLag uint64
+	// HealthCheckInterval is the interval of checking the heads of the endpoints.
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout bounds each head query of the health check.
+	HealthCheckTimeout time.Duration
+
+	// MaxRetries is the number of retries of an idempotent request failed by an
+	// I/O error. Each retry is sent to the next endpoint in the rotation.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled for each retry.
+	RetryBackoff time.Duration
+
+	// MaxBatchSize is the maximum number of requests in a batch sent to an
+	// endpoint. Larger batches are split and sent in parallel. 0 means no limit.
+	MaxBatchSize int
+	// MaxInflight is the maximum number of requests in flight to an endpoint.
+	// The requests exceeding it wait for the earlier ones. 0 means no limit.
+	MaxInflight int
+}
+
+// DefaultMultiClientConfig is the default configuration of MultiClient.
+var DefaultMultiClientConfig = MultiClientConfig{
+	MaxBlockLag:         10,
+	HealthCheckInterval: 5 * time.Second,
+	HealthCheckTimeout:  3 * time.Second,
+	MaxRetries:          3,
+	RetryBackoff:        100 * time.Millisecond,
+	MaxBatchSize:        100,
+	MaxInflight:         64,
+}
+
+// EndpointStatus is the health of an endpoint of MultiClient.
+type EndpointStatus struct {
+	URL     string
+	Head    uint64 // Block number of the head at the last health check
+	Healthy bool   // Whether the endpoint is in the rotation
+	Err     error  // Error of the last health check
+}
+
+type endpoint struct {
+	url      string
+	c        *rpc.Client
+	inflight chan struct{} // nil if unlimited
+
+	mu     sync.RWMutex
+	status EndpointStatus
+}
+
+func (ep *endpoint) healthy() bool {
+	ep.mu.RLock()
+	defer ep.mu.RUnlock()
+	return ep.status.Healthy
+}
+
+// acquire waits for a slot of the in-flight requests of the endpoint.
+func (ep *endpoint) acquire(ctx context.Context) error {
+	if ep.inflight == nil {
+		return nil
+	}
+	select {
+	case ep.inflight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (ep *endpoint) release() {
+	if ep.inflight != nil {
+		<-ep.inflight
+	}
+}
+
+func (ep *endpoint) call(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if err := ep.acquire(ctx); err != nil {
+		return err
+	}
+	defer ep.release()
+	return ep.c.CallContext(ctx, result, method, args...)
+}
+
+func (ep *endpoint) batchCall(ctx context.Context, b []rpc.BatchElem) error {
+	if err := ep.acquire(ctx); err != nil {
+		return err
+	}
+	defer ep.release()
+	return ep.c.BatchCallContext(ctx, b)
+}
+
+// MultiClient is an RPC client spreading the requests over several endpoints,
+// e.g. the ENs behind an indexer. It implements RPCClient, so that it can be
+// used by Client through NewClientWithRPC, or directly like rpc.Client.
+//
+// The requests are sent to the healthy endpoints in turn. An endpoint is healthy
+// if its head is not behind the best head of the endpoints by more than
+// MaxBlockLag. Idempotent requests failed by an I/O error are retried on the
+// next endpoint with backoff, while the ones sending transactions are not.
+// The filters are pinned to the endpoint which created them, since the other
+// endpoints don't know them.
+//
+// Subscriptions are not supported, so Client falls back to polling the filters.
+type MultiClient struct {
+	config    MultiClientConfig
+	endpoints []*endpoint
+	next      uint32
+
+	filtersMu sync.Mutex
+	filters   map[string]*endpoint // Filter ID to the endpoint which created it
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// DialMultiClient connects to the endpoints of the URLs and checks their health.
+// If config is nil, DefaultMultiClientConfig is used.
+func DialMultiClient(ctx context.Context, urls []string, config *MultiClientConfig) (*MultiClient, error) {
+	if len(urls) == 0 {
+		return nil, errNoEndpoint
+	}
+	if config == nil {
+		config = &DefaultMultiClientConfig
+	}
+	mc := &MultiClient{
+		config:  *config,
+		filters: make(map[string]*endpoint),
+		quit:    make(chan struct{}),
+	}
+	for _, url := range urls {
+		c, err := rpc.DialContext(ctx, url)
+		if err != nil {
+			mc.closeEndpoints()
+			return nil, fmt.Errorf("failed to dial %s: %w", url, err)
+		}
+		ep := &endpoint{url: url, c: c, status: EndpointStatus{URL: url}}
+		if config.MaxInflight > 0 {
+			ep.inflight = make(chan struct{}, config.MaxInflight)
+		}
+		mc.endpoints = append(mc.endpoints, ep)
+	}
+
+	mc.checkHealth()
+	mc.wg.Add(1)
+	go mc.healthCheckLoop()
+	return mc, nil
+}
+
+// Close stops the health check and closes the connections to the endpoints.
+func (mc *MultiClient) Close() {
+	close(mc.quit)
+	mc.wg.Wait()
+	mc.closeEndpoints()
+}
+
+func (mc *MultiClient) closeEndpoints() {
+	for _, ep := range mc.endpoints {
+		ep.c.Close()
+	}
+}
+
+// SetHeader adds a custom HTTP header to the requests to all the endpoints.
+func (mc *MultiClient) SetHeader(key, value string) {
+	for _, ep := range mc.endpoints {
+		ep.c.SetHeader(key, value)
+	}
+}
+
+// Endpoints returns the status of the endpoints at the last health check.
+func (mc *MultiClient) Endpoints() []EndpointStatus {
+	statuses := make([]EndpointStatus, len(mc.endpoints))
+	for i, ep := range mc.endpoints {
+		ep.mu.RLock()
+		statuses[i] = ep.status
+		ep.mu.RUnlock()
+	}
+	return statuses
+}
+
+func (mc *MultiClient) healthCheckLoop() {
+	defer mc.wg.Done()
+	ticker := time.NewTicker(mc.config.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-mc.quit:
+			return
+		case <-ticker.C:
+			mc.checkHealth()
+		}
+	}
+}
+
+// checkHealth queries the heads of the endpoints in parallel, and keeps the
+// endpoints close enough to the best head in the rotation.
+func (mc *MultiClient) checkHealth() {
+	var (
+		heads = make([]uint64, len(mc.endpoints))
+		errs  = make([]error, len(mc.endpoints))
+		wg    sync.WaitGroup
+	)
+	for i, ep := range mc.endpoints {
+		wg.Add(1)
+		go func(i int, ep *endpoint) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), mc.config.HealthCheckTimeout)
+			defer cancel()
+			var head hexutil.Uint64
+			errs[i] = ep.c.CallContext(ctx, &head, "kaia_blockNumber")
+			heads[i] = uint64(head)
+		}(i, ep)
+	}
+	wg.Wait()
+
+	var best uint64
+	for i := range mc.endpoints {
+		if errs[i] == nil && heads[i] > best {
+			best = heads[i]
+		}
+	}
+	for i, ep := range mc.endpoints {
+		ep.mu.Lock()
+		ep.status.Err = errs[i]
+		if errs[i] == nil {
+			ep.status.Head = heads[i]
+		}
+		ep.status.Healthy = errs[i] == nil && best-heads[i] <= mc.config.MaxBlockLag
+		ep.mu.Unlock()
+	}
+}
+
+// pick returns the next healthy endpoint in the rotation.
+func (mc *MultiClient) pick() (*endpoint, error) {
+	n := uint32(len(mc.endpoints))
+	start := atomic.AddUint32(&mc.next, 1)
+	for i := uint32(0); i < n; i++ {
+		if ep := mc.endpoints[(start+i)%n]; ep.healthy() {
+			return ep, nil
+		}
+	}
+	return nil, errNoHealthyEndpoint
+}
+
+// CallContext performs a JSON-RPC call on one of the healthy endpoints.
+func (mc *MultiClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if ep, ok := mc.filterEndpoint(method, args); ok {
+		err := ep.call(ctx, result, method, args...)
+		if isFilterUninstall(method) || (err != nil && isFilterNotFound(err)) {
+			mc.unpinFilter(args)
+		}
+		return err
+	}
+	return mc.retry(ctx, isIdempotent(method), func(ep *endpoint) error {
+		if !isFilterCreation(method) {
+			return ep.call(ctx, result, method, args...)
+		}
+		var raw json.RawMessage
+		if err := ep.call(ctx, &raw, method, args...); err != nil {
+			return err
+		}
+		var id string
+		if err := json.Unmarshal(raw, &id); err == nil {
+			mc.pinFilter(id, ep)
+		}
+		if result == nil {
+			return nil
+		}
+		return json.Unmarshal(raw, result)
+	})
+}
+
+// BatchCallContext sends the requests in batches of at most MaxBatchSize to
+// the healthy endpoints in parallel. A batch rejected for its size is split in
+// half and sent again. Like rpc.Client, it returns the errors occurred while
+// sending the requests, and reports the errors of each request through the
+// Error field of the BatchElem.
+func (mc *MultiClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	size := mc.config.MaxBatchSize
+	if size <= 0 || size > len(b) {
+		size = len(b)
+	}
+	if size == 0 {
+		return nil
+	}
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 0, (len(b)+size-1)/size)
+		mu   sync.Mutex
+	)
+	for start := 0; start < len(b); start += size {
+		end := start + size
+		if end > len(b) {
+			end = len(b)
+		}
+		wg.Add(1)
+		go func(batch []rpc.BatchElem) {
+			defer wg.Done()
+			if err := mc.sendBatch(ctx, batch); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(b[start:end])
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (mc *MultiClient) sendBatch(ctx context.Context, batch []rpc.BatchElem) error {
+	idempotent := true
+	for _, elem := range batch {
+		idempotent = idempotent && isIdempotent(elem.Method)
+	}
+	err := mc.retry(ctx, idempotent, func(ep *endpoint) error {
+		return ep.batchCall(ctx, batch)
+	})
+	if err != nil && isTooLarge(err) && len(batch) > 1 {
+		half := len(batch) / 2
+		if err := mc.sendBatch(ctx, batch[:half]); err != nil {
+			return err
+		}
+		return mc.sendBatch(ctx, batch[half:])
+	}
+	return err
+}
+
+// retry runs the request on the healthy endpoints in turn until it succeeds,
+// fails by an error other than an I/O one, or runs out of the retries.
+func (mc *MultiClient) retry(ctx context.Context, idempotent bool, request func(*endpoint) error) error {
+	backoff := mc.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		ep, err := mc.pick()
+		if err != nil {
+			return err
+		}
+		err = request(ep)
+		if err == nil || !idempotent || attempt >= mc.config.MaxRetries || !isRetryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+func (mc *MultiClient) pinFilter(id string, ep *endpoint) {
+	mc.filtersMu.Lock()
+	defer mc.filtersMu.Unlock()
+	mc.filters[id] = ep
+}
+
+func (mc *MultiClient) unpinFilter(args []interface{}) {
+	if id, ok := filterID(args); ok {
+		mc.filtersMu.Lock()
+		defer mc.filtersMu.Unlock()
+		delete(mc.filters, id)
+	}
+}
+
+// filterEndpoint returns the endpoint which created the filter the method is
+// called for, if any.
+func (mc *MultiClient) filterEndpoint(method string, args []interface{}) (*endpoint, bool) {
+	if !isFilterAccess(method) {
+		return nil, false
+	}
+	id, ok := filterID(args)
+	if !ok {
+		return nil, false
+	}
+	mc.filtersMu.Lock()
+	defer mc.filtersMu.Unlock()
+	ep, ok := mc.filters[id]
+	return ep, ok
+}
+
+// KaiaSubscribe always fails since the subscriptions are bound to a connection,
+// which MultiClient doesn't have. Client falls back to polling the filters.
+func (mc *MultiClient) KaiaSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (*rpc.ClientSubscription, error) {
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+func filterID(args []interface{}) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+	switch id := args[0].(type) {
+	case string:
+		return id, true
+	case rpc.ID:
+		return string(id), true
+	}
+	return "", false
+}
+
+// methodName returns the method name without the namespace.
+func methodName(method string) string {
+	if i := strings.Index(method, "_"); i >= 0 {
+		return method[i+1:]
+	}
+	return method
+}
+
+func isFilterCreation(method string) bool {
+	switch methodName(method) {
+	case "newFilter", "newBlockFilter", "newPendingTransactionFilter":
+		return true
+	}
+	return false
+}
+
+func isFilterAccess(method string) bool {
+	switch methodName(method) {
+	case "getFilterChanges", "getFilterLogs", "uninstallFilter":
+		return true
+	}
+	return false
+}
+
+func isFilterUninstall(method string) bool {
+	return methodName(method) == "uninstallFilter"
+}
+
+// readOnlyNamespaces are the namespaces of which the read-only methods may be
+// sent again. The methods of the other namespaces are never sent again.
+var readOnlyNamespaces = map[string]bool{
+	"kaia": true, "klay": true, "eth": true, "net": true, "web3": true, "governance": true,
+}
+
+// readOnlyMethods are the read-only methods not starting with "get", named
+// without the namespace.
+var readOnlyMethods = map[string]bool{
+	"accounts":                      true,
+	"blockNumber":                   true,
+	"call":                          true,
+	"chainConfig":                   true,
+	"chainID":                       true,
+	"chainId":                       true,
+	"clientVersion":                 true,
+	"createAccessList":              true,
+	"estimateComputationCost":       true,
+	"estimateGas":                   true,
+	"feeHistory":                    true,
+	"gasPrice":                      true,
+	"isContractAccount":             true,
+	"isSenderTxHashIndexingEnabled": true,
+	"listening":                     true,
+	"lowerBoundGasPrice":            true,
+	"maxPriorityFeePerGas":          true,
+	"networkID":                     true,
+	"peerCount":                     true,
+	"protocolVersion":               true,
+	"sha3":                          true,
+	"syncing":                       true,
+	"upperBoundGasPrice":            true,
+	"version":                       true,
+}
+
+// isIdempotent returns whether the method can be sent again without side
+// effects if the first attempt is lost. Only the known read-only methods are,
+// so that an unknown method with side effects is never sent twice.
+func isIdempotent(method string) bool {
+	i := strings.Index(method, "_")
+	if i < 0 || !readOnlyNamespaces[method[:i]] || isFilterAccess(method) {
+		return false
+	}
+	name := method[i+1:]
+	return strings.HasPrefix(name, "get") || readOnlyMethods[name]
+}
+
+// isRetryable returns whether the error is an I/O error rather than the one
+// answered by the server, which would be the same on the other endpoints.
+func isRetryable(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) || isTooLarge(err) {
+		return false
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// isTooLarge returns whether the HTTP server rejected the request for its size.
+func isTooLarge(err error) bool {
+	return strings.HasPrefix(err.Error(), fmt.Sprint(http.StatusRequestEntityTooLarge))
+}
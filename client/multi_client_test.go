@@ -0,0 +1,308 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/networks/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEndpointAPI is the kaia namespace of an endpoint of MultiClient.
+type testEndpointAPI struct {
+	name    string
+	head    uint64
+	calls   int32
+	sends   int32
+	mu      sync.Mutex
+	filters map[string]bool
+}
+
+func (api *testEndpointAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(atomic.LoadUint64(&api.head))
+}
+
+// ChainID answers the name of the endpoint to tell which one served the call.
+func (api *testEndpointAPI) ChainID() string {
+	atomic.AddInt32(&api.calls, 1)
+	return api.name
+}
+
+func (api *testEndpointAPI) SendRawTransaction(tx hexutil.Bytes) (string, error) {
+	atomic.AddInt32(&api.sends, 1)
+	return "", fmt.Errorf("rejected by %s", api.name)
+}
+
+func (api *testEndpointAPI) NewBlockFilter() string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	id := fmt.Sprintf("%s-%d", api.name, len(api.filters))
+	api.filters[id] = true
+	return id
+}
+
+func (api *testEndpointAPI) GetFilterChanges(id string) ([]string, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if !api.filters[id] {
+		return nil, fmt.Errorf("filter not found")
+	}
+	return []string{}, nil
+}
+
+func (api *testEndpointAPI) UninstallFilter(id string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	ok := api.filters[id]
+	delete(api.filters, id)
+	return ok
+}
+
+type testEndpoint struct {
+	api    *testEndpointAPI
+	server *httptest.Server
+
+	maxBatch int32 // Batches larger than it are rejected with 413 if positive
+	batches  int32 // Number of the batches received
+}
+
+func (ep *testEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	body, _ := io.ReadAll(r.Body)
+	var batch []json.RawMessage
+	if json.Unmarshal(body, &batch) == nil {
+		atomic.AddInt32(&ep.batches, 1)
+		if max := atomic.LoadInt32(&ep.maxBatch); max > 0 && len(batch) > int(max) {
+			http.Error(w, "too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	next.ServeHTTP(w, r)
+}
+
+func newTestEndpoints(t *testing.T, n int) []*testEndpoint {
+	eps := make([]*testEndpoint, n)
+	for i := range eps {
+		ep := &testEndpoint{api: &testEndpointAPI{name: fmt.Sprintf("ep%d", i), head: 100, filters: make(map[string]bool)}}
+		server := rpc.NewServer()
+		require.NoError(t, server.RegisterName("kaia", ep.api))
+		ep.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ep.ServeHTTP(w, r, server)
+		}))
+		t.Cleanup(ep.server.Close)
+		eps[i] = ep
+	}
+	return eps
+}
+
+func dialTestEndpoints(t *testing.T, eps []*testEndpoint, config MultiClientConfig) *MultiClient {
+	urls := make([]string, len(eps))
+	for i, ep := range eps {
+		urls[i] = ep.server.URL
+	}
+	mc, err := DialMultiClient(context.Background(), urls, &config)
+	require.NoError(t, err)
+	t.Cleanup(mc.Close)
+	return mc
+}
+
+func testMultiClientConfig() MultiClientConfig {
+	config := DefaultMultiClientConfig
+	config.HealthCheckInterval = time.Hour // checked by hand
+	config.RetryBackoff = time.Millisecond
+	return config
+}
+
+func TestMultiClient_RoundRobin(t *testing.T) {
+	eps := newTestEndpoints(t, 3)
+	mc := dialTestEndpoints(t, eps, testMultiClientConfig())
+
+	served := make(map[string]int)
+	for i := 0; i < 9; i++ {
+		var name string
+		require.NoError(t, mc.CallContext(context.Background(), &name, "kaia_chainID"))
+		served[name]++
+	}
+	assert.Equal(t, map[string]int{"ep0": 3, "ep1": 3, "ep2": 3}, served)
+
+	// The client works as the connection of Client.
+	id, err := NewClientWithRPC(mc).BlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), id.Uint64())
+}
+
+func TestMultiClient_BlockLag(t *testing.T) {
+	eps := newTestEndpoints(t, 2)
+	config := testMultiClientConfig()
+	config.MaxBlockLag = 5
+	mc := dialTestEndpoints(t, eps, config)
+
+	// The endpoint behind the best head by more than MaxBlockLag is dropped.
+	atomic.StoreUint64(&eps[0].api.head, 106)
+	mc.checkHealth()
+	statuses := mc.Endpoints()
+	assert.True(t, statuses[0].Healthy)
+	assert.False(t, statuses[1].Healthy)
+	assert.Equal(t, uint64(100), statuses[1].Head)
+	for i := 0; i < 4; i++ {
+		var name string
+		require.NoError(t, mc.CallContext(context.Background(), &name, "kaia_chainID"))
+		assert.Equal(t, "ep0", name)
+	}
+
+	// It comes back after catching up.
+	atomic.StoreUint64(&eps[1].api.head, 101)
+	mc.checkHealth()
+	assert.True(t, mc.Endpoints()[1].Healthy)
+
+	// No endpoint is available if all of them are down.
+	for _, ep := range eps {
+		ep.server.Close()
+	}
+	mc.checkHealth()
+	for _, status := range mc.Endpoints() {
+		assert.False(t, status.Healthy)
+		assert.Error(t, status.Err)
+	}
+	assert.ErrorIs(t, mc.CallContext(context.Background(), nil, "kaia_chainID"), errNoHealthyEndpoint)
+}
+
+func TestMultiClient_Retry(t *testing.T) {
+	eps := newTestEndpoints(t, 2)
+	mc := dialTestEndpoints(t, eps, testMultiClientConfig())
+
+	// The idempotent calls fail over to the live endpoint before the health
+	// check notices the dead one.
+	eps[0].server.Close()
+	for i := 0; i < 4; i++ {
+		var name string
+		require.NoError(t, mc.CallContext(context.Background(), &name, "kaia_chainID"))
+		assert.Equal(t, "ep1", name)
+	}
+
+	// The errors answered by the server are not retried.
+	for atomic.LoadUint32(&mc.next)%2 != 0 {
+		atomic.AddUint32(&mc.next, 1) // make the live endpoint next
+	}
+	err := mc.CallContext(context.Background(), nil, "kaia_sendRawTransaction", hexutil.Bytes{1})
+	assert.ErrorContains(t, err, "rejected by ep1")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&eps[1].api.sends))
+
+	// The transactions are not sent again after an I/O error.
+	for atomic.LoadUint32(&mc.next)%2 != 1 {
+		atomic.AddUint32(&mc.next, 1) // make the dead endpoint next
+	}
+	err = mc.CallContext(context.Background(), nil, "kaia_sendRawTransaction", hexutil.Bytes{1})
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&eps[1].api.sends))
+}
+
+func TestMultiClient_Batch(t *testing.T) {
+	eps := newTestEndpoints(t, 2)
+	config := testMultiClientConfig()
+	config.MaxBatchSize = 4
+	mc := dialTestEndpoints(t, eps, config)
+
+	newBatch := func(n int) []rpc.BatchElem {
+		batch := make([]rpc.BatchElem, n)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{Method: "kaia_blockNumber", Result: new(hexutil.Uint64)}
+		}
+		return batch
+	}
+	check := func(batch []rpc.BatchElem) {
+		for _, elem := range batch {
+			assert.NoError(t, elem.Error)
+			assert.Equal(t, hexutil.Uint64(100), *elem.Result.(*hexutil.Uint64))
+		}
+	}
+
+	// The batch is split by MaxBatchSize.
+	batch := newBatch(10)
+	require.NoError(t, mc.BatchCallContext(context.Background(), batch))
+	check(batch)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&eps[0].batches)+atomic.LoadInt32(&eps[1].batches))
+
+	// The batch rejected for its size is split further.
+	for _, ep := range eps {
+		atomic.StoreInt32(&ep.maxBatch, 1)
+		atomic.StoreInt32(&ep.batches, 0)
+	}
+	batch = newBatch(4)
+	require.NoError(t, mc.BatchCallContext(context.Background(), batch))
+	check(batch)
+	// 4 -> 2+2 -> 1+1+1+1
+	assert.Equal(t, int32(7), atomic.LoadInt32(&eps[0].batches)+atomic.LoadInt32(&eps[1].batches))
+}
+
+func TestMultiClient_Filter(t *testing.T) {
+	eps := newTestEndpoints(t, 3)
+	mc := dialTestEndpoints(t, eps, testMultiClientConfig())
+
+	// The filters are accessed on the endpoint which created them.
+	ids := make([]string, 3)
+	for i := range ids {
+		require.NoError(t, mc.CallContext(context.Background(), &ids[i], "kaia_newBlockFilter"))
+	}
+	for i := 0; i < 3; i++ {
+		for _, id := range ids {
+			var hashes []string
+			require.NoError(t, mc.CallContext(context.Background(), &hashes, "kaia_getFilterChanges", id))
+		}
+	}
+
+	// Uninstalling the filters unpins them.
+	for _, id := range ids {
+		var ok bool
+		require.NoError(t, mc.CallContext(context.Background(), &ok, "kaia_uninstallFilter", id))
+		assert.True(t, ok)
+	}
+	assert.Empty(t, mc.filters)
+}
+
+func TestIsIdempotent(t *testing.T) {
+	for method, expected := range map[string]bool{
+		"kaia_blockNumber":         true,
+		"kaia_getBlockByNumber":    true,
+		"eth_call":                 true,
+		"net_peerCount":            true,
+		"kaia_sendRawTransaction":  false,
+		"kaia_signTransaction":     false,
+		"kaia_getFilterChanges":    false,
+		"kaia_newFilter":           false,
+		"governance_vote":          false,
+		"personal_getRawKey":       false,
+		"debug_getBadBlocks":       false,
+		"kaia_someFutureWriteCall": false,
+		"noNamespace":              false,
+	} {
+		assert.Equal(t, expected, isIdempotent(method), method)
+	}
+}
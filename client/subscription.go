@@ -0,0 +1,299 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/kaiachain/kaia"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/event"
+)
+
+const (
+	defaultPollInterval = time.Second
+
+	// uninstallTimeout bounds the best-effort uninstallation of a filter
+	// after the subscription ends.
+	uninstallTimeout = 5 * time.Second
+)
+
+// SetPollInterval sets the interval of polling the filters of the subscriptions
+// made over HTTP. It must be shorter than the filter timeout of the server,
+// 5 minutes by default, not to let the server forget the filters. A non-positive
+// interval restores the default, 1 second.
+func (ec *Client) SetPollInterval(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	ec.pollInterval = interval
+}
+
+// filterPoller delivers the changes of a filter installed on the server.
+type filterPoller interface {
+	// install creates the filter. If reinstall is true, the filter is created
+	// again since the server forgot the previous one, and the changes missed
+	// in between are delivered on the next poll.
+	install(ctx context.Context, reinstall bool) error
+	// poll delivers the changes of the filter since the last poll.
+	poll(ctx context.Context) error
+	// uninstall removes the filter from the server.
+	uninstall(ctx context.Context)
+}
+
+// subscribeByPolling emulates a subscription with a filter polled periodically,
+// for the connections without notifications like HTTP. The subscription ends
+// with an error if polling fails for a reason other than the server forgetting
+// the filter, which is handled by installing the filter again.
+func (ec *Client) subscribeByPolling(ctx context.Context, p filterPoller) (kaia.Subscription, error) {
+	if err := p.install(ctx, false); err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), uninstallTimeout)
+			defer cancel()
+			p.uninstall(ctx)
+		}()
+
+		ticker := time.NewTicker(ec.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+			}
+
+			err := p.poll(ctx)
+			if err != nil && isFilterNotFound(err) {
+				err = p.install(ctx, true)
+			}
+			if ctx.Err() != nil {
+				return nil // unsubscribed
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}), nil
+}
+
+// isFilterNotFound returns whether the server has forgotten the filter, e.g. by
+// the timeout of the unused filters.
+func isFilterNotFound(err error) bool {
+	return strings.Contains(err.Error(), "filter not found")
+}
+
+// logPoller polls a log filter. The logs removed by a chain reorganization are
+// delivered with the Removed field set, as the websocket subscription does.
+type logPoller struct {
+	ec *Client
+	q  kaia.FilterQuery
+	ch chan<- types.Log
+
+	id         string
+	lastBlock  uint64              // Last block whose logs are delivered
+	gapFrom    uint64              // First block of the logs missed while reinstalling, 0 if none
+	gapTo      uint64              // Last block of the logs missed while reinstalling
+	backfilled map[logKey]struct{} // Logs of the gap, which the new filter may report again
+}
+
+type logKey struct {
+	blockHash common.Hash
+	index     uint
+}
+
+func (p *logPoller) install(ctx context.Context, reinstall bool) error {
+	var id string
+	if err := p.ec.c.CallContext(ctx, &id, "kaia_newFilter", toFilterArg(p.q)); err != nil {
+		return err
+	}
+	head, err := p.ec.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	p.id = id
+	p.backfilled = nil
+	if reinstall && p.lastBlock < head.Uint64() {
+		// The filter reports the logs after its creation, and the logs of the
+		// blocks up to the head are fetched on the next poll.
+		p.gapFrom, p.gapTo = p.lastBlock+1, head.Uint64()
+	}
+	p.lastBlock = head.Uint64()
+	return nil
+}
+
+func (p *logPoller) poll(ctx context.Context) error {
+	if p.gapFrom != 0 {
+		if err := p.fillGap(ctx); err != nil {
+			return err
+		}
+	}
+	var logs []types.Log
+	if err := p.ec.c.CallContext(ctx, &logs, "kaia_getFilterChanges", p.id); err != nil {
+		return err
+	}
+	for _, log := range logs {
+		if _, ok := p.backfilled[logKey{log.BlockHash, log.Index}]; ok && !log.Removed {
+			continue
+		}
+		if err := p.deliver(ctx, log); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillGap delivers the logs of the blocks mined while the filter was missing.
+func (p *logPoller) fillGap(ctx context.Context) error {
+	q := p.q
+	q.FromBlock = new(big.Int).SetUint64(p.gapFrom)
+	if p.q.FromBlock != nil && p.q.FromBlock.Cmp(q.FromBlock) > 0 {
+		q.FromBlock = p.q.FromBlock
+	}
+	q.ToBlock = new(big.Int).SetUint64(p.gapTo)
+	if p.q.ToBlock != nil && p.q.ToBlock.Sign() >= 0 && p.q.ToBlock.Cmp(q.ToBlock) < 0 {
+		q.ToBlock = p.q.ToBlock
+	}
+	if q.FromBlock.Cmp(q.ToBlock) <= 0 {
+		logs, err := p.ec.FilterLogs(ctx, q)
+		if err != nil {
+			return err
+		}
+		p.backfilled = make(map[logKey]struct{}, len(logs))
+		for _, log := range logs {
+			if err := p.deliver(ctx, log); err != nil {
+				return err
+			}
+			p.backfilled[logKey{log.BlockHash, log.Index}] = struct{}{}
+		}
+	}
+	p.gapFrom = 0
+	return nil
+}
+
+func (p *logPoller) deliver(ctx context.Context, log types.Log) error {
+	select {
+	case p.ch <- log:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if log.BlockNumber > p.lastBlock {
+		p.lastBlock = log.BlockNumber
+	}
+	return nil
+}
+
+func (p *logPoller) uninstall(ctx context.Context) {
+	var ok bool
+	p.ec.c.CallContext(ctx, &ok, "kaia_uninstallFilter", p.id)
+}
+
+// headPoller polls a block filter and delivers the headers of the new blocks.
+type headPoller struct {
+	ec *Client
+	ch chan<- *types.Header
+
+	id         string
+	lastBlock  uint64                   // Last block whose header is delivered
+	gapFrom    uint64                   // First block of the headers missed while reinstalling, 0 if none
+	gapTo      uint64                   // Last block of the headers missed while reinstalling
+	backfilled map[common.Hash]struct{} // Headers of the gap, which the new filter may report again
+}
+
+func (p *headPoller) install(ctx context.Context, reinstall bool) error {
+	var id string
+	if err := p.ec.c.CallContext(ctx, &id, "kaia_newBlockFilter"); err != nil {
+		return err
+	}
+	head, err := p.ec.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	p.id = id
+	p.backfilled = nil
+	if reinstall && p.lastBlock < head.Uint64() {
+		p.gapFrom, p.gapTo = p.lastBlock+1, head.Uint64()
+	}
+	p.lastBlock = head.Uint64()
+	return nil
+}
+
+func (p *headPoller) poll(ctx context.Context) error {
+	if p.gapFrom != 0 {
+		p.backfilled = make(map[common.Hash]struct{}, p.gapTo-p.gapFrom+1)
+		for num := p.gapFrom; num <= p.gapTo; num++ {
+			header, err := p.ec.HeaderByNumber(ctx, new(big.Int).SetUint64(num))
+			if err != nil {
+				return err
+			}
+			if err := p.deliver(ctx, header); err != nil {
+				return err
+			}
+			p.backfilled[header.Hash()] = struct{}{}
+		}
+		p.gapFrom = 0
+	}
+	var hashes []common.Hash
+	if err := p.ec.c.CallContext(ctx, &hashes, "kaia_getFilterChanges", p.id); err != nil {
+		return err
+	}
+	for _, hash := range hashes {
+		if _, ok := p.backfilled[hash]; ok {
+			continue
+		}
+		header, err := p.ec.HeaderByHash(ctx, hash)
+		if err != nil {
+			return err
+		}
+		if err := p.deliver(ctx, header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *headPoller) deliver(ctx context.Context, header *types.Header) error {
+	select {
+	case p.ch <- header:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if num := header.Number.Uint64(); num > p.lastBlock {
+		p.lastBlock = num
+	}
+	return nil
+}
+
+func (p *headPoller) uninstall(ctx context.Context) {
+	var ok bool
+	p.ec.c.CallContext(ctx, &ok, "kaia_uninstallFilter", p.id)
+}
@@ -0,0 +1,304 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/networks/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testFilter struct {
+	isBlock bool
+	logs    []types.Log
+	hashes  []common.Hash
+}
+
+// testFilterAPI serves the filter APIs of a chain the test mines by hand.
+type testFilterAPI struct {
+	mu      sync.Mutex
+	headers []*types.Header
+	logs    []types.Log
+	filters map[string]*testFilter
+	nextID  int
+}
+
+func newTestFilterAPI() *testFilterAPI {
+	api := &testFilterAPI{filters: make(map[string]*testFilter)}
+	api.mine()
+	return api
+}
+
+func (api *testFilterAPI) newFilter(isBlock bool) string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.nextID++
+	id := fmt.Sprintf("0x%x", api.nextID)
+	api.filters[id] = &testFilter{isBlock: isBlock}
+	return id
+}
+
+func (api *testFilterAPI) NewFilter(crit map[string]interface{}) string { return api.newFilter(false) }
+func (api *testFilterAPI) NewBlockFilter() string                       { return api.newFilter(true) }
+
+func (api *testFilterAPI) GetFilterChanges(id string) (interface{}, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	f, ok := api.filters[id]
+	if !ok {
+		return nil, errors.New("filter not found")
+	}
+	if f.isBlock {
+		hashes := f.hashes
+		f.hashes = nil
+		return append([]common.Hash{}, hashes...), nil
+	}
+	logs := f.logs
+	f.logs = nil
+	return append([]types.Log{}, logs...), nil
+}
+
+func (api *testFilterAPI) UninstallFilter(id string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	_, ok := api.filters[id]
+	delete(api.filters, id)
+	return ok
+}
+
+func (api *testFilterAPI) GetLogs(crit map[string]interface{}) []types.Log {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	from, _ := hexutil.DecodeUint64(crit["fromBlock"].(string))
+	to, _ := hexutil.DecodeUint64(crit["toBlock"].(string))
+	logs := []types.Log{}
+	for _, log := range api.logs {
+		if from <= log.BlockNumber && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func (api *testFilterAPI) BlockNumber() hexutil.Uint64 {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return hexutil.Uint64(len(api.headers) - 1)
+}
+
+func (api *testFilterAPI) GetBlockByHash(hash common.Hash, full bool) *types.Header {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for _, header := range api.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+func (api *testFilterAPI) GetBlockByNumber(num rpc.BlockNumber, full bool) *types.Header {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if int(num) >= len(api.headers) {
+		return nil
+	}
+	return api.headers[num]
+}
+
+// mine appends a block with the logs of the addresses, and notifies the filters.
+func (api *testFilterAPI) mine(addrs ...common.Address) []types.Log {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	header := &types.Header{
+		Number:     big.NewInt(int64(len(api.headers))),
+		Time:       big.NewInt(int64(len(api.headers))),
+		BlockScore: common.Big1,
+		Extra:      []byte{},
+	}
+	if len(api.headers) > 0 {
+		header.ParentHash = api.headers[len(api.headers)-1].Hash()
+	}
+	api.headers = append(api.headers, header)
+
+	logs := make([]types.Log, len(addrs))
+	for i, addr := range addrs {
+		logs[i] = types.Log{
+			Address:     addr,
+			Topics:      []common.Hash{},
+			Data:        []byte{},
+			BlockNumber: header.Number.Uint64(),
+			BlockHash:   header.Hash(),
+			Index:       uint(i),
+		}
+	}
+	api.logs = append(api.logs, logs...)
+	for _, f := range api.filters {
+		f.logs = append(f.logs, logs...)
+		f.hashes = append(f.hashes, header.Hash())
+	}
+	return logs
+}
+
+// remove notifies the log filters of the logs removed by a reorganization.
+func (api *testFilterAPI) remove(logs ...types.Log) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for _, f := range api.filters {
+		for _, log := range logs {
+			log.Removed = true
+			f.logs = append(f.logs, log)
+		}
+	}
+}
+
+// forget drops all the filters as the timeout of the unused filters does.
+func (api *testFilterAPI) forget() {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	api.filters = make(map[string]*testFilter)
+}
+
+func (api *testFilterAPI) numFilters() int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return len(api.filters)
+}
+
+func newTestHTTPClient(t *testing.T) (*Client, *testFilterAPI) {
+	api := newTestFilterAPI()
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("kaia", api))
+	httpsrv := httptest.NewServer(server)
+	t.Cleanup(httpsrv.Close)
+
+	c, err := rpc.DialHTTP(httpsrv.URL)
+	require.NoError(t, err)
+	client := NewClient(c)
+	client.SetPollInterval(10 * time.Millisecond)
+	t.Cleanup(client.Close)
+	return client, api
+}
+
+func TestSubscribeFilterLogs_Polling(t *testing.T) {
+	client, api := newTestHTTPClient(t)
+	ch := make(chan types.Log)
+	sub, err := client.SubscribeFilterLogs(context.Background(), kaia.FilterQuery{}, ch)
+	require.NoError(t, err)
+
+	recv := func() types.Log {
+		select {
+		case log := <-ch:
+			return log
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+		return types.Log{}
+	}
+
+	// The logs of the new blocks are delivered.
+	logs1 := api.mine(common.HexToAddress("0x1"))
+	assert.Equal(t, logs1[0], recv())
+
+	// The logs removed by a reorganization are delivered with the flag.
+	api.remove(logs1...)
+	removed := recv()
+	assert.True(t, removed.Removed)
+	assert.Equal(t, logs1[0].BlockHash, removed.BlockHash)
+
+	// The filter forgotten by the server is installed again, and the logs
+	// mined in between are delivered once.
+	api.forget()
+	logs2 := api.mine(common.HexToAddress("0x2"), common.HexToAddress("0x3"))
+	assert.Equal(t, logs2[0], recv())
+	assert.Equal(t, logs2[1], recv())
+	logs3 := api.mine(common.HexToAddress("0x4"))
+	assert.Equal(t, logs3[0], recv())
+	select {
+	case log := <-ch:
+		t.Fatalf("unexpected log: %v", log)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Unsubscribing uninstalls the filter.
+	sub.Unsubscribe()
+	assert.Eventually(t, func() bool { return api.numFilters() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSubscribeNewHead_Polling(t *testing.T) {
+	client, api := newTestHTTPClient(t)
+	ch := make(chan *types.Header)
+	sub, err := client.SubscribeNewHead(context.Background(), ch)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	recv := func() uint64 {
+		select {
+		case header := <-ch:
+			return header.Number.Uint64()
+		case err := <-sub.Err():
+			t.Fatalf("subscription failed: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+		return 0
+	}
+
+	api.mine()
+	assert.Equal(t, uint64(1), recv())
+
+	// The headers mined while the filter is missing are delivered once.
+	api.forget()
+	api.mine()
+	api.mine()
+	assert.Equal(t, uint64(2), recv())
+	assert.Equal(t, uint64(3), recv())
+	api.mine()
+	assert.Equal(t, uint64(4), recv())
+	select {
+	case header := <-ch:
+		t.Fatalf("unexpected header: %v", header.Number)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSetPollInterval(t *testing.T) {
+	client := NewClient(nil)
+	client.SetPollInterval(10 * time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, client.pollInterval)
+
+	// A non-positive interval would panic in time.NewTicker.
+	client.SetPollInterval(0)
+	assert.Equal(t, defaultPollInterval, client.pollInterval)
+	client.SetPollInterval(-time.Second)
+	assert.Equal(t, defaultPollInterval, client.pollInterval)
+}
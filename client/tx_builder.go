@@ -0,0 +1,284 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/types/accountkey"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/rlp"
+)
+
+var (
+	errNoGasLimit        = errors.New("gas limit is not set")
+	errNoGasPrice        = errors.New("gas price is not set")
+	errNoNonce           = errors.New("nonce is not set")
+	errNoFeePayer        = errors.New("fee ratio is set without the fee payer")
+	errInvalidFeeRatio   = errors.New("fee ratio must be in [1, 99]")
+	errNoSigningKey      = errors.New("no signing key")
+	errNotFeeDelegatedTx = errors.New("not a fee-delegated transaction")
+)
+
+// TxBuilderBackend is the backend filling the default values of a TxBuilder.
+// Both Client and the simulated backend implement it.
+type TxBuilderBackend interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// TxBuilder builds a Kaia transaction with typed fields instead of the value map
+// of types.NewTransactionWithMap. The builder is made by the constructor of the
+// basic transaction type, and turns into the fee-delegated variant of the type
+// if the fee payer is set, or into the one with ratio if the fee ratio is set too.
+//
+// A fee-delegated transaction is made by the following steps:
+//  1. the sender builds the transaction with the fee payer address and signs it
+//     with SignTx,
+//  2. the sender hands the transaction encoded by EncodeTx over to the fee payer,
+//  3. the fee payer decodes it with DecodeTx and co-signs it with SignTxAsFeePayer,
+//  4. either of them submits the transaction, e.g. with Client.SendTransaction.
+type TxBuilder struct {
+	txType types.TxType
+	values map[types.TxValueKeyType]interface{}
+
+	nonce    *uint64
+	gasLimit uint64
+	gasPrice *big.Int
+	feePayer *common.Address
+	feeRatio types.FeeRatio
+}
+
+func newTxBuilder(txType types.TxType, from common.Address) *TxBuilder {
+	return &TxBuilder{
+		txType: txType,
+		values: map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyFrom: from,
+		},
+	}
+}
+
+// NewValueTransferTx returns a builder of a transaction sending the amount of KAIA.
+func NewValueTransferTx(from, to common.Address, amount *big.Int) *TxBuilder {
+	b := newTxBuilder(types.TxTypeValueTransfer, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(amount)
+	return b
+}
+
+// NewValueTransferMemoTx returns a builder of a transaction sending the amount of
+// KAIA with the memo.
+func NewValueTransferMemoTx(from, to common.Address, amount *big.Int, memo []byte) *TxBuilder {
+	b := newTxBuilder(types.TxTypeValueTransferMemo, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(amount)
+	b.values[types.TxValueKeyData] = common.CopyBytes(memo)
+	return b
+}
+
+// NewAccountUpdateTx returns a builder of a transaction replacing the account key
+// of the sender.
+func NewAccountUpdateTx(from common.Address, key accountkey.AccountKey) *TxBuilder {
+	b := newTxBuilder(types.TxTypeAccountUpdate, from)
+	b.values[types.TxValueKeyAccountKey] = key
+	return b
+}
+
+// NewSmartContractDeployTx returns a builder of a transaction deploying the EVM
+// contract of the code with the amount of KAIA.
+func NewSmartContractDeployTx(from common.Address, amount *big.Int, code []byte) *TxBuilder {
+	b := newTxBuilder(types.TxTypeSmartContractDeploy, from)
+	b.values[types.TxValueKeyTo] = (*common.Address)(nil)
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(amount)
+	b.values[types.TxValueKeyData] = common.CopyBytes(code)
+	b.values[types.TxValueKeyHumanReadable] = false
+	b.values[types.TxValueKeyCodeFormat] = params.CodeFormatEVM
+	return b
+}
+
+// NewSmartContractExecutionTx returns a builder of a transaction calling the
+// contract with the input data and the amount of KAIA.
+func NewSmartContractExecutionTx(from, to common.Address, amount *big.Int, data []byte) *TxBuilder {
+	b := newTxBuilder(types.TxTypeSmartContractExecution, from)
+	b.values[types.TxValueKeyTo] = to
+	b.values[types.TxValueKeyAmount] = new(big.Int).Set(amount)
+	b.values[types.TxValueKeyData] = common.CopyBytes(data)
+	return b
+}
+
+// NewCancelTx returns a builder of a transaction cancelling the pending
+// transaction of the same nonce.
+func NewCancelTx(from common.Address) *TxBuilder {
+	return newTxBuilder(types.TxTypeCancel, from)
+}
+
+// NewChainDataAnchoringTx returns a builder of a transaction anchoring the data
+// of a service chain.
+func NewChainDataAnchoringTx(from common.Address, anchoredData []byte) *TxBuilder {
+	b := newTxBuilder(types.TxTypeChainDataAnchoring, from)
+	b.values[types.TxValueKeyAnchoredData] = common.CopyBytes(anchoredData)
+	return b
+}
+
+// Nonce sets the nonce of the transaction.
+func (b *TxBuilder) Nonce(nonce uint64) *TxBuilder {
+	b.nonce = &nonce
+	return b
+}
+
+// GasLimit sets the gas limit of the transaction.
+func (b *TxBuilder) GasLimit(gasLimit uint64) *TxBuilder {
+	b.gasLimit = gasLimit
+	return b
+}
+
+// GasPrice sets the gas price of the transaction.
+func (b *TxBuilder) GasPrice(gasPrice *big.Int) *TxBuilder {
+	b.gasPrice = new(big.Int).Set(gasPrice)
+	return b
+}
+
+// FeePayer makes the transaction fee-delegated to the fee payer.
+func (b *TxBuilder) FeePayer(feePayer common.Address) *TxBuilder {
+	b.feePayer = &feePayer
+	return b
+}
+
+// FeeRatio makes the fee payer pay the given percentage of the transaction fee,
+// and the sender pay the rest.
+func (b *TxBuilder) FeeRatio(ratio uint8) *TxBuilder {
+	b.feeRatio = types.FeeRatio(ratio)
+	return b
+}
+
+// Fill sets the nonce and the gas price not set yet with the pending nonce of
+// the sender and the suggested gas price of the backend.
+func (b *TxBuilder) Fill(ctx context.Context, backend TxBuilderBackend) error {
+	if b.nonce == nil {
+		nonce, err := backend.PendingNonceAt(ctx, b.values[types.TxValueKeyFrom].(common.Address))
+		if err != nil {
+			return err
+		}
+		b.nonce = &nonce
+	}
+	if b.gasPrice == nil {
+		gasPrice, err := backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+		b.gasPrice = gasPrice
+	}
+	return nil
+}
+
+// Type returns the transaction type to be built.
+func (b *TxBuilder) Type() types.TxType {
+	switch {
+	case b.feePayer != nil && b.feeRatio != 0:
+		return b.txType + types.TxType(types.TxFeeDelegationWithRatioBitMask)
+	case b.feePayer != nil:
+		return b.txType + types.TxType(types.TxFeeDelegationBitMask)
+	default:
+		return b.txType
+	}
+}
+
+// Build returns the unsigned transaction. The gas limit must be set, and the
+// nonce and the gas price must be set or filled by Fill.
+func (b *TxBuilder) Build() (*types.Transaction, error) {
+	if b.gasLimit == 0 {
+		return nil, errNoGasLimit
+	}
+	if b.nonce == nil {
+		return nil, errNoNonce
+	}
+	if b.gasPrice == nil {
+		return nil, errNoGasPrice
+	}
+	if b.feeRatio != 0 {
+		if b.feePayer == nil {
+			return nil, errNoFeePayer
+		}
+		if !b.feeRatio.IsValid() {
+			return nil, errInvalidFeeRatio
+		}
+	}
+	// The value map is consumed by types.NewTransactionWithMap.
+	values := make(map[types.TxValueKeyType]interface{}, len(b.values)+5)
+	for k, v := range b.values {
+		values[k] = v
+	}
+	values[types.TxValueKeyNonce] = *b.nonce
+	values[types.TxValueKeyGasLimit] = b.gasLimit
+	values[types.TxValueKeyGasPrice] = new(big.Int).Set(b.gasPrice)
+	if b.feePayer != nil {
+		values[types.TxValueKeyFeePayer] = *b.feePayer
+	}
+	if b.feeRatio != 0 {
+		values[types.TxValueKeyFeeRatioOfFeePayer] = b.feeRatio
+	}
+
+	txType := b.Type()
+	tx, err := types.NewTransactionWithMap(txType, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s: %w", txType, err)
+	}
+	return tx, nil
+}
+
+// SignTx signs the transaction as the sender. All the keys of the sender are
+// given together if the account key of the sender is a multisig or role-based one.
+func SignTx(tx *types.Transaction, signer types.Signer, keys ...*ecdsa.PrivateKey) error {
+	if len(keys) == 0 {
+		return errNoSigningKey
+	}
+	return tx.SignWithKeys(signer, keys)
+}
+
+// SignTxAsFeePayer co-signs the fee-delegated transaction as the fee payer
+// designated in the transaction. Since the signature of the sender doesn't cover
+// the fee payer's one, the fee payer may sign before or after the sender.
+func SignTxAsFeePayer(tx *types.Transaction, signer types.Signer, keys ...*ecdsa.PrivateKey) error {
+	if !tx.IsFeeDelegatedTransaction() {
+		return errNotFeeDelegatedTx
+	}
+	if len(keys) == 0 {
+		return errNoSigningKey
+	}
+	return tx.SignFeePayerWithKeys(signer, keys)
+}
+
+// EncodeTx returns the RLP encoding of the transaction, which is the format of
+// kaia_sendRawTransaction, to hand it over to the other signer.
+func EncodeTx(tx *types.Transaction) ([]byte, error) {
+	return rlp.EncodeToBytes(tx)
+}
+
+// DecodeTx decodes the transaction encoded by EncodeTx.
+func DecodeTx(data []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(data, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
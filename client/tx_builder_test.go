@@ -0,0 +1,211 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/types/accountkey"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ = TxBuilderBackend(&Client{})
+
+type txBuilderTestEnv struct {
+	sim      *backends.SimulatedBackend
+	signer   types.Signer
+	sender   *ecdsa.PrivateKey
+	feePayer *ecdsa.PrivateKey
+}
+
+func newTxBuilderTestEnv(t *testing.T) *txBuilderTestEnv {
+	sender, _ := crypto.GenerateKey()
+	feePayer, _ := crypto.GenerateKey()
+	balance := new(big.Int).Mul(big.NewInt(params.KAIA), big.NewInt(1000))
+	sim := backends.NewSimulatedBackendWithGasPrice(blockchain.GenesisAlloc{
+		crypto.PubkeyToAddress(sender.PublicKey):   {Balance: balance},
+		crypto.PubkeyToAddress(feePayer.PublicKey): {Balance: balance},
+	}, params.DefaultUnitPrice)
+	t.Cleanup(func() { sim.Close() })
+	return &txBuilderTestEnv{
+		sim:      sim,
+		signer:   types.LatestSignerForChainID(params.AllGxhashProtocolChanges.ChainID),
+		sender:   sender,
+		feePayer: feePayer,
+	}
+}
+
+func (env *txBuilderTestEnv) balance(t *testing.T, addr common.Address) *big.Int {
+	balance, err := env.sim.BalanceAt(context.Background(), addr, nil)
+	require.NoError(t, err)
+	return balance
+}
+
+// send builds the transaction, signs it as the sender, hands it over to the fee
+// payer if fee-delegated, and submits it. It returns the receipt of the transaction.
+func (env *txBuilderTestEnv) send(t *testing.T, b *TxBuilder, senderKeys ...*ecdsa.PrivateKey) *types.Receipt {
+	ctx := context.Background()
+	require.NoError(t, b.GasLimit(1000000).Fill(ctx, env.sim))
+	tx, err := b.Build()
+	require.NoError(t, err)
+	require.NoError(t, SignTx(tx, env.signer, senderKeys...))
+
+	if tx.IsFeeDelegatedTransaction() {
+		data, err := EncodeTx(tx)
+		require.NoError(t, err)
+		tx, err = DecodeTx(data)
+		require.NoError(t, err)
+		require.NoError(t, SignTxAsFeePayer(tx, env.signer, env.feePayer))
+	}
+
+	require.NoError(t, env.sim.SendTransaction(ctx, tx))
+	env.sim.Commit()
+	receipt, err := env.sim.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	return receipt
+}
+
+func TestTxBuilder_Types(t *testing.T) {
+	var (
+		from     = common.HexToAddress("0x1")
+		to       = common.HexToAddress("0x2")
+		feePayer = common.HexToAddress("0x3")
+		key, _   = crypto.GenerateKey()
+		builders = []struct {
+			b    *TxBuilder
+			base types.TxType
+		}{
+			{NewValueTransferTx(from, to, common.Big1), types.TxTypeValueTransfer},
+			{NewValueTransferMemoTx(from, to, common.Big1, []byte("memo")), types.TxTypeValueTransferMemo},
+			{NewAccountUpdateTx(from, accountkey.NewAccountKeyPublicWithValue(&key.PublicKey)), types.TxTypeAccountUpdate},
+			{NewSmartContractDeployTx(from, common.Big0, []byte{0x00}), types.TxTypeSmartContractDeploy},
+			{NewSmartContractExecutionTx(from, to, common.Big0, []byte{0x01}), types.TxTypeSmartContractExecution},
+			{NewCancelTx(from), types.TxTypeCancel},
+			{NewChainDataAnchoringTx(from, []byte("anchored")), types.TxTypeChainDataAnchoring},
+		}
+	)
+	for _, tc := range builders {
+		b := tc.b.Nonce(1).GasLimit(100000).GasPrice(common.Big1)
+		tx, err := b.Build()
+		require.NoError(t, err, tc.base)
+		assert.Equal(t, tc.base, tx.Type())
+
+		// The builder may be reused for the fee-delegated variants.
+		tx, err = b.FeePayer(feePayer).Build()
+		require.NoError(t, err, tc.base)
+		assert.Equal(t, tc.base+1, tx.Type())
+		txFeePayer, err := tx.FeePayer()
+		assert.NoError(t, err)
+		assert.Equal(t, feePayer, txFeePayer)
+
+		tx, err = b.FeeRatio(30).Build()
+		require.NoError(t, err, tc.base)
+		assert.Equal(t, tc.base+2, tx.Type())
+		ratio, _ := tx.FeeRatio()
+		assert.Equal(t, types.FeeRatio(30), ratio)
+	}
+}
+
+func TestTxBuilder_Errors(t *testing.T) {
+	from, to := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+
+	_, err := NewValueTransferTx(from, to, common.Big1).Nonce(0).GasPrice(common.Big1).Build()
+	assert.ErrorIs(t, err, errNoGasLimit)
+	_, err = NewValueTransferTx(from, to, common.Big1).GasLimit(21000).GasPrice(common.Big1).Build()
+	assert.ErrorIs(t, err, errNoNonce)
+	_, err = NewValueTransferTx(from, to, common.Big1).Nonce(0).GasLimit(21000).Build()
+	assert.ErrorIs(t, err, errNoGasPrice)
+
+	b := NewValueTransferTx(from, to, common.Big1).Nonce(0).GasLimit(21000).GasPrice(common.Big1)
+	_, err = b.FeeRatio(30).Build()
+	assert.ErrorIs(t, err, errNoFeePayer)
+	_, err = b.FeePayer(from).FeeRatio(100).Build()
+	assert.ErrorIs(t, err, errInvalidFeeRatio)
+
+	tx, err := NewValueTransferTx(from, to, common.Big1).Nonce(0).GasLimit(21000).GasPrice(common.Big1).Build()
+	require.NoError(t, err)
+	key, _ := crypto.GenerateKey()
+	signer := types.LatestSignerForChainID(common.Big1)
+	assert.ErrorIs(t, SignTx(tx, signer), errNoSigningKey)
+	assert.ErrorIs(t, SignTxAsFeePayer(tx, signer, key), errNotFeeDelegatedTx)
+}
+
+func TestTxBuilder_FeeDelegation(t *testing.T) {
+	var (
+		env          = newTxBuilderTestEnv(t)
+		senderAddr   = crypto.PubkeyToAddress(env.sender.PublicKey)
+		feePayerAddr = crypto.PubkeyToAddress(env.feePayer.PublicKey)
+		to           = common.HexToAddress("0xbeef")
+		amount       = big.NewInt(params.KAIA)
+	)
+
+	// The fee payer pays the whole fee.
+	senderBalance, feePayerBalance := env.balance(t, senderAddr), env.balance(t, feePayerAddr)
+	receipt := env.send(t, NewValueTransferMemoTx(senderAddr, to, amount, []byte("hello")).FeePayer(feePayerAddr), env.sender)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	assert.Equal(t, amount, env.balance(t, to))
+	assert.Equal(t, new(big.Int).Sub(senderBalance, amount), env.balance(t, senderAddr))
+	assert.Equal(t, -1, env.balance(t, feePayerAddr).Cmp(feePayerBalance))
+
+	// The fee is split by the ratio.
+	senderBalance, feePayerBalance = env.balance(t, senderAddr), env.balance(t, feePayerAddr)
+	receipt = env.send(t, NewValueTransferTx(senderAddr, to, amount).FeePayer(feePayerAddr).FeeRatio(30), env.sender)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	senderFee := new(big.Int).Sub(senderBalance, env.balance(t, senderAddr))
+	senderFee.Sub(senderFee, amount)
+	feePayerFee := new(big.Int).Sub(feePayerBalance, env.balance(t, feePayerAddr))
+	assert.True(t, feePayerFee.Sign() > 0)
+	assert.Equal(t, new(big.Int).Mul(feePayerFee, big.NewInt(70)), new(big.Int).Mul(senderFee, big.NewInt(30)))
+
+	// The anchoring data is carried by a fee-delegated transaction as well.
+	receipt = env.send(t, NewChainDataAnchoringTx(senderAddr, []byte("anchored")).FeePayer(feePayerAddr), env.sender)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+func TestTxBuilder_AccountUpdate(t *testing.T) {
+	var (
+		env          = newTxBuilderTestEnv(t)
+		senderAddr   = crypto.PubkeyToAddress(env.sender.PublicKey)
+		feePayerAddr = crypto.PubkeyToAddress(env.feePayer.PublicKey)
+		to           = common.HexToAddress("0xbeef")
+	)
+	k1, _ := crypto.GenerateKey()
+	k2, _ := crypto.GenerateKey()
+	key := accountkey.NewAccountKeyWeightedMultiSigWithValues(2, accountkey.WeightedPublicKeys{
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&k1.PublicKey)),
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&k2.PublicKey)),
+	})
+
+	receipt := env.send(t, NewAccountUpdateTx(senderAddr, key).FeePayer(feePayerAddr).FeeRatio(50), env.sender)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// The transaction of the updated account is signed by the multisig keys.
+	receipt = env.send(t, NewValueTransferTx(senderAddr, to, common.Big1), k1, k2)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	assert.Equal(t, common.Big1, env.balance(t, to))
+}
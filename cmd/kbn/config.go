@@ -47,14 +47,15 @@ const (
 
 type bootnodeConfig struct {
 	// Parameter variables
-	networkID    uint64
-	addr         string
-	genKeyPath   string
-	nodeKeyFile  string
-	nodeKeyHex   string
-	natFlag      string
-	netrestrict  string
-	writeAddress bool
+	networkID      uint64
+	addr           string
+	genKeyPath     string
+	nodeKeyFile    string
+	nodeKeyHex     string
+	natFlag        string
+	netrestrict    string
+	writeAddress   bool
+	topicDiscovery bool
 
 	// Context
 	restrictList *netutil.Netlist
@@ -58,14 +58,15 @@ func bootnode(ctx *cli.Context) error {
 		err  error
 		bcfg = bootnodeConfig{
 			// Config variables
-			networkID:    ctx.Uint64(utils.NetworkIdFlag.Name),
-			addr:         ctx.String(utils.BNAddrFlag.Name),
-			genKeyPath:   ctx.String(utils.GenKeyFlag.Name),
-			nodeKeyFile:  ctx.String(utils.NodeKeyFileFlag.Name),
-			nodeKeyHex:   ctx.String(utils.NodeKeyHexFlag.Name),
-			natFlag:      ctx.String(utils.NATFlag.Name),
-			netrestrict:  ctx.String(utils.NetrestrictFlag.Name),
-			writeAddress: ctx.Bool(utils.WriteAddressFlag.Name),
+			networkID:      ctx.Uint64(utils.NetworkIdFlag.Name),
+			addr:           ctx.String(utils.BNAddrFlag.Name),
+			genKeyPath:     ctx.String(utils.GenKeyFlag.Name),
+			nodeKeyFile:    ctx.String(utils.NodeKeyFileFlag.Name),
+			nodeKeyHex:     ctx.String(utils.NodeKeyHexFlag.Name),
+			natFlag:        ctx.String(utils.NATFlag.Name),
+			netrestrict:    ctx.String(utils.NetrestrictFlag.Name),
+			writeAddress:   ctx.Bool(utils.WriteAddressFlag.Name),
+			topicDiscovery: ctx.Bool(utils.TopicDiscoveryFlag.Name),
 
 			IPCPath:          "klay.ipc",
 			DataDir:          ctx.String(utils.DataDirFlag.Name),
@@ -150,10 +151,21 @@ func bootnode(ctx *cli.Context) error {
 		AuthorizedNodes: bcfg.AuthorizedNodes,
 	}
 
+	// The bootnode of the topic discovery keeps the topic registrations of the other nodes.
+	var unhandled chan discover.ReadPacket
+	if bcfg.topicDiscovery {
+		unhandled = make(chan discover.ReadPacket, 100)
+		cfg.Unhandled = unhandled
+	}
 	tab, err := discover.ListenUDP(&cfg)
 	if err != nil {
 		log.Fatalf("%v", err)
 	}
+	if bcfg.topicDiscovery {
+		if _, err := discover.ListenTopic(&cfg, unhandled, tab); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
 
 	node, err := New(&bcfg)
 	if err != nil {
@@ -70,6 +70,12 @@ func init() {
 
 		// See utils/nodecmd/snapshot.go:
 		nodecmd.SnapshotCommand,
+
+		// See utils/nodecmd/dbcmd.go:
+		nodecmd.DBCommand,
+
+		// See utils/nodecmd/systemcontractscmd.go:
+		nodecmd.SystemContractsCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -192,6 +192,7 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 	}
 
 	cfg.NoDiscovery = ctx.Bool(NoDiscoverFlag.Name)
+	cfg.TopicDiscovery = ctx.Bool(TopicDiscoveryFlag.Name)
 
 	cfg.RWTimerConfig = p2p.RWTimerConfig{}
 	cfg.RWTimerConfig.Interval = ctx.Uint64(RWTimerIntervalFlag.Name)
@@ -440,6 +441,31 @@ func setHTTP(ctx *cli.Context, cfg *node.Config) {
 		rpc.UpstreamArchiveEN = ctx.String(RPCUpstreamArchiveENFlag.Name)
 		cfg.UpstreamArchiveEN = rpc.UpstreamArchiveEN
 	}
+	if ctx.IsSet(RPCRequestLogFlag.Name) {
+		rpc.RequestLogEnabled = ctx.Bool(RPCRequestLogFlag.Name)
+	}
+	if ctx.IsSet(RPCRequestLogSampleRateFlag.Name) {
+		rpc.RequestLogSampleRate = ctx.Float64(RPCRequestLogSampleRateFlag.Name)
+	}
+	if ctx.IsSet(RPCSlowRequestThresholdFlag.Name) {
+		rpc.SlowRequestThreshold = ctx.Duration(RPCSlowRequestThresholdFlag.Name)
+	}
+	if ctx.IsSet(RPCRateLimitFlag.Name) {
+		costs, err := rpc.ParseMethodCosts(ctx.String(RPCMethodCostsFlag.Name))
+		if err != nil {
+			log.Fatalf("Option %q: %v", RPCMethodCostsFlag.Name, err)
+		}
+		err = rpc.SetRateLimit(rpc.RateLimitConfig{
+			Rate:         ctx.Float64(RPCRateLimitFlag.Name),
+			Burst:        ctx.Int(RPCRateLimitBurstFlag.Name),
+			APIKeyHeader: ctx.String(RPCRateLimitAPIKeyHeaderFlag.Name),
+			APIKeys:      SplitAndTrim(ctx.String(RPCRateLimitAPIKeysFlag.Name)),
+			MethodCosts:  costs,
+		})
+		if err != nil {
+			log.Fatalf("Option %q: %v", RPCRateLimitBurstFlag.Name, err)
+		}
+	}
 }
 
 // setWS creates the WebSocket RPC listener interface string from the set
@@ -604,6 +630,7 @@ func (kCfg *KaiaConfig) SetKaiaConfig(ctx *cli.Context, stack *node.Node) {
 	cfg.TriesInMemory = ctx.Uint64(TriesInMemoryFlag.Name)
 	cfg.LivePruning = ctx.Bool(LivePruningFlag.Name)
 	cfg.LivePruningRetention = ctx.Uint64(LivePruningRetentionFlag.Name)
+	cfg.StateScheme = ctx.String(StateSchemeFlag.Name)
 
 	if ctx.IsSet(CacheScaleFlag.Name) {
 		common.CacheScale = ctx.Int(CacheScaleFlag.Name)
@@ -630,8 +657,12 @@ func (kCfg *KaiaConfig) SetKaiaConfig(ctx *cli.Context, stack *node.Node) {
 	if ctx.IsSet(ExtraDataFlag.Name) {
 		cfg.ExtraData = []byte(ctx.String(ExtraDataFlag.Name))
 	}
+	setKaiaBridge(ctx, cfg)
+	cfg.StakingDelegatorsStartBlock = ctx.Uint64(StakingDelegatorsStartBlockFlag.Name)
 
 	cfg.SenderTxHashIndexing = ctx.Bool(SenderTxHashIndexingFlag.Name)
+	cfg.HistoryExpiryRetention = ctx.Uint64(HistoryExpiryRetentionFlag.Name)
+	cfg.HistoryExpiryBlock = ctx.Uint64(HistoryExpiryBlockFlag.Name)
 	cfg.ParallelDBWrite = !ctx.Bool(NoParallelDBWriteFlag.Name)
 	cfg.TrieNodeCacheConfig = statedb.TrieNodeCacheConfig{
 		CacheType: statedb.TrieNodeCacheType(ctx.String(TrieNodeCacheTypeFlag.
@@ -716,6 +747,12 @@ func (kCfg *KaiaConfig) SetKaiaConfig(ctx *cli.Context, stack *node.Node) {
 	// Set the Tx resending related configuration variables
 	setTxResendConfig(ctx, cfg)
 
+	// Set the inbound message rate limits
+	cfg.TxMsgRateLimit = ctx.Float64(TxMsgRateLimitFlag.Name)
+	cfg.BlockMsgRateLimit = ctx.Float64(BlockMsgRateLimitFlag.Name)
+	cfg.SyncRequestRateLimit = ctx.Float64(SyncRequestRateLimitFlag.Name)
+	cfg.ConsensusMsgRateLimit = ctx.Float64(ConsensusMsgRateLimitFlag.Name)
+
 	// Set gas price oracle configs
 	cfg.GPO.Blocks = ctx.Int(GpoBlocksFlag.Name)
 	cfg.GPO.Percentile = ctx.Int(GpoPercentileFlag.Name)
@@ -749,6 +786,30 @@ func setServiceChainSigner(ctx *cli.Context, ks *keystore.KeyStore, cfg *cn.Conf
 	}
 }
 
+// setKaiaBridge retrieves the kaiabridge contracts to watch from the flags.
+func setKaiaBridge(ctx *cli.Context, cfg *cn.Config) {
+	for _, f := range []struct {
+		flag *cli.StringFlag
+		addr *common.Address
+	}{
+		{KaiaBridgeAddrFlag, &cfg.KaiaBridgeAddr},
+		{KaiaBridgeOperatorAddrFlag, &cfg.KaiaBridgeOperatorAddr},
+	} {
+		if !ctx.IsSet(f.flag.Name) {
+			continue
+		}
+		addr := ctx.String(f.flag.Name)
+		if !common.IsHexAddress(addr) {
+			log.Fatalf("Option %q: invalid address %q", f.flag.Name, addr)
+		}
+		*f.addr = common.HexToAddress(addr)
+	}
+	if !common.EmptyAddress(cfg.KaiaBridgeAddr) && common.EmptyAddress(cfg.KaiaBridgeOperatorAddr) {
+		log.Fatalf("Option %q is required with %q", KaiaBridgeOperatorAddrFlag.Name, KaiaBridgeAddrFlag.Name)
+	}
+	cfg.KaiaBridgeStartBlock = ctx.Uint64(KaiaBridgeStartBlockFlag.Name)
+}
+
 // setRewardbase retrieves the rewardbase either from the directly specified
 // command line flags or from the keystore if CLI indexed.
 func setRewardbase(ctx *cli.Context, ks *keystore.KeyStore, cfg *cn.Config) {
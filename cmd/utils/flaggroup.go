@@ -49,6 +49,10 @@ var FlagGroups = []FlagGroup{
 			GCModeFlag,
 			SrvTypeFlag,
 			ExtraDataFlag,
+			KaiaBridgeAddrFlag,
+			KaiaBridgeOperatorAddrFlag,
+			KaiaBridgeStartBlockFlag,
+			StakingDelegatorsStartBlockFlag,
 			ConfigFileFlag,
 			OverwriteGenesisFlag,
 			StartBlockNumberFlag,
@@ -113,6 +117,8 @@ var FlagGroups = []FlagGroup{
 			DynamoDBReadOnlyFlag,
 			NoParallelDBWriteFlag,
 			SenderTxHashIndexingFlag,
+			HistoryExpiryRetentionFlag,
+			HistoryExpiryBlockFlag,
 			DBNoPerformanceMetricsFlag,
 		},
 	},
@@ -194,6 +200,14 @@ var FlagGroups = []FlagGroup{
 			DstRocksDBCacheIndexAndFilterFlag,
 		},
 	},
+	{
+		Name: "DATABASE VERIFICATION",
+		Flags: []cli.Flag{
+			DBVerifyBlocksFlag,
+			DBVerifySkipStateFlag,
+			DBVerifyRepairFlag,
+		},
+	},
 	{
 		Name: "STATE",
 		Flags: []cli.Flag{
@@ -202,6 +216,7 @@ var FlagGroups = []FlagGroup{
 			TriesInMemoryFlag,
 			LivePruningFlag,
 			LivePruningRetentionFlag,
+			StateSchemeFlag,
 		},
 	},
 	{
@@ -241,6 +256,11 @@ var FlagGroups = []FlagGroup{
 			TargetGasLimitFlag,
 			NATFlag,
 			NoDiscoverFlag,
+			TopicDiscoveryFlag,
+			TxMsgRateLimitFlag,
+			BlockMsgRateLimitFlag,
+			SyncRequestRateLimitFlag,
+			ConsensusMsgRateLimitFlag,
 			RWTimerWaitTimeFlag,
 			RWTimerIntervalFlag,
 			NetrestrictFlag,
@@ -289,6 +309,14 @@ var FlagGroups = []FlagGroup{
 			RPCReadTimeout,
 			RPCWriteTimeoutFlag,
 			RPCUpstreamArchiveENFlag,
+			RPCRequestLogFlag,
+			RPCRequestLogSampleRateFlag,
+			RPCSlowRequestThresholdFlag,
+			RPCRateLimitFlag,
+			RPCRateLimitBurstFlag,
+			RPCRateLimitAPIKeyHeaderFlag,
+			RPCRateLimitAPIKeysFlag,
+			RPCMethodCostsFlag,
 			UnsafeDebugDisableFlag,
 			IPCDisabledFlag,
 			IPCPathFlag,
@@ -550,6 +550,13 @@ var (
 		EnvVars:  []string{"KLAYTN_STATE_LIVE_PRUNING_RETENTION", "KAIA_STATE_LIVE_PRUNING_RETENTION"},
 		Category: "STATE",
 	}
+	StateSchemeFlag = &cli.StringFlag{
+		Name:     "state.scheme",
+		Usage:    "Scheme of the state trie storage (hash, path). Defaults to the scheme stored in the database, or hash for a new database",
+		Aliases:  []string{},
+		EnvVars:  []string{"KLAYTN_STATE_SCHEME", "KAIA_STATE_SCHEME"},
+		Category: "STATE",
+	}
 	CacheTypeFlag = &cli.IntFlag{
 		Name:     "cache.type",
 		Usage:    "Cache Type: 0=LRUCache, 1=LRUShardCache, 2=FIFOCache",
@@ -655,6 +662,22 @@ var (
 		EnvVars:  []string{"KLAYTN_SENDERTXHASHINDEXING", "KAIA_SENDERTXHASHINDEXING"},
 		Category: "DATABASE",
 	}
+	HistoryExpiryRetentionFlag = &cli.Uint64Flag{
+		Name:     "history.expiry-retention",
+		Usage:    "Number of recent blocks whose bodies and receipts are kept. Older ones are deleted (0 = disabled)",
+		Value:    0,
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_HISTORY_EXPIRY_RETENTION"},
+		Category: "DATABASE",
+	}
+	HistoryExpiryBlockFlag = &cli.Uint64Flag{
+		Name:     "history.expiry-block",
+		Usage:    "Block number before which bodies and receipts are deleted (0 = disabled)",
+		Value:    0,
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_HISTORY_EXPIRY_BLOCK"},
+		Category: "DATABASE",
+	}
 	ChildChainIndexingFlag = &cli.BoolFlag{
 		Name:     "childchainindexing",
 		Usage:    "Enables storing transaction hash of child chain transaction for fast access to child chain data",
@@ -693,6 +716,34 @@ var (
 		EnvVars:  []string{"KLAYTN_EXTRADATA", "KAIA_EXTRADATA"},
 		Category: "KAIA",
 	}
+	KaiaBridgeAddrFlag = &cli.StringFlag{
+		Name:     "kaiabridge.bridge",
+		Usage:    "Address of the kaiabridge bridge contract to watch the provisions of (default = disabled)",
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_KAIABRIDGE_BRIDGE"},
+		Category: "KAIA",
+	}
+	KaiaBridgeOperatorAddrFlag = &cli.StringFlag{
+		Name:     "kaiabridge.operator",
+		Usage:    "Address of the kaiabridge operator contract, required with --kaiabridge.bridge",
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_KAIABRIDGE_OPERATOR"},
+		Category: "KAIA",
+	}
+	KaiaBridgeStartBlockFlag = &cli.Uint64Flag{
+		Name:     "kaiabridge.startblock",
+		Usage:    "Block number to watch the kaiabridge provisions from, e.g. where the bridge is deployed",
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_KAIABRIDGE_STARTBLOCK"},
+		Category: "KAIA",
+	}
+	StakingDelegatorsStartBlockFlag = &cli.Uint64Flag{
+		Name:     "staking.delegators.startblock",
+		Usage:    "Block number to track the PublicDelegation delegators from, e.g. where the first one is deployed (0 = the head when the tracking starts)",
+		Aliases:  []string{},
+		EnvVars:  []string{"KAIA_STAKING_DELEGATORS_STARTBLOCK"},
+		Category: "KAIA",
+	}
 
 	TxResendIntervalFlag = &cli.Uint64Flag{
 		Name:     "txresend.interval",
@@ -914,6 +965,66 @@ var (
 		Category: "API AND CONSOLE",
 	}
 
+	RPCRequestLogFlag = &cli.BoolFlag{
+		Name:     "rpc.log",
+		Usage:    "Logs each RPC request with its method, latency, response size, error code and remote address, and records the method statistics of admin_rpcStats",
+		Aliases:  []string{"http-rpc.log"},
+		EnvVars:  []string{"KLAYTN_RPC_LOG", "KAIA_RPC_LOG"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRequestLogSampleRateFlag = &cli.Float64Flag{
+		Name:     "rpc.log.samplerate",
+		Usage:    "Ratio of the RPC requests written to the request log (0 to 1)",
+		Value:    rpc.RequestLogSampleRate,
+		Aliases:  []string{"http-rpc.log-sample-rate"},
+		EnvVars:  []string{"KLAYTN_RPC_LOG_SAMPLERATE", "KAIA_RPC_LOG_SAMPLERATE"},
+		Category: "API AND CONSOLE",
+	}
+	RPCSlowRequestThresholdFlag = &cli.DurationFlag{
+		Name:     "rpc.log.slowthreshold",
+		Usage:    "Logs the RPC requests slower than the threshold with their params (0 = disabled)",
+		Value:    rpc.SlowRequestThreshold,
+		Aliases:  []string{"http-rpc.log-slow-threshold"},
+		EnvVars:  []string{"KLAYTN_RPC_LOG_SLOWTHRESHOLD", "KAIA_RPC_LOG_SLOWTHRESHOLD"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRateLimitFlag = &cli.Float64Flag{
+		Name:     "rpc.ratelimit",
+		Usage:    "Cost of the RPC requests a client may make per second over all transports (0 = disabled)",
+		Aliases:  []string{"http-rpc.rate-limit"},
+		EnvVars:  []string{"KLAYTN_RPC_RATELIMIT", "KAIA_RPC_RATELIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRateLimitBurstFlag = &cli.IntFlag{
+		Name:     "rpc.ratelimit.burst",
+		Usage:    "Cost of the RPC requests a client may make at once, at least the largest cost of the methods (default = the cost of a second, or the largest cost if larger)",
+		Aliases:  []string{"http-rpc.rate-limit-burst"},
+		EnvVars:  []string{"KLAYTN_RPC_RATELIMIT_BURST", "KAIA_RPC_RATELIMIT_BURST"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRateLimitAPIKeyHeaderFlag = &cli.StringFlag{
+		Name:     "rpc.ratelimit.apikeyheader",
+		Usage:    "HTTP header or gRPC metadata with the API key identifying the RPC clients instead of their IPs",
+		Value:    rpc.DefaultAPIKeyHeader,
+		Aliases:  []string{"http-rpc.rate-limit-api-key-header"},
+		EnvVars:  []string{"KLAYTN_RPC_RATELIMIT_APIKEYHEADER", "KAIA_RPC_RATELIMIT_APIKEYHEADER"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRateLimitAPIKeysFlag = &cli.StringFlag{
+		Name:     "rpc.ratelimit.apikeys",
+		Usage:    "Comma separated API keys identifying the RPC clients instead of their IPs (default = identified by IPs only)",
+		Aliases:  []string{"http-rpc.rate-limit-api-keys"},
+		EnvVars:  []string{"KLAYTN_RPC_RATELIMIT_APIKEYS", "KAIA_RPC_RATELIMIT_APIKEYS"},
+		Category: "API AND CONSOLE",
+	}
+	RPCMethodCostsFlag = &cli.StringFlag{
+		Name:     "rpc.ratelimit.costs",
+		Usage:    "Costs of the RPC methods for the rate limit, e.g. \"debug_trace*=200,kaia_call=10\" (other methods cost 1)",
+		Aliases:  []string{"http-rpc.rate-limit-costs"},
+		EnvVars:  []string{"KLAYTN_RPC_RATELIMIT_COSTS", "KAIA_RPC_RATELIMIT_COSTS"},
+		Category: "API AND CONSOLE",
+	}
+
 	WSEnabledFlag = &cli.BoolFlag{
 		Name:     "ws",
 		Usage:    "Enable the WS-RPC server",
@@ -1192,6 +1303,44 @@ var (
 		EnvVars:  []string{"KLAYTN_NODISCOVER", "KAIA_NODISCOVER"},
 		Category: "NETWORK",
 	}
+	TopicDiscoveryFlag = &cli.BoolFlag{
+		Name:     "topicdiscovery",
+		Usage:    "Enables the topic discovery on the discovery v5.1 wire protocol, which advertises the node types with node records, alongside the v4",
+		Aliases:  []string{"p2p.topic-discovery"},
+		EnvVars:  []string{"KLAYTN_TOPICDISCOVERY", "KAIA_TOPICDISCOVERY"},
+		Category: "NETWORK",
+	}
+	TxMsgRateLimitFlag = &cli.Float64Flag{
+		Name:     "ratelimit.tx",
+		Usage:    "Maximum number of transaction propagation messages per second from a peer (0 = unlimited)",
+		Value:    cn.DefaultTxMsgRateLimit,
+		Aliases:  []string{"p2p.ratelimit.tx"},
+		EnvVars:  []string{"KLAYTN_RATELIMIT_TX", "KAIA_RATELIMIT_TX"},
+		Category: "NETWORK",
+	}
+	BlockMsgRateLimitFlag = &cli.Float64Flag{
+		Name:     "ratelimit.block",
+		Usage:    "Maximum number of block propagation messages per second from a peer (0 = unlimited)",
+		Value:    cn.DefaultBlockMsgRateLimit,
+		Aliases:  []string{"p2p.ratelimit.block"},
+		EnvVars:  []string{"KLAYTN_RATELIMIT_BLOCK", "KAIA_RATELIMIT_BLOCK"},
+		Category: "NETWORK",
+	}
+	SyncRequestRateLimitFlag = &cli.Float64Flag{
+		Name:     "ratelimit.sync",
+		Usage:    "Maximum number of sync request messages per second from a peer, over which the requests are delayed (0 = unlimited)",
+		Value:    cn.DefaultSyncRequestRateLimit,
+		Aliases:  []string{"p2p.ratelimit.sync"},
+		EnvVars:  []string{"KLAYTN_RATELIMIT_SYNC", "KAIA_RATELIMIT_SYNC"},
+		Category: "NETWORK",
+	}
+	ConsensusMsgRateLimitFlag = &cli.Float64Flag{
+		Name:     "ratelimit.consensus",
+		Usage:    "Maximum number of consensus messages per second from a peer (0 = unlimited)",
+		Aliases:  []string{"p2p.ratelimit.consensus"},
+		EnvVars:  []string{"KLAYTN_RATELIMIT_CONSENSUS", "KAIA_RATELIMIT_CONSENSUS"},
+		Category: "NETWORK",
+	}
 	NetrestrictFlag = &cli.StringFlag{
 		Name:     "netrestrict",
 		Usage:    "Restricts network communication to the given IP network (CIDR masks)",
@@ -1953,6 +2102,27 @@ var (
 		Category: "DATABASE MIGRATION",
 	}
 
+	// Database verification
+	DBVerifyBlocksFlag = &cli.Uint64Flag{
+		Name:     "db.verify.blocks",
+		Usage:    "Number of the recent blocks whose chain data is verified (0 = all blocks)",
+		Value:    0,
+		EnvVars:  []string{"KAIA_DB_VERIFY_BLOCKS"},
+		Category: "DATABASE VERIFICATION",
+	}
+	DBVerifySkipStateFlag = &cli.BoolFlag{
+		Name:     "db.verify.skip-state",
+		Usage:    "Skip walking the state trie of the head block",
+		EnvVars:  []string{"KAIA_DB_VERIFY_SKIP_STATE"},
+		Category: "DATABASE VERIFICATION",
+	}
+	DBVerifyRepairFlag = &cli.BoolFlag{
+		Name:     "db.verify.repair",
+		Usage:    "Rewind the head to the last fully consistent block if an inconsistency is found",
+		EnvVars:  []string{"KAIA_DB_VERIFY_REPAIR"},
+		Category: "DATABASE VERIFICATION",
+	}
+
 	// Config
 	ConfigFileFlag = &cli.StringFlag{
 		Name:     "config",
@@ -31,6 +31,7 @@ import (
 	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/cmd/utils"
+	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/governance"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/params"
@@ -71,6 +72,7 @@ var (
 			utils.RocksDBCacheIndexAndFilterFlag,
 			utils.OverwriteGenesisFlag,
 			utils.LivePruningFlag,
+			utils.StateSchemeFlag,
 		},
 		Category: "BLOCKCHAIN COMMANDS",
 		Description: `
@@ -146,6 +148,13 @@ func initGenesis(ctx *cli.Context) error {
 	numStateTrieShards := ctx.Uint(utils.NumStateTrieShardsFlag.Name)
 	overwriteGenesis := ctx.Bool(utils.OverwriteGenesisFlag.Name)
 	livePruning := ctx.Bool(utils.LivePruningFlag.Name)
+	stateScheme := ctx.String(utils.StateSchemeFlag.Name)
+	if stateScheme != "" && stateScheme != database.HashScheme && stateScheme != database.PathScheme {
+		logger.Crit("invalid state scheme", "scheme", stateScheme)
+	}
+	if stateScheme == database.PathScheme && livePruning {
+		logger.Crit("Path-based state scheme cannot be used with live pruning")
+	}
 
 	dbtype := database.DBType(ctx.String(utils.DbTypeFlag.Name)).ToValid()
 	if len(dbtype) == 0 {
@@ -187,6 +196,16 @@ func initGenesis(ctx *cli.Context) error {
 		}
 		chainDB := stack.OpenDatabase(dbc)
 
+		// Write the state scheme to database before the genesis state is committed
+		if stateScheme != "" {
+			if stored := chainDB.ReadStateScheme(); stored == "" && common.EmptyHash(chainDB.ReadHeadBlockHash()) {
+				logger.Info("Writing state scheme to database", "scheme", stateScheme)
+				chainDB.WriteStateScheme(stateScheme)
+			} else if stored != stateScheme && (stored != "" || stateScheme != database.HashScheme) {
+				logger.Crit("State scheme is incompatible with the database", "scheme", stateScheme, "stored", stored)
+			}
+		}
+
 		// Initialize DeriveSha implementation
 		blockchain.InitDeriveSha(genesis.Config)
 
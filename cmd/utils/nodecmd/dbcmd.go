@@ -0,0 +1,576 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/types/account"
+	"github.com/kaiachain/kaia/blockchain/vm"
+	"github.com/kaiachain/kaia/cmd/utils"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/math"
+	"github.com/kaiachain/kaia/consensus/gxhash"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/governance"
+	kaiabridge_impl "github.com/kaiachain/kaia/kaiax/kaiabridge/impl"
+	reward_impl "github.com/kaiachain/kaia/kaiax/reward/impl"
+	staking_impl "github.com/kaiachain/kaia/kaiax/staking/impl"
+	"github.com/kaiachain/kaia/node/cn"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/reward"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/kaiachain/kaia/storage/statedb"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	maxChainIssues  = 1000 // Maximum number of chain data issues reported by db verify
+	maxMissingState = 1000 // Maximum number of missing trie nodes and codes reported by db verify
+)
+
+var (
+	errInconsistentDB    = errors.New("database is inconsistent")
+	errTooManyMissing    = errors.New("too many missing state entries")
+	errSupplyDiscrepancy = errors.New("total supply discrepancy found")
+	dbEmptyCodeHash      = crypto.Keccak256(nil)
+)
+
+var DBCommand = &cli.Command{
+	Name:        "db",
+	Usage:       "A set of commands for the low level database operations",
+	Description: "",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "inspect",
+			Usage:  "Inspect the size of the database by logical database and key prefix",
+			Action: utils.MigrateFlags(inspectDB),
+			Flags:  utils.SnapshotFlags,
+			Description: `
+Kaia db inspect
+iterates all the entries of the logical databases (header, body, receipts,
+statetrie, etc.), and reports the number and the size of the entries
+by the key prefixes defined in storage/database/schema.go.
+`,
+		},
+		{
+			Name:   "verify",
+			Usage:  "Verify the consistency of the chain data and the state of the head block",
+			Action: utils.MigrateFlags(verifyDB),
+			Flags:  utils.DBVerifyFlags,
+			Description: `
+Kaia db verify
+checks that the canonical hashes, headers, bodies, receipts and tx lookup
+entries agree with each other, and walks the state trie of the head block
+to find missing trie nodes and contract codes.
+With --db.verify.repair, the head is rewound to the last block whose chain
+data and state are fully consistent. The provisions of the kaiabridge given
+by --kaiabridge.bridge are rewound along.
+`,
+		},
+		{
+			Name:      "audit-supply",
+			Usage:     "Verify the total supply against the sum of all account balances",
+			ArgsUsage: "<number> ...",
+			Action:    utils.MigrateFlags(auditSupply),
+			Flags:     utils.SnapshotFlags,
+			Description: `
+Kaia db audit-supply <number> ...
+verifies at each given block (the head block by default) that the tracked
+total supply, i.e. genesis + minted - burnt fees - KIP103/KIP160 burns,
+equals the sum of all account balances, by walking the entire state of the
+block. The supply checkpoints recorded by the node and the states of the
+blocks must be available, and it fails if any discrepancy is found. Same as
+debug_auditTotalSupply, without running the node.
+`,
+		},
+		{
+			Name:      "mint-breakdown",
+			Usage:     "Break down the amount minted in a block range by the reward recipient categories",
+			ArgsUsage: "<from> <to>",
+			Action:    utils.MigrateFlags(mintBreakdown),
+			Flags:     utils.SnapshotFlags,
+			Description: `
+Kaia db mint-breakdown <from> <to>
+prints the amount minted in the blocks [from, to] and its shares of the
+proposer, the stakers, KIF and KEF. Same as debug_getMintBreakdown, without
+running the node.
+`,
+		},
+		{
+			Name:      "convert-to-path",
+			Usage:     "Convert the state of the hash-based scheme to the path-based scheme",
+			ArgsUsage: "<root>",
+			Action:    utils.MigrateFlags(convertToPathScheme),
+			Flags:     utils.SnapshotFlags,
+			Description: `
+Kaia db convert-to-path <state-root>
+writes all the account and storage trie nodes of the given state root
+(the state root of the head block by default) in the path-based scheme,
+where a trie node is keyed by its owner and path instead of its hash,
+and switches the database to the path-based scheme, so the node has to be
+started with --state.scheme=path afterwards. The chain is resumed from the
+converted state, thus the state root of the head block should be given.
+The trie nodes of the hash-based scheme are left untouched.
+A database with live pruning cannot be converted.
+`,
+		},
+	},
+}
+
+// convertToPathScheme converts the state of the given root to the path-based scheme.
+// If a root hash isn't given, the state of the head block is converted.
+func convertToPathScheme(ctx *cli.Context) error {
+	stack := MakeFullNode(ctx)
+	db := stack.OpenDatabase(getConfig(ctx))
+	defer db.Close()
+
+	if ctx.NArg() > 1 {
+		return errors.New("too many arguments")
+	}
+	var root common.Hash
+	if ctx.NArg() == 1 {
+		var err error
+		if root, err = parseRoot(ctx.Args().First()); err != nil {
+			logger.Error("Failed to resolve state root", "err", err)
+			return err
+		}
+	} else {
+		head := db.ReadHeadBlockHash()
+		if head == (common.Hash{}) {
+			return errors.New("empty database")
+		}
+		headBlock := db.ReadBlockByHash(head)
+		if headBlock == nil {
+			return fmt.Errorf("head block missing: %v", head.String())
+		}
+		root = headBlock.Root()
+	}
+	if _, err := statedb.ConvertToPathScheme(db, root); err != nil {
+		logger.Error("Failed to convert state to the path-based scheme", "root", root, "err", err)
+		return err
+	}
+	return nil
+}
+
+// inspectDB prints the number and the size of the database entries by key category.
+func inspectDB(ctx *cli.Context) error {
+	stack := MakeFullNode(ctx)
+	db := stack.OpenDatabase(getConfig(ctx))
+	defer db.Close()
+
+	stats, err := database.InspectDatabase(db)
+	if err != nil {
+		logger.Error("Failed to inspect database", "err", err)
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tCATEGORY\tCOUNT\tSIZE")
+	var (
+		totalCount uint64
+		totalSize  common.StorageSize
+	)
+	for _, stat := range stats {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", stat.Database, stat.Category, stat.Count, stat.Size.String())
+		totalCount += stat.Count
+		totalSize += stat.Size
+	}
+	fmt.Fprintf(w, "TOTAL\t\t%d\t%s\n", totalCount, totalSize.String())
+	return w.Flush()
+}
+
+// verifyDB verifies the chain data of the recent blocks and the state of the head block.
+// If repair is requested, the head is rewound to the last fully consistent block.
+func verifyDB(ctx *cli.Context) error {
+	stack, cfg := utils.MakeConfigNode(ctx)
+	db := stack.OpenDatabase(getConfig(ctx))
+	defer db.Close()
+
+	headHash := db.ReadHeadBlockHash()
+	if headHash == (common.Hash{}) {
+		return errors.New("empty database")
+	}
+	headNum := db.ReadHeaderNumber(headHash)
+	if headNum == nil {
+		return fmt.Errorf("head block missing: %v", headHash.String())
+	}
+	var (
+		head      = *headNum
+		from      = uint64(0)
+		skipState = ctx.Bool(utils.DBVerifySkipStateFlag.Name)
+	)
+	if blocks := ctx.Uint64(utils.DBVerifyBlocksFlag.Name); blocks != 0 && blocks <= head {
+		from = head - blocks + 1
+	}
+	logger.Info("Verifying chain data", "from", from, "to", head)
+
+	// The blocks from the first inconsistent block cannot be the head.
+	target := head
+	issues := database.VerifyChain(db, from, head, maxChainIssues)
+	for _, issue := range issues {
+		logger.Error("Inconsistent chain data", "number", issue.Number, "hash", issue.Hash, "reason", issue.Reason)
+		if issue.Number == 0 {
+			return errors.New("genesis block is inconsistent")
+		}
+		if issue.Number <= target {
+			target = issue.Number - 1
+		}
+	}
+	if len(issues) == 0 {
+		logger.Info("Verified chain data", "from", from, "to", head)
+	}
+
+	target, err := lastConsistentState(db, target, skipState)
+	if err != nil {
+		return err
+	}
+	if target == head {
+		logger.Info("Database is consistent", "head", head)
+		return nil
+	}
+	if !ctx.Bool(utils.DBVerifyRepairFlag.Name) {
+		logger.Error("Database is inconsistent, run with --db.verify.repair to rewind the head",
+			"head", head, "lastConsistent", target)
+		return errInconsistentDB
+	}
+	return rewindHead(db, &cfg.CN, head, target)
+}
+
+// lastConsistentState returns the highest block not above the given number whose state
+// is complete. Only the blocks whose state root is stored are walked, as the state is
+// committed periodically. If skipState is true, only the state root is checked.
+//
+// In the path-based scheme, only the persisted state is stored, and the older states
+// which can be rolled back to with the state histories are taken as consistent without
+// being verified.
+func lastConsistentState(db database.DBManager, number uint64, skipState bool) (uint64, error) {
+	triedb := statedb.NewDatabase(db)
+	for ; ; number-- {
+		header := db.ReadHeader(db.ReadCanonicalHash(number), number)
+		if header == nil {
+			return 0, fmt.Errorf("header missing: %d", number)
+		}
+		if triedb.Scheme() == database.PathScheme && triedb.Recoverable(header.Root) {
+			logger.Info("State is recoverable with the state histories", "number", number, "root", header.Root)
+			return number, nil
+		}
+		if hasStateRoot(db, triedb, header.Root) {
+			if skipState {
+				return number, nil
+			}
+			logger.Info("Verifying state", "number", number, "root", header.Root)
+			missing, err := findMissingState(db, header.Root, maxMissingState)
+			if err != nil {
+				return 0, err
+			}
+			if missing == 0 {
+				logger.Info("Verified state", "number", number, "root", header.Root)
+				return number, nil
+			}
+			logger.Error("Found missing state", "number", number, "root", header.Root, "missing", missing)
+		}
+		if number == 0 {
+			return 0, errors.New("no block has a complete state")
+		}
+	}
+}
+
+// hasStateRoot returns true if the root node of the given state is stored in the scheme
+// of the database.
+func hasStateRoot(db database.DBManager, triedb *statedb.Database, root common.Hash) bool {
+	if root == types.EmptyRootHashOriginal {
+		return true
+	}
+	if triedb.Scheme() == database.PathScheme {
+		return triedb.DiskRoot() == root
+	}
+	ok, _ := db.HasTrieNode(root.ExtendZero())
+	return ok
+}
+
+// findMissingState walks the state of the given root, and returns the number of the
+// missing trie nodes and contract codes. It stops when the number reaches the limit.
+func findMissingState(db database.DBManager, root common.Hash, limit int) (int, error) {
+	var (
+		triedb  = statedb.NewDatabase(db)
+		missing = 0
+	)
+	report := func(owner common.Hash) statedb.MissingNodeFn {
+		return func(path []byte, hash common.Hash) error {
+			logger.Error("Missing trie node", "owner", owner, "path", common.Bytes2Hex(path), "hash", hash)
+			if missing++; missing >= limit {
+				return errTooManyMissing
+			}
+			return nil
+		}
+	}
+	err := triedb.FindMissingNodes(common.Hash{}, root.ExtendZero(), report(common.Hash{}))
+	if errors.Is(err, errTooManyMissing) || missing > 0 {
+		return missing, nil // Some accounts are unreachable.
+	} else if err != nil {
+		return 0, err
+	}
+
+	accTrie, err := statedb.NewSecureTrie(root, triedb, nil)
+	if err != nil {
+		return 0, err
+	}
+	it := accTrie.NodeIterator(nil)
+	for it.Next(true) {
+		if !it.Leaf() {
+			continue
+		}
+		serializer := account.NewAccountSerializer()
+		if err := rlp.Decode(bytes.NewReader(it.LeafBlob()), serializer); err != nil {
+			return 0, err
+		}
+		pa := account.GetProgramAccount(serializer.GetAccount())
+		if pa == nil {
+			continue
+		}
+		owner := common.BytesToHash(it.LeafKey())
+		err := triedb.FindMissingNodes(owner, pa.GetStorageRoot(), report(owner))
+		if errors.Is(err, errTooManyMissing) {
+			return missing, nil
+		} else if err != nil {
+			return 0, err
+		}
+		if codeHash := pa.GetCodeHash(); !bytes.Equal(codeHash, dbEmptyCodeHash) && !db.HasCode(common.BytesToHash(codeHash)) {
+			logger.Error("Missing contract code", "owner", owner, "codeHash", common.BytesToHash(codeHash))
+			if missing++; missing >= limit {
+				return missing, nil
+			}
+		}
+	}
+	return missing, it.Error()
+}
+
+// rewindHead rewinds the head to the canonical block of the given number with the
+// blockchain, so that the head markers, the canonical index and the state are rewound
+// as done by debug_setHead. The kaiabridge module is registered if configured.
+func rewindHead(db database.DBManager, cnConfig *cn.Config, head, target uint64) error {
+	// The blockchain resets itself to the genesis if neither the head block nor its
+	// backup is readable, which is more than a repair.
+	if db.ReadBlockByHash(db.ReadHeadBlockHash()) == nil && db.ReadBlockByHash(db.ReadHeadBlockBackupHash()) == nil {
+		return errors.New("head block missing, the head cannot be rewound")
+	}
+	bc, chainConfig, err := openOfflineChain(db)
+	if err != nil {
+		return err
+	}
+	defer bc.Stop()
+
+	// Register the modules deleting their data of the rewound blocks, as the node does.
+	mStaking := staking_impl.NewStakingModule()
+	mReward := reward_impl.NewRewardModule()
+	if err := errors.Join(
+		mStaking.Init(&staking_impl.InitOpts{
+			ChainKv:     db.GetMiscDB(),
+			ChainConfig: chainConfig,
+			Chain:       bc,
+		}),
+		mReward.Init(&reward_impl.InitOpts{
+			ChainKv:       db.GetMiscDB(),
+			ChainConfig:   chainConfig,
+			Chain:         bc,
+			GovModule:     governance.NewMixedEngineNoInit(chainConfig, db),
+			StakingModule: mStaking,
+		}),
+	); err != nil {
+		return err
+	}
+	bc.RegisterRewindableModule(mStaking, mReward)
+	if !common.EmptyAddress(cnConfig.KaiaBridgeAddr) {
+		mKaiaBridge := kaiabridge_impl.NewKaiaBridgeModule()
+		if err := mKaiaBridge.Init(&kaiabridge_impl.InitOpts{
+			ChainKv:      db.GetMiscDB(),
+			Chain:        bc,
+			BridgeAddr:   cnConfig.KaiaBridgeAddr,
+			OperatorAddr: cnConfig.KaiaBridgeOperatorAddr,
+			StartBlock:   cnConfig.KaiaBridgeStartBlock,
+		}); err != nil {
+			return err
+		}
+		bc.RegisterRewindableModule(mKaiaBridge)
+	}
+
+	if err := bc.SetHead(target); err != nil {
+		return err
+	}
+	logger.Warn("Rewound the head to the last consistent block", "from", head, "to", target, "hash", bc.CurrentBlock().Hash())
+	return nil
+}
+
+// openOfflineChain opens the blockchain of the database without running the node.
+// No block is processed, so the consensus engine isn't used.
+func openOfflineChain(db database.DBManager) (*blockchain.BlockChain, *params.ChainConfig, error) {
+	chainConfig := db.ReadChainConfig(db.ReadCanonicalHash(0))
+	if chainConfig == nil {
+		return nil, nil, errors.New("chain config missing")
+	}
+	blockchain.InitDeriveSha(chainConfig)
+
+	cacheConfig := &blockchain.CacheConfig{
+		ArchiveMode:         true, // No state to be committed on stop
+		CacheSize:           512,
+		BlockInterval:       blockchain.DefaultBlockInterval,
+		TriesInMemory:       blockchain.DefaultTriesInMemory,
+		TrieNodeCacheConfig: statedb.GetEmptyTrieNodeCacheConfig(),
+	}
+	bc, err := blockchain.NewBlockChain(db, cacheConfig, chainConfig, gxhash.NewFaker(), vm.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return bc, chainConfig, nil
+}
+
+// openSupplyManager opens the blockchain of the database and the supply manager on it,
+// with the governance and the staking module set up as the node does.
+func openSupplyManager(db database.DBManager) (reward.SupplyManager, *blockchain.BlockChain, error) {
+	bc, chainConfig, err := openOfflineChain(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	gov := governance.NewMixedEngine(chainConfig, db)
+	gov.SetBlockchain(bc)
+	if err := gov.UpdateParams(bc.CurrentBlock().NumberU64()); err != nil {
+		bc.Stop()
+		return nil, nil, err
+	}
+
+	mStaking := staking_impl.NewStakingModule()
+	if err := mStaking.Init(&staking_impl.InitOpts{
+		ChainKv:     db.GetMiscDB(),
+		ChainConfig: chainConfig,
+		Chain:       bc,
+	}); err != nil {
+		bc.Stop()
+		return nil, nil, err
+	}
+	sm := reward.NewSupplyManager(bc, gov, db)
+	sm.RegisterStakingModule(mStaking)
+	return sm, bc, nil
+}
+
+// parseBlockNumber parses a block number argument, in decimal or in hex with 0x prefix.
+func parseBlockNumber(arg string) (uint64, error) {
+	num, ok := math.ParseUint64(arg)
+	if !ok {
+		return 0, fmt.Errorf("invalid block number: %s", arg)
+	}
+	return num, nil
+}
+
+// auditSupply verifies the total supply invariant at the given blocks, the head block by default,
+// by walking their entire states. It fails if any discrepancy is found.
+func auditSupply(ctx *cli.Context) error {
+	stack := MakeFullNode(ctx)
+	db := stack.OpenDatabase(getConfig(ctx))
+	defer db.Close()
+
+	sm, bc, err := openSupplyManager(db)
+	if err != nil {
+		return err
+	}
+	defer bc.Stop()
+
+	nums := []uint64{bc.CurrentBlock().NumberU64()}
+	if ctx.NArg() > 0 {
+		nums = nums[:0]
+		for _, arg := range ctx.Args().Slice() {
+			num, err := parseBlockNumber(arg)
+			if err != nil {
+				return err
+			}
+			nums = append(nums, num)
+		}
+	}
+
+	// The state walk can take hours, so it stops on interrupt.
+	runCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NUMBER\tTOTAL SUPPLY\tEXPECTED\tSTATE TOTAL\tDISCREPANCY\tACCOUNTS\tCONSISTENT")
+	inconsistent := false
+	for _, num := range nums {
+		logger.Info("Auditing total supply", "number", num)
+		audit, err := sm.AuditTotalSupply(runCtx, num)
+		if err != nil {
+			w.Flush()
+			return fmt.Errorf("audit failed at block %d: %w", num, err)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%t\n", audit.Number, audit.TotalSupply, audit.Expected,
+			audit.StateTotal, audit.Discrepancy, audit.Accounts, audit.Consistent)
+		inconsistent = inconsistent || !audit.Consistent
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if inconsistent {
+		return errSupplyDiscrepancy
+	}
+	return nil
+}
+
+// mintBreakdown prints the amount minted in the given block range, broken down by
+// the reward recipient categories.
+func mintBreakdown(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return errors.New("the first and the last block numbers are required")
+	}
+	from, err := parseBlockNumber(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	to, err := parseBlockNumber(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	stack := MakeFullNode(ctx)
+	db := stack.OpenDatabase(getConfig(ctx))
+	defer db.Close()
+
+	sm, bc, err := openSupplyManager(db)
+	if err != nil {
+		return err
+	}
+	defer bc.Stop()
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	breakdown, err := sm.GetMintBreakdown(runCtx, from, to)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "FROM\tTO\tMINTED\tPROPOSER\tSTAKERS\tKIF\tKEF")
+	fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%s\n", breakdown.From, breakdown.To, breakdown.Minted,
+		breakdown.Proposer, breakdown.Stakers, breakdown.KIF, breakdown.KEF)
+	return w.Flush()
+}
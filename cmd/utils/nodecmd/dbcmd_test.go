@@ -0,0 +1,251 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/vm"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus/gxhash"
+	"github.com/kaiachain/kaia/crypto"
+	kaiabridge_impl "github.com/kaiachain/kaia/kaiax/kaiabridge/impl"
+	"github.com/kaiachain/kaia/kaiax/staking"
+	staking_impl "github.com/kaiachain/kaia/kaiax/staking/impl"
+	"github.com/kaiachain/kaia/node/cn"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/kaiachain/kaia/storage/statedb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBVerify_State(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	stateDB, err := state.New(common.Hash{}, state.NewDatabase(dbm), nil, nil)
+	require.NoError(t, err)
+
+	contract := common.HexToAddress("0xc0de")
+	code := []byte{0x60, 0x00}
+	for i := int64(1); i <= 50; i++ {
+		stateDB.AddBalance(common.BigToAddress(big.NewInt(i)), big.NewInt(i))
+	}
+	stateDB.CreateSmartContractAccount(contract, params.CodeFormatEVM, params.Rules{})
+	stateDB.SetCode(contract, code)
+	stateDB.SetState(contract, common.HexToHash("0x01"), common.HexToHash("0x02"))
+	root, err := stateDB.Commit(false)
+	require.NoError(t, err)
+	require.NoError(t, stateDB.Database().TrieDB().Commit(root, false, 0))
+
+	missing, err := findMissingState(dbm, root, maxMissingState)
+	require.NoError(t, err)
+	assert.Zero(t, missing)
+
+	// The state of the head block is not stored.
+	parent := common.Hash{}
+	for i := int64(0); i < 4; i++ {
+		header := &types.Header{Number: big.NewInt(i), ParentHash: parent, Root: root}
+		if i == 3 {
+			header.Root = common.HexToHash("0xff")
+		}
+		dbm.WriteHeader(header)
+		dbm.WriteCanonicalHash(header.Hash(), uint64(i))
+		parent = header.Hash()
+	}
+	dbm.WriteHeadBlockHash(parent)
+
+	target, err := lastConsistentState(dbm, 3, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), target)
+
+	// A missing code makes the state incomplete.
+	dbm.DeleteCode(crypto.Keccak256Hash(code))
+	missing, err = findMissingState(dbm, root, maxMissingState)
+	require.NoError(t, err)
+	assert.Equal(t, 1, missing)
+
+	_, err = lastConsistentState(dbm, 2, false)
+	assert.Error(t, err)
+	target, err = lastConsistentState(dbm, 2, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), target)
+}
+
+func TestDBVerify_PathScheme(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	dbm.WriteStateScheme(database.PathScheme)
+	db := state.NewDatabase(dbm)
+
+	contract := common.HexToAddress("0xc0de")
+	roots := make([]common.Hash, 2)
+	for i := range roots {
+		parent := common.Hash{}
+		if i > 0 {
+			parent = roots[i-1]
+		}
+		stateDB, err := state.New(parent, db, nil, nil)
+		require.NoError(t, err)
+		if i == 0 {
+			stateDB.CreateSmartContractAccount(contract, params.CodeFormatEVM, params.Rules{})
+		}
+		stateDB.AddBalance(common.BigToAddress(big.NewInt(int64(i+1))), big.NewInt(1))
+		for j := int64(1); j <= 20; j++ {
+			stateDB.SetState(contract, common.BigToHash(big.NewInt(j)), common.BigToHash(big.NewInt(j+int64(i))))
+		}
+		roots[i], err = stateDB.Commit(false)
+		require.NoError(t, err)
+		require.NoError(t, db.TrieDB().Commit(roots[i], false, uint64(i)))
+	}
+
+	// Only the persisted state is verified, and the previous one is recoverable.
+	parent := common.Hash{}
+	for i, root := range append(roots, common.HexToHash("0xff")) {
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: parent, Root: root}
+		dbm.WriteHeader(header)
+		dbm.WriteCanonicalHash(header.Hash(), uint64(i))
+		parent = header.Hash()
+	}
+	target, err := lastConsistentState(dbm, 2, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), target)
+	target, err = lastConsistentState(dbm, 0, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), target)
+
+	// The storage nodes are located by their owners.
+	missing, err := findMissingState(dbm, roots[1], maxMissingState)
+	require.NoError(t, err)
+	assert.Zero(t, missing)
+
+	batch := dbm.NewPathStateDBBatch()
+	batch.DeleteStorageTrieNode(crypto.Keccak256Hash(contract.Bytes()), nil)
+	require.NoError(t, batch.Write())
+	batch.Release()
+	missing, err = findMissingState(dbm, roots[1], maxMissingState)
+	require.NoError(t, err)
+	assert.Equal(t, 1, missing)
+}
+
+func TestDBVerify_RewindHead(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	config := params.TestChainConfig.Copy()
+	config.Istanbul = params.GetDefaultIstanbulConfig()
+	config.Governance = params.GetDefaultGovernanceConfig()
+	blockchain.InitDeriveSha(config)
+	genesis := (&blockchain.Genesis{Config: config}).MustCommit(dbm)
+	blocks, _ := blockchain.GenerateChain(config, genesis, gxhash.NewFaker(), dbm, 4, nil)
+
+	cacheConfig := &blockchain.CacheConfig{
+		ArchiveMode:         true,
+		CacheSize:           512,
+		BlockInterval:       blockchain.DefaultBlockInterval,
+		TriesInMemory:       blockchain.DefaultTriesInMemory,
+		TrieNodeCacheConfig: statedb.GetEmptyTrieNodeCacheConfig(),
+	}
+	bc, err := blockchain.NewBlockChain(dbm, cacheConfig, config, gxhash.NewFaker(), vm.Config{})
+	require.NoError(t, err)
+	_, err = bc.InsertChain(blocks)
+	require.NoError(t, err)
+	bc.Stop()
+
+	// The data of the kaiax modules for the rewound blocks is deleted.
+	staking_impl.WriteStakingInfo(dbm.GetMiscDB(), 3, &staking.StakingInfo{SourceBlockNum: 3})
+	cnConfig := &cn.Config{
+		KaiaBridgeAddr:         common.HexToAddress("0xb4d9e"),
+		KaiaBridgeOperatorAddr: common.HexToAddress("0x96e4"),
+	}
+	newKaiaBridge := func() *kaiabridge_impl.KaiaBridgeModule {
+		// The stopped chain still reports the head before the rewind.
+		m := kaiabridge_impl.NewKaiaBridgeModule()
+		require.NoError(t, m.Init(&kaiabridge_impl.InitOpts{
+			ChainKv:      dbm.GetMiscDB(),
+			Chain:        bc,
+			BridgeAddr:   cnConfig.KaiaBridgeAddr,
+			OperatorAddr: cnConfig.KaiaBridgeOperatorAddr,
+		}))
+		return m
+	}
+	mKaiaBridge := newKaiaBridge()
+	require.NoError(t, mKaiaBridge.Start())
+	require.Eventually(t, func() bool {
+		status, err := mKaiaBridge.GetBridgeStatus()
+		return err == nil && status.LastBlock == 4
+	}, time.Second, 10*time.Millisecond)
+	mKaiaBridge.Stop()
+
+	require.NoError(t, rewindHead(dbm, cnConfig, 4, 2))
+	assert.Equal(t, blocks[1].Hash(), dbm.ReadHeadBlockHash())
+	assert.Equal(t, blocks[1].Hash(), dbm.ReadHeadHeaderHash())
+	assert.Equal(t, common.Hash{}, dbm.ReadCanonicalHash(3))
+	assert.Equal(t, common.Hash{}, dbm.ReadCanonicalHash(4))
+	assert.Nil(t, staking_impl.ReadStakingInfo(dbm.GetMiscDB(), 3))
+	status, err := newKaiaBridge().GetBridgeStatus()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), status.LastBlock)
+}
+
+func TestDBAuditSupply(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	config := params.TestChainConfig.Copy()
+	config.Istanbul = params.GetDefaultIstanbulConfig()
+	config.Governance = params.GetDefaultGovernanceConfig()
+	blockchain.InitDeriveSha(config)
+	genesis := (&blockchain.Genesis{
+		Config: config,
+		Alloc:  blockchain.GenesisAlloc{common.HexToAddress("0xa0"): {Balance: big.NewInt(params.KAIA)}},
+	}).MustCommit(dbm)
+	blocks, _ := blockchain.GenerateChain(config, genesis, gxhash.NewFaker(), dbm, 4, nil)
+
+	bc, _, err := openOfflineChain(dbm)
+	require.NoError(t, err)
+	_, err = bc.InsertChain(blocks)
+	require.NoError(t, err)
+	bc.Stop()
+
+	sm, bc, err := openSupplyManager(dbm)
+	require.NoError(t, err)
+	defer bc.Stop()
+
+	// The supply checkpoints are recorded by the node.
+	_, err = sm.AuditTotalSupply(context.Background(), 4)
+	assert.Error(t, err)
+	sm.Start()
+	assert.Eventually(t, func() bool {
+		_, err := sm.GetTotalSupply(4)
+		return err == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	sm.Stop()
+
+	audit, err := sm.AuditTotalSupply(context.Background(), 4)
+	require.NoError(t, err)
+	// The faker engine pays the block rewards the supply manager doesn't track, hence the discrepancy.
+	assert.Equal(t, big.NewInt(params.KAIA), audit.Expected)
+	assert.Equal(t, new(big.Int).Sub(audit.StateTotal, audit.Expected), audit.Discrepancy)
+	assert.Equal(t, 1, audit.Discrepancy.Sign())
+	assert.False(t, audit.Consistent)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = sm.AuditTotalSupply(ctx, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+}
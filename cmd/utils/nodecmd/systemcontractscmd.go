@@ -0,0 +1,179 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/cmd/utils"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/params"
+	"github.com/urfave/cli/v2"
+)
+
+var errNoGenesis = errors.New("must supply a genesis file or a network flag")
+
+var SystemContractsCommand = &cli.Command{
+	Name:        "system-contracts",
+	Usage:       "A set of commands for the system contracts",
+	Category:    "BLOCKCHAIN COMMANDS",
+	Description: "",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "plan",
+			Usage:     "Print the system contract upgrades scheduled in the chain config",
+			ArgsUsage: "[<genesisPath>]",
+			Action:    utils.MigrateFlags(planSystemContracts),
+			Flags: []cli.Flag{
+				utils.MainnetFlag,
+				utils.KairosFlag,
+			},
+			Description: `
+Kaia system-contracts plan [<genesisPath>]
+validates the chain config of the given genesis file (or of the network
+selected by --mainnet or --kairos) and prints the system contract changes
+applied at the end of each block, in order of application.
+The Registry installation at the Randao hardfork is listed together with
+the upgrades scheduled in "systemContractUpgrades".
+`,
+		},
+	},
+}
+
+// systemContractsPlanEntry is a single system contract change applied at the end of Block.
+type systemContractsPlanEntry struct {
+	Block          *big.Int
+	Name           string
+	Address        common.Address
+	CodeSize       int
+	CodeHash       common.Hash
+	StorageSlots   int
+	Implementation *common.Address
+	Activation     *big.Int // Registry activation block; nil if not registered
+}
+
+func planSystemContracts(ctx *cli.Context) error {
+	genesis, err := loadPlanGenesis(ctx)
+	if err != nil {
+		return err
+	}
+	plan, err := makeSystemContractsPlan(genesis.Config)
+	if err != nil {
+		return err
+	}
+	return printSystemContractsPlan(os.Stdout, plan)
+}
+
+func loadPlanGenesis(ctx *cli.Context) (*blockchain.Genesis, error) {
+	if genesisPath := ctx.Args().First(); len(genesisPath) > 0 {
+		file, err := os.Open(genesisPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read genesis file: %w", err)
+		}
+		defer file.Close()
+
+		genesis := new(blockchain.Genesis)
+		if err := json.NewDecoder(file).Decode(genesis); err != nil {
+			return nil, fmt.Errorf("invalid genesis file: %w", err)
+		}
+		return genesis, nil
+	}
+	if genesis := MakeGenesis(ctx); genesis != nil {
+		return genesis, nil
+	}
+	return nil, errNoGenesis
+}
+
+// makeSystemContractsPlan validates the config and lists the system contract changes it schedules.
+func makeSystemContractsPlan(config *params.ChainConfig) ([]*systemContractsPlanEntry, error) {
+	if config == nil {
+		return nil, errors.New("chain config is not specified")
+	}
+	if err := config.CheckConfigForkOrder(); err != nil {
+		return nil, err
+	}
+
+	var plan []*systemContractsPlanEntry
+	if config.RandaoCompatibleBlock != nil {
+		// The Registry is installed at the Randao hardfork block.
+		plan = append(plan, &systemContractsPlanEntry{
+			Block:   config.RandaoCompatibleBlock,
+			Name:    "Registry",
+			Address: system.RegistryAddr,
+		})
+	}
+	for _, upgrade := range config.SortedSystemContractUpgrades() {
+		entry := &systemContractsPlanEntry{
+			Block:          upgrade.Block,
+			Name:           upgrade.Name,
+			Address:        upgrade.Address,
+			CodeSize:       len(upgrade.Code),
+			StorageSlots:   len(upgrade.Storage),
+			Implementation: upgrade.Implementation,
+		}
+		if len(upgrade.Code) > 0 {
+			entry.CodeHash = crypto.Keccak256Hash(upgrade.Code)
+		}
+		if upgrade.Name != "" {
+			entry.Activation = new(big.Int).Add(upgrade.Block, common.Big1)
+		}
+		plan = append(plan, entry)
+	}
+	sort.SliceStable(plan, func(i, j int) bool {
+		return plan[i].Block.Cmp(plan[j].Block) < 0
+	})
+	return plan, nil
+}
+
+func printSystemContractsPlan(out io.Writer, plan []*systemContractsPlanEntry) error {
+	if len(plan) == 0 {
+		fmt.Fprintln(out, "No system contract changes are scheduled")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "BLOCK\tNAME\tADDRESS\tCODE\tSTORAGE\tIMPLEMENTATION\tACTIVATION")
+	for _, e := range plan {
+		code := "-"
+		if e.CodeSize > 0 {
+			code = fmt.Sprintf("%d bytes (%s)", e.CodeSize, e.CodeHash.Hex())
+		}
+		impl := "-"
+		if e.Implementation != nil {
+			impl = e.Implementation.Hex()
+		}
+		name, activation := e.Name, "-"
+		if name == "" {
+			name = "-"
+		}
+		if e.Activation != nil {
+			activation = e.Activation.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", e.Block, name, e.Address.Hex(), code, e.StorageSlots, impl, activation)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSystemContractsPlan(t *testing.T) {
+	var (
+		addr = common.HexToAddress("0xaaaa")
+		impl = common.HexToAddress("0xbbbb")
+	)
+	config := params.TestChainConfig.Copy()
+	config.IstanbulCompatibleBlock = common.Big0
+	config.LondonCompatibleBlock = common.Big0
+	config.EthTxTypeCompatibleBlock = common.Big0
+	config.MagmaCompatibleBlock = common.Big0
+	config.KoreCompatibleBlock = common.Big0
+	config.ShanghaiCompatibleBlock = common.Big0
+	config.CancunCompatibleBlock = common.Big0
+	config.RandaoCompatibleBlock = big.NewInt(10)
+	config.SystemContractUpgrades = []*params.SystemContractUpgrade{
+		{Block: big.NewInt(30), Address: addr, Implementation: &impl},
+		{Block: big.NewInt(20), Name: "Test", Address: addr, Code: []byte{0x60, 0x00}},
+	}
+
+	plan, err := makeSystemContractsPlan(config)
+	require.NoError(t, err)
+	require.Len(t, plan, 3)
+
+	assert.Equal(t, system.RegistryAddr, plan[0].Address)
+	assert.Equal(t, big.NewInt(10), plan[0].Block)
+	assert.Equal(t, "Test", plan[1].Name)
+	assert.Equal(t, 2, plan[1].CodeSize)
+	assert.Equal(t, big.NewInt(21), plan[1].Activation)
+	assert.Equal(t, &impl, plan[2].Implementation)
+	assert.Nil(t, plan[2].Activation)
+
+	var out bytes.Buffer
+	require.NoError(t, printSystemContractsPlan(&out, plan))
+	assert.Contains(t, out.String(), impl.Hex())
+
+	// An invalid schedule is rejected.
+	config.SystemContractUpgrades = append(config.SystemContractUpgrades,
+		&params.SystemContractUpgrade{Block: big.NewInt(30), Address: addr, Code: []byte{0x00}})
+	_, err = makeSystemContractsPlan(config)
+	assert.Error(t, err)
+}
@@ -217,11 +217,14 @@ var CommonNodeFlags = []cli.Flag{
 	altsrc.NewIntFlag(PebbleDBCacheSizeFlag),
 	altsrc.NewBoolFlag(NoParallelDBWriteFlag),
 	altsrc.NewBoolFlag(SenderTxHashIndexingFlag),
+	altsrc.NewUint64Flag(HistoryExpiryRetentionFlag),
+	altsrc.NewUint64Flag(HistoryExpiryBlockFlag),
 	altsrc.NewIntFlag(TrieMemoryCacheSizeFlag),
 	altsrc.NewUintFlag(TrieBlockIntervalFlag),
 	altsrc.NewUint64Flag(TriesInMemoryFlag),
 	altsrc.NewBoolFlag(LivePruningFlag),
 	altsrc.NewUint64Flag(LivePruningRetentionFlag),
+	altsrc.NewStringFlag(StateSchemeFlag),
 	altsrc.NewIntFlag(CacheTypeFlag),
 	altsrc.NewIntFlag(CacheScaleFlag),
 	altsrc.NewStringFlag(CacheUsageLevelFlag),
@@ -244,6 +247,11 @@ var CommonNodeFlags = []cli.Flag{
 	altsrc.NewUint64Flag(TargetGasLimitFlag),
 	altsrc.NewStringFlag(NATFlag),
 	altsrc.NewBoolFlag(NoDiscoverFlag),
+	altsrc.NewBoolFlag(TopicDiscoveryFlag),
+	altsrc.NewFloat64Flag(TxMsgRateLimitFlag),
+	altsrc.NewFloat64Flag(BlockMsgRateLimitFlag),
+	altsrc.NewFloat64Flag(SyncRequestRateLimitFlag),
+	altsrc.NewFloat64Flag(ConsensusMsgRateLimitFlag),
 	altsrc.NewDurationFlag(RWTimerWaitTimeFlag),
 	altsrc.NewUint64Flag(RWTimerIntervalFlag),
 	altsrc.NewStringFlag(NetrestrictFlag),
@@ -260,6 +268,10 @@ var CommonNodeFlags = []cli.Flag{
 	altsrc.NewBoolFlag(PrometheusExporterFlag),
 	altsrc.NewIntFlag(PrometheusExporterPortFlag),
 	altsrc.NewStringFlag(ExtraDataFlag),
+	altsrc.NewStringFlag(KaiaBridgeAddrFlag),
+	altsrc.NewStringFlag(KaiaBridgeOperatorAddrFlag),
+	altsrc.NewUint64Flag(KaiaBridgeStartBlockFlag),
+	altsrc.NewUint64Flag(StakingDelegatorsStartBlockFlag),
 	altsrc.NewStringFlag(SrvTypeFlag),
 	altsrc.NewBoolFlag(AutoRestartFlag),
 	altsrc.NewDurationFlag(RestartTimeOutFlag),
@@ -311,6 +323,14 @@ var CommonRPCFlags = []cli.Flag{
 	altsrc.NewIntFlag(HeavyDebugRequestLimitFlag),
 	altsrc.NewDurationFlag(StateRegenerationTimeLimitFlag),
 	altsrc.NewStringFlag(RPCUpstreamArchiveENFlag),
+	altsrc.NewBoolFlag(RPCRequestLogFlag),
+	altsrc.NewFloat64Flag(RPCRequestLogSampleRateFlag),
+	altsrc.NewDurationFlag(RPCSlowRequestThresholdFlag),
+	altsrc.NewFloat64Flag(RPCRateLimitFlag),
+	altsrc.NewIntFlag(RPCRateLimitBurstFlag),
+	altsrc.NewStringFlag(RPCRateLimitAPIKeyHeaderFlag),
+	altsrc.NewStringFlag(RPCRateLimitAPIKeysFlag),
+	altsrc.NewStringFlag(RPCMethodCostsFlag),
 }
 
 var BNFlags = []cli.Flag{
@@ -323,6 +343,7 @@ var BNFlags = []cli.Flag{
 	altsrc.NewStringFlag(BNAddrFlag),
 	altsrc.NewStringFlag(NATFlag),
 	altsrc.NewStringFlag(NetrestrictFlag),
+	altsrc.NewBoolFlag(TopicDiscoveryFlag),
 	altsrc.NewBoolFlag(MetricsEnabledFlag),
 	altsrc.NewBoolFlag(PrometheusExporterFlag),
 	altsrc.NewIntFlag(PrometheusExporterPortFlag),
@@ -559,6 +580,15 @@ var DBMigrationDstFlags = []cli.Flag{
 	altsrc.NewBoolFlag(DstRocksDBCacheIndexAndFilterFlag),
 }
 
+var DBVerifyFlags = append(append([]cli.Flag{}, SnapshotFlags...),
+	altsrc.NewUint64Flag(DBVerifyBlocksFlag),
+	altsrc.NewBoolFlag(DBVerifySkipStateFlag),
+	altsrc.NewBoolFlag(DBVerifyRepairFlag),
+	altsrc.NewStringFlag(KaiaBridgeAddrFlag),
+	altsrc.NewStringFlag(KaiaBridgeOperatorAddrFlag),
+	altsrc.NewUint64Flag(KaiaBridgeStartBlockFlag),
+)
+
 var ChainDataFetcherFlags = []cli.Flag{
 	altsrc.NewBoolFlag(EnableChainDataFetcherFlag),
 	altsrc.NewStringFlag(ChainDataFetcherMode),
@@ -28,6 +28,7 @@ import (
 	"github.com/kaiachain/kaia/blockchain/state"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/kaiax"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/networks/p2p"
 	"github.com/kaiachain/kaia/networks/rpc"
@@ -173,7 +174,12 @@ type Istanbul interface {
 	// UpdateParam updates the governance parameter
 	UpdateParam(num uint64) error
 
+	// GetCouncilFromSnapshot returns the council of the snapshot at the given block,
+	// which verifies the next block.
+	GetCouncilFromSnapshot(chain ChainReader, number uint64, hash common.Hash) ([]common.Address, error)
+
 	staking.StakingModuleHost
+	kaiax.ConsensusModuleHost
 }
 
 type ConsensusInfo struct {
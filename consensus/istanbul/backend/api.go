@@ -232,13 +232,12 @@ func (api *APIExtension) GetCouncil(number *rpc.BlockNumber) ([]common.Address,
 		return istanbulExtra.Validators, nil
 	}
 
-	snap, err := checkStatesAndGetSnapshot(api.chain, api.istanbul, blockNumber-1, header.ParentHash)
+	council, err := api.istanbul.GetCouncilFromSnapshot(api.chain, blockNumber-1, header.ParentHash)
 	if err != nil {
 		logger.Error("Failed to get snapshot.", "blockNum", blockNumber, "err", err)
 		return nil, err
 	}
-
-	return append(snap.validators(), snap.demotedValidators()...), nil
+	return council, nil
 }
 
 func (api *APIExtension) GetCouncilSize(number *rpc.BlockNumber) (int, error) {
@@ -41,6 +41,7 @@ import (
 	"github.com/kaiachain/kaia/crypto/bls"
 	"github.com/kaiachain/kaia/event"
 	"github.com/kaiachain/kaia/governance"
+	"github.com/kaiachain/kaia/kaiax"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/reward"
@@ -111,6 +112,7 @@ type backend struct {
 	db               database.DBManager
 	chain            consensus.ChainReader
 	stakingModule    staking.StakingModule
+	consensusModules []kaiax.ConsensusModule
 	currentBlock     func() *types.Block
 	hasBadBlock      func(hash common.Hash) bool
 
@@ -41,6 +41,8 @@ import (
 	"github.com/kaiachain/kaia/consensus/istanbul/validator"
 	"github.com/kaiachain/kaia/consensus/misc"
 	"github.com/kaiachain/kaia/crypto/sha3"
+	"github.com/kaiachain/kaia/kaiax"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"github.com/kaiachain/kaia/params"
@@ -235,6 +237,12 @@ func (sb *backend) verifyHeader(chain consensus.ChainReader, header *types.Heade
 		return errInvalidBlockScore
 	}
 
+	for _, module := range sb.consensusModules {
+		if err := module.VerifyHeader(header); err != nil {
+			return err
+		}
+	}
+
 	return sb.verifyCascadingFields(chain, header, parents)
 }
 
@@ -485,6 +493,12 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 		header.Time = big.NewInt(t.Unix())
 		header.TimeFoS = uint8((t.UnixNano() / 1000 / 1000 / 10) % 100)
 	}
+
+	for _, module := range sb.consensusModules {
+		if err := module.PrepareHeader(header); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -554,35 +568,18 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 
 	reward.DistributeBlockReward(state, rewardSpec.Rewards)
 
-	// RebalanceTreasury can modify the global state (state),
-	// so the existing state db should be used to apply the rebalancing result.
-	// Only on the KIP-103 or KIP-160 hardfork block, the following logic should be executed
-	if chain.Config().IsKIP160ForkBlock(header.Number) || chain.Config().IsKIP103ForkBlock(header.Number) {
-		rebalanceResult, err := system.RebalanceTreasury(state, chain, header)
-		if err != nil {
-			logger.Error("failed to execute treasury rebalancing. State not changed", "err", err)
-		} else {
-			// Leave the memo in the log for later contract finalization
-			isKIP103 := chain.Config().IsKIP103ForkBlock(header.Number) // because memo format differs between KIP-103 and KIP-160
-			logger.Info("successfully executed treasury rebalancing", "memo", string(rebalanceResult.Memo(isKIP103)))
-		}
-	}
-
-	// The Registry contract are installed at RandaoCompatibleBlock with a KIP113 record
-	if chain.Config().IsRandaoForkBlock(header.Number) {
-		err := system.InstallRegistry(state, chain.Config().RandaoRegistry)
-		if err != nil {
-			return nil, err
-		}
+	if err := system.ApplyHardforkChanges(chain, header, state); err != nil {
+		return nil, err
 	}
 
-	// Replace the Mainnet credit contract
-	if chain.Config().IsKaiaForkBlockParent(header.Number) {
-		if chain.Config().ChainID.Uint64() == params.MainnetNetworkId && state.GetCode(system.MainnetCreditAddr) != nil {
-			if err := state.SetCode(system.MainnetCreditAddr, system.MainnetCreditV2Code); err != nil {
+	for _, module := range sb.consensusModules {
+		if hook, ok := module.(kaiax_reward.FinalizeRewardHook); ok {
+			if err := hook.FinalizeReward(header, txs, receipts, rewardSpec); err != nil {
 				return nil, err
 			}
-			logger.Info("Replaced CypressCredit with CypressCreditV2", "blockNum", header.Number.Uint64())
+		}
+		if err := module.FinalizeHeader(header, state, txs, receipts); err != nil {
+			return nil, err
 		}
 	}
 
@@ -704,6 +701,10 @@ func (sb *backend) RegisterStakingModule(module staking.StakingModule) {
 	sb.stakingModule = module
 }
 
+func (sb *backend) RegisterConsensusModule(modules ...kaiax.ConsensusModule) {
+	sb.consensusModules = append(sb.consensusModules, modules...)
+}
+
 // Start implements consensus.Istanbul.Start
 func (sb *backend) Start(chain consensus.ChainReader, currentBlock func() *types.Block, hasBadBlock func(hash common.Hash) bool) error {
 	sb.coreMu.Lock()
@@ -809,6 +810,16 @@ func (sb *backend) CreateSnapshot(chain consensus.ChainReader, number uint64, ha
 	return nil
 }
 
+// GetCouncilFromSnapshot returns the council, i.e. the validators and the demoted validators,
+// of the snapshot at the given block. It is the council that verifies the next block.
+func (sb *backend) GetCouncilFromSnapshot(chain consensus.ChainReader, number uint64, hash common.Hash) ([]common.Address, error) {
+	snap, err := checkStatesAndGetSnapshot(chain, sb, number, hash)
+	if err != nil {
+		return nil, err
+	}
+	return append(snap.validators(), snap.demotedValidators()...), nil
+}
+
 // GetConsensusInfo returns consensus information regarding the given block number.
 func (sb *backend) GetConsensusInfo(block *types.Block) (consensus.ConsensusInfo, error) {
 	blockNumber := block.NumberU64()
@@ -36,6 +36,8 @@ var Modules = map[string]string{
 	"subbridge":        SubBridge_JS,
 	"clique":           CliqueJs,
 	"governance":       Governance_JS,
+	"staking":          Staking_JS,
+	"kaiabridge":       KaiaBridge_JS,
 	"bootnode":         Bootnode_JS,
 	"chaindatafetcher": ChainDataFetcher_JS,
 	"eth":              Eth_JS,
@@ -333,6 +335,51 @@ web3._extend({
 });
 `
 
+const Staking_JS = `
+web3._extend({
+	property: 'staking',
+	methods: [
+		new web3._extend.Method({
+			name: 'getValidatorHistory',
+			call: 'staking_getValidatorHistory',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+	]
+});
+`
+
+const KaiaBridge_JS = `
+web3._extend({
+	property: 'kaiabridge',
+	methods: [
+		new web3._extend.Method({
+			name: 'getProvision',
+			call: 'kaiabridge_getProvision',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getUnconfirmedProvisions',
+			call: 'kaiabridge_getUnconfirmedProvisions',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getUnclaimedProvisions',
+			call: 'kaiabridge_getUnclaimedProvisions',
+			params: 1,
+			inputFormatter: [null]
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'status',
+			getter: 'kaiabridge_getStatus'
+		}),
+	]
+});
+`
+
 const Governance_JS = `
 web3._extend({
 	property: 'governance',
@@ -342,6 +389,12 @@ web3._extend({
 			call: 'governance_vote',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'prepareParamChange',
+			call: 'governance_prepareParamChange',
+			params: 3,
+			inputFormatter: [null, null, null]
+		}),
 		new web3._extend.Method({
 			name: 'getParams',
 			call: 'governance_getParams',
@@ -360,6 +413,12 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getPublicDelegationInfo',
+			call: 'governance_getPublicDelegationInfo',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'getChainConfig',
 			call: 'governance_getChainConfig',
@@ -371,6 +430,12 @@ web3._extend({
 			call: 'governance_getRewardsAccumulated',
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'simulateRewards',
+			call: 'governance_simulateRewards',
+			params: 3,
+			inputFormatter: [null, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		})
 	],
 	properties: [
@@ -398,6 +463,10 @@ web3._extend({
 			name: 'pendingChanges',
 			getter: 'governance_pendingChanges',
 		}),
+		new web3._extend.Property({
+			name: 'pendingContractChanges',
+			getter: 'governance_pendingContractChanges',
+		}),
 		new web3._extend.Property({
 			name: 'votes',
 			getter: 'governance_votes',
@@ -428,6 +497,29 @@ web3._extend({
 			call: 'admin_removePeer',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'banPeer',
+			call: 'admin_banPeer',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'unbanPeer',
+			call: 'admin_unbanPeer',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'peerTraffic',
+			call: 'admin_peerTraffic',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'rpcRateLimits',
+			call: 'admin_rpcRateLimits',
+			params: 1,
+			inputFormatter: [null]
+		}),
 		new web3._extend.Method({
 			name: 'exportChain',
 			call: 'admin_exportChain',
@@ -493,11 +585,20 @@ web3._extend({
 			name: 'saveTrieNodeCacheToDisk',
 			call: 'admin_saveTrieNodeCacheToDisk',
 		}),
+		new web3._extend.Method({
+			name: 'loadTrieNodeCacheFromDisk',
+			call: 'admin_loadTrieNodeCacheFromDisk',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'setMaxSubscriptionPerWSConn',
 			call: 'admin_setMaxSubscriptionPerWSConn',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'rpcStats',
+			call: 'admin_rpcStats'
+		}),
 		new web3._extend.Method({
 			name: 'startSpamThrottler',
 			call: 'admin_startSpamThrottler',
@@ -534,6 +635,12 @@ web3._extend({
 			name: 'syncStakingInfoStatus',
 			call: 'admin_syncStakingInfoStatus',
 		}),
+		new web3._extend.Method({
+			name: 'checkBlsKey',
+			call: 'admin_checkBlsKey',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -544,6 +651,10 @@ web3._extend({
 			name: 'peers',
 			getter: 'admin_peers'
 		}),
+		new web3._extend.Property({
+			name: 'bannedPeers',
+			getter: 'admin_bannedPeers'
+		}),
 		new web3._extend.Property({
 			name: 'datadir',
 			getter: 'admin_datadir'
@@ -856,6 +967,18 @@ web3._extend({
 			params: 1,
 			inputFormatter: [null]
 		}),
+		new web3._extend.Method({
+			name: 'auditTotalSupply',
+			call: 'debug_auditTotalSupply',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getMintBreakdown',
+			call: 'debug_getMintBreakdown',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'storageRangeAt',
 			call: 'debug_storageRangeAt',
@@ -955,6 +1078,18 @@ var klayMethods = [
 		params: 1,
 		inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 	}),
+	new web3._extend.Method({
+		name: 'getPublicDelegationInfo',
+		call: 'klay_getPublicDelegationInfo',
+		params: 1,
+		inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+	}),
+	new web3._extend.Method({
+		name: 'simulateTreasuryRebalance',
+		call: 'klay_simulateTreasuryRebalance',
+		params: 2,
+		inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+	}),
 	new web3._extend.Method({
 		name: 'getParams',
 		call: 'klay_getParams',
@@ -1020,6 +1155,12 @@ var klayMethods = [
 		params: 1,
 		inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 	}),
+	new web3._extend.Method({
+		name: 'getBlsKeyReport',
+		call: 'klay_getBlsKeyReport',
+		params: 1,
+		inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+	}),
 	new web3._extend.Method({
 		name: 'isContractAccount',
 		call: 'klay_isContractAccount',
@@ -0,0 +1,7998 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package kaiabridge
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/kaiachain/kaia"
+	"github.com/kaiachain/kaia/accounts/abi"
+	"github.com/kaiachain/kaia/accounts/abi/bind"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = kaia.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IBridgeProvisionConfirmedEvent is an auto generated low-level Go binding around an user-defined struct.
+type IBridgeProvisionConfirmedEvent struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// IBridgeProvisionData is an auto generated low-level Go binding around an user-defined struct.
+type IBridgeProvisionData struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// IBridgeProvisionIndividualEvent is an auto generated low-level Go binding around an user-defined struct.
+type IBridgeProvisionIndividualEvent struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+	TxID     uint64
+	Operator common.Address
+}
+
+// IBridgeSwapRequest is an auto generated low-level Go binding around an user-defined struct.
+type IBridgeSwapRequest struct {
+	Seq      uint64
+	Sender   common.Address
+	Receiver string
+	Amount   *big.Int
+}
+
+// IBridgeMetaData contains all meta data concerning the IBridge contract.
+var IBridgeMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"bridgeBalance\",\"type\":\"uint256\"}],\"name\":\"BridgeBalanceBurned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"msg\",\"type\":\"string\"}],\"name\":\"BridgePause\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"string\",\"name\":\"msg\",\"type\":\"string\"}],\"name\":\"BridgeResume\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bool\",\"name\":\"before\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"now\",\"type\":\"bool\"}],\"name\":\"ChangeAddrValidation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"bridgeServicePeriod\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newPeriod\",\"type\":\"uint256\"}],\"name\":\"ChangeBridgeServicePeriod\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeGuardian\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newGuardian\",\"type\":\"address\"}],\"name\":\"ChangeGuardian\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeJudge\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newJudge\",\"type\":\"address\"}],\"name\":\"ChangeJudge\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeOperator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOperator\",\"type\":\"address\"}],\"name\":\"ChangeOperator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"time\",\"type\":\"uint256\"}],\"name\":\"ChangeTransferTimeLock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":true,\"internalType\":\"structIBridge.ProvisionData\",\"name\":\"provision\",\"type\":\"tuple\"}],\"name\":\"Claim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"seq\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"time\",\"type\":\"uint256\"}],\"name\":\"HoldClaim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"KAIACharged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"beforeMaxLock\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMaxLock\",\"type\":\"uint256\"}],\"name\":\"MaxLockableKAIAChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"maxTryTransfer\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMaxTryTransfer\",\"type\":\"uint256\"}],\"name\":\"MaxTryTransferChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"beforeMinLock\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinLock\",\"type\":\"uint256\"}],\"name\":\"MinLockableKAIAChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structIBridge.ProvisionIndividualEvent\",\"name\":\"provision\",\"type\":\"tuple\"}],\"name\":\"Provision\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIBridge.ProvisionConfirmedEvent\",\"name\":\"provision\",\"type\":\"tuple\"}],\"name\":\"ProvisionConfirm\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeReceiver\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newReceiver\",\"type\":\"address\"}],\"name\":\"ProvisionReceiverChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"seq\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"time\",\"type\":\"uint256\"}],\"name\":\"ReleaseClaim\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIBridge.ProvisionData\",\"name\":\"provision\",\"type\":\"tuple\"}],\"name\":\"RemoveProvision\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIBridge.SwapRequest\",\"name\":\"lockInfo\",\"type\":\"tuple\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bool\",\"name\":\"transferFromKaiaOn\",\"type\":\"bool\"},{\"indexed\":true,\"internalType\":\"bool\",\"name\":\"set\",\"type\":\"bool\"}],\"name\":\"TransferFromKaiaOnOffChanged\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"KAIA_UNIT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TRANSFERLOCK\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"accumulatedClaimAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"addrValidationOn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"bridgeServicePeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"bridgeServiceStarted\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"burnBridgeBalance\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"bytes2Provision\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIBridge.ProvisionData\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newPeriod\",\"type\":\"uint256\"}],\"name\":\"changeBridgeServicePeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newGuardian\",\"type\":\"address\"}],\"name\":\"changeGuardian\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newJudge\",\"type\":\"address\"}],\"name\":\"changeJudge\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMaxLockableKAIA\",\"type\":\"uint256\"}],\"name\":\"changeMaxLockableKAIA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMaxTryTransfer\",\"type\":\"uint256\"}],\"name\":\"changeMaxTryTransfer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newMinLockableKAIA\",\"type\":\"uint256\"}],\"name\":\"changeMinLockableKAIA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOperator\",\"type\":\"address\"}],\"name\":\"changeOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"set\",\"type\":\"bool\"}],\"name\":\"changeTransferEnable\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"duration\",\"type\":\"uint256\"}],\"name\":\"changeTransferTimeLock\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"name\":\"claimed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAllSwapRequests\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIBridge.SwapRequest[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getClaimCandidates\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"range\",\"type\":\"uint64\"}],\"name\":\"getClaimCandidatesRange\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"range\",\"type\":\"uint64\"}],\"name\":\"getClaimCandidatesRangePure\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getClaimCandidatesSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getClaimFailures\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"range\",\"type\":\"uint64\"}],\"name\":\"getClaimFailuresRange\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"from\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"to\",\"type\":\"uint256\"}],\"name\":\"getSwapRequests\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIBridge.SwapRequest[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"greatestConfirmedSeq\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"guardian\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"seq\",\"type\":\"uint256\"}],\"name\":\"holdClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"seq\",\"type\":\"uint256\"}],\"name\":\"isPassedTimeLockDuration\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"isProvisioned\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"from\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"to\",\"type\":\"uint64\"}],\"name\":\"isProvisionedRange\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"judge\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"locked\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxLockableKAIA\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"maxTryTransfer\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"minLockableKAIA\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nClaimed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nProvisioned\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nTransferHolds\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nextProvisionSeq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"operator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pause\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"pauseMsg\",\"type\":\"string\"}],\"name\":\"pauseBridge\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIBridge.ProvisionData\",\"name\":\"prov\",\"type\":\"tuple\"}],\"name\":\"provision\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"name\":\"provisions\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"seq\",\"type\":\"uint256\"}],\"name\":\"releaseClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"removeProvision\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"range\",\"type\":\"uint64\"}],\"name\":\"requestBatchClaim\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"requestClaim\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"newReceiver\",\"type\":\"address\"}],\"name\":\"resolveUnclaimable\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"resumeMsg\",\"type\":\"string\"}],\"name\":\"resumeBridge\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"seq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"name\":\"seq2BlockNum\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"onOff\",\"type\":\"bool\"}],\"name\":\"setAddrValidation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"timelocks\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"}],\"name\":\"transfer\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transferFail\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"transferFromKaiaOn\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"0b6b5bfa": "KAIA_UNIT()",
+		"fc0d4506": "TRANSFERLOCK()",
+		"a2a82991": "accumulatedClaimAmount()",
+		"d67806b8": "addrValidationOn()",
+		"714a9a75": "bridgeServicePeriod()",
+		"ea8ebb14": "bridgeServiceStarted()",
+		"44818617": "burnBridgeBalance()",
+		"9369ccd7": "bytes2Provision(bytes)",
+		"7276952e": "changeBridgeServicePeriod(uint256)",
+		"2fcb4f04": "changeGuardian(address)",
+		"e0caf578": "changeJudge(address)",
+		"61a5a871": "changeMaxLockableKAIA(uint256)",
+		"82d90251": "changeMaxTryTransfer(uint256)",
+		"cbbde105": "changeMinLockableKAIA(uint256)",
+		"06394c9b": "changeOperator(address)",
+		"d75bf506": "changeTransferEnable(bool)",
+		"206eb384": "changeTransferTimeLock(uint256)",
+		"9e8da543": "claimed(uint64)",
+		"ce39fe20": "getAllSwapRequests()",
+		"a34c853d": "getClaimCandidates()",
+		"eba24d1f": "getClaimCandidatesRange(uint64)",
+		"2cc55926": "getClaimCandidatesRangePure(uint64)",
+		"d8beba83": "getClaimCandidatesSize()",
+		"7a51dd5b": "getClaimFailures()",
+		"57fc42b1": "getClaimFailuresRange(uint64)",
+		"700c69b4": "getSwapRequests(uint256,uint256)",
+		"87d55377": "greatestConfirmedSeq()",
+		"452a9320": "guardian()",
+		"177a1555": "holdClaim(uint256)",
+		"7d1e6656": "isPassedTimeLockDuration(uint256)",
+		"3b0d01e8": "isProvisioned(uint64)",
+		"cefcab8a": "isProvisionedRange(uint64,uint64)",
+		"573255f4": "judge()",
+		"b45a3c0e": "locked(uint256)",
+		"5efac2ec": "maxLockableKAIA()",
+		"fe87b162": "maxTryTransfer()",
+		"19ab4e3a": "minLockableKAIA()",
+		"fa2f49b4": "nClaimed()",
+		"7202be13": "nProvisioned()",
+		"13f4bab9": "nTransferHolds()",
+		"e9133f3b": "nextProvisionSeq()",
+		"570ca735": "operator()",
+		"8456cb59": "pause()",
+		"ea3776de": "pauseBridge(string)",
+		"1919b0c8": "provision((uint64,string,address,uint256))",
+		"2a10e777": "provisions(uint64)",
+		"a8bde4eb": "releaseClaim(uint256)",
+		"2dee1734": "removeProvision(uint64)",
+		"2ce434e9": "requestBatchClaim(uint64)",
+		"700fc4f7": "requestClaim(uint64)",
+		"3d1153e0": "resolveUnclaimable(uint64,address)",
+		"539135dd": "resumeBridge(string)",
+		"6857ab40": "seq()",
+		"084419b1": "seq2BlockNum(uint64)",
+		"dafa2f8d": "setAddrValidation(bool)",
+		"0d7e149f": "timelocks(uint256)",
+		"a0258d0b": "transfer(string)",
+		"39390874": "transferFail(uint256)",
+		"73297448": "transferFromKaiaOn()",
+	},
+}
+
+// IBridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IBridgeMetaData.ABI instead.
+var IBridgeABI = IBridgeMetaData.ABI
+
+// IBridgeBinRuntime is the compiled bytecode used for adding genesis block without deploying code.
+const IBridgeBinRuntime = ``
+
+// IBridgeFuncSigs maps the 4-byte function signature to its string representation.
+// Deprecated: Use IBridgeMetaData.Sigs instead.
+var IBridgeFuncSigs = IBridgeMetaData.Sigs
+
+// IBridge is an auto generated Go binding around a Kaia contract.
+type IBridge struct {
+	IBridgeCaller     // Read-only binding to the contract
+	IBridgeTransactor // Write-only binding to the contract
+	IBridgeFilterer   // Log filterer for contract events
+}
+
+// IBridgeCaller is an auto generated read-only Go binding around a Kaia contract.
+type IBridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeTransactor is an auto generated write-only Go binding around a Kaia contract.
+type IBridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeFilterer is an auto generated log filtering Go binding around a Kaia contract events.
+type IBridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBridgeSession is an auto generated Go binding around a Kaia contract,
+// with pre-set call and transact options.
+type IBridgeSession struct {
+	Contract     *IBridge          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IBridgeCallerSession is an auto generated read-only Go binding around a Kaia contract,
+// with pre-set call options.
+type IBridgeCallerSession struct {
+	Contract *IBridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// IBridgeTransactorSession is an auto generated write-only Go binding around a Kaia contract,
+// with pre-set transact options.
+type IBridgeTransactorSession struct {
+	Contract     *IBridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// IBridgeRaw is an auto generated low-level Go binding around a Kaia contract.
+type IBridgeRaw struct {
+	Contract *IBridge // Generic contract binding to access the raw methods on
+}
+
+// IBridgeCallerRaw is an auto generated low-level read-only Go binding around a Kaia contract.
+type IBridgeCallerRaw struct {
+	Contract *IBridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IBridgeTransactorRaw is an auto generated low-level write-only Go binding around a Kaia contract.
+type IBridgeTransactorRaw struct {
+	Contract *IBridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIBridge creates a new instance of IBridge, bound to a specific deployed contract.
+func NewIBridge(address common.Address, backend bind.ContractBackend) (*IBridge, error) {
+	contract, err := bindIBridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridge{IBridgeCaller: IBridgeCaller{contract: contract}, IBridgeTransactor: IBridgeTransactor{contract: contract}, IBridgeFilterer: IBridgeFilterer{contract: contract}}, nil
+}
+
+// NewIBridgeCaller creates a new read-only instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeCaller(address common.Address, caller bind.ContractCaller) (*IBridgeCaller, error) {
+	contract, err := bindIBridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeCaller{contract: contract}, nil
+}
+
+// NewIBridgeTransactor creates a new write-only instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*IBridgeTransactor, error) {
+	contract, err := bindIBridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeTransactor{contract: contract}, nil
+}
+
+// NewIBridgeFilterer creates a new log filterer instance of IBridge, bound to a specific deployed contract.
+func NewIBridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*IBridgeFilterer, error) {
+	contract, err := bindIBridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeFilterer{contract: contract}, nil
+}
+
+// bindIBridge binds a generic wrapper to an already deployed contract.
+func bindIBridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IBridgeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridge *IBridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridge.Contract.IBridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridge *IBridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridge.Contract.IBridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridge *IBridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridge.Contract.IBridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBridge *IBridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBridge *IBridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBridge *IBridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// KAIAUNIT is a free data retrieval call binding the contract method 0x0b6b5bfa.
+//
+// Solidity: function KAIA_UNIT() view returns(uint256)
+func (_IBridge *IBridgeCaller) KAIAUNIT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "KAIA_UNIT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// KAIAUNIT is a free data retrieval call binding the contract method 0x0b6b5bfa.
+//
+// Solidity: function KAIA_UNIT() view returns(uint256)
+func (_IBridge *IBridgeSession) KAIAUNIT() (*big.Int, error) {
+	return _IBridge.Contract.KAIAUNIT(&_IBridge.CallOpts)
+}
+
+// KAIAUNIT is a free data retrieval call binding the contract method 0x0b6b5bfa.
+//
+// Solidity: function KAIA_UNIT() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) KAIAUNIT() (*big.Int, error) {
+	return _IBridge.Contract.KAIAUNIT(&_IBridge.CallOpts)
+}
+
+// TRANSFERLOCK is a free data retrieval call binding the contract method 0xfc0d4506.
+//
+// Solidity: function TRANSFERLOCK() view returns(uint256)
+func (_IBridge *IBridgeCaller) TRANSFERLOCK(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "TRANSFERLOCK")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TRANSFERLOCK is a free data retrieval call binding the contract method 0xfc0d4506.
+//
+// Solidity: function TRANSFERLOCK() view returns(uint256)
+func (_IBridge *IBridgeSession) TRANSFERLOCK() (*big.Int, error) {
+	return _IBridge.Contract.TRANSFERLOCK(&_IBridge.CallOpts)
+}
+
+// TRANSFERLOCK is a free data retrieval call binding the contract method 0xfc0d4506.
+//
+// Solidity: function TRANSFERLOCK() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) TRANSFERLOCK() (*big.Int, error) {
+	return _IBridge.Contract.TRANSFERLOCK(&_IBridge.CallOpts)
+}
+
+// AccumulatedClaimAmount is a free data retrieval call binding the contract method 0xa2a82991.
+//
+// Solidity: function accumulatedClaimAmount() view returns(uint256)
+func (_IBridge *IBridgeCaller) AccumulatedClaimAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "accumulatedClaimAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AccumulatedClaimAmount is a free data retrieval call binding the contract method 0xa2a82991.
+//
+// Solidity: function accumulatedClaimAmount() view returns(uint256)
+func (_IBridge *IBridgeSession) AccumulatedClaimAmount() (*big.Int, error) {
+	return _IBridge.Contract.AccumulatedClaimAmount(&_IBridge.CallOpts)
+}
+
+// AccumulatedClaimAmount is a free data retrieval call binding the contract method 0xa2a82991.
+//
+// Solidity: function accumulatedClaimAmount() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) AccumulatedClaimAmount() (*big.Int, error) {
+	return _IBridge.Contract.AccumulatedClaimAmount(&_IBridge.CallOpts)
+}
+
+// AddrValidationOn is a free data retrieval call binding the contract method 0xd67806b8.
+//
+// Solidity: function addrValidationOn() view returns(bool)
+func (_IBridge *IBridgeCaller) AddrValidationOn(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "addrValidationOn")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AddrValidationOn is a free data retrieval call binding the contract method 0xd67806b8.
+//
+// Solidity: function addrValidationOn() view returns(bool)
+func (_IBridge *IBridgeSession) AddrValidationOn() (bool, error) {
+	return _IBridge.Contract.AddrValidationOn(&_IBridge.CallOpts)
+}
+
+// AddrValidationOn is a free data retrieval call binding the contract method 0xd67806b8.
+//
+// Solidity: function addrValidationOn() view returns(bool)
+func (_IBridge *IBridgeCallerSession) AddrValidationOn() (bool, error) {
+	return _IBridge.Contract.AddrValidationOn(&_IBridge.CallOpts)
+}
+
+// BridgeServicePeriod is a free data retrieval call binding the contract method 0x714a9a75.
+//
+// Solidity: function bridgeServicePeriod() view returns(uint256)
+func (_IBridge *IBridgeCaller) BridgeServicePeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "bridgeServicePeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BridgeServicePeriod is a free data retrieval call binding the contract method 0x714a9a75.
+//
+// Solidity: function bridgeServicePeriod() view returns(uint256)
+func (_IBridge *IBridgeSession) BridgeServicePeriod() (*big.Int, error) {
+	return _IBridge.Contract.BridgeServicePeriod(&_IBridge.CallOpts)
+}
+
+// BridgeServicePeriod is a free data retrieval call binding the contract method 0x714a9a75.
+//
+// Solidity: function bridgeServicePeriod() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) BridgeServicePeriod() (*big.Int, error) {
+	return _IBridge.Contract.BridgeServicePeriod(&_IBridge.CallOpts)
+}
+
+// BridgeServiceStarted is a free data retrieval call binding the contract method 0xea8ebb14.
+//
+// Solidity: function bridgeServiceStarted() view returns(uint256)
+func (_IBridge *IBridgeCaller) BridgeServiceStarted(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "bridgeServiceStarted")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BridgeServiceStarted is a free data retrieval call binding the contract method 0xea8ebb14.
+//
+// Solidity: function bridgeServiceStarted() view returns(uint256)
+func (_IBridge *IBridgeSession) BridgeServiceStarted() (*big.Int, error) {
+	return _IBridge.Contract.BridgeServiceStarted(&_IBridge.CallOpts)
+}
+
+// BridgeServiceStarted is a free data retrieval call binding the contract method 0xea8ebb14.
+//
+// Solidity: function bridgeServiceStarted() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) BridgeServiceStarted() (*big.Int, error) {
+	return _IBridge.Contract.BridgeServiceStarted(&_IBridge.CallOpts)
+}
+
+// Bytes2Provision is a free data retrieval call binding the contract method 0x9369ccd7.
+//
+// Solidity: function bytes2Provision(bytes data) pure returns((uint64,string,address,uint256))
+func (_IBridge *IBridgeCaller) Bytes2Provision(opts *bind.CallOpts, data []byte) (IBridgeProvisionData, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "bytes2Provision", data)
+
+	if err != nil {
+		return *new(IBridgeProvisionData), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IBridgeProvisionData)).(*IBridgeProvisionData)
+
+	return out0, err
+
+}
+
+// Bytes2Provision is a free data retrieval call binding the contract method 0x9369ccd7.
+//
+// Solidity: function bytes2Provision(bytes data) pure returns((uint64,string,address,uint256))
+func (_IBridge *IBridgeSession) Bytes2Provision(data []byte) (IBridgeProvisionData, error) {
+	return _IBridge.Contract.Bytes2Provision(&_IBridge.CallOpts, data)
+}
+
+// Bytes2Provision is a free data retrieval call binding the contract method 0x9369ccd7.
+//
+// Solidity: function bytes2Provision(bytes data) pure returns((uint64,string,address,uint256))
+func (_IBridge *IBridgeCallerSession) Bytes2Provision(data []byte) (IBridgeProvisionData, error) {
+	return _IBridge.Contract.Bytes2Provision(&_IBridge.CallOpts, data)
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x9e8da543.
+//
+// Solidity: function claimed(uint64 ) view returns(bool)
+func (_IBridge *IBridgeCaller) Claimed(opts *bind.CallOpts, arg0 uint64) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "claimed", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x9e8da543.
+//
+// Solidity: function claimed(uint64 ) view returns(bool)
+func (_IBridge *IBridgeSession) Claimed(arg0 uint64) (bool, error) {
+	return _IBridge.Contract.Claimed(&_IBridge.CallOpts, arg0)
+}
+
+// Claimed is a free data retrieval call binding the contract method 0x9e8da543.
+//
+// Solidity: function claimed(uint64 ) view returns(bool)
+func (_IBridge *IBridgeCallerSession) Claimed(arg0 uint64) (bool, error) {
+	return _IBridge.Contract.Claimed(&_IBridge.CallOpts, arg0)
+}
+
+// GetAllSwapRequests is a free data retrieval call binding the contract method 0xce39fe20.
+//
+// Solidity: function getAllSwapRequests() view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeCaller) GetAllSwapRequests(opts *bind.CallOpts) ([]IBridgeSwapRequest, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getAllSwapRequests")
+
+	if err != nil {
+		return *new([]IBridgeSwapRequest), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBridgeSwapRequest)).(*[]IBridgeSwapRequest)
+
+	return out0, err
+
+}
+
+// GetAllSwapRequests is a free data retrieval call binding the contract method 0xce39fe20.
+//
+// Solidity: function getAllSwapRequests() view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeSession) GetAllSwapRequests() ([]IBridgeSwapRequest, error) {
+	return _IBridge.Contract.GetAllSwapRequests(&_IBridge.CallOpts)
+}
+
+// GetAllSwapRequests is a free data retrieval call binding the contract method 0xce39fe20.
+//
+// Solidity: function getAllSwapRequests() view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeCallerSession) GetAllSwapRequests() ([]IBridgeSwapRequest, error) {
+	return _IBridge.Contract.GetAllSwapRequests(&_IBridge.CallOpts)
+}
+
+// GetClaimCandidates is a free data retrieval call binding the contract method 0xa34c853d.
+//
+// Solidity: function getClaimCandidates() view returns(uint64[])
+func (_IBridge *IBridgeCaller) GetClaimCandidates(opts *bind.CallOpts) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimCandidates")
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetClaimCandidates is a free data retrieval call binding the contract method 0xa34c853d.
+//
+// Solidity: function getClaimCandidates() view returns(uint64[])
+func (_IBridge *IBridgeSession) GetClaimCandidates() ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidates(&_IBridge.CallOpts)
+}
+
+// GetClaimCandidates is a free data retrieval call binding the contract method 0xa34c853d.
+//
+// Solidity: function getClaimCandidates() view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) GetClaimCandidates() ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidates(&_IBridge.CallOpts)
+}
+
+// GetClaimCandidatesRange is a free data retrieval call binding the contract method 0xeba24d1f.
+//
+// Solidity: function getClaimCandidatesRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCaller) GetClaimCandidatesRange(opts *bind.CallOpts, arg0 uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimCandidatesRange", arg0)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetClaimCandidatesRange is a free data retrieval call binding the contract method 0xeba24d1f.
+//
+// Solidity: function getClaimCandidatesRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeSession) GetClaimCandidatesRange(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidatesRange(&_IBridge.CallOpts, arg0)
+}
+
+// GetClaimCandidatesRange is a free data retrieval call binding the contract method 0xeba24d1f.
+//
+// Solidity: function getClaimCandidatesRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) GetClaimCandidatesRange(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidatesRange(&_IBridge.CallOpts, arg0)
+}
+
+// GetClaimCandidatesRangePure is a free data retrieval call binding the contract method 0x2cc55926.
+//
+// Solidity: function getClaimCandidatesRangePure(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCaller) GetClaimCandidatesRangePure(opts *bind.CallOpts, arg0 uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimCandidatesRangePure", arg0)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetClaimCandidatesRangePure is a free data retrieval call binding the contract method 0x2cc55926.
+//
+// Solidity: function getClaimCandidatesRangePure(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeSession) GetClaimCandidatesRangePure(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidatesRangePure(&_IBridge.CallOpts, arg0)
+}
+
+// GetClaimCandidatesRangePure is a free data retrieval call binding the contract method 0x2cc55926.
+//
+// Solidity: function getClaimCandidatesRangePure(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) GetClaimCandidatesRangePure(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimCandidatesRangePure(&_IBridge.CallOpts, arg0)
+}
+
+// GetClaimCandidatesSize is a free data retrieval call binding the contract method 0xd8beba83.
+//
+// Solidity: function getClaimCandidatesSize() view returns(uint256)
+func (_IBridge *IBridgeCaller) GetClaimCandidatesSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimCandidatesSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetClaimCandidatesSize is a free data retrieval call binding the contract method 0xd8beba83.
+//
+// Solidity: function getClaimCandidatesSize() view returns(uint256)
+func (_IBridge *IBridgeSession) GetClaimCandidatesSize() (*big.Int, error) {
+	return _IBridge.Contract.GetClaimCandidatesSize(&_IBridge.CallOpts)
+}
+
+// GetClaimCandidatesSize is a free data retrieval call binding the contract method 0xd8beba83.
+//
+// Solidity: function getClaimCandidatesSize() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) GetClaimCandidatesSize() (*big.Int, error) {
+	return _IBridge.Contract.GetClaimCandidatesSize(&_IBridge.CallOpts)
+}
+
+// GetClaimFailures is a free data retrieval call binding the contract method 0x7a51dd5b.
+//
+// Solidity: function getClaimFailures() view returns(uint64[])
+func (_IBridge *IBridgeCaller) GetClaimFailures(opts *bind.CallOpts) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimFailures")
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetClaimFailures is a free data retrieval call binding the contract method 0x7a51dd5b.
+//
+// Solidity: function getClaimFailures() view returns(uint64[])
+func (_IBridge *IBridgeSession) GetClaimFailures() ([]uint64, error) {
+	return _IBridge.Contract.GetClaimFailures(&_IBridge.CallOpts)
+}
+
+// GetClaimFailures is a free data retrieval call binding the contract method 0x7a51dd5b.
+//
+// Solidity: function getClaimFailures() view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) GetClaimFailures() ([]uint64, error) {
+	return _IBridge.Contract.GetClaimFailures(&_IBridge.CallOpts)
+}
+
+// GetClaimFailuresRange is a free data retrieval call binding the contract method 0x57fc42b1.
+//
+// Solidity: function getClaimFailuresRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCaller) GetClaimFailuresRange(opts *bind.CallOpts, arg0 uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getClaimFailuresRange", arg0)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetClaimFailuresRange is a free data retrieval call binding the contract method 0x57fc42b1.
+//
+// Solidity: function getClaimFailuresRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeSession) GetClaimFailuresRange(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimFailuresRange(&_IBridge.CallOpts, arg0)
+}
+
+// GetClaimFailuresRange is a free data retrieval call binding the contract method 0x57fc42b1.
+//
+// Solidity: function getClaimFailuresRange(uint64 range) view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) GetClaimFailuresRange(arg0 uint64) ([]uint64, error) {
+	return _IBridge.Contract.GetClaimFailuresRange(&_IBridge.CallOpts, arg0)
+}
+
+// GetSwapRequests is a free data retrieval call binding the contract method 0x700c69b4.
+//
+// Solidity: function getSwapRequests(uint256 from, uint256 to) view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeCaller) GetSwapRequests(opts *bind.CallOpts, from *big.Int, to *big.Int) ([]IBridgeSwapRequest, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "getSwapRequests", from, to)
+
+	if err != nil {
+		return *new([]IBridgeSwapRequest), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IBridgeSwapRequest)).(*[]IBridgeSwapRequest)
+
+	return out0, err
+
+}
+
+// GetSwapRequests is a free data retrieval call binding the contract method 0x700c69b4.
+//
+// Solidity: function getSwapRequests(uint256 from, uint256 to) view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeSession) GetSwapRequests(from *big.Int, to *big.Int) ([]IBridgeSwapRequest, error) {
+	return _IBridge.Contract.GetSwapRequests(&_IBridge.CallOpts, from, to)
+}
+
+// GetSwapRequests is a free data retrieval call binding the contract method 0x700c69b4.
+//
+// Solidity: function getSwapRequests(uint256 from, uint256 to) view returns((uint64,address,string,uint256)[])
+func (_IBridge *IBridgeCallerSession) GetSwapRequests(from *big.Int, to *big.Int) ([]IBridgeSwapRequest, error) {
+	return _IBridge.Contract.GetSwapRequests(&_IBridge.CallOpts, from, to)
+}
+
+// GreatestConfirmedSeq is a free data retrieval call binding the contract method 0x87d55377.
+//
+// Solidity: function greatestConfirmedSeq() view returns(uint256)
+func (_IBridge *IBridgeCaller) GreatestConfirmedSeq(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "greatestConfirmedSeq")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GreatestConfirmedSeq is a free data retrieval call binding the contract method 0x87d55377.
+//
+// Solidity: function greatestConfirmedSeq() view returns(uint256)
+func (_IBridge *IBridgeSession) GreatestConfirmedSeq() (*big.Int, error) {
+	return _IBridge.Contract.GreatestConfirmedSeq(&_IBridge.CallOpts)
+}
+
+// GreatestConfirmedSeq is a free data retrieval call binding the contract method 0x87d55377.
+//
+// Solidity: function greatestConfirmedSeq() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) GreatestConfirmedSeq() (*big.Int, error) {
+	return _IBridge.Contract.GreatestConfirmedSeq(&_IBridge.CallOpts)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IBridge *IBridgeCaller) Guardian(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "guardian")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IBridge *IBridgeSession) Guardian() (common.Address, error) {
+	return _IBridge.Contract.Guardian(&_IBridge.CallOpts)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IBridge *IBridgeCallerSession) Guardian() (common.Address, error) {
+	return _IBridge.Contract.Guardian(&_IBridge.CallOpts)
+}
+
+// IsProvisioned is a free data retrieval call binding the contract method 0x3b0d01e8.
+//
+// Solidity: function isProvisioned(uint64 seq) view returns(bool)
+func (_IBridge *IBridgeCaller) IsProvisioned(opts *bind.CallOpts, seq uint64) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "isProvisioned", seq)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsProvisioned is a free data retrieval call binding the contract method 0x3b0d01e8.
+//
+// Solidity: function isProvisioned(uint64 seq) view returns(bool)
+func (_IBridge *IBridgeSession) IsProvisioned(seq uint64) (bool, error) {
+	return _IBridge.Contract.IsProvisioned(&_IBridge.CallOpts, seq)
+}
+
+// IsProvisioned is a free data retrieval call binding the contract method 0x3b0d01e8.
+//
+// Solidity: function isProvisioned(uint64 seq) view returns(bool)
+func (_IBridge *IBridgeCallerSession) IsProvisioned(seq uint64) (bool, error) {
+	return _IBridge.Contract.IsProvisioned(&_IBridge.CallOpts, seq)
+}
+
+// IsProvisionedRange is a free data retrieval call binding the contract method 0xcefcab8a.
+//
+// Solidity: function isProvisionedRange(uint64 from, uint64 to) view returns(uint64[])
+func (_IBridge *IBridgeCaller) IsProvisionedRange(opts *bind.CallOpts, from uint64, to uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "isProvisionedRange", from, to)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// IsProvisionedRange is a free data retrieval call binding the contract method 0xcefcab8a.
+//
+// Solidity: function isProvisionedRange(uint64 from, uint64 to) view returns(uint64[])
+func (_IBridge *IBridgeSession) IsProvisionedRange(from uint64, to uint64) ([]uint64, error) {
+	return _IBridge.Contract.IsProvisionedRange(&_IBridge.CallOpts, from, to)
+}
+
+// IsProvisionedRange is a free data retrieval call binding the contract method 0xcefcab8a.
+//
+// Solidity: function isProvisionedRange(uint64 from, uint64 to) view returns(uint64[])
+func (_IBridge *IBridgeCallerSession) IsProvisionedRange(from uint64, to uint64) ([]uint64, error) {
+	return _IBridge.Contract.IsProvisionedRange(&_IBridge.CallOpts, from, to)
+}
+
+// Judge is a free data retrieval call binding the contract method 0x573255f4.
+//
+// Solidity: function judge() view returns(address)
+func (_IBridge *IBridgeCaller) Judge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "judge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Judge is a free data retrieval call binding the contract method 0x573255f4.
+//
+// Solidity: function judge() view returns(address)
+func (_IBridge *IBridgeSession) Judge() (common.Address, error) {
+	return _IBridge.Contract.Judge(&_IBridge.CallOpts)
+}
+
+// Judge is a free data retrieval call binding the contract method 0x573255f4.
+//
+// Solidity: function judge() view returns(address)
+func (_IBridge *IBridgeCallerSession) Judge() (common.Address, error) {
+	return _IBridge.Contract.Judge(&_IBridge.CallOpts)
+}
+
+// Locked is a free data retrieval call binding the contract method 0xb45a3c0e.
+//
+// Solidity: function locked(uint256 ) view returns(uint64 seq, address sender, string receiver, uint256 amount)
+func (_IBridge *IBridgeCaller) Locked(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Seq      uint64
+	Sender   common.Address
+	Receiver string
+	Amount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "locked", arg0)
+
+	outstruct := new(struct {
+		Seq      uint64
+		Sender   common.Address
+		Receiver string
+		Amount   *big.Int
+	})
+
+	outstruct.Seq = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Sender = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Receiver = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.Amount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	return *outstruct, err
+
+}
+
+// Locked is a free data retrieval call binding the contract method 0xb45a3c0e.
+//
+// Solidity: function locked(uint256 ) view returns(uint64 seq, address sender, string receiver, uint256 amount)
+func (_IBridge *IBridgeSession) Locked(arg0 *big.Int) (struct {
+	Seq      uint64
+	Sender   common.Address
+	Receiver string
+	Amount   *big.Int
+}, error) {
+	return _IBridge.Contract.Locked(&_IBridge.CallOpts, arg0)
+}
+
+// Locked is a free data retrieval call binding the contract method 0xb45a3c0e.
+//
+// Solidity: function locked(uint256 ) view returns(uint64 seq, address sender, string receiver, uint256 amount)
+func (_IBridge *IBridgeCallerSession) Locked(arg0 *big.Int) (struct {
+	Seq      uint64
+	Sender   common.Address
+	Receiver string
+	Amount   *big.Int
+}, error) {
+	return _IBridge.Contract.Locked(&_IBridge.CallOpts, arg0)
+}
+
+// MaxLockableKAIA is a free data retrieval call binding the contract method 0x5efac2ec.
+//
+// Solidity: function maxLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeCaller) MaxLockableKAIA(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "maxLockableKAIA")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxLockableKAIA is a free data retrieval call binding the contract method 0x5efac2ec.
+//
+// Solidity: function maxLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeSession) MaxLockableKAIA() (*big.Int, error) {
+	return _IBridge.Contract.MaxLockableKAIA(&_IBridge.CallOpts)
+}
+
+// MaxLockableKAIA is a free data retrieval call binding the contract method 0x5efac2ec.
+//
+// Solidity: function maxLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) MaxLockableKAIA() (*big.Int, error) {
+	return _IBridge.Contract.MaxLockableKAIA(&_IBridge.CallOpts)
+}
+
+// MaxTryTransfer is a free data retrieval call binding the contract method 0xfe87b162.
+//
+// Solidity: function maxTryTransfer() view returns(uint256)
+func (_IBridge *IBridgeCaller) MaxTryTransfer(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "maxTryTransfer")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxTryTransfer is a free data retrieval call binding the contract method 0xfe87b162.
+//
+// Solidity: function maxTryTransfer() view returns(uint256)
+func (_IBridge *IBridgeSession) MaxTryTransfer() (*big.Int, error) {
+	return _IBridge.Contract.MaxTryTransfer(&_IBridge.CallOpts)
+}
+
+// MaxTryTransfer is a free data retrieval call binding the contract method 0xfe87b162.
+//
+// Solidity: function maxTryTransfer() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) MaxTryTransfer() (*big.Int, error) {
+	return _IBridge.Contract.MaxTryTransfer(&_IBridge.CallOpts)
+}
+
+// MinLockableKAIA is a free data retrieval call binding the contract method 0x19ab4e3a.
+//
+// Solidity: function minLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeCaller) MinLockableKAIA(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "minLockableKAIA")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinLockableKAIA is a free data retrieval call binding the contract method 0x19ab4e3a.
+//
+// Solidity: function minLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeSession) MinLockableKAIA() (*big.Int, error) {
+	return _IBridge.Contract.MinLockableKAIA(&_IBridge.CallOpts)
+}
+
+// MinLockableKAIA is a free data retrieval call binding the contract method 0x19ab4e3a.
+//
+// Solidity: function minLockableKAIA() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) MinLockableKAIA() (*big.Int, error) {
+	return _IBridge.Contract.MinLockableKAIA(&_IBridge.CallOpts)
+}
+
+// NClaimed is a free data retrieval call binding the contract method 0xfa2f49b4.
+//
+// Solidity: function nClaimed() view returns(uint256)
+func (_IBridge *IBridgeCaller) NClaimed(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "nClaimed")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NClaimed is a free data retrieval call binding the contract method 0xfa2f49b4.
+//
+// Solidity: function nClaimed() view returns(uint256)
+func (_IBridge *IBridgeSession) NClaimed() (*big.Int, error) {
+	return _IBridge.Contract.NClaimed(&_IBridge.CallOpts)
+}
+
+// NClaimed is a free data retrieval call binding the contract method 0xfa2f49b4.
+//
+// Solidity: function nClaimed() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) NClaimed() (*big.Int, error) {
+	return _IBridge.Contract.NClaimed(&_IBridge.CallOpts)
+}
+
+// NProvisioned is a free data retrieval call binding the contract method 0x7202be13.
+//
+// Solidity: function nProvisioned() view returns(uint256)
+func (_IBridge *IBridgeCaller) NProvisioned(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "nProvisioned")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NProvisioned is a free data retrieval call binding the contract method 0x7202be13.
+//
+// Solidity: function nProvisioned() view returns(uint256)
+func (_IBridge *IBridgeSession) NProvisioned() (*big.Int, error) {
+	return _IBridge.Contract.NProvisioned(&_IBridge.CallOpts)
+}
+
+// NProvisioned is a free data retrieval call binding the contract method 0x7202be13.
+//
+// Solidity: function nProvisioned() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) NProvisioned() (*big.Int, error) {
+	return _IBridge.Contract.NProvisioned(&_IBridge.CallOpts)
+}
+
+// NTransferHolds is a free data retrieval call binding the contract method 0x13f4bab9.
+//
+// Solidity: function nTransferHolds() view returns(uint256)
+func (_IBridge *IBridgeCaller) NTransferHolds(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "nTransferHolds")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NTransferHolds is a free data retrieval call binding the contract method 0x13f4bab9.
+//
+// Solidity: function nTransferHolds() view returns(uint256)
+func (_IBridge *IBridgeSession) NTransferHolds() (*big.Int, error) {
+	return _IBridge.Contract.NTransferHolds(&_IBridge.CallOpts)
+}
+
+// NTransferHolds is a free data retrieval call binding the contract method 0x13f4bab9.
+//
+// Solidity: function nTransferHolds() view returns(uint256)
+func (_IBridge *IBridgeCallerSession) NTransferHolds() (*big.Int, error) {
+	return _IBridge.Contract.NTransferHolds(&_IBridge.CallOpts)
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xe9133f3b.
+//
+// Solidity: function nextProvisionSeq() view returns(uint64)
+func (_IBridge *IBridgeCaller) NextProvisionSeq(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "nextProvisionSeq")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xe9133f3b.
+//
+// Solidity: function nextProvisionSeq() view returns(uint64)
+func (_IBridge *IBridgeSession) NextProvisionSeq() (uint64, error) {
+	return _IBridge.Contract.NextProvisionSeq(&_IBridge.CallOpts)
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xe9133f3b.
+//
+// Solidity: function nextProvisionSeq() view returns(uint64)
+func (_IBridge *IBridgeCallerSession) NextProvisionSeq() (uint64, error) {
+	return _IBridge.Contract.NextProvisionSeq(&_IBridge.CallOpts)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_IBridge *IBridgeCaller) Operator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "operator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_IBridge *IBridgeSession) Operator() (common.Address, error) {
+	return _IBridge.Contract.Operator(&_IBridge.CallOpts)
+}
+
+// Operator is a free data retrieval call binding the contract method 0x570ca735.
+//
+// Solidity: function operator() view returns(address)
+func (_IBridge *IBridgeCallerSession) Operator() (common.Address, error) {
+	return _IBridge.Contract.Operator(&_IBridge.CallOpts)
+}
+
+// Pause is a free data retrieval call binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() view returns(bool)
+func (_IBridge *IBridgeCaller) Pause(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "pause")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Pause is a free data retrieval call binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() view returns(bool)
+func (_IBridge *IBridgeSession) Pause() (bool, error) {
+	return _IBridge.Contract.Pause(&_IBridge.CallOpts)
+}
+
+// Pause is a free data retrieval call binding the contract method 0x8456cb59.
+//
+// Solidity: function pause() view returns(bool)
+func (_IBridge *IBridgeCallerSession) Pause() (bool, error) {
+	return _IBridge.Contract.Pause(&_IBridge.CallOpts)
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IBridge *IBridgeCaller) Provisions(opts *bind.CallOpts, arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "provisions", arg0)
+
+	outstruct := new(struct {
+		Seq      uint64
+		Sender   string
+		Receiver common.Address
+		Amount   *big.Int
+	})
+
+	outstruct.Seq = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Sender = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Receiver = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	return *outstruct, err
+
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IBridge *IBridgeSession) Provisions(arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _IBridge.Contract.Provisions(&_IBridge.CallOpts, arg0)
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IBridge *IBridgeCallerSession) Provisions(arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _IBridge.Contract.Provisions(&_IBridge.CallOpts, arg0)
+}
+
+// Seq is a free data retrieval call binding the contract method 0x6857ab40.
+//
+// Solidity: function seq() view returns(uint64)
+func (_IBridge *IBridgeCaller) Seq(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "seq")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Seq is a free data retrieval call binding the contract method 0x6857ab40.
+//
+// Solidity: function seq() view returns(uint64)
+func (_IBridge *IBridgeSession) Seq() (uint64, error) {
+	return _IBridge.Contract.Seq(&_IBridge.CallOpts)
+}
+
+// Seq is a free data retrieval call binding the contract method 0x6857ab40.
+//
+// Solidity: function seq() view returns(uint64)
+func (_IBridge *IBridgeCallerSession) Seq() (uint64, error) {
+	return _IBridge.Contract.Seq(&_IBridge.CallOpts)
+}
+
+// Seq2BlockNum is a free data retrieval call binding the contract method 0x084419b1.
+//
+// Solidity: function seq2BlockNum(uint64 ) view returns(uint256)
+func (_IBridge *IBridgeCaller) Seq2BlockNum(opts *bind.CallOpts, arg0 uint64) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "seq2BlockNum", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Seq2BlockNum is a free data retrieval call binding the contract method 0x084419b1.
+//
+// Solidity: function seq2BlockNum(uint64 ) view returns(uint256)
+func (_IBridge *IBridgeSession) Seq2BlockNum(arg0 uint64) (*big.Int, error) {
+	return _IBridge.Contract.Seq2BlockNum(&_IBridge.CallOpts, arg0)
+}
+
+// Seq2BlockNum is a free data retrieval call binding the contract method 0x084419b1.
+//
+// Solidity: function seq2BlockNum(uint64 ) view returns(uint256)
+func (_IBridge *IBridgeCallerSession) Seq2BlockNum(arg0 uint64) (*big.Int, error) {
+	return _IBridge.Contract.Seq2BlockNum(&_IBridge.CallOpts, arg0)
+}
+
+// Timelocks is a free data retrieval call binding the contract method 0x0d7e149f.
+//
+// Solidity: function timelocks(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeCaller) Timelocks(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "timelocks", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Timelocks is a free data retrieval call binding the contract method 0x0d7e149f.
+//
+// Solidity: function timelocks(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeSession) Timelocks(arg0 *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.Timelocks(&_IBridge.CallOpts, arg0)
+}
+
+// Timelocks is a free data retrieval call binding the contract method 0x0d7e149f.
+//
+// Solidity: function timelocks(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeCallerSession) Timelocks(arg0 *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.Timelocks(&_IBridge.CallOpts, arg0)
+}
+
+// TransferFail is a free data retrieval call binding the contract method 0x39390874.
+//
+// Solidity: function transferFail(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeCaller) TransferFail(opts *bind.CallOpts, arg0 *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "transferFail", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TransferFail is a free data retrieval call binding the contract method 0x39390874.
+//
+// Solidity: function transferFail(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeSession) TransferFail(arg0 *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.TransferFail(&_IBridge.CallOpts, arg0)
+}
+
+// TransferFail is a free data retrieval call binding the contract method 0x39390874.
+//
+// Solidity: function transferFail(uint256 ) view returns(uint256)
+func (_IBridge *IBridgeCallerSession) TransferFail(arg0 *big.Int) (*big.Int, error) {
+	return _IBridge.Contract.TransferFail(&_IBridge.CallOpts, arg0)
+}
+
+// TransferFromKaiaOn is a free data retrieval call binding the contract method 0x73297448.
+//
+// Solidity: function transferFromKaiaOn() view returns(bool)
+func (_IBridge *IBridgeCaller) TransferFromKaiaOn(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IBridge.contract.Call(opts, &out, "transferFromKaiaOn")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// TransferFromKaiaOn is a free data retrieval call binding the contract method 0x73297448.
+//
+// Solidity: function transferFromKaiaOn() view returns(bool)
+func (_IBridge *IBridgeSession) TransferFromKaiaOn() (bool, error) {
+	return _IBridge.Contract.TransferFromKaiaOn(&_IBridge.CallOpts)
+}
+
+// TransferFromKaiaOn is a free data retrieval call binding the contract method 0x73297448.
+//
+// Solidity: function transferFromKaiaOn() view returns(bool)
+func (_IBridge *IBridgeCallerSession) TransferFromKaiaOn() (bool, error) {
+	return _IBridge.Contract.TransferFromKaiaOn(&_IBridge.CallOpts)
+}
+
+// BurnBridgeBalance is a paid mutator transaction binding the contract method 0x44818617.
+//
+// Solidity: function burnBridgeBalance() returns()
+func (_IBridge *IBridgeTransactor) BurnBridgeBalance(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "burnBridgeBalance")
+}
+
+// BurnBridgeBalance is a paid mutator transaction binding the contract method 0x44818617.
+//
+// Solidity: function burnBridgeBalance() returns()
+func (_IBridge *IBridgeSession) BurnBridgeBalance() (*types.Transaction, error) {
+	return _IBridge.Contract.BurnBridgeBalance(&_IBridge.TransactOpts)
+}
+
+// BurnBridgeBalance is a paid mutator transaction binding the contract method 0x44818617.
+//
+// Solidity: function burnBridgeBalance() returns()
+func (_IBridge *IBridgeTransactorSession) BurnBridgeBalance() (*types.Transaction, error) {
+	return _IBridge.Contract.BurnBridgeBalance(&_IBridge.TransactOpts)
+}
+
+// ChangeBridgeServicePeriod is a paid mutator transaction binding the contract method 0x7276952e.
+//
+// Solidity: function changeBridgeServicePeriod(uint256 newPeriod) returns()
+func (_IBridge *IBridgeTransactor) ChangeBridgeServicePeriod(opts *bind.TransactOpts, newPeriod *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeBridgeServicePeriod", newPeriod)
+}
+
+// ChangeBridgeServicePeriod is a paid mutator transaction binding the contract method 0x7276952e.
+//
+// Solidity: function changeBridgeServicePeriod(uint256 newPeriod) returns()
+func (_IBridge *IBridgeSession) ChangeBridgeServicePeriod(newPeriod *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeBridgeServicePeriod(&_IBridge.TransactOpts, newPeriod)
+}
+
+// ChangeBridgeServicePeriod is a paid mutator transaction binding the contract method 0x7276952e.
+//
+// Solidity: function changeBridgeServicePeriod(uint256 newPeriod) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeBridgeServicePeriod(newPeriod *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeBridgeServicePeriod(&_IBridge.TransactOpts, newPeriod)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IBridge *IBridgeTransactor) ChangeGuardian(opts *bind.TransactOpts, newGuardian common.Address) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeGuardian", newGuardian)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IBridge *IBridgeSession) ChangeGuardian(newGuardian common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeGuardian(&_IBridge.TransactOpts, newGuardian)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeGuardian(newGuardian common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeGuardian(&_IBridge.TransactOpts, newGuardian)
+}
+
+// ChangeJudge is a paid mutator transaction binding the contract method 0xe0caf578.
+//
+// Solidity: function changeJudge(address newJudge) returns()
+func (_IBridge *IBridgeTransactor) ChangeJudge(opts *bind.TransactOpts, newJudge common.Address) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeJudge", newJudge)
+}
+
+// ChangeJudge is a paid mutator transaction binding the contract method 0xe0caf578.
+//
+// Solidity: function changeJudge(address newJudge) returns()
+func (_IBridge *IBridgeSession) ChangeJudge(newJudge common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeJudge(&_IBridge.TransactOpts, newJudge)
+}
+
+// ChangeJudge is a paid mutator transaction binding the contract method 0xe0caf578.
+//
+// Solidity: function changeJudge(address newJudge) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeJudge(newJudge common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeJudge(&_IBridge.TransactOpts, newJudge)
+}
+
+// ChangeMaxLockableKAIA is a paid mutator transaction binding the contract method 0x61a5a871.
+//
+// Solidity: function changeMaxLockableKAIA(uint256 newMaxLockableKAIA) returns()
+func (_IBridge *IBridgeTransactor) ChangeMaxLockableKAIA(opts *bind.TransactOpts, newMaxLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeMaxLockableKAIA", newMaxLockableKAIA)
+}
+
+// ChangeMaxLockableKAIA is a paid mutator transaction binding the contract method 0x61a5a871.
+//
+// Solidity: function changeMaxLockableKAIA(uint256 newMaxLockableKAIA) returns()
+func (_IBridge *IBridgeSession) ChangeMaxLockableKAIA(newMaxLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMaxLockableKAIA(&_IBridge.TransactOpts, newMaxLockableKAIA)
+}
+
+// ChangeMaxLockableKAIA is a paid mutator transaction binding the contract method 0x61a5a871.
+//
+// Solidity: function changeMaxLockableKAIA(uint256 newMaxLockableKAIA) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeMaxLockableKAIA(newMaxLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMaxLockableKAIA(&_IBridge.TransactOpts, newMaxLockableKAIA)
+}
+
+// ChangeMaxTryTransfer is a paid mutator transaction binding the contract method 0x82d90251.
+//
+// Solidity: function changeMaxTryTransfer(uint256 newMaxTryTransfer) returns()
+func (_IBridge *IBridgeTransactor) ChangeMaxTryTransfer(opts *bind.TransactOpts, newMaxTryTransfer *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeMaxTryTransfer", newMaxTryTransfer)
+}
+
+// ChangeMaxTryTransfer is a paid mutator transaction binding the contract method 0x82d90251.
+//
+// Solidity: function changeMaxTryTransfer(uint256 newMaxTryTransfer) returns()
+func (_IBridge *IBridgeSession) ChangeMaxTryTransfer(newMaxTryTransfer *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMaxTryTransfer(&_IBridge.TransactOpts, newMaxTryTransfer)
+}
+
+// ChangeMaxTryTransfer is a paid mutator transaction binding the contract method 0x82d90251.
+//
+// Solidity: function changeMaxTryTransfer(uint256 newMaxTryTransfer) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeMaxTryTransfer(newMaxTryTransfer *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMaxTryTransfer(&_IBridge.TransactOpts, newMaxTryTransfer)
+}
+
+// ChangeMinLockableKAIA is a paid mutator transaction binding the contract method 0xcbbde105.
+//
+// Solidity: function changeMinLockableKAIA(uint256 newMinLockableKAIA) returns()
+func (_IBridge *IBridgeTransactor) ChangeMinLockableKAIA(opts *bind.TransactOpts, newMinLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeMinLockableKAIA", newMinLockableKAIA)
+}
+
+// ChangeMinLockableKAIA is a paid mutator transaction binding the contract method 0xcbbde105.
+//
+// Solidity: function changeMinLockableKAIA(uint256 newMinLockableKAIA) returns()
+func (_IBridge *IBridgeSession) ChangeMinLockableKAIA(newMinLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMinLockableKAIA(&_IBridge.TransactOpts, newMinLockableKAIA)
+}
+
+// ChangeMinLockableKAIA is a paid mutator transaction binding the contract method 0xcbbde105.
+//
+// Solidity: function changeMinLockableKAIA(uint256 newMinLockableKAIA) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeMinLockableKAIA(newMinLockableKAIA *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeMinLockableKAIA(&_IBridge.TransactOpts, newMinLockableKAIA)
+}
+
+// ChangeOperator is a paid mutator transaction binding the contract method 0x06394c9b.
+//
+// Solidity: function changeOperator(address newOperator) returns()
+func (_IBridge *IBridgeTransactor) ChangeOperator(opts *bind.TransactOpts, newOperator common.Address) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeOperator", newOperator)
+}
+
+// ChangeOperator is a paid mutator transaction binding the contract method 0x06394c9b.
+//
+// Solidity: function changeOperator(address newOperator) returns()
+func (_IBridge *IBridgeSession) ChangeOperator(newOperator common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeOperator(&_IBridge.TransactOpts, newOperator)
+}
+
+// ChangeOperator is a paid mutator transaction binding the contract method 0x06394c9b.
+//
+// Solidity: function changeOperator(address newOperator) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeOperator(newOperator common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeOperator(&_IBridge.TransactOpts, newOperator)
+}
+
+// ChangeTransferEnable is a paid mutator transaction binding the contract method 0xd75bf506.
+//
+// Solidity: function changeTransferEnable(bool set) returns()
+func (_IBridge *IBridgeTransactor) ChangeTransferEnable(opts *bind.TransactOpts, set bool) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeTransferEnable", set)
+}
+
+// ChangeTransferEnable is a paid mutator transaction binding the contract method 0xd75bf506.
+//
+// Solidity: function changeTransferEnable(bool set) returns()
+func (_IBridge *IBridgeSession) ChangeTransferEnable(set bool) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeTransferEnable(&_IBridge.TransactOpts, set)
+}
+
+// ChangeTransferEnable is a paid mutator transaction binding the contract method 0xd75bf506.
+//
+// Solidity: function changeTransferEnable(bool set) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeTransferEnable(set bool) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeTransferEnable(&_IBridge.TransactOpts, set)
+}
+
+// ChangeTransferTimeLock is a paid mutator transaction binding the contract method 0x206eb384.
+//
+// Solidity: function changeTransferTimeLock(uint256 duration) returns()
+func (_IBridge *IBridgeTransactor) ChangeTransferTimeLock(opts *bind.TransactOpts, duration *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "changeTransferTimeLock", duration)
+}
+
+// ChangeTransferTimeLock is a paid mutator transaction binding the contract method 0x206eb384.
+//
+// Solidity: function changeTransferTimeLock(uint256 duration) returns()
+func (_IBridge *IBridgeSession) ChangeTransferTimeLock(duration *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeTransferTimeLock(&_IBridge.TransactOpts, duration)
+}
+
+// ChangeTransferTimeLock is a paid mutator transaction binding the contract method 0x206eb384.
+//
+// Solidity: function changeTransferTimeLock(uint256 duration) returns()
+func (_IBridge *IBridgeTransactorSession) ChangeTransferTimeLock(duration *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ChangeTransferTimeLock(&_IBridge.TransactOpts, duration)
+}
+
+// HoldClaim is a paid mutator transaction binding the contract method 0x177a1555.
+//
+// Solidity: function holdClaim(uint256 seq) returns()
+func (_IBridge *IBridgeTransactor) HoldClaim(opts *bind.TransactOpts, seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "holdClaim", seq)
+}
+
+// HoldClaim is a paid mutator transaction binding the contract method 0x177a1555.
+//
+// Solidity: function holdClaim(uint256 seq) returns()
+func (_IBridge *IBridgeSession) HoldClaim(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.HoldClaim(&_IBridge.TransactOpts, seq)
+}
+
+// HoldClaim is a paid mutator transaction binding the contract method 0x177a1555.
+//
+// Solidity: function holdClaim(uint256 seq) returns()
+func (_IBridge *IBridgeTransactorSession) HoldClaim(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.HoldClaim(&_IBridge.TransactOpts, seq)
+}
+
+// IsPassedTimeLockDuration is a paid mutator transaction binding the contract method 0x7d1e6656.
+//
+// Solidity: function isPassedTimeLockDuration(uint256 seq) returns(bool)
+func (_IBridge *IBridgeTransactor) IsPassedTimeLockDuration(opts *bind.TransactOpts, seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "isPassedTimeLockDuration", seq)
+}
+
+// IsPassedTimeLockDuration is a paid mutator transaction binding the contract method 0x7d1e6656.
+//
+// Solidity: function isPassedTimeLockDuration(uint256 seq) returns(bool)
+func (_IBridge *IBridgeSession) IsPassedTimeLockDuration(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.IsPassedTimeLockDuration(&_IBridge.TransactOpts, seq)
+}
+
+// IsPassedTimeLockDuration is a paid mutator transaction binding the contract method 0x7d1e6656.
+//
+// Solidity: function isPassedTimeLockDuration(uint256 seq) returns(bool)
+func (_IBridge *IBridgeTransactorSession) IsPassedTimeLockDuration(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.IsPassedTimeLockDuration(&_IBridge.TransactOpts, seq)
+}
+
+// PauseBridge is a paid mutator transaction binding the contract method 0xea3776de.
+//
+// Solidity: function pauseBridge(string pauseMsg) returns()
+func (_IBridge *IBridgeTransactor) PauseBridge(opts *bind.TransactOpts, pauseMsg string) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "pauseBridge", pauseMsg)
+}
+
+// PauseBridge is a paid mutator transaction binding the contract method 0xea3776de.
+//
+// Solidity: function pauseBridge(string pauseMsg) returns()
+func (_IBridge *IBridgeSession) PauseBridge(pauseMsg string) (*types.Transaction, error) {
+	return _IBridge.Contract.PauseBridge(&_IBridge.TransactOpts, pauseMsg)
+}
+
+// PauseBridge is a paid mutator transaction binding the contract method 0xea3776de.
+//
+// Solidity: function pauseBridge(string pauseMsg) returns()
+func (_IBridge *IBridgeTransactorSession) PauseBridge(pauseMsg string) (*types.Transaction, error) {
+	return _IBridge.Contract.PauseBridge(&_IBridge.TransactOpts, pauseMsg)
+}
+
+// Provision is a paid mutator transaction binding the contract method 0x1919b0c8.
+//
+// Solidity: function provision((uint64,string,address,uint256) prov) returns()
+func (_IBridge *IBridgeTransactor) Provision(opts *bind.TransactOpts, prov IBridgeProvisionData) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "provision", prov)
+}
+
+// Provision is a paid mutator transaction binding the contract method 0x1919b0c8.
+//
+// Solidity: function provision((uint64,string,address,uint256) prov) returns()
+func (_IBridge *IBridgeSession) Provision(prov IBridgeProvisionData) (*types.Transaction, error) {
+	return _IBridge.Contract.Provision(&_IBridge.TransactOpts, prov)
+}
+
+// Provision is a paid mutator transaction binding the contract method 0x1919b0c8.
+//
+// Solidity: function provision((uint64,string,address,uint256) prov) returns()
+func (_IBridge *IBridgeTransactorSession) Provision(prov IBridgeProvisionData) (*types.Transaction, error) {
+	return _IBridge.Contract.Provision(&_IBridge.TransactOpts, prov)
+}
+
+// ReleaseClaim is a paid mutator transaction binding the contract method 0xa8bde4eb.
+//
+// Solidity: function releaseClaim(uint256 seq) returns()
+func (_IBridge *IBridgeTransactor) ReleaseClaim(opts *bind.TransactOpts, seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "releaseClaim", seq)
+}
+
+// ReleaseClaim is a paid mutator transaction binding the contract method 0xa8bde4eb.
+//
+// Solidity: function releaseClaim(uint256 seq) returns()
+func (_IBridge *IBridgeSession) ReleaseClaim(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ReleaseClaim(&_IBridge.TransactOpts, seq)
+}
+
+// ReleaseClaim is a paid mutator transaction binding the contract method 0xa8bde4eb.
+//
+// Solidity: function releaseClaim(uint256 seq) returns()
+func (_IBridge *IBridgeTransactorSession) ReleaseClaim(seq *big.Int) (*types.Transaction, error) {
+	return _IBridge.Contract.ReleaseClaim(&_IBridge.TransactOpts, seq)
+}
+
+// RemoveProvision is a paid mutator transaction binding the contract method 0x2dee1734.
+//
+// Solidity: function removeProvision(uint64 seq) returns()
+func (_IBridge *IBridgeTransactor) RemoveProvision(opts *bind.TransactOpts, seq uint64) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "removeProvision", seq)
+}
+
+// RemoveProvision is a paid mutator transaction binding the contract method 0x2dee1734.
+//
+// Solidity: function removeProvision(uint64 seq) returns()
+func (_IBridge *IBridgeSession) RemoveProvision(seq uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RemoveProvision(&_IBridge.TransactOpts, seq)
+}
+
+// RemoveProvision is a paid mutator transaction binding the contract method 0x2dee1734.
+//
+// Solidity: function removeProvision(uint64 seq) returns()
+func (_IBridge *IBridgeTransactorSession) RemoveProvision(seq uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RemoveProvision(&_IBridge.TransactOpts, seq)
+}
+
+// RequestBatchClaim is a paid mutator transaction binding the contract method 0x2ce434e9.
+//
+// Solidity: function requestBatchClaim(uint64 range) returns()
+func (_IBridge *IBridgeTransactor) RequestBatchClaim(opts *bind.TransactOpts, arg0 uint64) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "requestBatchClaim", arg0)
+}
+
+// RequestBatchClaim is a paid mutator transaction binding the contract method 0x2ce434e9.
+//
+// Solidity: function requestBatchClaim(uint64 range) returns()
+func (_IBridge *IBridgeSession) RequestBatchClaim(arg0 uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RequestBatchClaim(&_IBridge.TransactOpts, arg0)
+}
+
+// RequestBatchClaim is a paid mutator transaction binding the contract method 0x2ce434e9.
+//
+// Solidity: function requestBatchClaim(uint64 range) returns()
+func (_IBridge *IBridgeTransactorSession) RequestBatchClaim(arg0 uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RequestBatchClaim(&_IBridge.TransactOpts, arg0)
+}
+
+// RequestClaim is a paid mutator transaction binding the contract method 0x700fc4f7.
+//
+// Solidity: function requestClaim(uint64 seq) returns(bool)
+func (_IBridge *IBridgeTransactor) RequestClaim(opts *bind.TransactOpts, seq uint64) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "requestClaim", seq)
+}
+
+// RequestClaim is a paid mutator transaction binding the contract method 0x700fc4f7.
+//
+// Solidity: function requestClaim(uint64 seq) returns(bool)
+func (_IBridge *IBridgeSession) RequestClaim(seq uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RequestClaim(&_IBridge.TransactOpts, seq)
+}
+
+// RequestClaim is a paid mutator transaction binding the contract method 0x700fc4f7.
+//
+// Solidity: function requestClaim(uint64 seq) returns(bool)
+func (_IBridge *IBridgeTransactorSession) RequestClaim(seq uint64) (*types.Transaction, error) {
+	return _IBridge.Contract.RequestClaim(&_IBridge.TransactOpts, seq)
+}
+
+// ResolveUnclaimable is a paid mutator transaction binding the contract method 0x3d1153e0.
+//
+// Solidity: function resolveUnclaimable(uint64 seq, address newReceiver) returns()
+func (_IBridge *IBridgeTransactor) ResolveUnclaimable(opts *bind.TransactOpts, seq uint64, newReceiver common.Address) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "resolveUnclaimable", seq, newReceiver)
+}
+
+// ResolveUnclaimable is a paid mutator transaction binding the contract method 0x3d1153e0.
+//
+// Solidity: function resolveUnclaimable(uint64 seq, address newReceiver) returns()
+func (_IBridge *IBridgeSession) ResolveUnclaimable(seq uint64, newReceiver common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ResolveUnclaimable(&_IBridge.TransactOpts, seq, newReceiver)
+}
+
+// ResolveUnclaimable is a paid mutator transaction binding the contract method 0x3d1153e0.
+//
+// Solidity: function resolveUnclaimable(uint64 seq, address newReceiver) returns()
+func (_IBridge *IBridgeTransactorSession) ResolveUnclaimable(seq uint64, newReceiver common.Address) (*types.Transaction, error) {
+	return _IBridge.Contract.ResolveUnclaimable(&_IBridge.TransactOpts, seq, newReceiver)
+}
+
+// ResumeBridge is a paid mutator transaction binding the contract method 0x539135dd.
+//
+// Solidity: function resumeBridge(string resumeMsg) returns()
+func (_IBridge *IBridgeTransactor) ResumeBridge(opts *bind.TransactOpts, resumeMsg string) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "resumeBridge", resumeMsg)
+}
+
+// ResumeBridge is a paid mutator transaction binding the contract method 0x539135dd.
+//
+// Solidity: function resumeBridge(string resumeMsg) returns()
+func (_IBridge *IBridgeSession) ResumeBridge(resumeMsg string) (*types.Transaction, error) {
+	return _IBridge.Contract.ResumeBridge(&_IBridge.TransactOpts, resumeMsg)
+}
+
+// ResumeBridge is a paid mutator transaction binding the contract method 0x539135dd.
+//
+// Solidity: function resumeBridge(string resumeMsg) returns()
+func (_IBridge *IBridgeTransactorSession) ResumeBridge(resumeMsg string) (*types.Transaction, error) {
+	return _IBridge.Contract.ResumeBridge(&_IBridge.TransactOpts, resumeMsg)
+}
+
+// SetAddrValidation is a paid mutator transaction binding the contract method 0xdafa2f8d.
+//
+// Solidity: function setAddrValidation(bool onOff) returns()
+func (_IBridge *IBridgeTransactor) SetAddrValidation(opts *bind.TransactOpts, onOff bool) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "setAddrValidation", onOff)
+}
+
+// SetAddrValidation is a paid mutator transaction binding the contract method 0xdafa2f8d.
+//
+// Solidity: function setAddrValidation(bool onOff) returns()
+func (_IBridge *IBridgeSession) SetAddrValidation(onOff bool) (*types.Transaction, error) {
+	return _IBridge.Contract.SetAddrValidation(&_IBridge.TransactOpts, onOff)
+}
+
+// SetAddrValidation is a paid mutator transaction binding the contract method 0xdafa2f8d.
+//
+// Solidity: function setAddrValidation(bool onOff) returns()
+func (_IBridge *IBridgeTransactorSession) SetAddrValidation(onOff bool) (*types.Transaction, error) {
+	return _IBridge.Contract.SetAddrValidation(&_IBridge.TransactOpts, onOff)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa0258d0b.
+//
+// Solidity: function transfer(string receiver) payable returns()
+func (_IBridge *IBridgeTransactor) Transfer(opts *bind.TransactOpts, receiver string) (*types.Transaction, error) {
+	return _IBridge.contract.Transact(opts, "transfer", receiver)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa0258d0b.
+//
+// Solidity: function transfer(string receiver) payable returns()
+func (_IBridge *IBridgeSession) Transfer(receiver string) (*types.Transaction, error) {
+	return _IBridge.Contract.Transfer(&_IBridge.TransactOpts, receiver)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa0258d0b.
+//
+// Solidity: function transfer(string receiver) payable returns()
+func (_IBridge *IBridgeTransactorSession) Transfer(receiver string) (*types.Transaction, error) {
+	return _IBridge.Contract.Transfer(&_IBridge.TransactOpts, receiver)
+}
+
+// IBridgeBridgeBalanceBurnedIterator is returned from FilterBridgeBalanceBurned and is used to iterate over the raw logs and unpacked data for BridgeBalanceBurned events raised by the IBridge contract.
+type IBridgeBridgeBalanceBurnedIterator struct {
+	Event *IBridgeBridgeBalanceBurned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeBridgeBalanceBurnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeBridgeBalanceBurned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeBridgeBalanceBurned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeBridgeBalanceBurnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeBridgeBalanceBurnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeBridgeBalanceBurned represents a BridgeBalanceBurned event raised by the IBridge contract.
+type IBridgeBridgeBalanceBurned struct {
+	BridgeBalance *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterBridgeBalanceBurned is a free log retrieval operation binding the contract event 0x971b82dee5940222a2a2c7c1f8387f5ea5d2da6e0c0aa8d42be7f43ad3498092.
+//
+// Solidity: event BridgeBalanceBurned(uint256 indexed bridgeBalance)
+func (_IBridge *IBridgeFilterer) FilterBridgeBalanceBurned(opts *bind.FilterOpts, bridgeBalance []*big.Int) (*IBridgeBridgeBalanceBurnedIterator, error) {
+
+	var bridgeBalanceRule []interface{}
+	for _, bridgeBalanceItem := range bridgeBalance {
+		bridgeBalanceRule = append(bridgeBalanceRule, bridgeBalanceItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "BridgeBalanceBurned", bridgeBalanceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeBridgeBalanceBurnedIterator{contract: _IBridge.contract, event: "BridgeBalanceBurned", logs: logs, sub: sub}, nil
+}
+
+// WatchBridgeBalanceBurned is a free log subscription operation binding the contract event 0x971b82dee5940222a2a2c7c1f8387f5ea5d2da6e0c0aa8d42be7f43ad3498092.
+//
+// Solidity: event BridgeBalanceBurned(uint256 indexed bridgeBalance)
+func (_IBridge *IBridgeFilterer) WatchBridgeBalanceBurned(opts *bind.WatchOpts, sink chan<- *IBridgeBridgeBalanceBurned, bridgeBalance []*big.Int) (event.Subscription, error) {
+
+	var bridgeBalanceRule []interface{}
+	for _, bridgeBalanceItem := range bridgeBalance {
+		bridgeBalanceRule = append(bridgeBalanceRule, bridgeBalanceItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "BridgeBalanceBurned", bridgeBalanceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeBridgeBalanceBurned)
+				if err := _IBridge.contract.UnpackLog(event, "BridgeBalanceBurned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBridgeBalanceBurned is a log parse operation binding the contract event 0x971b82dee5940222a2a2c7c1f8387f5ea5d2da6e0c0aa8d42be7f43ad3498092.
+//
+// Solidity: event BridgeBalanceBurned(uint256 indexed bridgeBalance)
+func (_IBridge *IBridgeFilterer) ParseBridgeBalanceBurned(log types.Log) (*IBridgeBridgeBalanceBurned, error) {
+	event := new(IBridgeBridgeBalanceBurned)
+	if err := _IBridge.contract.UnpackLog(event, "BridgeBalanceBurned", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeBridgePauseIterator is returned from FilterBridgePause and is used to iterate over the raw logs and unpacked data for BridgePause events raised by the IBridge contract.
+type IBridgeBridgePauseIterator struct {
+	Event *IBridgeBridgePause // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeBridgePauseIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeBridgePause)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeBridgePause)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeBridgePauseIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeBridgePauseIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeBridgePause represents a BridgePause event raised by the IBridge contract.
+type IBridgeBridgePause struct {
+	Msg common.Hash
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterBridgePause is a free log retrieval operation binding the contract event 0x4910e8e9025e52d5703324eb19309bd2d57c1be1054424a68511f84e62abe4f4.
+//
+// Solidity: event BridgePause(string indexed msg)
+func (_IBridge *IBridgeFilterer) FilterBridgePause(opts *bind.FilterOpts, msg []string) (*IBridgeBridgePauseIterator, error) {
+
+	var msgRule []interface{}
+	for _, msgItem := range msg {
+		msgRule = append(msgRule, msgItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "BridgePause", msgRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeBridgePauseIterator{contract: _IBridge.contract, event: "BridgePause", logs: logs, sub: sub}, nil
+}
+
+// WatchBridgePause is a free log subscription operation binding the contract event 0x4910e8e9025e52d5703324eb19309bd2d57c1be1054424a68511f84e62abe4f4.
+//
+// Solidity: event BridgePause(string indexed msg)
+func (_IBridge *IBridgeFilterer) WatchBridgePause(opts *bind.WatchOpts, sink chan<- *IBridgeBridgePause, msg []string) (event.Subscription, error) {
+
+	var msgRule []interface{}
+	for _, msgItem := range msg {
+		msgRule = append(msgRule, msgItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "BridgePause", msgRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeBridgePause)
+				if err := _IBridge.contract.UnpackLog(event, "BridgePause", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBridgePause is a log parse operation binding the contract event 0x4910e8e9025e52d5703324eb19309bd2d57c1be1054424a68511f84e62abe4f4.
+//
+// Solidity: event BridgePause(string indexed msg)
+func (_IBridge *IBridgeFilterer) ParseBridgePause(log types.Log) (*IBridgeBridgePause, error) {
+	event := new(IBridgeBridgePause)
+	if err := _IBridge.contract.UnpackLog(event, "BridgePause", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeBridgeResumeIterator is returned from FilterBridgeResume and is used to iterate over the raw logs and unpacked data for BridgeResume events raised by the IBridge contract.
+type IBridgeBridgeResumeIterator struct {
+	Event *IBridgeBridgeResume // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeBridgeResumeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeBridgeResume)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeBridgeResume)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeBridgeResumeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeBridgeResumeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeBridgeResume represents a BridgeResume event raised by the IBridge contract.
+type IBridgeBridgeResume struct {
+	Msg common.Hash
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterBridgeResume is a free log retrieval operation binding the contract event 0x4491215d33ff44cbbe5abce58bc65efb225a681d5757be893e336bf1d652d6af.
+//
+// Solidity: event BridgeResume(string indexed msg)
+func (_IBridge *IBridgeFilterer) FilterBridgeResume(opts *bind.FilterOpts, msg []string) (*IBridgeBridgeResumeIterator, error) {
+
+	var msgRule []interface{}
+	for _, msgItem := range msg {
+		msgRule = append(msgRule, msgItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "BridgeResume", msgRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeBridgeResumeIterator{contract: _IBridge.contract, event: "BridgeResume", logs: logs, sub: sub}, nil
+}
+
+// WatchBridgeResume is a free log subscription operation binding the contract event 0x4491215d33ff44cbbe5abce58bc65efb225a681d5757be893e336bf1d652d6af.
+//
+// Solidity: event BridgeResume(string indexed msg)
+func (_IBridge *IBridgeFilterer) WatchBridgeResume(opts *bind.WatchOpts, sink chan<- *IBridgeBridgeResume, msg []string) (event.Subscription, error) {
+
+	var msgRule []interface{}
+	for _, msgItem := range msg {
+		msgRule = append(msgRule, msgItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "BridgeResume", msgRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeBridgeResume)
+				if err := _IBridge.contract.UnpackLog(event, "BridgeResume", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBridgeResume is a log parse operation binding the contract event 0x4491215d33ff44cbbe5abce58bc65efb225a681d5757be893e336bf1d652d6af.
+//
+// Solidity: event BridgeResume(string indexed msg)
+func (_IBridge *IBridgeFilterer) ParseBridgeResume(log types.Log) (*IBridgeBridgeResume, error) {
+	event := new(IBridgeBridgeResume)
+	if err := _IBridge.contract.UnpackLog(event, "BridgeResume", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeAddrValidationIterator is returned from FilterChangeAddrValidation and is used to iterate over the raw logs and unpacked data for ChangeAddrValidation events raised by the IBridge contract.
+type IBridgeChangeAddrValidationIterator struct {
+	Event *IBridgeChangeAddrValidation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeAddrValidationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeAddrValidation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeAddrValidation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeAddrValidationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeAddrValidationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeAddrValidation represents a ChangeAddrValidation event raised by the IBridge contract.
+type IBridgeChangeAddrValidation struct {
+	Before bool
+	Now    bool
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeAddrValidation is a free log retrieval operation binding the contract event 0xe9e2eec3b99b1c516c9add9eb68b22aaafcf8eae59243986f20cb294e017445b.
+//
+// Solidity: event ChangeAddrValidation(bool indexed before, bool now)
+func (_IBridge *IBridgeFilterer) FilterChangeAddrValidation(opts *bind.FilterOpts, before []bool) (*IBridgeChangeAddrValidationIterator, error) {
+
+	var beforeRule []interface{}
+	for _, beforeItem := range before {
+		beforeRule = append(beforeRule, beforeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeAddrValidation", beforeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeAddrValidationIterator{contract: _IBridge.contract, event: "ChangeAddrValidation", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeAddrValidation is a free log subscription operation binding the contract event 0xe9e2eec3b99b1c516c9add9eb68b22aaafcf8eae59243986f20cb294e017445b.
+//
+// Solidity: event ChangeAddrValidation(bool indexed before, bool now)
+func (_IBridge *IBridgeFilterer) WatchChangeAddrValidation(opts *bind.WatchOpts, sink chan<- *IBridgeChangeAddrValidation, before []bool) (event.Subscription, error) {
+
+	var beforeRule []interface{}
+	for _, beforeItem := range before {
+		beforeRule = append(beforeRule, beforeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeAddrValidation", beforeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeAddrValidation)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeAddrValidation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeAddrValidation is a log parse operation binding the contract event 0xe9e2eec3b99b1c516c9add9eb68b22aaafcf8eae59243986f20cb294e017445b.
+//
+// Solidity: event ChangeAddrValidation(bool indexed before, bool now)
+func (_IBridge *IBridgeFilterer) ParseChangeAddrValidation(log types.Log) (*IBridgeChangeAddrValidation, error) {
+	event := new(IBridgeChangeAddrValidation)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeAddrValidation", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeBridgeServicePeriodIterator is returned from FilterChangeBridgeServicePeriod and is used to iterate over the raw logs and unpacked data for ChangeBridgeServicePeriod events raised by the IBridge contract.
+type IBridgeChangeBridgeServicePeriodIterator struct {
+	Event *IBridgeChangeBridgeServicePeriod // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeBridgeServicePeriodIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeBridgeServicePeriod)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeBridgeServicePeriod)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeBridgeServicePeriodIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeBridgeServicePeriodIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeBridgeServicePeriod represents a ChangeBridgeServicePeriod event raised by the IBridge contract.
+type IBridgeChangeBridgeServicePeriod struct {
+	BridgeServicePeriod *big.Int
+	NewPeriod           *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeBridgeServicePeriod is a free log retrieval operation binding the contract event 0xdd89d4ddcb77ca86b9c9f02e5554b79d8b59b433066d851e5ea126993b8ca291.
+//
+// Solidity: event ChangeBridgeServicePeriod(uint256 indexed bridgeServicePeriod, uint256 indexed newPeriod)
+func (_IBridge *IBridgeFilterer) FilterChangeBridgeServicePeriod(opts *bind.FilterOpts, bridgeServicePeriod []*big.Int, newPeriod []*big.Int) (*IBridgeChangeBridgeServicePeriodIterator, error) {
+
+	var bridgeServicePeriodRule []interface{}
+	for _, bridgeServicePeriodItem := range bridgeServicePeriod {
+		bridgeServicePeriodRule = append(bridgeServicePeriodRule, bridgeServicePeriodItem)
+	}
+	var newPeriodRule []interface{}
+	for _, newPeriodItem := range newPeriod {
+		newPeriodRule = append(newPeriodRule, newPeriodItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeBridgeServicePeriod", bridgeServicePeriodRule, newPeriodRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeBridgeServicePeriodIterator{contract: _IBridge.contract, event: "ChangeBridgeServicePeriod", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeBridgeServicePeriod is a free log subscription operation binding the contract event 0xdd89d4ddcb77ca86b9c9f02e5554b79d8b59b433066d851e5ea126993b8ca291.
+//
+// Solidity: event ChangeBridgeServicePeriod(uint256 indexed bridgeServicePeriod, uint256 indexed newPeriod)
+func (_IBridge *IBridgeFilterer) WatchChangeBridgeServicePeriod(opts *bind.WatchOpts, sink chan<- *IBridgeChangeBridgeServicePeriod, bridgeServicePeriod []*big.Int, newPeriod []*big.Int) (event.Subscription, error) {
+
+	var bridgeServicePeriodRule []interface{}
+	for _, bridgeServicePeriodItem := range bridgeServicePeriod {
+		bridgeServicePeriodRule = append(bridgeServicePeriodRule, bridgeServicePeriodItem)
+	}
+	var newPeriodRule []interface{}
+	for _, newPeriodItem := range newPeriod {
+		newPeriodRule = append(newPeriodRule, newPeriodItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeBridgeServicePeriod", bridgeServicePeriodRule, newPeriodRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeBridgeServicePeriod)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeBridgeServicePeriod", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeBridgeServicePeriod is a log parse operation binding the contract event 0xdd89d4ddcb77ca86b9c9f02e5554b79d8b59b433066d851e5ea126993b8ca291.
+//
+// Solidity: event ChangeBridgeServicePeriod(uint256 indexed bridgeServicePeriod, uint256 indexed newPeriod)
+func (_IBridge *IBridgeFilterer) ParseChangeBridgeServicePeriod(log types.Log) (*IBridgeChangeBridgeServicePeriod, error) {
+	event := new(IBridgeChangeBridgeServicePeriod)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeBridgeServicePeriod", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeGuardianIterator is returned from FilterChangeGuardian and is used to iterate over the raw logs and unpacked data for ChangeGuardian events raised by the IBridge contract.
+type IBridgeChangeGuardianIterator struct {
+	Event *IBridgeChangeGuardian // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeGuardianIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeGuardian)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeGuardian)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeGuardianIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeGuardianIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeGuardian represents a ChangeGuardian event raised by the IBridge contract.
+type IBridgeChangeGuardian struct {
+	BeforeGuardian common.Address
+	NewGuardian    common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeGuardian is a free log retrieval operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IBridge *IBridgeFilterer) FilterChangeGuardian(opts *bind.FilterOpts, beforeGuardian []common.Address, newGuardian []common.Address) (*IBridgeChangeGuardianIterator, error) {
+
+	var beforeGuardianRule []interface{}
+	for _, beforeGuardianItem := range beforeGuardian {
+		beforeGuardianRule = append(beforeGuardianRule, beforeGuardianItem)
+	}
+	var newGuardianRule []interface{}
+	for _, newGuardianItem := range newGuardian {
+		newGuardianRule = append(newGuardianRule, newGuardianItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeGuardian", beforeGuardianRule, newGuardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeGuardianIterator{contract: _IBridge.contract, event: "ChangeGuardian", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeGuardian is a free log subscription operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IBridge *IBridgeFilterer) WatchChangeGuardian(opts *bind.WatchOpts, sink chan<- *IBridgeChangeGuardian, beforeGuardian []common.Address, newGuardian []common.Address) (event.Subscription, error) {
+
+	var beforeGuardianRule []interface{}
+	for _, beforeGuardianItem := range beforeGuardian {
+		beforeGuardianRule = append(beforeGuardianRule, beforeGuardianItem)
+	}
+	var newGuardianRule []interface{}
+	for _, newGuardianItem := range newGuardian {
+		newGuardianRule = append(newGuardianRule, newGuardianItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeGuardian", beforeGuardianRule, newGuardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeGuardian)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeGuardian", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeGuardian is a log parse operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IBridge *IBridgeFilterer) ParseChangeGuardian(log types.Log) (*IBridgeChangeGuardian, error) {
+	event := new(IBridgeChangeGuardian)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeGuardian", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeJudgeIterator is returned from FilterChangeJudge and is used to iterate over the raw logs and unpacked data for ChangeJudge events raised by the IBridge contract.
+type IBridgeChangeJudgeIterator struct {
+	Event *IBridgeChangeJudge // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeJudgeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeJudge)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeJudge)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeJudgeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeJudgeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeJudge represents a ChangeJudge event raised by the IBridge contract.
+type IBridgeChangeJudge struct {
+	BeforeJudge common.Address
+	NewJudge    common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeJudge is a free log retrieval operation binding the contract event 0x142fbcfec942039c17c5d1a7100495fe199b0cc6f71f8665252070aec287a94c.
+//
+// Solidity: event ChangeJudge(address indexed beforeJudge, address indexed newJudge)
+func (_IBridge *IBridgeFilterer) FilterChangeJudge(opts *bind.FilterOpts, beforeJudge []common.Address, newJudge []common.Address) (*IBridgeChangeJudgeIterator, error) {
+
+	var beforeJudgeRule []interface{}
+	for _, beforeJudgeItem := range beforeJudge {
+		beforeJudgeRule = append(beforeJudgeRule, beforeJudgeItem)
+	}
+	var newJudgeRule []interface{}
+	for _, newJudgeItem := range newJudge {
+		newJudgeRule = append(newJudgeRule, newJudgeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeJudge", beforeJudgeRule, newJudgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeJudgeIterator{contract: _IBridge.contract, event: "ChangeJudge", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeJudge is a free log subscription operation binding the contract event 0x142fbcfec942039c17c5d1a7100495fe199b0cc6f71f8665252070aec287a94c.
+//
+// Solidity: event ChangeJudge(address indexed beforeJudge, address indexed newJudge)
+func (_IBridge *IBridgeFilterer) WatchChangeJudge(opts *bind.WatchOpts, sink chan<- *IBridgeChangeJudge, beforeJudge []common.Address, newJudge []common.Address) (event.Subscription, error) {
+
+	var beforeJudgeRule []interface{}
+	for _, beforeJudgeItem := range beforeJudge {
+		beforeJudgeRule = append(beforeJudgeRule, beforeJudgeItem)
+	}
+	var newJudgeRule []interface{}
+	for _, newJudgeItem := range newJudge {
+		newJudgeRule = append(newJudgeRule, newJudgeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeJudge", beforeJudgeRule, newJudgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeJudge)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeJudge", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeJudge is a log parse operation binding the contract event 0x142fbcfec942039c17c5d1a7100495fe199b0cc6f71f8665252070aec287a94c.
+//
+// Solidity: event ChangeJudge(address indexed beforeJudge, address indexed newJudge)
+func (_IBridge *IBridgeFilterer) ParseChangeJudge(log types.Log) (*IBridgeChangeJudge, error) {
+	event := new(IBridgeChangeJudge)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeJudge", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeOperatorIterator is returned from FilterChangeOperator and is used to iterate over the raw logs and unpacked data for ChangeOperator events raised by the IBridge contract.
+type IBridgeChangeOperatorIterator struct {
+	Event *IBridgeChangeOperator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeOperatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeOperator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeOperator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeOperatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeOperatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeOperator represents a ChangeOperator event raised by the IBridge contract.
+type IBridgeChangeOperator struct {
+	BeforeOperator common.Address
+	NewOperator    common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeOperator is a free log retrieval operation binding the contract event 0x43ecbc1bb0a9bebbcadca5bdaedc649040adb65597772899e25403afb28b6794.
+//
+// Solidity: event ChangeOperator(address indexed beforeOperator, address indexed newOperator)
+func (_IBridge *IBridgeFilterer) FilterChangeOperator(opts *bind.FilterOpts, beforeOperator []common.Address, newOperator []common.Address) (*IBridgeChangeOperatorIterator, error) {
+
+	var beforeOperatorRule []interface{}
+	for _, beforeOperatorItem := range beforeOperator {
+		beforeOperatorRule = append(beforeOperatorRule, beforeOperatorItem)
+	}
+	var newOperatorRule []interface{}
+	for _, newOperatorItem := range newOperator {
+		newOperatorRule = append(newOperatorRule, newOperatorItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeOperator", beforeOperatorRule, newOperatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeOperatorIterator{contract: _IBridge.contract, event: "ChangeOperator", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeOperator is a free log subscription operation binding the contract event 0x43ecbc1bb0a9bebbcadca5bdaedc649040adb65597772899e25403afb28b6794.
+//
+// Solidity: event ChangeOperator(address indexed beforeOperator, address indexed newOperator)
+func (_IBridge *IBridgeFilterer) WatchChangeOperator(opts *bind.WatchOpts, sink chan<- *IBridgeChangeOperator, beforeOperator []common.Address, newOperator []common.Address) (event.Subscription, error) {
+
+	var beforeOperatorRule []interface{}
+	for _, beforeOperatorItem := range beforeOperator {
+		beforeOperatorRule = append(beforeOperatorRule, beforeOperatorItem)
+	}
+	var newOperatorRule []interface{}
+	for _, newOperatorItem := range newOperator {
+		newOperatorRule = append(newOperatorRule, newOperatorItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeOperator", beforeOperatorRule, newOperatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeOperator)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeOperator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeOperator is a log parse operation binding the contract event 0x43ecbc1bb0a9bebbcadca5bdaedc649040adb65597772899e25403afb28b6794.
+//
+// Solidity: event ChangeOperator(address indexed beforeOperator, address indexed newOperator)
+func (_IBridge *IBridgeFilterer) ParseChangeOperator(log types.Log) (*IBridgeChangeOperator, error) {
+	event := new(IBridgeChangeOperator)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeOperator", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeChangeTransferTimeLockIterator is returned from FilterChangeTransferTimeLock and is used to iterate over the raw logs and unpacked data for ChangeTransferTimeLock events raised by the IBridge contract.
+type IBridgeChangeTransferTimeLockIterator struct {
+	Event *IBridgeChangeTransferTimeLock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeChangeTransferTimeLockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeChangeTransferTimeLock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeChangeTransferTimeLock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeChangeTransferTimeLockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeChangeTransferTimeLockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeChangeTransferTimeLock represents a ChangeTransferTimeLock event raised by the IBridge contract.
+type IBridgeChangeTransferTimeLock struct {
+	Time *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeTransferTimeLock is a free log retrieval operation binding the contract event 0x4dc47f4cf5973a6141e21dcf6b5e81815e47a823fa0d8321c8e857485adc231a.
+//
+// Solidity: event ChangeTransferTimeLock(uint256 indexed time)
+func (_IBridge *IBridgeFilterer) FilterChangeTransferTimeLock(opts *bind.FilterOpts, time []*big.Int) (*IBridgeChangeTransferTimeLockIterator, error) {
+
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ChangeTransferTimeLock", timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeChangeTransferTimeLockIterator{contract: _IBridge.contract, event: "ChangeTransferTimeLock", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeTransferTimeLock is a free log subscription operation binding the contract event 0x4dc47f4cf5973a6141e21dcf6b5e81815e47a823fa0d8321c8e857485adc231a.
+//
+// Solidity: event ChangeTransferTimeLock(uint256 indexed time)
+func (_IBridge *IBridgeFilterer) WatchChangeTransferTimeLock(opts *bind.WatchOpts, sink chan<- *IBridgeChangeTransferTimeLock, time []*big.Int) (event.Subscription, error) {
+
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ChangeTransferTimeLock", timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeChangeTransferTimeLock)
+				if err := _IBridge.contract.UnpackLog(event, "ChangeTransferTimeLock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeTransferTimeLock is a log parse operation binding the contract event 0x4dc47f4cf5973a6141e21dcf6b5e81815e47a823fa0d8321c8e857485adc231a.
+//
+// Solidity: event ChangeTransferTimeLock(uint256 indexed time)
+func (_IBridge *IBridgeFilterer) ParseChangeTransferTimeLock(log types.Log) (*IBridgeChangeTransferTimeLock, error) {
+	event := new(IBridgeChangeTransferTimeLock)
+	if err := _IBridge.contract.UnpackLog(event, "ChangeTransferTimeLock", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeClaimIterator is returned from FilterClaim and is used to iterate over the raw logs and unpacked data for Claim events raised by the IBridge contract.
+type IBridgeClaimIterator struct {
+	Event *IBridgeClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeClaim represents a Claim event raised by the IBridge contract.
+type IBridgeClaim struct {
+	Provision IBridgeProvisionData
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaim is a free log retrieval operation binding the contract event 0x177b6046feaa5ac9793d715b69a9056ccd504da33d2f1f5d5588dfa654ddfa19.
+//
+// Solidity: event Claim((uint64,string,address,uint256) indexed provision)
+func (_IBridge *IBridgeFilterer) FilterClaim(opts *bind.FilterOpts, provision []IBridgeProvisionData) (*IBridgeClaimIterator, error) {
+
+	var provisionRule []interface{}
+	for _, provisionItem := range provision {
+		provisionRule = append(provisionRule, provisionItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "Claim", provisionRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeClaimIterator{contract: _IBridge.contract, event: "Claim", logs: logs, sub: sub}, nil
+}
+
+// WatchClaim is a free log subscription operation binding the contract event 0x177b6046feaa5ac9793d715b69a9056ccd504da33d2f1f5d5588dfa654ddfa19.
+//
+// Solidity: event Claim((uint64,string,address,uint256) indexed provision)
+func (_IBridge *IBridgeFilterer) WatchClaim(opts *bind.WatchOpts, sink chan<- *IBridgeClaim, provision []IBridgeProvisionData) (event.Subscription, error) {
+
+	var provisionRule []interface{}
+	for _, provisionItem := range provision {
+		provisionRule = append(provisionRule, provisionItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "Claim", provisionRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeClaim)
+				if err := _IBridge.contract.UnpackLog(event, "Claim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaim is a log parse operation binding the contract event 0x177b6046feaa5ac9793d715b69a9056ccd504da33d2f1f5d5588dfa654ddfa19.
+//
+// Solidity: event Claim((uint64,string,address,uint256) indexed provision)
+func (_IBridge *IBridgeFilterer) ParseClaim(log types.Log) (*IBridgeClaim, error) {
+	event := new(IBridgeClaim)
+	if err := _IBridge.contract.UnpackLog(event, "Claim", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeHoldClaimIterator is returned from FilterHoldClaim and is used to iterate over the raw logs and unpacked data for HoldClaim events raised by the IBridge contract.
+type IBridgeHoldClaimIterator struct {
+	Event *IBridgeHoldClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeHoldClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeHoldClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeHoldClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeHoldClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeHoldClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeHoldClaim represents a HoldClaim event raised by the IBridge contract.
+type IBridgeHoldClaim struct {
+	Seq  *big.Int
+	Time *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterHoldClaim is a free log retrieval operation binding the contract event 0x06dfd90492bb157da142b740963e167c0d4b56c79c1449a0c70f63d8ac8bb574.
+//
+// Solidity: event HoldClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) FilterHoldClaim(opts *bind.FilterOpts, seq []*big.Int, time []*big.Int) (*IBridgeHoldClaimIterator, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "HoldClaim", seqRule, timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeHoldClaimIterator{contract: _IBridge.contract, event: "HoldClaim", logs: logs, sub: sub}, nil
+}
+
+// WatchHoldClaim is a free log subscription operation binding the contract event 0x06dfd90492bb157da142b740963e167c0d4b56c79c1449a0c70f63d8ac8bb574.
+//
+// Solidity: event HoldClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) WatchHoldClaim(opts *bind.WatchOpts, sink chan<- *IBridgeHoldClaim, seq []*big.Int, time []*big.Int) (event.Subscription, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "HoldClaim", seqRule, timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeHoldClaim)
+				if err := _IBridge.contract.UnpackLog(event, "HoldClaim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseHoldClaim is a log parse operation binding the contract event 0x06dfd90492bb157da142b740963e167c0d4b56c79c1449a0c70f63d8ac8bb574.
+//
+// Solidity: event HoldClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) ParseHoldClaim(log types.Log) (*IBridgeHoldClaim, error) {
+	event := new(IBridgeHoldClaim)
+	if err := _IBridge.contract.UnpackLog(event, "HoldClaim", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeKAIAChargedIterator is returned from FilterKAIACharged and is used to iterate over the raw logs and unpacked data for KAIACharged events raised by the IBridge contract.
+type IBridgeKAIAChargedIterator struct {
+	Event *IBridgeKAIACharged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeKAIAChargedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeKAIACharged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeKAIACharged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeKAIAChargedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeKAIAChargedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeKAIACharged represents a KAIACharged event raised by the IBridge contract.
+type IBridgeKAIACharged struct {
+	Sender common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterKAIACharged is a free log retrieval operation binding the contract event 0xe9ff06928457f217f1eb646829c843fb5f899852b604f31d4250b5c333541513.
+//
+// Solidity: event KAIACharged(address sender, uint256 amount)
+func (_IBridge *IBridgeFilterer) FilterKAIACharged(opts *bind.FilterOpts) (*IBridgeKAIAChargedIterator, error) {
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "KAIACharged")
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeKAIAChargedIterator{contract: _IBridge.contract, event: "KAIACharged", logs: logs, sub: sub}, nil
+}
+
+// WatchKAIACharged is a free log subscription operation binding the contract event 0xe9ff06928457f217f1eb646829c843fb5f899852b604f31d4250b5c333541513.
+//
+// Solidity: event KAIACharged(address sender, uint256 amount)
+func (_IBridge *IBridgeFilterer) WatchKAIACharged(opts *bind.WatchOpts, sink chan<- *IBridgeKAIACharged) (event.Subscription, error) {
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "KAIACharged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeKAIACharged)
+				if err := _IBridge.contract.UnpackLog(event, "KAIACharged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseKAIACharged is a log parse operation binding the contract event 0xe9ff06928457f217f1eb646829c843fb5f899852b604f31d4250b5c333541513.
+//
+// Solidity: event KAIACharged(address sender, uint256 amount)
+func (_IBridge *IBridgeFilterer) ParseKAIACharged(log types.Log) (*IBridgeKAIACharged, error) {
+	event := new(IBridgeKAIACharged)
+	if err := _IBridge.contract.UnpackLog(event, "KAIACharged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeMaxLockableKAIAChangeIterator is returned from FilterMaxLockableKAIAChange and is used to iterate over the raw logs and unpacked data for MaxLockableKAIAChange events raised by the IBridge contract.
+type IBridgeMaxLockableKAIAChangeIterator struct {
+	Event *IBridgeMaxLockableKAIAChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeMaxLockableKAIAChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeMaxLockableKAIAChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeMaxLockableKAIAChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeMaxLockableKAIAChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeMaxLockableKAIAChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeMaxLockableKAIAChange represents a MaxLockableKAIAChange event raised by the IBridge contract.
+type IBridgeMaxLockableKAIAChange struct {
+	BeforeMaxLock *big.Int
+	NewMaxLock    *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterMaxLockableKAIAChange is a free log retrieval operation binding the contract event 0xad8ec639c14eab82be945c7ae5c060174fb853d9cda596a194558f77fc5e3d75.
+//
+// Solidity: event MaxLockableKAIAChange(uint256 indexed beforeMaxLock, uint256 indexed newMaxLock)
+func (_IBridge *IBridgeFilterer) FilterMaxLockableKAIAChange(opts *bind.FilterOpts, beforeMaxLock []*big.Int, newMaxLock []*big.Int) (*IBridgeMaxLockableKAIAChangeIterator, error) {
+
+	var beforeMaxLockRule []interface{}
+	for _, beforeMaxLockItem := range beforeMaxLock {
+		beforeMaxLockRule = append(beforeMaxLockRule, beforeMaxLockItem)
+	}
+	var newMaxLockRule []interface{}
+	for _, newMaxLockItem := range newMaxLock {
+		newMaxLockRule = append(newMaxLockRule, newMaxLockItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "MaxLockableKAIAChange", beforeMaxLockRule, newMaxLockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeMaxLockableKAIAChangeIterator{contract: _IBridge.contract, event: "MaxLockableKAIAChange", logs: logs, sub: sub}, nil
+}
+
+// WatchMaxLockableKAIAChange is a free log subscription operation binding the contract event 0xad8ec639c14eab82be945c7ae5c060174fb853d9cda596a194558f77fc5e3d75.
+//
+// Solidity: event MaxLockableKAIAChange(uint256 indexed beforeMaxLock, uint256 indexed newMaxLock)
+func (_IBridge *IBridgeFilterer) WatchMaxLockableKAIAChange(opts *bind.WatchOpts, sink chan<- *IBridgeMaxLockableKAIAChange, beforeMaxLock []*big.Int, newMaxLock []*big.Int) (event.Subscription, error) {
+
+	var beforeMaxLockRule []interface{}
+	for _, beforeMaxLockItem := range beforeMaxLock {
+		beforeMaxLockRule = append(beforeMaxLockRule, beforeMaxLockItem)
+	}
+	var newMaxLockRule []interface{}
+	for _, newMaxLockItem := range newMaxLock {
+		newMaxLockRule = append(newMaxLockRule, newMaxLockItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "MaxLockableKAIAChange", beforeMaxLockRule, newMaxLockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeMaxLockableKAIAChange)
+				if err := _IBridge.contract.UnpackLog(event, "MaxLockableKAIAChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMaxLockableKAIAChange is a log parse operation binding the contract event 0xad8ec639c14eab82be945c7ae5c060174fb853d9cda596a194558f77fc5e3d75.
+//
+// Solidity: event MaxLockableKAIAChange(uint256 indexed beforeMaxLock, uint256 indexed newMaxLock)
+func (_IBridge *IBridgeFilterer) ParseMaxLockableKAIAChange(log types.Log) (*IBridgeMaxLockableKAIAChange, error) {
+	event := new(IBridgeMaxLockableKAIAChange)
+	if err := _IBridge.contract.UnpackLog(event, "MaxLockableKAIAChange", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeMaxTryTransferChangeIterator is returned from FilterMaxTryTransferChange and is used to iterate over the raw logs and unpacked data for MaxTryTransferChange events raised by the IBridge contract.
+type IBridgeMaxTryTransferChangeIterator struct {
+	Event *IBridgeMaxTryTransferChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeMaxTryTransferChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeMaxTryTransferChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeMaxTryTransferChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeMaxTryTransferChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeMaxTryTransferChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeMaxTryTransferChange represents a MaxTryTransferChange event raised by the IBridge contract.
+type IBridgeMaxTryTransferChange struct {
+	MaxTryTransfer    *big.Int
+	NewMaxTryTransfer *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterMaxTryTransferChange is a free log retrieval operation binding the contract event 0x7303d056c6d5243d142101fd24eab0d078178b99257ec851a358091fac65bd5f.
+//
+// Solidity: event MaxTryTransferChange(uint256 indexed maxTryTransfer, uint256 indexed newMaxTryTransfer)
+func (_IBridge *IBridgeFilterer) FilterMaxTryTransferChange(opts *bind.FilterOpts, maxTryTransfer []*big.Int, newMaxTryTransfer []*big.Int) (*IBridgeMaxTryTransferChangeIterator, error) {
+
+	var maxTryTransferRule []interface{}
+	for _, maxTryTransferItem := range maxTryTransfer {
+		maxTryTransferRule = append(maxTryTransferRule, maxTryTransferItem)
+	}
+	var newMaxTryTransferRule []interface{}
+	for _, newMaxTryTransferItem := range newMaxTryTransfer {
+		newMaxTryTransferRule = append(newMaxTryTransferRule, newMaxTryTransferItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "MaxTryTransferChange", maxTryTransferRule, newMaxTryTransferRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeMaxTryTransferChangeIterator{contract: _IBridge.contract, event: "MaxTryTransferChange", logs: logs, sub: sub}, nil
+}
+
+// WatchMaxTryTransferChange is a free log subscription operation binding the contract event 0x7303d056c6d5243d142101fd24eab0d078178b99257ec851a358091fac65bd5f.
+//
+// Solidity: event MaxTryTransferChange(uint256 indexed maxTryTransfer, uint256 indexed newMaxTryTransfer)
+func (_IBridge *IBridgeFilterer) WatchMaxTryTransferChange(opts *bind.WatchOpts, sink chan<- *IBridgeMaxTryTransferChange, maxTryTransfer []*big.Int, newMaxTryTransfer []*big.Int) (event.Subscription, error) {
+
+	var maxTryTransferRule []interface{}
+	for _, maxTryTransferItem := range maxTryTransfer {
+		maxTryTransferRule = append(maxTryTransferRule, maxTryTransferItem)
+	}
+	var newMaxTryTransferRule []interface{}
+	for _, newMaxTryTransferItem := range newMaxTryTransfer {
+		newMaxTryTransferRule = append(newMaxTryTransferRule, newMaxTryTransferItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "MaxTryTransferChange", maxTryTransferRule, newMaxTryTransferRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeMaxTryTransferChange)
+				if err := _IBridge.contract.UnpackLog(event, "MaxTryTransferChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMaxTryTransferChange is a log parse operation binding the contract event 0x7303d056c6d5243d142101fd24eab0d078178b99257ec851a358091fac65bd5f.
+//
+// Solidity: event MaxTryTransferChange(uint256 indexed maxTryTransfer, uint256 indexed newMaxTryTransfer)
+func (_IBridge *IBridgeFilterer) ParseMaxTryTransferChange(log types.Log) (*IBridgeMaxTryTransferChange, error) {
+	event := new(IBridgeMaxTryTransferChange)
+	if err := _IBridge.contract.UnpackLog(event, "MaxTryTransferChange", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeMinLockableKAIAChangeIterator is returned from FilterMinLockableKAIAChange and is used to iterate over the raw logs and unpacked data for MinLockableKAIAChange events raised by the IBridge contract.
+type IBridgeMinLockableKAIAChangeIterator struct {
+	Event *IBridgeMinLockableKAIAChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeMinLockableKAIAChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeMinLockableKAIAChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeMinLockableKAIAChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeMinLockableKAIAChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeMinLockableKAIAChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeMinLockableKAIAChange represents a MinLockableKAIAChange event raised by the IBridge contract.
+type IBridgeMinLockableKAIAChange struct {
+	BeforeMinLock *big.Int
+	NewMinLock    *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinLockableKAIAChange is a free log retrieval operation binding the contract event 0x1b7f0deea9ea187dce54b4b235a1adf4bf2cbf51ecf5e8ac20547105c8a052be.
+//
+// Solidity: event MinLockableKAIAChange(uint256 indexed beforeMinLock, uint256 indexed newMinLock)
+func (_IBridge *IBridgeFilterer) FilterMinLockableKAIAChange(opts *bind.FilterOpts, beforeMinLock []*big.Int, newMinLock []*big.Int) (*IBridgeMinLockableKAIAChangeIterator, error) {
+
+	var beforeMinLockRule []interface{}
+	for _, beforeMinLockItem := range beforeMinLock {
+		beforeMinLockRule = append(beforeMinLockRule, beforeMinLockItem)
+	}
+	var newMinLockRule []interface{}
+	for _, newMinLockItem := range newMinLock {
+		newMinLockRule = append(newMinLockRule, newMinLockItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "MinLockableKAIAChange", beforeMinLockRule, newMinLockRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeMinLockableKAIAChangeIterator{contract: _IBridge.contract, event: "MinLockableKAIAChange", logs: logs, sub: sub}, nil
+}
+
+// WatchMinLockableKAIAChange is a free log subscription operation binding the contract event 0x1b7f0deea9ea187dce54b4b235a1adf4bf2cbf51ecf5e8ac20547105c8a052be.
+//
+// Solidity: event MinLockableKAIAChange(uint256 indexed beforeMinLock, uint256 indexed newMinLock)
+func (_IBridge *IBridgeFilterer) WatchMinLockableKAIAChange(opts *bind.WatchOpts, sink chan<- *IBridgeMinLockableKAIAChange, beforeMinLock []*big.Int, newMinLock []*big.Int) (event.Subscription, error) {
+
+	var beforeMinLockRule []interface{}
+	for _, beforeMinLockItem := range beforeMinLock {
+		beforeMinLockRule = append(beforeMinLockRule, beforeMinLockItem)
+	}
+	var newMinLockRule []interface{}
+	for _, newMinLockItem := range newMinLock {
+		newMinLockRule = append(newMinLockRule, newMinLockItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "MinLockableKAIAChange", beforeMinLockRule, newMinLockRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeMinLockableKAIAChange)
+				if err := _IBridge.contract.UnpackLog(event, "MinLockableKAIAChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinLockableKAIAChange is a log parse operation binding the contract event 0x1b7f0deea9ea187dce54b4b235a1adf4bf2cbf51ecf5e8ac20547105c8a052be.
+//
+// Solidity: event MinLockableKAIAChange(uint256 indexed beforeMinLock, uint256 indexed newMinLock)
+func (_IBridge *IBridgeFilterer) ParseMinLockableKAIAChange(log types.Log) (*IBridgeMinLockableKAIAChange, error) {
+	event := new(IBridgeMinLockableKAIAChange)
+	if err := _IBridge.contract.UnpackLog(event, "MinLockableKAIAChange", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeProvisionIterator is returned from FilterProvision and is used to iterate over the raw logs and unpacked data for Provision events raised by the IBridge contract.
+type IBridgeProvisionIterator struct {
+	Event *IBridgeProvision // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeProvisionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeProvision)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeProvision)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeProvisionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeProvisionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeProvision represents a Provision event raised by the IBridge contract.
+type IBridgeProvision struct {
+	Provision IBridgeProvisionIndividualEvent
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterProvision is a free log retrieval operation binding the contract event 0x7cd846f24e54de6f7a280d98ae20bb0f09dbbe12d747c73f4f32e5673ee0dbc5.
+//
+// Solidity: event Provision((uint64,string,address,uint256,uint64,address) provision)
+func (_IBridge *IBridgeFilterer) FilterProvision(opts *bind.FilterOpts) (*IBridgeProvisionIterator, error) {
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "Provision")
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeProvisionIterator{contract: _IBridge.contract, event: "Provision", logs: logs, sub: sub}, nil
+}
+
+// WatchProvision is a free log subscription operation binding the contract event 0x7cd846f24e54de6f7a280d98ae20bb0f09dbbe12d747c73f4f32e5673ee0dbc5.
+//
+// Solidity: event Provision((uint64,string,address,uint256,uint64,address) provision)
+func (_IBridge *IBridgeFilterer) WatchProvision(opts *bind.WatchOpts, sink chan<- *IBridgeProvision) (event.Subscription, error) {
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "Provision")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeProvision)
+				if err := _IBridge.contract.UnpackLog(event, "Provision", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProvision is a log parse operation binding the contract event 0x7cd846f24e54de6f7a280d98ae20bb0f09dbbe12d747c73f4f32e5673ee0dbc5.
+//
+// Solidity: event Provision((uint64,string,address,uint256,uint64,address) provision)
+func (_IBridge *IBridgeFilterer) ParseProvision(log types.Log) (*IBridgeProvision, error) {
+	event := new(IBridgeProvision)
+	if err := _IBridge.contract.UnpackLog(event, "Provision", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeProvisionConfirmIterator is returned from FilterProvisionConfirm and is used to iterate over the raw logs and unpacked data for ProvisionConfirm events raised by the IBridge contract.
+type IBridgeProvisionConfirmIterator struct {
+	Event *IBridgeProvisionConfirm // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeProvisionConfirmIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeProvisionConfirm)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeProvisionConfirm)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeProvisionConfirmIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeProvisionConfirmIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeProvisionConfirm represents a ProvisionConfirm event raised by the IBridge contract.
+type IBridgeProvisionConfirm struct {
+	Provision IBridgeProvisionConfirmedEvent
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterProvisionConfirm is a free log retrieval operation binding the contract event 0xeaafa88e429e778603ec9aff8a1834097dc88ea1d628bb01dc4e24a2738fc612.
+//
+// Solidity: event ProvisionConfirm((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) FilterProvisionConfirm(opts *bind.FilterOpts) (*IBridgeProvisionConfirmIterator, error) {
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ProvisionConfirm")
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeProvisionConfirmIterator{contract: _IBridge.contract, event: "ProvisionConfirm", logs: logs, sub: sub}, nil
+}
+
+// WatchProvisionConfirm is a free log subscription operation binding the contract event 0xeaafa88e429e778603ec9aff8a1834097dc88ea1d628bb01dc4e24a2738fc612.
+//
+// Solidity: event ProvisionConfirm((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) WatchProvisionConfirm(opts *bind.WatchOpts, sink chan<- *IBridgeProvisionConfirm) (event.Subscription, error) {
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ProvisionConfirm")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeProvisionConfirm)
+				if err := _IBridge.contract.UnpackLog(event, "ProvisionConfirm", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProvisionConfirm is a log parse operation binding the contract event 0xeaafa88e429e778603ec9aff8a1834097dc88ea1d628bb01dc4e24a2738fc612.
+//
+// Solidity: event ProvisionConfirm((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) ParseProvisionConfirm(log types.Log) (*IBridgeProvisionConfirm, error) {
+	event := new(IBridgeProvisionConfirm)
+	if err := _IBridge.contract.UnpackLog(event, "ProvisionConfirm", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeProvisionReceiverChangedIterator is returned from FilterProvisionReceiverChanged and is used to iterate over the raw logs and unpacked data for ProvisionReceiverChanged events raised by the IBridge contract.
+type IBridgeProvisionReceiverChangedIterator struct {
+	Event *IBridgeProvisionReceiverChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeProvisionReceiverChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeProvisionReceiverChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeProvisionReceiverChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeProvisionReceiverChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeProvisionReceiverChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeProvisionReceiverChanged represents a ProvisionReceiverChanged event raised by the IBridge contract.
+type IBridgeProvisionReceiverChanged struct {
+	BeforeReceiver common.Address
+	NewReceiver    common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterProvisionReceiverChanged is a free log retrieval operation binding the contract event 0xa134134b227383c3e6d7eca491d135fe89377ef62d74a6b45cdf320ab6f584ed.
+//
+// Solidity: event ProvisionReceiverChanged(address indexed beforeReceiver, address indexed newReceiver)
+func (_IBridge *IBridgeFilterer) FilterProvisionReceiverChanged(opts *bind.FilterOpts, beforeReceiver []common.Address, newReceiver []common.Address) (*IBridgeProvisionReceiverChangedIterator, error) {
+
+	var beforeReceiverRule []interface{}
+	for _, beforeReceiverItem := range beforeReceiver {
+		beforeReceiverRule = append(beforeReceiverRule, beforeReceiverItem)
+	}
+	var newReceiverRule []interface{}
+	for _, newReceiverItem := range newReceiver {
+		newReceiverRule = append(newReceiverRule, newReceiverItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ProvisionReceiverChanged", beforeReceiverRule, newReceiverRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeProvisionReceiverChangedIterator{contract: _IBridge.contract, event: "ProvisionReceiverChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchProvisionReceiverChanged is a free log subscription operation binding the contract event 0xa134134b227383c3e6d7eca491d135fe89377ef62d74a6b45cdf320ab6f584ed.
+//
+// Solidity: event ProvisionReceiverChanged(address indexed beforeReceiver, address indexed newReceiver)
+func (_IBridge *IBridgeFilterer) WatchProvisionReceiverChanged(opts *bind.WatchOpts, sink chan<- *IBridgeProvisionReceiverChanged, beforeReceiver []common.Address, newReceiver []common.Address) (event.Subscription, error) {
+
+	var beforeReceiverRule []interface{}
+	for _, beforeReceiverItem := range beforeReceiver {
+		beforeReceiverRule = append(beforeReceiverRule, beforeReceiverItem)
+	}
+	var newReceiverRule []interface{}
+	for _, newReceiverItem := range newReceiver {
+		newReceiverRule = append(newReceiverRule, newReceiverItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ProvisionReceiverChanged", beforeReceiverRule, newReceiverRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeProvisionReceiverChanged)
+				if err := _IBridge.contract.UnpackLog(event, "ProvisionReceiverChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProvisionReceiverChanged is a log parse operation binding the contract event 0xa134134b227383c3e6d7eca491d135fe89377ef62d74a6b45cdf320ab6f584ed.
+//
+// Solidity: event ProvisionReceiverChanged(address indexed beforeReceiver, address indexed newReceiver)
+func (_IBridge *IBridgeFilterer) ParseProvisionReceiverChanged(log types.Log) (*IBridgeProvisionReceiverChanged, error) {
+	event := new(IBridgeProvisionReceiverChanged)
+	if err := _IBridge.contract.UnpackLog(event, "ProvisionReceiverChanged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeReleaseClaimIterator is returned from FilterReleaseClaim and is used to iterate over the raw logs and unpacked data for ReleaseClaim events raised by the IBridge contract.
+type IBridgeReleaseClaimIterator struct {
+	Event *IBridgeReleaseClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeReleaseClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeReleaseClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeReleaseClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeReleaseClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeReleaseClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeReleaseClaim represents a ReleaseClaim event raised by the IBridge contract.
+type IBridgeReleaseClaim struct {
+	Seq  *big.Int
+	Time *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterReleaseClaim is a free log retrieval operation binding the contract event 0x468a3507d22268fb97a9d268b3784814440c7d66c8b3ad80eff0961048a72288.
+//
+// Solidity: event ReleaseClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) FilterReleaseClaim(opts *bind.FilterOpts, seq []*big.Int, time []*big.Int) (*IBridgeReleaseClaimIterator, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "ReleaseClaim", seqRule, timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeReleaseClaimIterator{contract: _IBridge.contract, event: "ReleaseClaim", logs: logs, sub: sub}, nil
+}
+
+// WatchReleaseClaim is a free log subscription operation binding the contract event 0x468a3507d22268fb97a9d268b3784814440c7d66c8b3ad80eff0961048a72288.
+//
+// Solidity: event ReleaseClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) WatchReleaseClaim(opts *bind.WatchOpts, sink chan<- *IBridgeReleaseClaim, seq []*big.Int, time []*big.Int) (event.Subscription, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+	var timeRule []interface{}
+	for _, timeItem := range time {
+		timeRule = append(timeRule, timeItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "ReleaseClaim", seqRule, timeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeReleaseClaim)
+				if err := _IBridge.contract.UnpackLog(event, "ReleaseClaim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReleaseClaim is a log parse operation binding the contract event 0x468a3507d22268fb97a9d268b3784814440c7d66c8b3ad80eff0961048a72288.
+//
+// Solidity: event ReleaseClaim(uint256 indexed seq, uint256 indexed time)
+func (_IBridge *IBridgeFilterer) ParseReleaseClaim(log types.Log) (*IBridgeReleaseClaim, error) {
+	event := new(IBridgeReleaseClaim)
+	if err := _IBridge.contract.UnpackLog(event, "ReleaseClaim", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeRemoveProvisionIterator is returned from FilterRemoveProvision and is used to iterate over the raw logs and unpacked data for RemoveProvision events raised by the IBridge contract.
+type IBridgeRemoveProvisionIterator struct {
+	Event *IBridgeRemoveProvision // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeRemoveProvisionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeRemoveProvision)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeRemoveProvision)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeRemoveProvisionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeRemoveProvisionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeRemoveProvision represents a RemoveProvision event raised by the IBridge contract.
+type IBridgeRemoveProvision struct {
+	Provision IBridgeProvisionData
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRemoveProvision is a free log retrieval operation binding the contract event 0x1d1da9bc58e89b30029e7edaca4296b7d464295a50800349a3e3568577b32cd6.
+//
+// Solidity: event RemoveProvision((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) FilterRemoveProvision(opts *bind.FilterOpts) (*IBridgeRemoveProvisionIterator, error) {
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "RemoveProvision")
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeRemoveProvisionIterator{contract: _IBridge.contract, event: "RemoveProvision", logs: logs, sub: sub}, nil
+}
+
+// WatchRemoveProvision is a free log subscription operation binding the contract event 0x1d1da9bc58e89b30029e7edaca4296b7d464295a50800349a3e3568577b32cd6.
+//
+// Solidity: event RemoveProvision((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) WatchRemoveProvision(opts *bind.WatchOpts, sink chan<- *IBridgeRemoveProvision) (event.Subscription, error) {
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "RemoveProvision")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeRemoveProvision)
+				if err := _IBridge.contract.UnpackLog(event, "RemoveProvision", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRemoveProvision is a log parse operation binding the contract event 0x1d1da9bc58e89b30029e7edaca4296b7d464295a50800349a3e3568577b32cd6.
+//
+// Solidity: event RemoveProvision((uint64,string,address,uint256) provision)
+func (_IBridge *IBridgeFilterer) ParseRemoveProvision(log types.Log) (*IBridgeRemoveProvision, error) {
+	event := new(IBridgeRemoveProvision)
+	if err := _IBridge.contract.UnpackLog(event, "RemoveProvision", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the IBridge contract.
+type IBridgeTransferIterator struct {
+	Event *IBridgeTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeTransfer represents a Transfer event raised by the IBridge contract.
+type IBridgeTransfer struct {
+	LockInfo IBridgeSwapRequest
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0x58c20180b91b98e6e0c214ab2ed6428ba657677b8da73f1213e4fdb9dce208f3.
+//
+// Solidity: event Transfer((uint64,address,string,uint256) lockInfo)
+func (_IBridge *IBridgeFilterer) FilterTransfer(opts *bind.FilterOpts) (*IBridgeTransferIterator, error) {
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "Transfer")
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeTransferIterator{contract: _IBridge.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0x58c20180b91b98e6e0c214ab2ed6428ba657677b8da73f1213e4fdb9dce208f3.
+//
+// Solidity: event Transfer((uint64,address,string,uint256) lockInfo)
+func (_IBridge *IBridgeFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *IBridgeTransfer) (event.Subscription, error) {
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "Transfer")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeTransfer)
+				if err := _IBridge.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0x58c20180b91b98e6e0c214ab2ed6428ba657677b8da73f1213e4fdb9dce208f3.
+//
+// Solidity: event Transfer((uint64,address,string,uint256) lockInfo)
+func (_IBridge *IBridgeFilterer) ParseTransfer(log types.Log) (*IBridgeTransfer, error) {
+	event := new(IBridgeTransfer)
+	if err := _IBridge.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IBridgeTransferFromKaiaOnOffChangedIterator is returned from FilterTransferFromKaiaOnOffChanged and is used to iterate over the raw logs and unpacked data for TransferFromKaiaOnOffChanged events raised by the IBridge contract.
+type IBridgeTransferFromKaiaOnOffChangedIterator struct {
+	Event *IBridgeTransferFromKaiaOnOffChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBridgeTransferFromKaiaOnOffChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBridgeTransferFromKaiaOnOffChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBridgeTransferFromKaiaOnOffChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBridgeTransferFromKaiaOnOffChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBridgeTransferFromKaiaOnOffChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBridgeTransferFromKaiaOnOffChanged represents a TransferFromKaiaOnOffChanged event raised by the IBridge contract.
+type IBridgeTransferFromKaiaOnOffChanged struct {
+	TransferFromKaiaOn bool
+	Set                bool
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferFromKaiaOnOffChanged is a free log retrieval operation binding the contract event 0x3eada64629927ae1a1a4b98aa7d4a80f7cf222636dd147045164c72b8c45a870.
+//
+// Solidity: event TransferFromKaiaOnOffChanged(bool indexed transferFromKaiaOn, bool indexed set)
+func (_IBridge *IBridgeFilterer) FilterTransferFromKaiaOnOffChanged(opts *bind.FilterOpts, transferFromKaiaOn []bool, set []bool) (*IBridgeTransferFromKaiaOnOffChangedIterator, error) {
+
+	var transferFromKaiaOnRule []interface{}
+	for _, transferFromKaiaOnItem := range transferFromKaiaOn {
+		transferFromKaiaOnRule = append(transferFromKaiaOnRule, transferFromKaiaOnItem)
+	}
+	var setRule []interface{}
+	for _, setItem := range set {
+		setRule = append(setRule, setItem)
+	}
+
+	logs, sub, err := _IBridge.contract.FilterLogs(opts, "TransferFromKaiaOnOffChanged", transferFromKaiaOnRule, setRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBridgeTransferFromKaiaOnOffChangedIterator{contract: _IBridge.contract, event: "TransferFromKaiaOnOffChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferFromKaiaOnOffChanged is a free log subscription operation binding the contract event 0x3eada64629927ae1a1a4b98aa7d4a80f7cf222636dd147045164c72b8c45a870.
+//
+// Solidity: event TransferFromKaiaOnOffChanged(bool indexed transferFromKaiaOn, bool indexed set)
+func (_IBridge *IBridgeFilterer) WatchTransferFromKaiaOnOffChanged(opts *bind.WatchOpts, sink chan<- *IBridgeTransferFromKaiaOnOffChanged, transferFromKaiaOn []bool, set []bool) (event.Subscription, error) {
+
+	var transferFromKaiaOnRule []interface{}
+	for _, transferFromKaiaOnItem := range transferFromKaiaOn {
+		transferFromKaiaOnRule = append(transferFromKaiaOnRule, transferFromKaiaOnItem)
+	}
+	var setRule []interface{}
+	for _, setItem := range set {
+		setRule = append(setRule, setItem)
+	}
+
+	logs, sub, err := _IBridge.contract.WatchLogs(opts, "TransferFromKaiaOnOffChanged", transferFromKaiaOnRule, setRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBridgeTransferFromKaiaOnOffChanged)
+				if err := _IBridge.contract.UnpackLog(event, "TransferFromKaiaOnOffChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferFromKaiaOnOffChanged is a log parse operation binding the contract event 0x3eada64629927ae1a1a4b98aa7d4a80f7cf222636dd147045164c72b8c45a870.
+//
+// Solidity: event TransferFromKaiaOnOffChanged(bool indexed transferFromKaiaOn, bool indexed set)
+func (_IBridge *IBridgeFilterer) ParseTransferFromKaiaOnOffChanged(log types.Log) (*IBridgeTransferFromKaiaOnOffChanged, error) {
+	event := new(IBridgeTransferFromKaiaOnOffChanged)
+	if err := _IBridge.contract.UnpackLog(event, "TransferFromKaiaOnOffChanged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorMetaData contains all meta data concerning the IOperator contract.
+var IOperatorMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeBridge\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newBridge\",\"type\":\"address\"}],\"name\":\"ChangeBridge\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beforeGuardian\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newGuardian\",\"type\":\"address\"}],\"name\":\"ChangeGuardian\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"transactionId\",\"type\":\"uint64\"}],\"name\":\"Confirmation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"transactionId\",\"type\":\"uint64\"}],\"name\":\"Execution\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"OperatorAddition\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"OperatorRemoval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"required\",\"type\":\"uint64\"}],\"name\":\"RequirementChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"transactionId\",\"type\":\"uint64\"}],\"name\":\"Revocation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"RevokedProvision\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"transactionId\",\"type\":\"uint64\"}],\"name\":\"Submission\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"unsubmittedNextSeq\",\"type\":\"uint64\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"newSeq\",\"type\":\"uint64\"}],\"name\":\"UnsubmittedNextSeqUpdate\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"addOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"bridge\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"calldataHashes\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newBridge\",\"type\":\"address\"}],\"name\":\"changeBridge\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newGuardian\",\"type\":\"address\"}],\"name\":\"changeGuardian\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint8\",\"name\":\"_minOperatorRequiredConfirm\",\"type\":\"uint8\"}],\"name\":\"changeRequirement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"hashedData\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"checkProvisionShouldSubmit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"confirmTransaction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"confirmations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"targetOperator\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"seqFrom\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"seqTo\",\"type\":\"uint64\"}],\"name\":\"doGetUnconfirmedProvisionSeqs\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"executeTransaction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"getConfirmationCount\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"getConfirmations\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"getSeq2TxIDs\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"pending\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"executed\",\"type\":\"bool\"}],\"name\":\"getTransactionCount\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"from\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"to\",\"type\":\"uint64\"},{\"internalType\":\"bool\",\"name\":\"pending\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"executed\",\"type\":\"bool\"}],\"name\":\"getTransactionIds\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"},{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"targetOperator\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"range\",\"type\":\"uint64\"}],\"name\":\"getUnconfirmedProvisionSeqs\",\"outputs\":[{\"internalType\":\"uint64[]\",\"name\":\"\",\"type\":\"uint64[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"greatestSubmittedSeq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"guardian\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"isConfirmed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isOperator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"markRevokeSeq\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"minOperatorRequiredConfirm\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"nextProvisionSeq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"operators\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"name\":\"provisions\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"removeOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newOperator\",\"type\":\"address\"}],\"name\":\"replaceOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"txID\",\"type\":\"uint64\"}],\"name\":\"revokeConfirmation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"uniqUserTxIndex\",\"type\":\"uint256\"}],\"name\":\"submitTransaction\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transactions\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"executed\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"name\":\"txID2Seq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"seq\",\"type\":\"uint64\"}],\"name\":\"unmarkRevokeSeq\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"unsubmittedNextSeq\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"nextUnprovisionedSeq\",\"type\":\"uint64\"}],\"name\":\"updateNextUnsubmittedSeq\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"userIdx2TxID\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"9870d7fe": "addOperator(address)",
+		"e78cea92": "bridge()",
+		"b141be1d": "calldataHashes(bytes32)",
+		"08774410": "changeBridge(address)",
+		"2fcb4f04": "changeGuardian(address)",
+		"fe9abc7c": "changeRequirement(uint8)",
+		"60722685": "checkProvisionShouldSubmit(bytes32,address)",
+		"5798d056": "confirmTransaction(uint64)",
+		"d0a2156f": "confirmations(uint64,address)",
+		"c8d1c953": "doGetUnconfirmedProvisionSeqs(address,uint64,uint64)",
+		"6edec705": "executeTransaction(uint64)",
+		"faf4e58e": "getConfirmationCount(uint64)",
+		"c223f6fe": "getConfirmations(uint64)",
+		"27a099d8": "getOperators()",
+		"14a3aed2": "getSeq2TxIDs(uint64)",
+		"54741525": "getTransactionCount(bool,bool)",
+		"63011a09": "getTransactionIds(uint64,uint64,bool,bool)",
+		"4e0b8155": "getUnconfirmedProvisionSeqs(address,uint64)",
+		"b4c74214": "greatestSubmittedSeq(address)",
+		"452a9320": "guardian()",
+		"36ebcd90": "isConfirmed(uint64)",
+		"6d70f7ae": "isOperator(address)",
+		"7e5d9411": "markRevokeSeq(uint64)",
+		"cee4b251": "minOperatorRequiredConfirm()",
+		"a613252f": "nextProvisionSeq(address)",
+		"e28d4906": "operators(uint256)",
+		"2a10e777": "provisions(uint64)",
+		"ac8a584a": "removeOperator(address)",
+		"b7a55438": "replaceOperator(address,address)",
+		"eeb7a5a8": "revokeConfirmation(uint64)",
+		"e05afb68": "submitTransaction(address,bytes,uint256)",
+		"9ace38c2": "transactions(uint256)",
+		"e4a9f4ab": "txID2Seq(uint64)",
+		"8a8f09a5": "unmarkRevokeSeq(uint64)",
+		"59141a79": "unsubmittedNextSeq(address)",
+		"f6efee72": "updateNextUnsubmittedSeq(uint64)",
+		"3d7e1645": "userIdx2TxID(uint256)",
+	},
+}
+
+// IOperatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use IOperatorMetaData.ABI instead.
+var IOperatorABI = IOperatorMetaData.ABI
+
+// IOperatorBinRuntime is the compiled bytecode used for adding genesis block without deploying code.
+const IOperatorBinRuntime = ``
+
+// IOperatorFuncSigs maps the 4-byte function signature to its string representation.
+// Deprecated: Use IOperatorMetaData.Sigs instead.
+var IOperatorFuncSigs = IOperatorMetaData.Sigs
+
+// IOperator is an auto generated Go binding around a Kaia contract.
+type IOperator struct {
+	IOperatorCaller     // Read-only binding to the contract
+	IOperatorTransactor // Write-only binding to the contract
+	IOperatorFilterer   // Log filterer for contract events
+}
+
+// IOperatorCaller is an auto generated read-only Go binding around a Kaia contract.
+type IOperatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IOperatorTransactor is an auto generated write-only Go binding around a Kaia contract.
+type IOperatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IOperatorFilterer is an auto generated log filtering Go binding around a Kaia contract events.
+type IOperatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IOperatorSession is an auto generated Go binding around a Kaia contract,
+// with pre-set call and transact options.
+type IOperatorSession struct {
+	Contract     *IOperator        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IOperatorCallerSession is an auto generated read-only Go binding around a Kaia contract,
+// with pre-set call options.
+type IOperatorCallerSession struct {
+	Contract *IOperatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// IOperatorTransactorSession is an auto generated write-only Go binding around a Kaia contract,
+// with pre-set transact options.
+type IOperatorTransactorSession struct {
+	Contract     *IOperatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// IOperatorRaw is an auto generated low-level Go binding around a Kaia contract.
+type IOperatorRaw struct {
+	Contract *IOperator // Generic contract binding to access the raw methods on
+}
+
+// IOperatorCallerRaw is an auto generated low-level read-only Go binding around a Kaia contract.
+type IOperatorCallerRaw struct {
+	Contract *IOperatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IOperatorTransactorRaw is an auto generated low-level write-only Go binding around a Kaia contract.
+type IOperatorTransactorRaw struct {
+	Contract *IOperatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIOperator creates a new instance of IOperator, bound to a specific deployed contract.
+func NewIOperator(address common.Address, backend bind.ContractBackend) (*IOperator, error) {
+	contract, err := bindIOperator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperator{IOperatorCaller: IOperatorCaller{contract: contract}, IOperatorTransactor: IOperatorTransactor{contract: contract}, IOperatorFilterer: IOperatorFilterer{contract: contract}}, nil
+}
+
+// NewIOperatorCaller creates a new read-only instance of IOperator, bound to a specific deployed contract.
+func NewIOperatorCaller(address common.Address, caller bind.ContractCaller) (*IOperatorCaller, error) {
+	contract, err := bindIOperator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorCaller{contract: contract}, nil
+}
+
+// NewIOperatorTransactor creates a new write-only instance of IOperator, bound to a specific deployed contract.
+func NewIOperatorTransactor(address common.Address, transactor bind.ContractTransactor) (*IOperatorTransactor, error) {
+	contract, err := bindIOperator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorTransactor{contract: contract}, nil
+}
+
+// NewIOperatorFilterer creates a new log filterer instance of IOperator, bound to a specific deployed contract.
+func NewIOperatorFilterer(address common.Address, filterer bind.ContractFilterer) (*IOperatorFilterer, error) {
+	contract, err := bindIOperator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorFilterer{contract: contract}, nil
+}
+
+// bindIOperator binds a generic wrapper to an already deployed contract.
+func bindIOperator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IOperatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IOperator *IOperatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IOperator.Contract.IOperatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IOperator *IOperatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IOperator.Contract.IOperatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IOperator *IOperatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IOperator.Contract.IOperatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IOperator *IOperatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IOperator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IOperator *IOperatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IOperator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IOperator *IOperatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IOperator.Contract.contract.Transact(opts, method, params...)
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_IOperator *IOperatorCaller) Bridge(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "bridge")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_IOperator *IOperatorSession) Bridge() (common.Address, error) {
+	return _IOperator.Contract.Bridge(&_IOperator.CallOpts)
+}
+
+// Bridge is a free data retrieval call binding the contract method 0xe78cea92.
+//
+// Solidity: function bridge() view returns(address)
+func (_IOperator *IOperatorCallerSession) Bridge() (common.Address, error) {
+	return _IOperator.Contract.Bridge(&_IOperator.CallOpts)
+}
+
+// CalldataHashes is a free data retrieval call binding the contract method 0xb141be1d.
+//
+// Solidity: function calldataHashes(bytes32 ) view returns(uint64)
+func (_IOperator *IOperatorCaller) CalldataHashes(opts *bind.CallOpts, arg0 [32]byte) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "calldataHashes", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// CalldataHashes is a free data retrieval call binding the contract method 0xb141be1d.
+//
+// Solidity: function calldataHashes(bytes32 ) view returns(uint64)
+func (_IOperator *IOperatorSession) CalldataHashes(arg0 [32]byte) (uint64, error) {
+	return _IOperator.Contract.CalldataHashes(&_IOperator.CallOpts, arg0)
+}
+
+// CalldataHashes is a free data retrieval call binding the contract method 0xb141be1d.
+//
+// Solidity: function calldataHashes(bytes32 ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) CalldataHashes(arg0 [32]byte) (uint64, error) {
+	return _IOperator.Contract.CalldataHashes(&_IOperator.CallOpts, arg0)
+}
+
+// CheckProvisionShouldSubmit is a free data retrieval call binding the contract method 0x60722685.
+//
+// Solidity: function checkProvisionShouldSubmit(bytes32 hashedData, address operator) view returns(bool)
+func (_IOperator *IOperatorCaller) CheckProvisionShouldSubmit(opts *bind.CallOpts, hashedData [32]byte, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "checkProvisionShouldSubmit", hashedData, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckProvisionShouldSubmit is a free data retrieval call binding the contract method 0x60722685.
+//
+// Solidity: function checkProvisionShouldSubmit(bytes32 hashedData, address operator) view returns(bool)
+func (_IOperator *IOperatorSession) CheckProvisionShouldSubmit(hashedData [32]byte, operator common.Address) (bool, error) {
+	return _IOperator.Contract.CheckProvisionShouldSubmit(&_IOperator.CallOpts, hashedData, operator)
+}
+
+// CheckProvisionShouldSubmit is a free data retrieval call binding the contract method 0x60722685.
+//
+// Solidity: function checkProvisionShouldSubmit(bytes32 hashedData, address operator) view returns(bool)
+func (_IOperator *IOperatorCallerSession) CheckProvisionShouldSubmit(hashedData [32]byte, operator common.Address) (bool, error) {
+	return _IOperator.Contract.CheckProvisionShouldSubmit(&_IOperator.CallOpts, hashedData, operator)
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xd0a2156f.
+//
+// Solidity: function confirmations(uint64 , address ) view returns(bool)
+func (_IOperator *IOperatorCaller) Confirmations(opts *bind.CallOpts, arg0 uint64, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "confirmations", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xd0a2156f.
+//
+// Solidity: function confirmations(uint64 , address ) view returns(bool)
+func (_IOperator *IOperatorSession) Confirmations(arg0 uint64, arg1 common.Address) (bool, error) {
+	return _IOperator.Contract.Confirmations(&_IOperator.CallOpts, arg0, arg1)
+}
+
+// Confirmations is a free data retrieval call binding the contract method 0xd0a2156f.
+//
+// Solidity: function confirmations(uint64 , address ) view returns(bool)
+func (_IOperator *IOperatorCallerSession) Confirmations(arg0 uint64, arg1 common.Address) (bool, error) {
+	return _IOperator.Contract.Confirmations(&_IOperator.CallOpts, arg0, arg1)
+}
+
+// DoGetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0xc8d1c953.
+//
+// Solidity: function doGetUnconfirmedProvisionSeqs(address targetOperator, uint64 seqFrom, uint64 seqTo) view returns(uint64[])
+func (_IOperator *IOperatorCaller) DoGetUnconfirmedProvisionSeqs(opts *bind.CallOpts, targetOperator common.Address, seqFrom uint64, seqTo uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "doGetUnconfirmedProvisionSeqs", targetOperator, seqFrom, seqTo)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// DoGetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0xc8d1c953.
+//
+// Solidity: function doGetUnconfirmedProvisionSeqs(address targetOperator, uint64 seqFrom, uint64 seqTo) view returns(uint64[])
+func (_IOperator *IOperatorSession) DoGetUnconfirmedProvisionSeqs(targetOperator common.Address, seqFrom uint64, seqTo uint64) ([]uint64, error) {
+	return _IOperator.Contract.DoGetUnconfirmedProvisionSeqs(&_IOperator.CallOpts, targetOperator, seqFrom, seqTo)
+}
+
+// DoGetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0xc8d1c953.
+//
+// Solidity: function doGetUnconfirmedProvisionSeqs(address targetOperator, uint64 seqFrom, uint64 seqTo) view returns(uint64[])
+func (_IOperator *IOperatorCallerSession) DoGetUnconfirmedProvisionSeqs(targetOperator common.Address, seqFrom uint64, seqTo uint64) ([]uint64, error) {
+	return _IOperator.Contract.DoGetUnconfirmedProvisionSeqs(&_IOperator.CallOpts, targetOperator, seqFrom, seqTo)
+}
+
+// GetConfirmationCount is a free data retrieval call binding the contract method 0xfaf4e58e.
+//
+// Solidity: function getConfirmationCount(uint64 txID) view returns(uint64)
+func (_IOperator *IOperatorCaller) GetConfirmationCount(opts *bind.CallOpts, txID uint64) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getConfirmationCount", txID)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetConfirmationCount is a free data retrieval call binding the contract method 0xfaf4e58e.
+//
+// Solidity: function getConfirmationCount(uint64 txID) view returns(uint64)
+func (_IOperator *IOperatorSession) GetConfirmationCount(txID uint64) (uint64, error) {
+	return _IOperator.Contract.GetConfirmationCount(&_IOperator.CallOpts, txID)
+}
+
+// GetConfirmationCount is a free data retrieval call binding the contract method 0xfaf4e58e.
+//
+// Solidity: function getConfirmationCount(uint64 txID) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) GetConfirmationCount(txID uint64) (uint64, error) {
+	return _IOperator.Contract.GetConfirmationCount(&_IOperator.CallOpts, txID)
+}
+
+// GetConfirmations is a free data retrieval call binding the contract method 0xc223f6fe.
+//
+// Solidity: function getConfirmations(uint64 txID) view returns(address[])
+func (_IOperator *IOperatorCaller) GetConfirmations(opts *bind.CallOpts, txID uint64) ([]common.Address, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getConfirmations", txID)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetConfirmations is a free data retrieval call binding the contract method 0xc223f6fe.
+//
+// Solidity: function getConfirmations(uint64 txID) view returns(address[])
+func (_IOperator *IOperatorSession) GetConfirmations(txID uint64) ([]common.Address, error) {
+	return _IOperator.Contract.GetConfirmations(&_IOperator.CallOpts, txID)
+}
+
+// GetConfirmations is a free data retrieval call binding the contract method 0xc223f6fe.
+//
+// Solidity: function getConfirmations(uint64 txID) view returns(address[])
+func (_IOperator *IOperatorCallerSession) GetConfirmations(txID uint64) ([]common.Address, error) {
+	return _IOperator.Contract.GetConfirmations(&_IOperator.CallOpts, txID)
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_IOperator *IOperatorCaller) GetOperators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getOperators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_IOperator *IOperatorSession) GetOperators() ([]common.Address, error) {
+	return _IOperator.Contract.GetOperators(&_IOperator.CallOpts)
+}
+
+// GetOperators is a free data retrieval call binding the contract method 0x27a099d8.
+//
+// Solidity: function getOperators() view returns(address[])
+func (_IOperator *IOperatorCallerSession) GetOperators() ([]common.Address, error) {
+	return _IOperator.Contract.GetOperators(&_IOperator.CallOpts)
+}
+
+// GetSeq2TxIDs is a free data retrieval call binding the contract method 0x14a3aed2.
+//
+// Solidity: function getSeq2TxIDs(uint64 seq) view returns(uint64[])
+func (_IOperator *IOperatorCaller) GetSeq2TxIDs(opts *bind.CallOpts, seq uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getSeq2TxIDs", seq)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetSeq2TxIDs is a free data retrieval call binding the contract method 0x14a3aed2.
+//
+// Solidity: function getSeq2TxIDs(uint64 seq) view returns(uint64[])
+func (_IOperator *IOperatorSession) GetSeq2TxIDs(seq uint64) ([]uint64, error) {
+	return _IOperator.Contract.GetSeq2TxIDs(&_IOperator.CallOpts, seq)
+}
+
+// GetSeq2TxIDs is a free data retrieval call binding the contract method 0x14a3aed2.
+//
+// Solidity: function getSeq2TxIDs(uint64 seq) view returns(uint64[])
+func (_IOperator *IOperatorCallerSession) GetSeq2TxIDs(seq uint64) ([]uint64, error) {
+	return _IOperator.Contract.GetSeq2TxIDs(&_IOperator.CallOpts, seq)
+}
+
+// GetTransactionCount is a free data retrieval call binding the contract method 0x54741525.
+//
+// Solidity: function getTransactionCount(bool pending, bool executed) view returns(uint64, uint64)
+func (_IOperator *IOperatorCaller) GetTransactionCount(opts *bind.CallOpts, pending bool, executed bool) (uint64, uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getTransactionCount", pending, executed)
+
+	if err != nil {
+		return *new(uint64), *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	out1 := *abi.ConvertType(out[1], new(uint64)).(*uint64)
+
+	return out0, out1, err
+
+}
+
+// GetTransactionCount is a free data retrieval call binding the contract method 0x54741525.
+//
+// Solidity: function getTransactionCount(bool pending, bool executed) view returns(uint64, uint64)
+func (_IOperator *IOperatorSession) GetTransactionCount(pending bool, executed bool) (uint64, uint64, error) {
+	return _IOperator.Contract.GetTransactionCount(&_IOperator.CallOpts, pending, executed)
+}
+
+// GetTransactionCount is a free data retrieval call binding the contract method 0x54741525.
+//
+// Solidity: function getTransactionCount(bool pending, bool executed) view returns(uint64, uint64)
+func (_IOperator *IOperatorCallerSession) GetTransactionCount(pending bool, executed bool) (uint64, uint64, error) {
+	return _IOperator.Contract.GetTransactionCount(&_IOperator.CallOpts, pending, executed)
+}
+
+// GetTransactionIds is a free data retrieval call binding the contract method 0x63011a09.
+//
+// Solidity: function getTransactionIds(uint64 from, uint64 to, bool pending, bool executed) view returns(uint64[], uint64[])
+func (_IOperator *IOperatorCaller) GetTransactionIds(opts *bind.CallOpts, from uint64, to uint64, pending bool, executed bool) ([]uint64, []uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getTransactionIds", from, to, pending, executed)
+
+	if err != nil {
+		return *new([]uint64), *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+	out1 := *abi.ConvertType(out[1], new([]uint64)).(*[]uint64)
+
+	return out0, out1, err
+
+}
+
+// GetTransactionIds is a free data retrieval call binding the contract method 0x63011a09.
+//
+// Solidity: function getTransactionIds(uint64 from, uint64 to, bool pending, bool executed) view returns(uint64[], uint64[])
+func (_IOperator *IOperatorSession) GetTransactionIds(from uint64, to uint64, pending bool, executed bool) ([]uint64, []uint64, error) {
+	return _IOperator.Contract.GetTransactionIds(&_IOperator.CallOpts, from, to, pending, executed)
+}
+
+// GetTransactionIds is a free data retrieval call binding the contract method 0x63011a09.
+//
+// Solidity: function getTransactionIds(uint64 from, uint64 to, bool pending, bool executed) view returns(uint64[], uint64[])
+func (_IOperator *IOperatorCallerSession) GetTransactionIds(from uint64, to uint64, pending bool, executed bool) ([]uint64, []uint64, error) {
+	return _IOperator.Contract.GetTransactionIds(&_IOperator.CallOpts, from, to, pending, executed)
+}
+
+// GetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0x4e0b8155.
+//
+// Solidity: function getUnconfirmedProvisionSeqs(address targetOperator, uint64 range) view returns(uint64[])
+func (_IOperator *IOperatorCaller) GetUnconfirmedProvisionSeqs(opts *bind.CallOpts, targetOperator common.Address, arg1 uint64) ([]uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "getUnconfirmedProvisionSeqs", targetOperator, arg1)
+
+	if err != nil {
+		return *new([]uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]uint64)).(*[]uint64)
+
+	return out0, err
+
+}
+
+// GetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0x4e0b8155.
+//
+// Solidity: function getUnconfirmedProvisionSeqs(address targetOperator, uint64 range) view returns(uint64[])
+func (_IOperator *IOperatorSession) GetUnconfirmedProvisionSeqs(targetOperator common.Address, arg1 uint64) ([]uint64, error) {
+	return _IOperator.Contract.GetUnconfirmedProvisionSeqs(&_IOperator.CallOpts, targetOperator, arg1)
+}
+
+// GetUnconfirmedProvisionSeqs is a free data retrieval call binding the contract method 0x4e0b8155.
+//
+// Solidity: function getUnconfirmedProvisionSeqs(address targetOperator, uint64 range) view returns(uint64[])
+func (_IOperator *IOperatorCallerSession) GetUnconfirmedProvisionSeqs(targetOperator common.Address, arg1 uint64) ([]uint64, error) {
+	return _IOperator.Contract.GetUnconfirmedProvisionSeqs(&_IOperator.CallOpts, targetOperator, arg1)
+}
+
+// GreatestSubmittedSeq is a free data retrieval call binding the contract method 0xb4c74214.
+//
+// Solidity: function greatestSubmittedSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCaller) GreatestSubmittedSeq(opts *bind.CallOpts, arg0 common.Address) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "greatestSubmittedSeq", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GreatestSubmittedSeq is a free data retrieval call binding the contract method 0xb4c74214.
+//
+// Solidity: function greatestSubmittedSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorSession) GreatestSubmittedSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.GreatestSubmittedSeq(&_IOperator.CallOpts, arg0)
+}
+
+// GreatestSubmittedSeq is a free data retrieval call binding the contract method 0xb4c74214.
+//
+// Solidity: function greatestSubmittedSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) GreatestSubmittedSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.GreatestSubmittedSeq(&_IOperator.CallOpts, arg0)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IOperator *IOperatorCaller) Guardian(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "guardian")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IOperator *IOperatorSession) Guardian() (common.Address, error) {
+	return _IOperator.Contract.Guardian(&_IOperator.CallOpts)
+}
+
+// Guardian is a free data retrieval call binding the contract method 0x452a9320.
+//
+// Solidity: function guardian() view returns(address)
+func (_IOperator *IOperatorCallerSession) Guardian() (common.Address, error) {
+	return _IOperator.Contract.Guardian(&_IOperator.CallOpts)
+}
+
+// IsConfirmed is a free data retrieval call binding the contract method 0x36ebcd90.
+//
+// Solidity: function isConfirmed(uint64 txID) view returns(bool)
+func (_IOperator *IOperatorCaller) IsConfirmed(opts *bind.CallOpts, txID uint64) (bool, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "isConfirmed", txID)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsConfirmed is a free data retrieval call binding the contract method 0x36ebcd90.
+//
+// Solidity: function isConfirmed(uint64 txID) view returns(bool)
+func (_IOperator *IOperatorSession) IsConfirmed(txID uint64) (bool, error) {
+	return _IOperator.Contract.IsConfirmed(&_IOperator.CallOpts, txID)
+}
+
+// IsConfirmed is a free data retrieval call binding the contract method 0x36ebcd90.
+//
+// Solidity: function isConfirmed(uint64 txID) view returns(bool)
+func (_IOperator *IOperatorCallerSession) IsConfirmed(txID uint64) (bool, error) {
+	return _IOperator.Contract.IsConfirmed(&_IOperator.CallOpts, txID)
+}
+
+// IsOperator is a free data retrieval call binding the contract method 0x6d70f7ae.
+//
+// Solidity: function isOperator(address ) view returns(bool)
+func (_IOperator *IOperatorCaller) IsOperator(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "isOperator", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperator is a free data retrieval call binding the contract method 0x6d70f7ae.
+//
+// Solidity: function isOperator(address ) view returns(bool)
+func (_IOperator *IOperatorSession) IsOperator(arg0 common.Address) (bool, error) {
+	return _IOperator.Contract.IsOperator(&_IOperator.CallOpts, arg0)
+}
+
+// IsOperator is a free data retrieval call binding the contract method 0x6d70f7ae.
+//
+// Solidity: function isOperator(address ) view returns(bool)
+func (_IOperator *IOperatorCallerSession) IsOperator(arg0 common.Address) (bool, error) {
+	return _IOperator.Contract.IsOperator(&_IOperator.CallOpts, arg0)
+}
+
+// MinOperatorRequiredConfirm is a free data retrieval call binding the contract method 0xcee4b251.
+//
+// Solidity: function minOperatorRequiredConfirm() view returns(uint8)
+func (_IOperator *IOperatorCaller) MinOperatorRequiredConfirm(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "minOperatorRequiredConfirm")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// MinOperatorRequiredConfirm is a free data retrieval call binding the contract method 0xcee4b251.
+//
+// Solidity: function minOperatorRequiredConfirm() view returns(uint8)
+func (_IOperator *IOperatorSession) MinOperatorRequiredConfirm() (uint8, error) {
+	return _IOperator.Contract.MinOperatorRequiredConfirm(&_IOperator.CallOpts)
+}
+
+// MinOperatorRequiredConfirm is a free data retrieval call binding the contract method 0xcee4b251.
+//
+// Solidity: function minOperatorRequiredConfirm() view returns(uint8)
+func (_IOperator *IOperatorCallerSession) MinOperatorRequiredConfirm() (uint8, error) {
+	return _IOperator.Contract.MinOperatorRequiredConfirm(&_IOperator.CallOpts)
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xa613252f.
+//
+// Solidity: function nextProvisionSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCaller) NextProvisionSeq(opts *bind.CallOpts, arg0 common.Address) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "nextProvisionSeq", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xa613252f.
+//
+// Solidity: function nextProvisionSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorSession) NextProvisionSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.NextProvisionSeq(&_IOperator.CallOpts, arg0)
+}
+
+// NextProvisionSeq is a free data retrieval call binding the contract method 0xa613252f.
+//
+// Solidity: function nextProvisionSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) NextProvisionSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.NextProvisionSeq(&_IOperator.CallOpts, arg0)
+}
+
+// Operators is a free data retrieval call binding the contract method 0xe28d4906.
+//
+// Solidity: function operators(uint256 ) view returns(address)
+func (_IOperator *IOperatorCaller) Operators(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "operators", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Operators is a free data retrieval call binding the contract method 0xe28d4906.
+//
+// Solidity: function operators(uint256 ) view returns(address)
+func (_IOperator *IOperatorSession) Operators(arg0 *big.Int) (common.Address, error) {
+	return _IOperator.Contract.Operators(&_IOperator.CallOpts, arg0)
+}
+
+// Operators is a free data retrieval call binding the contract method 0xe28d4906.
+//
+// Solidity: function operators(uint256 ) view returns(address)
+func (_IOperator *IOperatorCallerSession) Operators(arg0 *big.Int) (common.Address, error) {
+	return _IOperator.Contract.Operators(&_IOperator.CallOpts, arg0)
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IOperator *IOperatorCaller) Provisions(opts *bind.CallOpts, arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "provisions", arg0)
+
+	outstruct := new(struct {
+		Seq      uint64
+		Sender   string
+		Receiver common.Address
+		Amount   *big.Int
+	})
+
+	outstruct.Seq = *abi.ConvertType(out[0], new(uint64)).(*uint64)
+	outstruct.Sender = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Receiver = *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	return *outstruct, err
+
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IOperator *IOperatorSession) Provisions(arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _IOperator.Contract.Provisions(&_IOperator.CallOpts, arg0)
+}
+
+// Provisions is a free data retrieval call binding the contract method 0x2a10e777.
+//
+// Solidity: function provisions(uint64 ) view returns(uint64 seq, string sender, address receiver, uint256 amount)
+func (_IOperator *IOperatorCallerSession) Provisions(arg0 uint64) (struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _IOperator.Contract.Provisions(&_IOperator.CallOpts, arg0)
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, bytes data, bool executed)
+func (_IOperator *IOperatorCaller) Transactions(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	To       common.Address
+	Data     []byte
+	Executed bool
+}, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "transactions", arg0)
+
+	outstruct := new(struct {
+		To       common.Address
+		Data     []byte
+		Executed bool
+	})
+
+	outstruct.To = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Data = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	outstruct.Executed = *abi.ConvertType(out[2], new(bool)).(*bool)
+	return *outstruct, err
+
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, bytes data, bool executed)
+func (_IOperator *IOperatorSession) Transactions(arg0 *big.Int) (struct {
+	To       common.Address
+	Data     []byte
+	Executed bool
+}, error) {
+	return _IOperator.Contract.Transactions(&_IOperator.CallOpts, arg0)
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, bytes data, bool executed)
+func (_IOperator *IOperatorCallerSession) Transactions(arg0 *big.Int) (struct {
+	To       common.Address
+	Data     []byte
+	Executed bool
+}, error) {
+	return _IOperator.Contract.Transactions(&_IOperator.CallOpts, arg0)
+}
+
+// TxID2Seq is a free data retrieval call binding the contract method 0xe4a9f4ab.
+//
+// Solidity: function txID2Seq(uint64 ) view returns(uint64)
+func (_IOperator *IOperatorCaller) TxID2Seq(opts *bind.CallOpts, arg0 uint64) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "txID2Seq", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// TxID2Seq is a free data retrieval call binding the contract method 0xe4a9f4ab.
+//
+// Solidity: function txID2Seq(uint64 ) view returns(uint64)
+func (_IOperator *IOperatorSession) TxID2Seq(arg0 uint64) (uint64, error) {
+	return _IOperator.Contract.TxID2Seq(&_IOperator.CallOpts, arg0)
+}
+
+// TxID2Seq is a free data retrieval call binding the contract method 0xe4a9f4ab.
+//
+// Solidity: function txID2Seq(uint64 ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) TxID2Seq(arg0 uint64) (uint64, error) {
+	return _IOperator.Contract.TxID2Seq(&_IOperator.CallOpts, arg0)
+}
+
+// UnsubmittedNextSeq is a free data retrieval call binding the contract method 0x59141a79.
+//
+// Solidity: function unsubmittedNextSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCaller) UnsubmittedNextSeq(opts *bind.CallOpts, arg0 common.Address) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "unsubmittedNextSeq", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// UnsubmittedNextSeq is a free data retrieval call binding the contract method 0x59141a79.
+//
+// Solidity: function unsubmittedNextSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorSession) UnsubmittedNextSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.UnsubmittedNextSeq(&_IOperator.CallOpts, arg0)
+}
+
+// UnsubmittedNextSeq is a free data retrieval call binding the contract method 0x59141a79.
+//
+// Solidity: function unsubmittedNextSeq(address ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) UnsubmittedNextSeq(arg0 common.Address) (uint64, error) {
+	return _IOperator.Contract.UnsubmittedNextSeq(&_IOperator.CallOpts, arg0)
+}
+
+// UserIdx2TxID is a free data retrieval call binding the contract method 0x3d7e1645.
+//
+// Solidity: function userIdx2TxID(uint256 ) view returns(uint64)
+func (_IOperator *IOperatorCaller) UserIdx2TxID(opts *bind.CallOpts, arg0 *big.Int) (uint64, error) {
+	var out []interface{}
+	err := _IOperator.contract.Call(opts, &out, "userIdx2TxID", arg0)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// UserIdx2TxID is a free data retrieval call binding the contract method 0x3d7e1645.
+//
+// Solidity: function userIdx2TxID(uint256 ) view returns(uint64)
+func (_IOperator *IOperatorSession) UserIdx2TxID(arg0 *big.Int) (uint64, error) {
+	return _IOperator.Contract.UserIdx2TxID(&_IOperator.CallOpts, arg0)
+}
+
+// UserIdx2TxID is a free data retrieval call binding the contract method 0x3d7e1645.
+//
+// Solidity: function userIdx2TxID(uint256 ) view returns(uint64)
+func (_IOperator *IOperatorCallerSession) UserIdx2TxID(arg0 *big.Int) (uint64, error) {
+	return _IOperator.Contract.UserIdx2TxID(&_IOperator.CallOpts, arg0)
+}
+
+// AddOperator is a paid mutator transaction binding the contract method 0x9870d7fe.
+//
+// Solidity: function addOperator(address operator) returns()
+func (_IOperator *IOperatorTransactor) AddOperator(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "addOperator", operator)
+}
+
+// AddOperator is a paid mutator transaction binding the contract method 0x9870d7fe.
+//
+// Solidity: function addOperator(address operator) returns()
+func (_IOperator *IOperatorSession) AddOperator(operator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.AddOperator(&_IOperator.TransactOpts, operator)
+}
+
+// AddOperator is a paid mutator transaction binding the contract method 0x9870d7fe.
+//
+// Solidity: function addOperator(address operator) returns()
+func (_IOperator *IOperatorTransactorSession) AddOperator(operator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.AddOperator(&_IOperator.TransactOpts, operator)
+}
+
+// ChangeBridge is a paid mutator transaction binding the contract method 0x08774410.
+//
+// Solidity: function changeBridge(address newBridge) returns()
+func (_IOperator *IOperatorTransactor) ChangeBridge(opts *bind.TransactOpts, newBridge common.Address) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "changeBridge", newBridge)
+}
+
+// ChangeBridge is a paid mutator transaction binding the contract method 0x08774410.
+//
+// Solidity: function changeBridge(address newBridge) returns()
+func (_IOperator *IOperatorSession) ChangeBridge(newBridge common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeBridge(&_IOperator.TransactOpts, newBridge)
+}
+
+// ChangeBridge is a paid mutator transaction binding the contract method 0x08774410.
+//
+// Solidity: function changeBridge(address newBridge) returns()
+func (_IOperator *IOperatorTransactorSession) ChangeBridge(newBridge common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeBridge(&_IOperator.TransactOpts, newBridge)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IOperator *IOperatorTransactor) ChangeGuardian(opts *bind.TransactOpts, newGuardian common.Address) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "changeGuardian", newGuardian)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IOperator *IOperatorSession) ChangeGuardian(newGuardian common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeGuardian(&_IOperator.TransactOpts, newGuardian)
+}
+
+// ChangeGuardian is a paid mutator transaction binding the contract method 0x2fcb4f04.
+//
+// Solidity: function changeGuardian(address newGuardian) returns()
+func (_IOperator *IOperatorTransactorSession) ChangeGuardian(newGuardian common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeGuardian(&_IOperator.TransactOpts, newGuardian)
+}
+
+// ChangeRequirement is a paid mutator transaction binding the contract method 0xfe9abc7c.
+//
+// Solidity: function changeRequirement(uint8 _minOperatorRequiredConfirm) returns()
+func (_IOperator *IOperatorTransactor) ChangeRequirement(opts *bind.TransactOpts, _minOperatorRequiredConfirm uint8) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "changeRequirement", _minOperatorRequiredConfirm)
+}
+
+// ChangeRequirement is a paid mutator transaction binding the contract method 0xfe9abc7c.
+//
+// Solidity: function changeRequirement(uint8 _minOperatorRequiredConfirm) returns()
+func (_IOperator *IOperatorSession) ChangeRequirement(_minOperatorRequiredConfirm uint8) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeRequirement(&_IOperator.TransactOpts, _minOperatorRequiredConfirm)
+}
+
+// ChangeRequirement is a paid mutator transaction binding the contract method 0xfe9abc7c.
+//
+// Solidity: function changeRequirement(uint8 _minOperatorRequiredConfirm) returns()
+func (_IOperator *IOperatorTransactorSession) ChangeRequirement(_minOperatorRequiredConfirm uint8) (*types.Transaction, error) {
+	return _IOperator.Contract.ChangeRequirement(&_IOperator.TransactOpts, _minOperatorRequiredConfirm)
+}
+
+// ConfirmTransaction is a paid mutator transaction binding the contract method 0x5798d056.
+//
+// Solidity: function confirmTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorTransactor) ConfirmTransaction(opts *bind.TransactOpts, txID uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "confirmTransaction", txID)
+}
+
+// ConfirmTransaction is a paid mutator transaction binding the contract method 0x5798d056.
+//
+// Solidity: function confirmTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorSession) ConfirmTransaction(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.ConfirmTransaction(&_IOperator.TransactOpts, txID)
+}
+
+// ConfirmTransaction is a paid mutator transaction binding the contract method 0x5798d056.
+//
+// Solidity: function confirmTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorTransactorSession) ConfirmTransaction(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.ConfirmTransaction(&_IOperator.TransactOpts, txID)
+}
+
+// ExecuteTransaction is a paid mutator transaction binding the contract method 0x6edec705.
+//
+// Solidity: function executeTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorTransactor) ExecuteTransaction(opts *bind.TransactOpts, txID uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "executeTransaction", txID)
+}
+
+// ExecuteTransaction is a paid mutator transaction binding the contract method 0x6edec705.
+//
+// Solidity: function executeTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorSession) ExecuteTransaction(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.ExecuteTransaction(&_IOperator.TransactOpts, txID)
+}
+
+// ExecuteTransaction is a paid mutator transaction binding the contract method 0x6edec705.
+//
+// Solidity: function executeTransaction(uint64 txID) returns()
+func (_IOperator *IOperatorTransactorSession) ExecuteTransaction(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.ExecuteTransaction(&_IOperator.TransactOpts, txID)
+}
+
+// MarkRevokeSeq is a paid mutator transaction binding the contract method 0x7e5d9411.
+//
+// Solidity: function markRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorTransactor) MarkRevokeSeq(opts *bind.TransactOpts, seq uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "markRevokeSeq", seq)
+}
+
+// MarkRevokeSeq is a paid mutator transaction binding the contract method 0x7e5d9411.
+//
+// Solidity: function markRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorSession) MarkRevokeSeq(seq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.MarkRevokeSeq(&_IOperator.TransactOpts, seq)
+}
+
+// MarkRevokeSeq is a paid mutator transaction binding the contract method 0x7e5d9411.
+//
+// Solidity: function markRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorTransactorSession) MarkRevokeSeq(seq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.MarkRevokeSeq(&_IOperator.TransactOpts, seq)
+}
+
+// RemoveOperator is a paid mutator transaction binding the contract method 0xac8a584a.
+//
+// Solidity: function removeOperator(address operator) returns()
+func (_IOperator *IOperatorTransactor) RemoveOperator(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "removeOperator", operator)
+}
+
+// RemoveOperator is a paid mutator transaction binding the contract method 0xac8a584a.
+//
+// Solidity: function removeOperator(address operator) returns()
+func (_IOperator *IOperatorSession) RemoveOperator(operator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.RemoveOperator(&_IOperator.TransactOpts, operator)
+}
+
+// RemoveOperator is a paid mutator transaction binding the contract method 0xac8a584a.
+//
+// Solidity: function removeOperator(address operator) returns()
+func (_IOperator *IOperatorTransactorSession) RemoveOperator(operator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.RemoveOperator(&_IOperator.TransactOpts, operator)
+}
+
+// ReplaceOperator is a paid mutator transaction binding the contract method 0xb7a55438.
+//
+// Solidity: function replaceOperator(address operator, address newOperator) returns()
+func (_IOperator *IOperatorTransactor) ReplaceOperator(opts *bind.TransactOpts, operator common.Address, newOperator common.Address) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "replaceOperator", operator, newOperator)
+}
+
+// ReplaceOperator is a paid mutator transaction binding the contract method 0xb7a55438.
+//
+// Solidity: function replaceOperator(address operator, address newOperator) returns()
+func (_IOperator *IOperatorSession) ReplaceOperator(operator common.Address, newOperator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ReplaceOperator(&_IOperator.TransactOpts, operator, newOperator)
+}
+
+// ReplaceOperator is a paid mutator transaction binding the contract method 0xb7a55438.
+//
+// Solidity: function replaceOperator(address operator, address newOperator) returns()
+func (_IOperator *IOperatorTransactorSession) ReplaceOperator(operator common.Address, newOperator common.Address) (*types.Transaction, error) {
+	return _IOperator.Contract.ReplaceOperator(&_IOperator.TransactOpts, operator, newOperator)
+}
+
+// RevokeConfirmation is a paid mutator transaction binding the contract method 0xeeb7a5a8.
+//
+// Solidity: function revokeConfirmation(uint64 txID) returns()
+func (_IOperator *IOperatorTransactor) RevokeConfirmation(opts *bind.TransactOpts, txID uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "revokeConfirmation", txID)
+}
+
+// RevokeConfirmation is a paid mutator transaction binding the contract method 0xeeb7a5a8.
+//
+// Solidity: function revokeConfirmation(uint64 txID) returns()
+func (_IOperator *IOperatorSession) RevokeConfirmation(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.RevokeConfirmation(&_IOperator.TransactOpts, txID)
+}
+
+// RevokeConfirmation is a paid mutator transaction binding the contract method 0xeeb7a5a8.
+//
+// Solidity: function revokeConfirmation(uint64 txID) returns()
+func (_IOperator *IOperatorTransactorSession) RevokeConfirmation(txID uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.RevokeConfirmation(&_IOperator.TransactOpts, txID)
+}
+
+// SubmitTransaction is a paid mutator transaction binding the contract method 0xe05afb68.
+//
+// Solidity: function submitTransaction(address to, bytes data, uint256 uniqUserTxIndex) returns(uint64)
+func (_IOperator *IOperatorTransactor) SubmitTransaction(opts *bind.TransactOpts, to common.Address, data []byte, uniqUserTxIndex *big.Int) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "submitTransaction", to, data, uniqUserTxIndex)
+}
+
+// SubmitTransaction is a paid mutator transaction binding the contract method 0xe05afb68.
+//
+// Solidity: function submitTransaction(address to, bytes data, uint256 uniqUserTxIndex) returns(uint64)
+func (_IOperator *IOperatorSession) SubmitTransaction(to common.Address, data []byte, uniqUserTxIndex *big.Int) (*types.Transaction, error) {
+	return _IOperator.Contract.SubmitTransaction(&_IOperator.TransactOpts, to, data, uniqUserTxIndex)
+}
+
+// SubmitTransaction is a paid mutator transaction binding the contract method 0xe05afb68.
+//
+// Solidity: function submitTransaction(address to, bytes data, uint256 uniqUserTxIndex) returns(uint64)
+func (_IOperator *IOperatorTransactorSession) SubmitTransaction(to common.Address, data []byte, uniqUserTxIndex *big.Int) (*types.Transaction, error) {
+	return _IOperator.Contract.SubmitTransaction(&_IOperator.TransactOpts, to, data, uniqUserTxIndex)
+}
+
+// UnmarkRevokeSeq is a paid mutator transaction binding the contract method 0x8a8f09a5.
+//
+// Solidity: function unmarkRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorTransactor) UnmarkRevokeSeq(opts *bind.TransactOpts, seq uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "unmarkRevokeSeq", seq)
+}
+
+// UnmarkRevokeSeq is a paid mutator transaction binding the contract method 0x8a8f09a5.
+//
+// Solidity: function unmarkRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorSession) UnmarkRevokeSeq(seq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.UnmarkRevokeSeq(&_IOperator.TransactOpts, seq)
+}
+
+// UnmarkRevokeSeq is a paid mutator transaction binding the contract method 0x8a8f09a5.
+//
+// Solidity: function unmarkRevokeSeq(uint64 seq) returns()
+func (_IOperator *IOperatorTransactorSession) UnmarkRevokeSeq(seq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.UnmarkRevokeSeq(&_IOperator.TransactOpts, seq)
+}
+
+// UpdateNextUnsubmittedSeq is a paid mutator transaction binding the contract method 0xf6efee72.
+//
+// Solidity: function updateNextUnsubmittedSeq(uint64 nextUnprovisionedSeq) returns()
+func (_IOperator *IOperatorTransactor) UpdateNextUnsubmittedSeq(opts *bind.TransactOpts, nextUnprovisionedSeq uint64) (*types.Transaction, error) {
+	return _IOperator.contract.Transact(opts, "updateNextUnsubmittedSeq", nextUnprovisionedSeq)
+}
+
+// UpdateNextUnsubmittedSeq is a paid mutator transaction binding the contract method 0xf6efee72.
+//
+// Solidity: function updateNextUnsubmittedSeq(uint64 nextUnprovisionedSeq) returns()
+func (_IOperator *IOperatorSession) UpdateNextUnsubmittedSeq(nextUnprovisionedSeq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.UpdateNextUnsubmittedSeq(&_IOperator.TransactOpts, nextUnprovisionedSeq)
+}
+
+// UpdateNextUnsubmittedSeq is a paid mutator transaction binding the contract method 0xf6efee72.
+//
+// Solidity: function updateNextUnsubmittedSeq(uint64 nextUnprovisionedSeq) returns()
+func (_IOperator *IOperatorTransactorSession) UpdateNextUnsubmittedSeq(nextUnprovisionedSeq uint64) (*types.Transaction, error) {
+	return _IOperator.Contract.UpdateNextUnsubmittedSeq(&_IOperator.TransactOpts, nextUnprovisionedSeq)
+}
+
+// IOperatorChangeBridgeIterator is returned from FilterChangeBridge and is used to iterate over the raw logs and unpacked data for ChangeBridge events raised by the IOperator contract.
+type IOperatorChangeBridgeIterator struct {
+	Event *IOperatorChangeBridge // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorChangeBridgeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorChangeBridge)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorChangeBridge)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorChangeBridgeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorChangeBridgeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorChangeBridge represents a ChangeBridge event raised by the IOperator contract.
+type IOperatorChangeBridge struct {
+	BeforeBridge common.Address
+	NewBridge    common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeBridge is a free log retrieval operation binding the contract event 0xdb7b0154cc2876183cd1d4c09e4a613ccf29be4b2ba9bcbf3a785d909842fa1f.
+//
+// Solidity: event ChangeBridge(address indexed beforeBridge, address indexed newBridge)
+func (_IOperator *IOperatorFilterer) FilterChangeBridge(opts *bind.FilterOpts, beforeBridge []common.Address, newBridge []common.Address) (*IOperatorChangeBridgeIterator, error) {
+
+	var beforeBridgeRule []interface{}
+	for _, beforeBridgeItem := range beforeBridge {
+		beforeBridgeRule = append(beforeBridgeRule, beforeBridgeItem)
+	}
+	var newBridgeRule []interface{}
+	for _, newBridgeItem := range newBridge {
+		newBridgeRule = append(newBridgeRule, newBridgeItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "ChangeBridge", beforeBridgeRule, newBridgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorChangeBridgeIterator{contract: _IOperator.contract, event: "ChangeBridge", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeBridge is a free log subscription operation binding the contract event 0xdb7b0154cc2876183cd1d4c09e4a613ccf29be4b2ba9bcbf3a785d909842fa1f.
+//
+// Solidity: event ChangeBridge(address indexed beforeBridge, address indexed newBridge)
+func (_IOperator *IOperatorFilterer) WatchChangeBridge(opts *bind.WatchOpts, sink chan<- *IOperatorChangeBridge, beforeBridge []common.Address, newBridge []common.Address) (event.Subscription, error) {
+
+	var beforeBridgeRule []interface{}
+	for _, beforeBridgeItem := range beforeBridge {
+		beforeBridgeRule = append(beforeBridgeRule, beforeBridgeItem)
+	}
+	var newBridgeRule []interface{}
+	for _, newBridgeItem := range newBridge {
+		newBridgeRule = append(newBridgeRule, newBridgeItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "ChangeBridge", beforeBridgeRule, newBridgeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorChangeBridge)
+				if err := _IOperator.contract.UnpackLog(event, "ChangeBridge", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeBridge is a log parse operation binding the contract event 0xdb7b0154cc2876183cd1d4c09e4a613ccf29be4b2ba9bcbf3a785d909842fa1f.
+//
+// Solidity: event ChangeBridge(address indexed beforeBridge, address indexed newBridge)
+func (_IOperator *IOperatorFilterer) ParseChangeBridge(log types.Log) (*IOperatorChangeBridge, error) {
+	event := new(IOperatorChangeBridge)
+	if err := _IOperator.contract.UnpackLog(event, "ChangeBridge", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorChangeGuardianIterator is returned from FilterChangeGuardian and is used to iterate over the raw logs and unpacked data for ChangeGuardian events raised by the IOperator contract.
+type IOperatorChangeGuardianIterator struct {
+	Event *IOperatorChangeGuardian // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorChangeGuardianIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorChangeGuardian)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorChangeGuardian)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorChangeGuardianIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorChangeGuardianIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorChangeGuardian represents a ChangeGuardian event raised by the IOperator contract.
+type IOperatorChangeGuardian struct {
+	BeforeGuardian common.Address
+	NewGuardian    common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangeGuardian is a free log retrieval operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IOperator *IOperatorFilterer) FilterChangeGuardian(opts *bind.FilterOpts, beforeGuardian []common.Address, newGuardian []common.Address) (*IOperatorChangeGuardianIterator, error) {
+
+	var beforeGuardianRule []interface{}
+	for _, beforeGuardianItem := range beforeGuardian {
+		beforeGuardianRule = append(beforeGuardianRule, beforeGuardianItem)
+	}
+	var newGuardianRule []interface{}
+	for _, newGuardianItem := range newGuardian {
+		newGuardianRule = append(newGuardianRule, newGuardianItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "ChangeGuardian", beforeGuardianRule, newGuardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorChangeGuardianIterator{contract: _IOperator.contract, event: "ChangeGuardian", logs: logs, sub: sub}, nil
+}
+
+// WatchChangeGuardian is a free log subscription operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IOperator *IOperatorFilterer) WatchChangeGuardian(opts *bind.WatchOpts, sink chan<- *IOperatorChangeGuardian, beforeGuardian []common.Address, newGuardian []common.Address) (event.Subscription, error) {
+
+	var beforeGuardianRule []interface{}
+	for _, beforeGuardianItem := range beforeGuardian {
+		beforeGuardianRule = append(beforeGuardianRule, beforeGuardianItem)
+	}
+	var newGuardianRule []interface{}
+	for _, newGuardianItem := range newGuardian {
+		newGuardianRule = append(newGuardianRule, newGuardianItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "ChangeGuardian", beforeGuardianRule, newGuardianRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorChangeGuardian)
+				if err := _IOperator.contract.UnpackLog(event, "ChangeGuardian", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangeGuardian is a log parse operation binding the contract event 0x61f3b62edd586d41117ebcc75bd4eae39389085250e64cf6295fb57bac22c1e7.
+//
+// Solidity: event ChangeGuardian(address indexed beforeGuardian, address indexed newGuardian)
+func (_IOperator *IOperatorFilterer) ParseChangeGuardian(log types.Log) (*IOperatorChangeGuardian, error) {
+	event := new(IOperatorChangeGuardian)
+	if err := _IOperator.contract.UnpackLog(event, "ChangeGuardian", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorConfirmationIterator is returned from FilterConfirmation and is used to iterate over the raw logs and unpacked data for Confirmation events raised by the IOperator contract.
+type IOperatorConfirmationIterator struct {
+	Event *IOperatorConfirmation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorConfirmationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorConfirmation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorConfirmation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorConfirmationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorConfirmationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorConfirmation represents a Confirmation event raised by the IOperator contract.
+type IOperatorConfirmation struct {
+	Sender        common.Address
+	TransactionId uint64
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterConfirmation is a free log retrieval operation binding the contract event 0x4892beeb3b29bc7750068647c2da95d7e65709e9b995ef7a18baca72e00c3e40.
+//
+// Solidity: event Confirmation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) FilterConfirmation(opts *bind.FilterOpts, sender []common.Address, transactionId []uint64) (*IOperatorConfirmationIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "Confirmation", senderRule, transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorConfirmationIterator{contract: _IOperator.contract, event: "Confirmation", logs: logs, sub: sub}, nil
+}
+
+// WatchConfirmation is a free log subscription operation binding the contract event 0x4892beeb3b29bc7750068647c2da95d7e65709e9b995ef7a18baca72e00c3e40.
+//
+// Solidity: event Confirmation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) WatchConfirmation(opts *bind.WatchOpts, sink chan<- *IOperatorConfirmation, sender []common.Address, transactionId []uint64) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "Confirmation", senderRule, transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorConfirmation)
+				if err := _IOperator.contract.UnpackLog(event, "Confirmation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConfirmation is a log parse operation binding the contract event 0x4892beeb3b29bc7750068647c2da95d7e65709e9b995ef7a18baca72e00c3e40.
+//
+// Solidity: event Confirmation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) ParseConfirmation(log types.Log) (*IOperatorConfirmation, error) {
+	event := new(IOperatorConfirmation)
+	if err := _IOperator.contract.UnpackLog(event, "Confirmation", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorExecutionIterator is returned from FilterExecution and is used to iterate over the raw logs and unpacked data for Execution events raised by the IOperator contract.
+type IOperatorExecutionIterator struct {
+	Event *IOperatorExecution // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorExecutionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorExecution)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorExecution)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorExecutionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorExecutionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorExecution represents a Execution event raised by the IOperator contract.
+type IOperatorExecution struct {
+	TransactionId uint64
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecution is a free log retrieval operation binding the contract event 0x5057c1fd5e00097a5e483c0e9c4a6ff7f96612119b00300cfd6db40c4efcc82a.
+//
+// Solidity: event Execution(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) FilterExecution(opts *bind.FilterOpts, transactionId []uint64) (*IOperatorExecutionIterator, error) {
+
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "Execution", transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorExecutionIterator{contract: _IOperator.contract, event: "Execution", logs: logs, sub: sub}, nil
+}
+
+// WatchExecution is a free log subscription operation binding the contract event 0x5057c1fd5e00097a5e483c0e9c4a6ff7f96612119b00300cfd6db40c4efcc82a.
+//
+// Solidity: event Execution(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) WatchExecution(opts *bind.WatchOpts, sink chan<- *IOperatorExecution, transactionId []uint64) (event.Subscription, error) {
+
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "Execution", transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorExecution)
+				if err := _IOperator.contract.UnpackLog(event, "Execution", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecution is a log parse operation binding the contract event 0x5057c1fd5e00097a5e483c0e9c4a6ff7f96612119b00300cfd6db40c4efcc82a.
+//
+// Solidity: event Execution(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) ParseExecution(log types.Log) (*IOperatorExecution, error) {
+	event := new(IOperatorExecution)
+	if err := _IOperator.contract.UnpackLog(event, "Execution", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorOperatorAdditionIterator is returned from FilterOperatorAddition and is used to iterate over the raw logs and unpacked data for OperatorAddition events raised by the IOperator contract.
+type IOperatorOperatorAdditionIterator struct {
+	Event *IOperatorOperatorAddition // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorOperatorAdditionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorOperatorAddition)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorOperatorAddition)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorOperatorAdditionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorOperatorAdditionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorOperatorAddition represents a OperatorAddition event raised by the IOperator contract.
+type IOperatorOperatorAddition struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorAddition is a free log retrieval operation binding the contract event 0xe96a56aa17dd8ae64ac3a51347e8e8a03d52f1cb2acdf8ed49b20d55662ebecc.
+//
+// Solidity: event OperatorAddition(address indexed operator)
+func (_IOperator *IOperatorFilterer) FilterOperatorAddition(opts *bind.FilterOpts, operator []common.Address) (*IOperatorOperatorAdditionIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "OperatorAddition", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorOperatorAdditionIterator{contract: _IOperator.contract, event: "OperatorAddition", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorAddition is a free log subscription operation binding the contract event 0xe96a56aa17dd8ae64ac3a51347e8e8a03d52f1cb2acdf8ed49b20d55662ebecc.
+//
+// Solidity: event OperatorAddition(address indexed operator)
+func (_IOperator *IOperatorFilterer) WatchOperatorAddition(opts *bind.WatchOpts, sink chan<- *IOperatorOperatorAddition, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "OperatorAddition", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorOperatorAddition)
+				if err := _IOperator.contract.UnpackLog(event, "OperatorAddition", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorAddition is a log parse operation binding the contract event 0xe96a56aa17dd8ae64ac3a51347e8e8a03d52f1cb2acdf8ed49b20d55662ebecc.
+//
+// Solidity: event OperatorAddition(address indexed operator)
+func (_IOperator *IOperatorFilterer) ParseOperatorAddition(log types.Log) (*IOperatorOperatorAddition, error) {
+	event := new(IOperatorOperatorAddition)
+	if err := _IOperator.contract.UnpackLog(event, "OperatorAddition", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorOperatorRemovalIterator is returned from FilterOperatorRemoval and is used to iterate over the raw logs and unpacked data for OperatorRemoval events raised by the IOperator contract.
+type IOperatorOperatorRemovalIterator struct {
+	Event *IOperatorOperatorRemoval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorOperatorRemovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorOperatorRemoval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorOperatorRemoval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorOperatorRemovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorOperatorRemovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorOperatorRemoval represents a OperatorRemoval event raised by the IOperator contract.
+type IOperatorOperatorRemoval struct {
+	Operator common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterOperatorRemoval is a free log retrieval operation binding the contract event 0x108286147377add4f5f7bcdd7b6104dc6bdac3443e499142a612d418aa0be154.
+//
+// Solidity: event OperatorRemoval(address indexed operator)
+func (_IOperator *IOperatorFilterer) FilterOperatorRemoval(opts *bind.FilterOpts, operator []common.Address) (*IOperatorOperatorRemovalIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "OperatorRemoval", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorOperatorRemovalIterator{contract: _IOperator.contract, event: "OperatorRemoval", logs: logs, sub: sub}, nil
+}
+
+// WatchOperatorRemoval is a free log subscription operation binding the contract event 0x108286147377add4f5f7bcdd7b6104dc6bdac3443e499142a612d418aa0be154.
+//
+// Solidity: event OperatorRemoval(address indexed operator)
+func (_IOperator *IOperatorFilterer) WatchOperatorRemoval(opts *bind.WatchOpts, sink chan<- *IOperatorOperatorRemoval, operator []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "OperatorRemoval", operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorOperatorRemoval)
+				if err := _IOperator.contract.UnpackLog(event, "OperatorRemoval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOperatorRemoval is a log parse operation binding the contract event 0x108286147377add4f5f7bcdd7b6104dc6bdac3443e499142a612d418aa0be154.
+//
+// Solidity: event OperatorRemoval(address indexed operator)
+func (_IOperator *IOperatorFilterer) ParseOperatorRemoval(log types.Log) (*IOperatorOperatorRemoval, error) {
+	event := new(IOperatorOperatorRemoval)
+	if err := _IOperator.contract.UnpackLog(event, "OperatorRemoval", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorRequirementChangeIterator is returned from FilterRequirementChange and is used to iterate over the raw logs and unpacked data for RequirementChange events raised by the IOperator contract.
+type IOperatorRequirementChangeIterator struct {
+	Event *IOperatorRequirementChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorRequirementChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorRequirementChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorRequirementChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorRequirementChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorRequirementChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorRequirementChange represents a RequirementChange event raised by the IOperator contract.
+type IOperatorRequirementChange struct {
+	Required uint64
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRequirementChange is a free log retrieval operation binding the contract event 0xf3604a37ad40b67db3984b0562df21ee8bbfe7e571ac9f45bbb68cec6cbf65ac.
+//
+// Solidity: event RequirementChange(uint64 indexed required)
+func (_IOperator *IOperatorFilterer) FilterRequirementChange(opts *bind.FilterOpts, required []uint64) (*IOperatorRequirementChangeIterator, error) {
+
+	var requiredRule []interface{}
+	for _, requiredItem := range required {
+		requiredRule = append(requiredRule, requiredItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "RequirementChange", requiredRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorRequirementChangeIterator{contract: _IOperator.contract, event: "RequirementChange", logs: logs, sub: sub}, nil
+}
+
+// WatchRequirementChange is a free log subscription operation binding the contract event 0xf3604a37ad40b67db3984b0562df21ee8bbfe7e571ac9f45bbb68cec6cbf65ac.
+//
+// Solidity: event RequirementChange(uint64 indexed required)
+func (_IOperator *IOperatorFilterer) WatchRequirementChange(opts *bind.WatchOpts, sink chan<- *IOperatorRequirementChange, required []uint64) (event.Subscription, error) {
+
+	var requiredRule []interface{}
+	for _, requiredItem := range required {
+		requiredRule = append(requiredRule, requiredItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "RequirementChange", requiredRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorRequirementChange)
+				if err := _IOperator.contract.UnpackLog(event, "RequirementChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRequirementChange is a log parse operation binding the contract event 0xf3604a37ad40b67db3984b0562df21ee8bbfe7e571ac9f45bbb68cec6cbf65ac.
+//
+// Solidity: event RequirementChange(uint64 indexed required)
+func (_IOperator *IOperatorFilterer) ParseRequirementChange(log types.Log) (*IOperatorRequirementChange, error) {
+	event := new(IOperatorRequirementChange)
+	if err := _IOperator.contract.UnpackLog(event, "RequirementChange", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorRevocationIterator is returned from FilterRevocation and is used to iterate over the raw logs and unpacked data for Revocation events raised by the IOperator contract.
+type IOperatorRevocationIterator struct {
+	Event *IOperatorRevocation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorRevocationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorRevocation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorRevocation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorRevocationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorRevocationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorRevocation represents a Revocation event raised by the IOperator contract.
+type IOperatorRevocation struct {
+	Sender        common.Address
+	TransactionId uint64
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevocation is a free log retrieval operation binding the contract event 0x79cf14ca0f5e0a5b1f0acd67edb82d2bc726cffdfdf5224486ad12ff0a6c32b0.
+//
+// Solidity: event Revocation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) FilterRevocation(opts *bind.FilterOpts, sender []common.Address, transactionId []uint64) (*IOperatorRevocationIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "Revocation", senderRule, transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorRevocationIterator{contract: _IOperator.contract, event: "Revocation", logs: logs, sub: sub}, nil
+}
+
+// WatchRevocation is a free log subscription operation binding the contract event 0x79cf14ca0f5e0a5b1f0acd67edb82d2bc726cffdfdf5224486ad12ff0a6c32b0.
+//
+// Solidity: event Revocation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) WatchRevocation(opts *bind.WatchOpts, sink chan<- *IOperatorRevocation, sender []common.Address, transactionId []uint64) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "Revocation", senderRule, transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorRevocation)
+				if err := _IOperator.contract.UnpackLog(event, "Revocation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevocation is a log parse operation binding the contract event 0x79cf14ca0f5e0a5b1f0acd67edb82d2bc726cffdfdf5224486ad12ff0a6c32b0.
+//
+// Solidity: event Revocation(address indexed sender, uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) ParseRevocation(log types.Log) (*IOperatorRevocation, error) {
+	event := new(IOperatorRevocation)
+	if err := _IOperator.contract.UnpackLog(event, "Revocation", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorRevokedProvisionIterator is returned from FilterRevokedProvision and is used to iterate over the raw logs and unpacked data for RevokedProvision events raised by the IOperator contract.
+type IOperatorRevokedProvisionIterator struct {
+	Event *IOperatorRevokedProvision // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorRevokedProvisionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorRevokedProvision)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorRevokedProvision)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorRevokedProvisionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorRevokedProvisionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorRevokedProvision represents a RevokedProvision event raised by the IOperator contract.
+type IOperatorRevokedProvision struct {
+	Seq uint64
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevokedProvision is a free log retrieval operation binding the contract event 0x1220d4c56b7bf0ea6a68cbf876062e2e71a4067d9857ddf2880e011cd5d6cbfb.
+//
+// Solidity: event RevokedProvision(uint64 indexed seq)
+func (_IOperator *IOperatorFilterer) FilterRevokedProvision(opts *bind.FilterOpts, seq []uint64) (*IOperatorRevokedProvisionIterator, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "RevokedProvision", seqRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorRevokedProvisionIterator{contract: _IOperator.contract, event: "RevokedProvision", logs: logs, sub: sub}, nil
+}
+
+// WatchRevokedProvision is a free log subscription operation binding the contract event 0x1220d4c56b7bf0ea6a68cbf876062e2e71a4067d9857ddf2880e011cd5d6cbfb.
+//
+// Solidity: event RevokedProvision(uint64 indexed seq)
+func (_IOperator *IOperatorFilterer) WatchRevokedProvision(opts *bind.WatchOpts, sink chan<- *IOperatorRevokedProvision, seq []uint64) (event.Subscription, error) {
+
+	var seqRule []interface{}
+	for _, seqItem := range seq {
+		seqRule = append(seqRule, seqItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "RevokedProvision", seqRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorRevokedProvision)
+				if err := _IOperator.contract.UnpackLog(event, "RevokedProvision", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevokedProvision is a log parse operation binding the contract event 0x1220d4c56b7bf0ea6a68cbf876062e2e71a4067d9857ddf2880e011cd5d6cbfb.
+//
+// Solidity: event RevokedProvision(uint64 indexed seq)
+func (_IOperator *IOperatorFilterer) ParseRevokedProvision(log types.Log) (*IOperatorRevokedProvision, error) {
+	event := new(IOperatorRevokedProvision)
+	if err := _IOperator.contract.UnpackLog(event, "RevokedProvision", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorSubmissionIterator is returned from FilterSubmission and is used to iterate over the raw logs and unpacked data for Submission events raised by the IOperator contract.
+type IOperatorSubmissionIterator struct {
+	Event *IOperatorSubmission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorSubmissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorSubmission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorSubmission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorSubmissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorSubmissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorSubmission represents a Submission event raised by the IOperator contract.
+type IOperatorSubmission struct {
+	TransactionId uint64
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterSubmission is a free log retrieval operation binding the contract event 0x4684b4b92a1820c1ed8fb269ebf40d1003f25684a11929df20e21bbb155b8462.
+//
+// Solidity: event Submission(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) FilterSubmission(opts *bind.FilterOpts, transactionId []uint64) (*IOperatorSubmissionIterator, error) {
+
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "Submission", transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorSubmissionIterator{contract: _IOperator.contract, event: "Submission", logs: logs, sub: sub}, nil
+}
+
+// WatchSubmission is a free log subscription operation binding the contract event 0x4684b4b92a1820c1ed8fb269ebf40d1003f25684a11929df20e21bbb155b8462.
+//
+// Solidity: event Submission(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) WatchSubmission(opts *bind.WatchOpts, sink chan<- *IOperatorSubmission, transactionId []uint64) (event.Subscription, error) {
+
+	var transactionIdRule []interface{}
+	for _, transactionIdItem := range transactionId {
+		transactionIdRule = append(transactionIdRule, transactionIdItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "Submission", transactionIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorSubmission)
+				if err := _IOperator.contract.UnpackLog(event, "Submission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSubmission is a log parse operation binding the contract event 0x4684b4b92a1820c1ed8fb269ebf40d1003f25684a11929df20e21bbb155b8462.
+//
+// Solidity: event Submission(uint64 indexed transactionId)
+func (_IOperator *IOperatorFilterer) ParseSubmission(log types.Log) (*IOperatorSubmission, error) {
+	event := new(IOperatorSubmission)
+	if err := _IOperator.contract.UnpackLog(event, "Submission", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// IOperatorUnsubmittedNextSeqUpdateIterator is returned from FilterUnsubmittedNextSeqUpdate and is used to iterate over the raw logs and unpacked data for UnsubmittedNextSeqUpdate events raised by the IOperator contract.
+type IOperatorUnsubmittedNextSeqUpdateIterator struct {
+	Event *IOperatorUnsubmittedNextSeqUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log    // Log channel receiving the found contract events
+	sub  kaia.Subscription // Subscription for errors, completion and termination
+	done bool              // Whether the subscription completed delivering logs
+	fail error             // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IOperatorUnsubmittedNextSeqUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IOperatorUnsubmittedNextSeqUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IOperatorUnsubmittedNextSeqUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IOperatorUnsubmittedNextSeqUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IOperatorUnsubmittedNextSeqUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IOperatorUnsubmittedNextSeqUpdate represents a UnsubmittedNextSeqUpdate event raised by the IOperator contract.
+type IOperatorUnsubmittedNextSeqUpdate struct {
+	UnsubmittedNextSeq uint64
+	NewSeq             uint64
+	Raw                types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnsubmittedNextSeqUpdate is a free log retrieval operation binding the contract event 0x8f90ccc60a2e0697e9e0d3421854409f557e78c2e1ebb7e9d77377d25b9fb09d.
+//
+// Solidity: event UnsubmittedNextSeqUpdate(uint64 indexed unsubmittedNextSeq, uint64 indexed newSeq)
+func (_IOperator *IOperatorFilterer) FilterUnsubmittedNextSeqUpdate(opts *bind.FilterOpts, unsubmittedNextSeq []uint64, newSeq []uint64) (*IOperatorUnsubmittedNextSeqUpdateIterator, error) {
+
+	var unsubmittedNextSeqRule []interface{}
+	for _, unsubmittedNextSeqItem := range unsubmittedNextSeq {
+		unsubmittedNextSeqRule = append(unsubmittedNextSeqRule, unsubmittedNextSeqItem)
+	}
+	var newSeqRule []interface{}
+	for _, newSeqItem := range newSeq {
+		newSeqRule = append(newSeqRule, newSeqItem)
+	}
+
+	logs, sub, err := _IOperator.contract.FilterLogs(opts, "UnsubmittedNextSeqUpdate", unsubmittedNextSeqRule, newSeqRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IOperatorUnsubmittedNextSeqUpdateIterator{contract: _IOperator.contract, event: "UnsubmittedNextSeqUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchUnsubmittedNextSeqUpdate is a free log subscription operation binding the contract event 0x8f90ccc60a2e0697e9e0d3421854409f557e78c2e1ebb7e9d77377d25b9fb09d.
+//
+// Solidity: event UnsubmittedNextSeqUpdate(uint64 indexed unsubmittedNextSeq, uint64 indexed newSeq)
+func (_IOperator *IOperatorFilterer) WatchUnsubmittedNextSeqUpdate(opts *bind.WatchOpts, sink chan<- *IOperatorUnsubmittedNextSeqUpdate, unsubmittedNextSeq []uint64, newSeq []uint64) (event.Subscription, error) {
+
+	var unsubmittedNextSeqRule []interface{}
+	for _, unsubmittedNextSeqItem := range unsubmittedNextSeq {
+		unsubmittedNextSeqRule = append(unsubmittedNextSeqRule, unsubmittedNextSeqItem)
+	}
+	var newSeqRule []interface{}
+	for _, newSeqItem := range newSeq {
+		newSeqRule = append(newSeqRule, newSeqItem)
+	}
+
+	logs, sub, err := _IOperator.contract.WatchLogs(opts, "UnsubmittedNextSeqUpdate", unsubmittedNextSeqRule, newSeqRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IOperatorUnsubmittedNextSeqUpdate)
+				if err := _IOperator.contract.UnpackLog(event, "UnsubmittedNextSeqUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnsubmittedNextSeqUpdate is a log parse operation binding the contract event 0x8f90ccc60a2e0697e9e0d3421854409f557e78c2e1ebb7e9d77377d25b9fb09d.
+//
+// Solidity: event UnsubmittedNextSeqUpdate(uint64 indexed unsubmittedNextSeq, uint64 indexed newSeq)
+func (_IOperator *IOperatorFilterer) ParseUnsubmittedNextSeqUpdate(log types.Log) (*IOperatorUnsubmittedNextSeqUpdate, error) {
+	event := new(IOperatorUnsubmittedNextSeqUpdate)
+	if err := _IOperator.contract.UnpackLog(event, "UnsubmittedNextSeqUpdate", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
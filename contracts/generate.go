@@ -21,7 +21,7 @@ package contracts
 /*
 Recommended to install solc-select or svm-rs to switch solc versions within abigenw.
 
-	solc-select install 0.4.24 0.5.6 0.8.19 0.8.25
+	solc-select install 0.4.24 0.5.6 0.8.19 0.8.24 0.8.25
 	go generate
 
 Othewise, you can manually switch solc versions and run go generate for each solc version.
@@ -29,6 +29,7 @@ Othewise, you can manually switch solc versions and run go generate for each sol
 	go generate --run 0.4.24
 	go generate --run 0.5.6
 	go generate --run 0.8.19
+	go generate --run 0.8.24
 	go generate --run 0.8.25
 */
 
@@ -56,6 +57,10 @@ Othewise, you can manually switch solc versions and run go generate for each sol
 //go:generate ./abigenw --pkg system_contracts --sol ./contracts/testing/system_contracts/all.sol --out ./contracts/testing/system_contracts/all.go --ver 0.8.19
 //go:generate ./abigenw --pkg multicall --sol ./contracts/system_contracts/multicall/MultiCallContract.sol --out ./contracts/system_contracts/multicall/MultiCallContract.go --ver 0.8.19
 
+// These files were compiled with solidity 0.8.24.
+
+//go:generate ./abigenw --pkg kaiabridge --sol ./contracts/system_contracts/kaiabridge/IOperator.sol --out ./contracts/system_contracts/kaiabridge/kaiabridge.go --ver 0.8.24
+
 // These files were compiled with solidity 0.8.25.
 
 //go:generate ./abigenw --pkg consensus --sol ./contracts/system_contracts/consensus/Kip163.sol --out ./contracts/system_contracts/consensus/Kip163.go --ver 0.8.25
@@ -292,13 +292,29 @@ func (d *Downloader) Progress() kaia.SyncProgress {
 	case LightSync:
 		current = d.lightchain.CurrentHeader().Number.Uint64()
 	}
-	return kaia.SyncProgress{
+	progress := kaia.SyncProgress{
 		StartingBlock: d.syncStatsChainOrigin,
 		CurrentBlock:  current,
 		HighestBlock:  d.syncStatsChainHeight,
 		PulledStates:  d.syncStatsState.processed,
 		KnownStates:   d.syncStatsState.processed + d.syncStatsState.pending,
 	}
+	if mode == SnapSync {
+		snapProgress, pending := d.SnapSyncer.Progress()
+		progress.SyncedAccounts = snapProgress.AccountSynced
+		progress.SyncedAccountBytes = uint64(snapProgress.AccountBytes)
+		progress.SyncedBytecodes = snapProgress.BytecodeSynced
+		progress.SyncedBytecodeBytes = uint64(snapProgress.BytecodeBytes)
+		progress.SyncedStorage = snapProgress.StorageSynced
+		progress.SyncedStorageBytes = uint64(snapProgress.StorageBytes)
+		progress.HealedTrienodes = snapProgress.TrienodeHealSynced
+		progress.HealedTrienodeBytes = uint64(snapProgress.TrienodeHealBytes)
+		progress.HealedBytecodes = snapProgress.BytecodeHealSynced
+		progress.HealedBytecodeBytes = uint64(snapProgress.BytecodeHealBytes)
+		progress.HealingTrienodes = pending.TrienodeHeal
+		progress.HealingBytecode = pending.BytecodeHeal
+	}
+	return progress
 }
 
 func (d *Downloader) getMode() SyncMode {
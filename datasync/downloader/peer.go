@@ -97,6 +97,12 @@ type Peer interface {
 	RequestNodeData([]common.Hash) error
 }
 
+// HistoryPeer is implemented by the peers that advertise the lowest block number
+// whose body and receipts they serve. Peers not implementing it serve the whole history.
+type HistoryPeer interface {
+	HistoryTail() uint64
+}
+
 // lightPeerWrapper wraps a LightPeer struct, stubbing out the Peer-only methods.
 type lightPeerWrapper struct {
 	peer LightPeer
@@ -403,6 +409,15 @@ func (p *peerConnection) Lacks(hash common.Hash) bool {
 	return ok
 }
 
+// LacksHistory retrieves whether the body and receipts of the given block are
+// known to be expired from the peer's history.
+func (p *peerConnection) LacksHistory(number uint64) bool {
+	if hp, ok := p.peer.(HistoryPeer); ok {
+		return number < hp.HistoryTail()
+	}
+	return false
+}
+
 // peerSet represents the collection of active peer participating in the chain
 // download procedure.
 type peerSet struct {
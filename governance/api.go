@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/kaiachain/kaia/common"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"github.com/kaiachain/kaia/params"
@@ -37,6 +38,7 @@ import (
 type GovernanceAPI struct {
 	governance    Engine // Node interfaced by this API
 	stakingModule staking.StakingModule
+	rewardModule  kaiax_reward.RewardModule
 }
 
 type returnTally struct {
@@ -49,6 +51,12 @@ func NewGovernanceAPI(gov Engine, stakingModule staking.StakingModule) *Governan
 	return &GovernanceAPI{governance: gov, stakingModule: stakingModule}
 }
 
+// RegisterRewardModule lets GetRewardsAccumulated and SimulateRewards read the
+// rewards persisted by the module instead of calculating them again.
+func (api *GovernanceAPI) RegisterRewardModule(module kaiax_reward.RewardModule) {
+	api.rewardModule = module
+}
+
 type GovernanceKaiaAPI struct {
 	governance    Engine
 	chain         blockChain
@@ -141,13 +149,80 @@ type AccumulatedRewards struct {
 	Rewards              map[common.Address]*big.Int `json:"rewards"`
 }
 
+// maxRewardsAccumulatedRange limits the block range of governance_getRewardsAccumulated.
+const maxRewardsAccumulatedRange = 604800 // 7 days. naive resource protection
+
 // GetRewardsAccumulated returns accumulated rewards data in the block range of [first, last].
 func (api *GovernanceAPI) GetRewardsAccumulated(first rpc.BlockNumber, last rpc.BlockNumber) (*AccumulatedRewards, error) {
 	blockchain := api.governance.BlockChain()
 	govKaiaAPI := NewGovernanceKaiaAPI(api.governance, blockchain, api.stakingModule)
 
-	currentBlock := blockchain.CurrentBlock().NumberU64()
+	firstBlock, lastBlock, err := resolveRewardsRange(blockchain.CurrentBlock().NumberU64(), first, last, maxRewardsAccumulatedRange)
+	if err != nil {
+		return nil, err
+	}
+
+	// initialize structures before request a job
+	accumRewards := &AccumulatedRewards{}
+	blockRewards := reward.NewRewardSpec()
+	mu := sync.Mutex{} // protect blockRewards
+
+	// write the information of the first block
+	header := blockchain.GetHeaderByNumber(firstBlock)
+	if header == nil {
+		return nil, fmt.Errorf("the block does not exist (block number: %d)", firstBlock)
+	}
+	accumRewards.FirstBlock = header.Number
+	accumRewards.FirstBlockTime = time.Unix(header.Time.Int64(), 0).String()
+
+	// write the information of the last block
+	header = blockchain.GetHeaderByNumber(lastBlock)
+	if header == nil {
+		return nil, fmt.Errorf("the block does not exist (block number: %d)", lastBlock)
+	}
+	accumRewards.LastBlock = header.Number
+	accumRewards.LastBlockTime = time.Unix(header.Time.Int64(), 0).String()
+
+	err = forEachBlock(firstBlock, lastBlock, func(num uint64) error {
+		blockReward, err := api.getBlockReward(govKaiaAPI, num)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		blockRewards.Add(blockReward)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the accumulated rewards information
+	accumRewards.Rewards = blockRewards.Rewards
+	accumRewards.TotalMinted = blockRewards.Minted
+	accumRewards.TotalTxFee = blockRewards.TotalFee
+	accumRewards.TotalBurntTxFee = blockRewards.BurntFee
+	accumRewards.TotalProposerRewards = blockRewards.Proposer
+	accumRewards.TotalStakingRewards = blockRewards.Stakers
+	accumRewards.TotalKIFRewards = blockRewards.KIF
+	accumRewards.TotalKEFRewards = blockRewards.KEF
+
+	return accumRewards, nil
+}
+
+// getBlockReward returns the rewards paid at the block. The rewards persisted by the reward
+// module are preferred over recalculating them from the governance parameters.
+func (api *GovernanceAPI) getBlockReward(govKaiaAPI *GovernanceKaiaAPI, num uint64) (*reward.RewardSpec, error) {
+	if api.rewardModule != nil {
+		return api.rewardModule.GetBlockReward(num)
+	}
+	bn := rpc.BlockNumber(num)
+	return govKaiaAPI.GetRewards(&bn)
+}
 
+// resolveRewardsRange converts the [first, last] block range of a reward query into block
+// numbers. Negative numbers stand for the current block. The range must not exceed maxRange.
+func resolveRewardsRange(currentBlock uint64, first, last rpc.BlockNumber, maxRange uint64) (uint64, uint64, error) {
 	firstBlock := currentBlock
 	if first >= rpc.EarliestBlockNumber {
 		firstBlock = uint64(first.Int64())
@@ -159,93 +234,103 @@ func (api *GovernanceAPI) GetRewardsAccumulated(first rpc.BlockNumber, last rpc.
 	}
 
 	if firstBlock > lastBlock {
-		return nil, errors.New("the last block number should be equal or larger the first block number")
+		return 0, 0, errors.New("the last block number should be equal or larger the first block number")
 	}
 
 	if lastBlock > currentBlock {
-		return nil, errors.New("the last block number should be equal or less than the current block number")
+		return 0, 0, errors.New("the last block number should be equal or less than the current block number")
 	}
 
-	blockCount := lastBlock - firstBlock + 1
-	if blockCount > 604800 { // 7 days. naive resource protection
-		return nil, errors.New("block range should be equal or less than 604800")
+	if lastBlock-firstBlock+1 > maxRange {
+		return 0, 0, fmt.Errorf("block range should be equal or less than %d", maxRange)
 	}
+	return firstBlock, lastBlock, nil
+}
 
-	// initialize structures before request a job
-	accumRewards := &AccumulatedRewards{}
-	blockRewards := reward.NewRewardSpec()
-	mu := sync.Mutex{} // protect blockRewards
+// forEachBlock calls fn for every block in [first, last] from a pool of workers and returns
+// the first error. fn must be safe for concurrent use.
+func forEachBlock(first, last uint64, fn func(num uint64) error) error {
+	var firstErr error
+	mu := sync.Mutex{} // protect firstErr
 
+	// introduce the worker pattern to prevent resource exhaustion
 	numWorkers := runtime.NumCPU()
 	reqCh := make(chan uint64, numWorkers)
-	errCh := make(chan error, 1)
 	wg := sync.WaitGroup{}
 
-	// introduce the worker pattern to prevent resource exhaustion
 	for i := 0; i < numWorkers; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			// the minimum digit of request is period to avoid current access to an accArray item
+			// keep draining reqCh after an error so that the sender never blocks
 			for num := range reqCh {
-				bn := rpc.BlockNumber(num)
-				blockReward, err := govKaiaAPI.GetRewards(&bn)
-				if err != nil {
-					errCh <- err
-					return
-				}
-
 				mu.Lock()
-				blockRewards.Add(blockReward)
+				failed := firstErr != nil
 				mu.Unlock()
+				if failed {
+					continue
+				}
+
+				if err := fn(num); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
 			}
 		}()
 	}
 
-	// write the information of the first block
-	header := blockchain.GetHeaderByNumber(firstBlock)
-	if header == nil {
-		return nil, fmt.Errorf("the block does not exist (block number: %d)", firstBlock)
+	for num := first; num <= last; num++ {
+		reqCh <- num
 	}
-	accumRewards.FirstBlock = header.Number
-	accumRewards.FirstBlockTime = time.Unix(header.Time.Int64(), 0).String()
+	close(reqCh)
+	wg.Wait()
 
-	// write the information of the last block
-	header = blockchain.GetHeaderByNumber(lastBlock)
-	if header == nil {
-		return nil, fmt.Errorf("the block does not exist (block number: %d)", lastBlock)
+	return firstErr
+}
+
+// SimulateRewards re-calculates the rewards in the block range of [first, last] with the
+// reward parameters replaced by the overrides, and compares them against the actual rewards.
+func (api *GovernanceAPI) SimulateRewards(overrides map[string]interface{}, first rpc.BlockNumber, last rpc.BlockNumber) (*RewardSimulation, error) {
+	overrideSet, err := parseRewardOverrides(overrides)
+	if err != nil {
+		return nil, err
 	}
-	accumRewards.LastBlock = header.Number
-	accumRewards.LastBlockTime = time.Unix(header.Time.Int64(), 0).String()
 
-	go func() {
-		defer close(reqCh)
-		for num := firstBlock; num <= lastBlock; num++ {
-			reqCh <- num
-		}
-	}()
+	firstBlock, lastBlock, err := resolveRewardsRange(api.governance.BlockChain().CurrentBlock().NumberU64(), first, last, maxSimulateRewardsRange)
+	if err != nil {
+		return nil, err
+	}
 
-	// generate a goroutine to return error early
-	go func() {
-		wg.Wait()
-		close(errCh)
-	}()
+	actualRewards := reward.NewRewardSpec()
+	simulatedRewards := reward.NewRewardSpec()
+	mu := sync.Mutex{} // protect actualRewards and simulatedRewards
 
-	if err := <-errCh; err != nil {
+	err = forEachBlock(firstBlock, lastBlock, func(num uint64) error {
+		actual, simulated, err := simulateBlockReward(api.governance, api.stakingModule, api.rewardModule, num, overrideSet)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		actualRewards.Add(actual)
+		simulatedRewards.Add(simulated)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// collect the accumulated rewards information
-	accumRewards.Rewards = blockRewards.Rewards
-	accumRewards.TotalMinted = blockRewards.Minted
-	accumRewards.TotalTxFee = blockRewards.TotalFee
-	accumRewards.TotalBurntTxFee = blockRewards.BurntFee
-	accumRewards.TotalProposerRewards = blockRewards.Proposer
-	accumRewards.TotalStakingRewards = blockRewards.Stakers
-	accumRewards.TotalKIFRewards = blockRewards.KIF
-	accumRewards.TotalKEFRewards = blockRewards.KEF
-
-	return accumRewards, nil
+	return &RewardSimulation{
+		FirstBlock: firstBlock,
+		LastBlock:  lastBlock,
+		Overrides:  overrideSet.StrMap(),
+		Actual:     actualRewards,
+		Simulated:  simulatedRewards,
+		Recipients: diffRecipientRewards(actualRewards, simulatedRewards),
+	}, nil
 }
 
 // Vote injects a new vote for governance targets such as unitprice and governingnode.
@@ -385,6 +470,45 @@ func (api *GovernanceAPI) PendingChanges() map[string]interface{} {
 	return api.governance.PendingChanges()
 }
 
+// PrepareParamChange validates the new value of the parameter and encodes the setParam call
+// of the GovParam contract. The call must be sent by the contract owner before the activation
+// block, which is DefaultParamChangeDelay blocks after the head block if not given.
+func (api *GovernanceAPI) PrepareParamChange(name string, value interface{}, activation *rpc.BlockNumber) (*ParamChangeProposal, error) {
+	num := api.governance.BlockChain().CurrentBlock().NumberU64() + DefaultParamChangeDelay
+	if activation != nil {
+		if *activation < 0 {
+			return nil, errInvalidActivation
+		}
+		num = uint64(activation.Int64())
+	}
+	return prepareParamChange(api.governance, name, value, num)
+}
+
+// PendingContractChanges lists the parameter changes scheduled in the GovParam contract
+// along with the pending changes voted in the block headers.
+func (api *GovernanceAPI) PendingContractChanges() (*AllPendingChanges, error) {
+	ret := &AllPendingChanges{
+		HeaderVotes:     api.governance.PendingChanges(),
+		ContractChanges: []*ContractParamChange{},
+	}
+
+	head := api.governance.BlockChain().CurrentBlock().NumberU64()
+	pset, err := api.governance.EffectiveParams(head + 1)
+	if err != nil {
+		return nil, err
+	}
+	ret.Contract = pset.GovParamContract()
+	if common.EmptyAddress(ret.Contract) {
+		return ret, nil
+	}
+
+	ret.ContractChanges, err = readPendingContractChanges(api.governance.BlockChain(), ret.Contract, head)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
 func (api *GovernanceAPI) Votes() []GovernanceVote {
 	return api.governance.Votes()
 }
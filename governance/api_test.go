@@ -21,10 +21,14 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/kaiachain/kaia/accounts/abi"
+	"github.com/kaiachain/kaia/accounts/abi/bind"
 	"github.com/kaiachain/kaia/blockchain/state"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
 	"github.com/kaiachain/kaia/consensus"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	staking_mock "github.com/kaiachain/kaia/kaiax/staking/mock"
 	"github.com/kaiachain/kaia/networks/rpc"
@@ -33,6 +37,7 @@ import (
 	"github.com/kaiachain/kaia/storage/database"
 	"github.com/kaiachain/kaia/work/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type testBlockChain struct {
@@ -300,6 +305,137 @@ func TestGetRewardsAccumulated(t *testing.T) {
 	assert.Equal(t, gcReward, new(big.Int).Add(ret.TotalStakingRewards, ret.TotalProposerRewards))
 }
 
+func TestSimulateRewards(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockBlockchain := mocks.NewMockBlockChain(mockCtrl)
+	mockGovEngine := NewMockEngine(mockCtrl)
+	mockStakingModule := staking_mock.NewMockStakingModule(mockCtrl)
+
+	chainConfig := params.MainnetChainConfig.Copy()
+	chainConfig.KoreCompatibleBlock = big.NewInt(0)
+	chainConfig.Governance.Reward.Ratio = "50/20/30"
+	chainConfig.Governance.Reward.Kip82Ratio = params.DefaultKip82Ratio
+
+	govParamSet, err := params.NewGovParamSetChainConfig(chainConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAddrList := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		common.HexToAddress("0x3333333333333333333333333333333333333333"),
+		common.HexToAddress("0x4444444444444444444444444444444444444444"),
+	}
+	stInfo := &staking.StakingInfo{
+		SourceBlockNum:   0,
+		NodeIds:          testAddrList,
+		StakingContracts: testAddrList,
+		RewardAddrs:      testAddrList,
+		KEFAddr:          common.HexToAddress("0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC"),
+		KIFAddr:          common.HexToAddress("0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF"),
+		StakingAmounts:   []uint64{5000000, 10000000, 15000000, 20000000},
+	}
+
+	endBlockNum := 10
+	blocks := make([]*types.Block, endBlockNum+1)
+	for i := 0; i <= endBlockNum; i++ {
+		blocks[i] = types.NewBlockWithHeader(&types.Header{
+			Number:     big.NewInt(int64(i)),
+			Rewardbase: testAddrList[i%4],
+			GasUsed:    uint64(1000),
+			BaseFee:    big.NewInt(25 * params.Gkei),
+			Time:       big.NewInt(int64(1000 + i)),
+		})
+		receipts := types.Receipts{&types.Receipt{GasUsed: uint64(1000)}}
+		mockBlockchain.EXPECT().GetBlock(blocks[i].Hash(), uint64(i)).Return(blocks[i]).AnyTimes()
+		mockBlockchain.EXPECT().GetHeaderByNumber(uint64(i)).Return(blocks[i].Header()).AnyTimes()
+		mockBlockchain.EXPECT().GetReceiptsByBlockHash(blocks[i].Hash()).Return(receipts).AnyTimes()
+	}
+
+	mockBlockchain.EXPECT().Config().Return(chainConfig).AnyTimes()
+	mockBlockchain.EXPECT().CurrentBlock().Return(blocks[endBlockNum]).AnyTimes()
+	mockGovEngine.EXPECT().EffectiveParams(gomock.Any()).Return(govParamSet, nil).AnyTimes()
+	mockGovEngine.EXPECT().BlockChain().Return(mockBlockchain).AnyTimes()
+	mockStakingModule.EXPECT().GetStakingInfo(gomock.Any()).Return(stInfo, nil).AnyTimes()
+
+	govAPI := NewGovernanceAPI(mockGovEngine, mockStakingModule)
+
+	// Doubling the minting amount doubles every reward, while the fees are all burnt.
+	doubled := new(big.Int).Mul(govParamSet.MintingAmountBig(), big.NewInt(2))
+	ret, err := govAPI.SimulateRewards(map[string]interface{}{
+		"reward.mintingAmount": doubled.String(),
+	}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(0), ret.FirstBlock)
+	assert.Equal(t, uint64(endBlockNum), ret.LastBlock)
+	assert.Equal(t, map[string]interface{}{"reward.mintingamount": doubled.String()}, ret.Overrides)
+
+	// The actual rewards are the same as governance_getRewardsAccumulated.
+	accum, err := govAPI.GetRewardsAccumulated(rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	require.NoError(t, err)
+	assert.Equal(t, accum.TotalMinted, ret.Actual.Minted)
+	assert.Equal(t, accum.Rewards, ret.Actual.Rewards)
+
+	double := func(x *big.Int) *big.Int { return new(big.Int).Mul(x, big.NewInt(2)) }
+	assert.Equal(t, double(ret.Actual.Minted), ret.Simulated.Minted)
+	assert.Equal(t, ret.Actual.TotalFee, ret.Simulated.TotalFee)
+	assert.Equal(t, ret.Actual.BurntFee, ret.Simulated.BurntFee)
+	assert.Equal(t, double(ret.Actual.Proposer), ret.Simulated.Proposer)
+	assert.Equal(t, double(ret.Actual.Stakers), ret.Simulated.Stakers)
+	assert.Equal(t, double(ret.Actual.KIF), ret.Simulated.KIF)
+	assert.Equal(t, double(ret.Actual.KEF), ret.Simulated.KEF)
+
+	require.Len(t, ret.Recipients, len(ret.Actual.Rewards))
+	for i, r := range ret.Recipients {
+		if i > 0 {
+			assert.Less(t, ret.Recipients[i-1].Address.Hex(), r.Address.Hex())
+		}
+		assert.Equal(t, ret.Actual.Rewards[r.Address], r.Actual)
+		assert.Equal(t, ret.Simulated.Rewards[r.Address], r.Simulated)
+		assert.Equal(t, r.Actual, r.Diff)
+	}
+
+	// The actual rewards are read from the reward module if registered.
+	persisted := reward.NewRewardSpec()
+	persisted.Minted = big.NewInt(7)
+	persisted.Proposer = big.NewInt(7)
+	persisted.Rewards[testAddrList[0]] = big.NewInt(7)
+	govAPI.RegisterRewardModule(&fakeRewardModule{spec: persisted})
+	ret, err = govAPI.SimulateRewards(map[string]interface{}{
+		"reward.mintingAmount": doubled.String(),
+	}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(int64(7*(endBlockNum+1))), ret.Actual.Minted)
+	assert.Equal(t, big.NewInt(int64(7*(endBlockNum+1))), ret.Actual.Rewards[testAddrList[0]])
+	assert.Equal(t, double(accum.TotalMinted), ret.Simulated.Minted)
+
+	// Invalid requests
+	_, err = govAPI.SimulateRewards(nil, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	assert.ErrorIs(t, err, errNoRewardOverride)
+	_, err = govAPI.SimulateRewards(map[string]interface{}{"governance.unitprice": uint64(1)}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	assert.Error(t, err)
+	_, err = govAPI.SimulateRewards(map[string]interface{}{"reward.ratio": true}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	assert.Error(t, err)
+	_, err = govAPI.SimulateRewards(map[string]interface{}{"reward.ratio": "50/50/50"}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum))
+	assert.Error(t, err)
+	_, err = govAPI.SimulateRewards(map[string]interface{}{"reward.ratio": "40/30/30"}, rpc.BlockNumber(0), rpc.BlockNumber(endBlockNum+1))
+	assert.Error(t, err)
+}
+
+// fakeRewardModule returns the same rewards at every block.
+type fakeRewardModule struct {
+	kaiax_reward.RewardModule
+	spec *reward.RewardSpec
+}
+
+func (m *fakeRewardModule) GetBlockReward(num uint64) (*reward.RewardSpec, error) {
+	return m.spec, nil
+}
+
 func (bc *testBlockChain) Engine() consensus.Engine                    { return nil }
 func (bc *testBlockChain) GetHeader(common.Hash, uint64) *types.Header { return nil }
 func (bc *testBlockChain) GetHeaderByNumber(val uint64) *types.Header {
@@ -341,3 +477,65 @@ func (bc *testBlockChain) SetBlockNum(num uint64) {
 func (bc *testBlockChain) GetBlock(hash common.Hash, num uint64) *types.Block {
 	return bc.GetBlockByNumber(num)
 }
+
+func TestPrepareParamChange(t *testing.T) {
+	e, owner, sim, _ := newTestMixedEngine(t, getTestConfig())
+	defer sim.Close()
+	api := NewGovernanceAPI(e, nil)
+
+	head := sim.BlockChain().CurrentBlock().NumberU64()
+	activation := rpc.BlockNumber(head + 5)
+
+	// Invalid parameters
+	_, err := api.PrepareParamChange("istanbul.unknown", 1, &activation)
+	assert.Error(t, err)
+	_, err = api.PrepareParamChange("reward.ratio", "34/33/32", &activation)
+	assert.Error(t, err)
+	_, err = api.PrepareParamChange("kip71.lowerboundbasefee", uint64(1e18), &activation)
+	assert.Equal(t, errInvalidLowerBound, err)
+	past := rpc.BlockNumber(head + 1)
+	_, err = api.PrepareParamChange("kip71.gastarget", uint64(1), &past)
+	assert.Equal(t, errInvalidActivation, err)
+
+	// Default activation
+	proposal, err := api.PrepareParamChange("kip71.gastarget", uint64(1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, head+DefaultParamChangeDelay, proposal.Activation)
+
+	proposal, err = api.PrepareParamChange("KIP71.GasTarget", float64(0xcccc), &activation)
+	assert.NoError(t, err)
+	assert.Equal(t, "kip71.gastarget", proposal.Name)
+	assert.Equal(t, uint64(0xcccc), proposal.Value)
+	assert.Equal(t, getTestConfig().Governance.KIP71.GasTarget, proposal.Current)
+	assert.Equal(t, hexutil.Bytes{0xcc, 0xcc}, proposal.Bytes)
+	assert.Equal(t, owner.From, proposal.Owner)
+
+	// Send the prepared call as it is
+	contract := bind.NewBoundContract(proposal.Contract, abi.ABI{}, sim, sim, sim)
+	_, err = contract.RawTransact(owner, proposal.Data)
+	assert.NoError(t, err)
+	sim.Commit()
+
+	pending, err := api.PendingContractChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, proposal.Contract, pending.Contract)
+	assert.Equal(t, []*ContractParamChange{{
+		Name:       "kip71.gastarget",
+		Exists:     true,
+		Value:      uint64(0xcccc),
+		Bytes:      hexutil.Bytes{0xcc, 0xcc},
+		Activation: uint64(activation),
+	}}, pending.ContractChanges)
+
+	pset, err := e.EffectiveParams(uint64(activation))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0xcccc), pset.GasTarget())
+
+	// The change is no longer pending once activated.
+	for sim.BlockChain().CurrentBlock().NumberU64() < uint64(activation) {
+		sim.Commit()
+	}
+	pending, err = api.PendingContractChanges()
+	assert.NoError(t, err)
+	assert.Empty(t, pending.ContractChanges)
+}
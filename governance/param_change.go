@@ -0,0 +1,180 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/kaiachain/kaia/accounts/abi"
+	"github.com/kaiachain/kaia/accounts/abi/bind"
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
+	govcontract "github.com/kaiachain/kaia/contracts/contracts/system_contracts/gov"
+	"github.com/kaiachain/kaia/params"
+)
+
+// DefaultParamChangeDelay is the number of blocks from the head block to the activation
+// of a GovParam change prepared without an activation block. It is about a day.
+const DefaultParamChangeDelay = 86400
+
+var errInvalidActivation = errors.New("activation must be later than the next block")
+
+// ParamChangeProposal is a GovParam change ready to be sent by the contract owner.
+type ParamChangeProposal struct {
+	Contract   common.Address `json:"contract"` // The GovParam contract to send the transaction to
+	Owner      common.Address `json:"owner"`    // The account to send the transaction from
+	Name       string         `json:"name"`
+	Value      interface{}    `json:"value"`   // The new value in the canonical type
+	Current    interface{}    `json:"current"` // The value effective at the next block
+	Bytes      hexutil.Bytes  `json:"bytes"`   // The new value as stored in the contract
+	Activation uint64         `json:"activation"`
+	Data       hexutil.Bytes  `json:"data"` // The setParam call data
+}
+
+// ContractParamChange is a GovParam change scheduled at a future block.
+type ContractParamChange struct {
+	Name       string        `json:"name"`
+	Exists     bool          `json:"exists"`          // False if the parameter is to be deleted
+	Value      interface{}   `json:"value,omitempty"` // Nil if deleted or malformed
+	Bytes      hexutil.Bytes `json:"bytes"`
+	Activation uint64        `json:"activation"`
+}
+
+// AllPendingChanges lists the parameter changes pending in both the header governance
+// and the GovParam contract.
+type AllPendingChanges struct {
+	HeaderVotes     map[string]interface{} `json:"headerVotes"` // Same as governance_pendingChanges
+	Contract        common.Address         `json:"contract"`
+	ContractChanges []*ContractParamChange `json:"contractChanges"`
+}
+
+// prepareParamChange validates the value of the parameter and encodes the setParam call
+// of the GovParam contract effective at the next block.
+func prepareParamChange(gov Engine, name string, value interface{}, activation uint64) (*ParamChangeProposal, error) {
+	chain := gov.BlockChain()
+	head := chain.CurrentBlock().NumberU64()
+	if activation <= head+1 {
+		return nil, errInvalidActivation
+	}
+
+	name = strings.ToLower(name)
+	value, b, err := params.EncodeGovParamBytes(name, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parameter %s: %w", name, err)
+	}
+
+	pset, err := gov.EffectiveParams(head + 1)
+	if err != nil {
+		return nil, err
+	}
+	addr := pset.GovParamContract()
+	if common.EmptyAddress(addr) {
+		return nil, errGovParamNotExist
+	}
+
+	// The KIP-71 base fee bounds must stay consistent with the parameters at the activation.
+	if next, err := gov.EffectiveParams(activation); err == nil {
+		switch name {
+		case "kip71.lowerboundbasefee":
+			if value.(uint64) > next.UpperBoundBaseFee() {
+				return nil, errInvalidLowerBound
+			}
+		case "kip71.upperboundbasefee":
+			if value.(uint64) < next.LowerBoundBaseFee() {
+				return nil, errInvalidUpperBound
+			}
+		}
+	}
+
+	caller, err := govcontract.NewGovParamCaller(addr, backends.NewBlockchainContractBackend(chain, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	owner, err := caller.Owner(&bind.CallOpts{BlockNumber: new(big.Int).SetUint64(head)})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errGovParamNotExist, err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(govcontract.GovParamABI))
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("setParam", name, true, b, new(big.Int).SetUint64(activation))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParamChangeProposal{
+		Contract:   addr,
+		Owner:      owner,
+		Name:       name,
+		Value:      value,
+		Current:    pset.StrMap()[name],
+		Bytes:      b,
+		Activation: activation,
+		Data:       data,
+	}, nil
+}
+
+// readPendingContractChanges returns the GovParam changes activated after the given block,
+// sorted by the activation block and the name.
+func readPendingContractChanges(chain blockChain, addr common.Address, num uint64) ([]*ContractParamChange, error) {
+	caller, err := govcontract.NewGovParamCaller(addr, backends.NewBlockchainContractBackend(chain, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	names, checkpoints, err := caller.GetAllCheckpoints(&bind.CallOpts{BlockNumber: new(big.Int).SetUint64(num)})
+	if err != nil {
+		return nil, err
+	}
+	if len(names) != len(checkpoints) {
+		return nil, errInvalidGovParam
+	}
+
+	changes := []*ContractParamChange{}
+	for i, name := range names {
+		for _, ckpt := range checkpoints[i] {
+			if ckpt.Activation.Cmp(new(big.Int).SetUint64(num)) <= 0 {
+				continue
+			}
+			change := &ContractParamChange{
+				Name:       name,
+				Exists:     ckpt.Exists,
+				Bytes:      ckpt.Val,
+				Activation: ckpt.Activation.Uint64(),
+			}
+			if ckpt.Exists {
+				if pset, err := params.NewGovParamSetBytesMap(map[string][]byte{name: ckpt.Val}); err == nil {
+					change.Value = pset.StrMap()[name]
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Activation != changes[j].Activation {
+			return changes[i].Activation < changes[j].Activation
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes, nil
+}
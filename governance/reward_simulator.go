@@ -0,0 +1,171 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/kaiachain/kaia/common"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
+	"github.com/kaiachain/kaia/kaiax/staking"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/reward"
+)
+
+// maxSimulateRewardsRange limits the block range of governance_simulateRewards.
+// A simulated block costs about twice as much as in governance_getRewardsAccumulated.
+const maxSimulateRewardsRange = 86400 // 1 day
+
+// simulatableRewardParams are the parameters that only affect the reward distribution.
+var simulatableRewardParams = map[string]bool{
+	"reward.mintingamount": true,
+	"reward.ratio":         true,
+	"reward.kip82ratio":    true,
+	"reward.minimumstake":  true,
+}
+
+var errNoRewardOverride = errors.New("no reward parameter to override")
+
+// RecipientRewardDiff compares the rewards paid to a recipient, which is either a
+// validator's reward address or a fund address.
+type RecipientRewardDiff struct {
+	Address   common.Address `json:"address"`
+	Actual    *big.Int       `json:"actual"`
+	Simulated *big.Int       `json:"simulated"`
+	Diff      *big.Int       `json:"diff"` // Simulated - Actual
+}
+
+// RewardSimulation compares the rewards in the block range of [FirstBlock, LastBlock]
+// calculated with the reward parameters overridden against the actual rewards.
+type RewardSimulation struct {
+	FirstBlock uint64                 `json:"firstBlock"`
+	LastBlock  uint64                 `json:"lastBlock"`
+	Overrides  map[string]interface{} `json:"overrides"`
+	Actual     *reward.RewardSpec     `json:"actual"`
+	Simulated  *reward.RewardSpec     `json:"simulated"`
+	Recipients []*RecipientRewardDiff `json:"recipients"` // Sorted by address
+}
+
+// parseRewardOverrides validates the overrides and returns them as a GovParamSet.
+func parseRewardOverrides(overrides map[string]interface{}) (*params.GovParamSet, error) {
+	if len(overrides) == 0 {
+		return nil, errNoRewardOverride
+	}
+	items := make(map[string]interface{}, len(overrides))
+	for name, value := range overrides {
+		name = strings.ToLower(name)
+		if !simulatableRewardParams[name] {
+			return nil, fmt.Errorf("cannot simulate the parameter: %s", name)
+		}
+		items[name] = value
+	}
+	pset, err := params.NewGovParamSetStrMap(items)
+	if err != nil {
+		return nil, fmt.Errorf("invalid overrides: %w", err)
+	}
+	return pset, nil
+}
+
+// simulateBlockReward calculates the rewards paid at the block in the same way as
+// governance_getRewards, once with the effective reward parameters and once with
+// the overrides applied on top of them. If the reward module is given, the actual
+// rewards are the ones it persisted when the block was finalized.
+func simulateBlockReward(gov Engine, stakingModule staking.StakingModule, rewardModule kaiax_reward.RewardModule, num uint64, overrides *params.GovParamSet) (*reward.RewardSpec, *reward.RewardSpec, error) {
+	chain := gov.BlockChain()
+	header := chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, nil, fmt.Errorf("the block does not exist (block number: %d)", num)
+	}
+	block := chain.GetBlock(header.Hash(), num)
+	if block == nil {
+		return nil, nil, fmt.Errorf("the block does not exist (block number: %d)", num)
+	}
+	txs, receipts := block.Transactions(), chain.GetReceiptsByBlockHash(header.Hash())
+
+	rules := chain.Config().Rules(header.Number)
+	pset, err := gov.EffectiveParams(num)
+	if err != nil {
+		return nil, nil, err
+	}
+	rewardParamNum := reward.CalcRewardParamBlock(num, pset.Epoch(), rules)
+	rewardParamSet, err := gov.EffectiveParams(rewardParamNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	simParamSet := params.NewGovParamSetMerged(rewardParamSet, overrides)
+
+	var stakingInfo *reward.StakingInfo
+	if !reward.IsRewardSimple(rewardParamSet) {
+		si, err := stakingModule.GetStakingInfo(num)
+		if err != nil {
+			return nil, nil, err
+		}
+		stakingInfo = reward.FromKaiax(si)
+	}
+
+	var actual *reward.RewardSpec
+	if rewardModule != nil {
+		actual, err = rewardModule.GetBlockReward(num)
+	} else {
+		actual, err = reward.GetBlockReward(header, txs, receipts, rules, rewardParamSet, stakingInfo)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	simulated, err := reward.GetBlockReward(header, txs, receipts, rules, simParamSet, stakingInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actual, simulated, nil
+}
+
+// diffRecipientRewards compares the rewards of every recipient in either spec.
+func diffRecipientRewards(actual, simulated *reward.RewardSpec) []*RecipientRewardDiff {
+	addrs := make(map[common.Address]bool)
+	for addr := range actual.Rewards {
+		addrs[addr] = true
+	}
+	for addr := range simulated.Rewards {
+		addrs[addr] = true
+	}
+
+	diffs := make([]*RecipientRewardDiff, 0, len(addrs))
+	for addr := range addrs {
+		d := &RecipientRewardDiff{
+			Address:   addr,
+			Actual:    new(big.Int),
+			Simulated: new(big.Int),
+		}
+		if amount, ok := actual.Rewards[addr]; ok {
+			d.Actual.Set(amount)
+		}
+		if amount, ok := simulated.Rewards[addr]; ok {
+			d.Simulated.Set(amount)
+		}
+		d.Diff = new(big.Int).Sub(d.Simulated, d.Actual)
+		diffs = append(diffs, d)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Address.Bytes(), diffs[j].Address.Bytes()) < 0
+	})
+	return diffs
+}
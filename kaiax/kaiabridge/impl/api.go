@@ -0,0 +1,64 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/common"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+	"github.com/kaiachain/kaia/networks/rpc"
+)
+
+func (k *KaiaBridgeModule) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "kaiabridge",
+			Version:   "1.0",
+			Service:   newKaiaBridgeAPI(k),
+			Public:    true,
+		},
+	}
+}
+
+type kaiaBridgeAPI struct {
+	k *KaiaBridgeModule
+}
+
+func newKaiaBridgeAPI(k *KaiaBridgeModule) *kaiaBridgeAPI {
+	return &kaiaBridgeAPI{k}
+}
+
+// GetProvision returns the provision of the sequence number with its submissions and status.
+func (api *kaiaBridgeAPI) GetProvision(seq uint64) (*kaiax_kaiabridge.Provision, error) {
+	return api.k.GetProvision(seq)
+}
+
+// GetUnconfirmedProvisions returns the provisions not confirmed by the bridge yet. If the
+// operator is given, only the ones the operator has to submit are returned.
+func (api *kaiaBridgeAPI) GetUnconfirmedProvisions(operator *common.Address) ([]*kaiax_kaiabridge.Provision, error) {
+	return api.k.GetUnconfirmedProvisions(operator)
+}
+
+// GetUnclaimedProvisions returns the confirmed provisions not claimed yet. If the operator
+// is given, only the ones the operator has submitted are returned.
+func (api *kaiaBridgeAPI) GetUnclaimedProvisions(operator *common.Address) ([]*kaiax_kaiabridge.Provision, error) {
+	return api.k.GetUnclaimedProvisions(operator)
+}
+
+// GetStatus returns the sequence state of the bridge seen by the watcher.
+func (api *kaiaBridgeAPI) GetStatus() (*kaiax_kaiabridge.BridgeStatus, error) {
+	return api.k.GetBridgeStatus()
+}
@@ -0,0 +1,439 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/kaiachain/kaia/accounts/abi"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/contracts/contracts/system_contracts/kaiabridge"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/rcrowley/go-metrics"
+)
+
+var (
+	unconfirmedGauge = metrics.NewRegisteredGauge("kaiax/kaiabridge/unconfirmed", nil)
+	unclaimedGauge   = metrics.NewRegisteredGauge("kaiax/kaiabridge/unclaimed", nil)
+	lastBlockGauge   = metrics.NewRegisteredGauge("kaiax/kaiabridge/lastBlock", nil)
+
+	bridgeABI, _   = abi.JSON(strings.NewReader(kaiabridge.IBridgeABI))
+	operatorABI, _ = abi.JSON(strings.NewReader(kaiabridge.IOperatorABI))
+
+	// The Provision event is declared in IBridge, but emitted by the operator contract.
+	provisionEventID        = bridgeABI.Events["Provision"].ID
+	revocationEventID       = operatorABI.Events["Revocation"].ID
+	provisionConfirmEventID = bridgeABI.Events["ProvisionConfirm"].ID
+	removeProvisionEventID  = bridgeABI.Events["RemoveProvision"].ID
+	receiverChangedEventID  = bridgeABI.Events["ProvisionReceiverChanged"].ID
+	claimEventID            = bridgeABI.Events["Claim"].ID
+)
+
+// undoRetention is the number of the latest blocks whose undo records are kept.
+var undoRetention uint64 = 86400 // 1 day
+
+// blockUpdate collects the changes made by the logs of a block.
+type blockUpdate struct {
+	num   uint64
+	dirty map[uint64]*kaiax_kaiabridge.Provision
+	undo  *storedUndo
+	// The receivers changed by the guardian in this block, to match the following Claim.
+	receivers map[common.Address][]common.Address
+}
+
+// snapshot records the provision before it is changed by the block for the first time.
+func (u *blockUpdate) snapshot(p *kaiax_kaiabridge.Provision, existed bool) {
+	for _, seq := range u.undo.Seqs {
+		if seq == p.Seq {
+			return
+		}
+	}
+	var b []byte
+	if existed {
+		var err error
+		if b, err = rlp.EncodeToBytes(p); err != nil {
+			logger.Error("Failed to encode kaiabridge provision", "seq", p.Seq, "err", err)
+		}
+	}
+	u.undo.Seqs = append(u.undo.Seqs, p.Seq)
+	u.undo.Provisions = append(u.undo.Provisions, b)
+}
+
+// PostInsertBlock applies the kaiabridge events in the block to the provisions. If some
+// blocks before are not watched yet, they are caught up in the background instead.
+func (k *KaiaBridgeModule) PostInsertBlock(block *types.Block) error {
+	// Skip the blocks inserted to the side chains.
+	if current := k.Chain.CurrentBlock(); current == nil || current.Hash() != block.Hash() {
+		return nil
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	num := block.NumberU64()
+	switch {
+	case num <= k.state.LastBlock:
+		return nil
+	case num == k.state.LastBlock+1:
+		k.applyBlock(block)
+	default:
+		select {
+		case k.catchUpCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// catchUpLoop watches the canonical blocks up to the head whenever woken up.
+func (k *KaiaBridgeModule) catchUpLoop(catchUpCh <-chan struct{}, quit <-chan struct{}) {
+	defer k.wg.Done()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-catchUpCh:
+			k.catchUp(quit)
+		}
+	}
+}
+
+func (k *KaiaBridgeModule) catchUp(quit <-chan struct{}) {
+	from := uint64(0)
+	for {
+		select {
+		case <-quit:
+			return
+		default:
+		}
+
+		// Lock each block, so that the APIs and the block insertion are not blocked for long.
+		k.mu.Lock()
+		num := k.state.LastBlock + 1
+		current := k.Chain.CurrentBlock()
+		if current == nil || num > current.NumberU64() {
+			k.mu.Unlock()
+			if from != 0 {
+				logger.Info("Caught up with kaiabridge provisions", "from", from, "to", num-1)
+			}
+			return
+		}
+		block := k.Chain.GetBlockByNumber(num)
+		if block == nil {
+			k.mu.Unlock()
+			logger.Error("Failed to watch kaiabridge provisions at a missing block", "number", num)
+			return
+		}
+		if from == 0 {
+			from = num
+			logger.Info("Catching up with kaiabridge provisions", "from", num, "head", current.NumberU64())
+		}
+		k.applyBlock(block)
+		k.mu.Unlock()
+	}
+}
+
+// applyBlock applies the kaiabridge events in the block, which follows the last block watched.
+func (k *KaiaBridgeModule) applyBlock(block *types.Block) {
+	num := block.NumberU64()
+	u := &blockUpdate{
+		num:       num,
+		dirty:     make(map[uint64]*kaiax_kaiabridge.Provision),
+		undo:      &storedUndo{State: *k.state},
+		receivers: make(map[common.Address][]common.Address),
+	}
+	for _, receipt := range k.Chain.GetReceiptsByBlockHash(block.Hash()) {
+		if receipt == nil {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if l == nil || len(l.Topics) == 0 {
+				continue
+			}
+			// A malformed log must not stop the block insertion.
+			var err error
+			switch l.Address {
+			case k.OperatorAddr:
+				err = k.handleOperatorLog(u, l)
+			case k.BridgeAddr:
+				err = k.handleBridgeLog(u, l)
+			}
+			if err != nil {
+				logger.Warn("Failed to handle kaiabridge log", "number", num, "txHash", l.TxHash, "err", err)
+			}
+		}
+	}
+
+	k.state.LastBlock = num
+	k.persist(u)
+}
+
+func (k *KaiaBridgeModule) handleOperatorLog(u *blockUpdate, l *types.Log) error {
+	switch l.Topics[0] {
+	case provisionEventID:
+		e, err := unpackTupleEvent[kaiabridge.IBridgeProvisionIndividualEvent](bridgeABI, "Provision", l)
+		if err != nil {
+			return err
+		}
+		p := k.getOrNewProvision(u, e.Seq)
+		if !p.Pending() {
+			if p.ClaimedBlock != 0 {
+				logger.Warn("Provision submitted after claimed", "seq", e.Seq, "operator", e.Operator, "txID", e.TxID)
+				return nil
+			}
+			// The removed sequence is provisioned again.
+			*p = kaiax_kaiabridge.Provision{Seq: e.Seq}
+		}
+		if len(p.Submissions) == 0 && p.ConfirmedBlock == 0 {
+			p.Sender, p.Receiver, p.Amount = e.Sender, e.Receiver, e.Amount
+		}
+		if p.AddSubmission(e.Operator, e.TxID, u.num) {
+			k.markDirty(u, p)
+		}
+		if e.Seq > k.state.GreatestSubmittedSeq {
+			k.state.GreatestSubmittedSeq = e.Seq
+		}
+	case revocationEventID:
+		ev, err := k.operator.ParseRevocation(*l)
+		if err != nil {
+			return err
+		}
+		for _, p := range k.sortedPending() {
+			for _, s := range p.Submissions {
+				if s.Operator == ev.Sender && s.TxID == ev.TransactionId {
+					u.snapshot(p, true)
+					p.RevokeSubmission(ev.Sender, ev.TransactionId)
+					k.markDirty(u, p)
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (k *KaiaBridgeModule) handleBridgeLog(u *blockUpdate, l *types.Log) error {
+	switch l.Topics[0] {
+	case provisionConfirmEventID:
+		e, err := unpackTupleEvent[kaiabridge.IBridgeProvisionConfirmedEvent](bridgeABI, "ProvisionConfirm", l)
+		if err != nil {
+			return err
+		}
+		p := k.getOrNewProvision(u, e.Seq)
+		if !p.Pending() {
+			*p = kaiax_kaiabridge.Provision{Seq: e.Seq}
+		}
+		// The confirmed data is authoritative over the submitted ones.
+		p.Sender, p.Receiver, p.Amount = e.Sender, e.Receiver, e.Amount
+		p.ConfirmedBlock = u.num
+		k.markDirty(u, p)
+		if e.Seq > k.state.GreatestConfirmedSeq {
+			k.state.GreatestConfirmedSeq = e.Seq
+		}
+	case removeProvisionEventID:
+		e, err := unpackTupleEvent[kaiabridge.IBridgeProvisionData](bridgeABI, "RemoveProvision", l)
+		if err != nil {
+			return err
+		}
+		p := k.getOrNewProvision(u, e.Seq)
+		if p.Amount == nil {
+			p.Sender, p.Receiver, p.Amount = e.Sender, e.Receiver, e.Amount
+		}
+		p.RemovedBlock = u.num
+		k.markDirty(u, p)
+	case receiverChangedEventID:
+		ev, err := k.bridge.ParseProvisionReceiverChanged(*l)
+		if err != nil {
+			return err
+		}
+		u.receivers[ev.BeforeReceiver] = append(u.receivers[ev.BeforeReceiver], ev.NewReceiver)
+	case claimEventID:
+		// The indexed struct is hashed into the topic, hence matched against the known provisions.
+		if len(l.Topics) < 2 {
+			return kaiax_kaiabridge.ErrMalformedClaim
+		}
+		for _, p := range k.sortedPending() {
+			if p.ConfirmedBlock == 0 {
+				continue
+			}
+			candidates := append([]common.Address{p.Receiver}, u.receivers[p.Receiver]...)
+			for _, receiver := range candidates {
+				if p.ClaimTopic(receiver) != l.Topics[1] {
+					continue
+				}
+				u.snapshot(p, true)
+				if receiver != p.Receiver {
+					p.PrevReceiver, p.Receiver = p.Receiver, receiver
+				}
+				p.ClaimedBlock = u.num
+				k.markDirty(u, p)
+				return nil
+			}
+		}
+		logger.Debug("Claim of an unknown provision", "number", u.num, "txHash", l.TxHash, "topic", l.Topics[1])
+	}
+	return nil
+}
+
+// unpackTupleEvent unpacks the event whose only argument is a tuple. The generated Parse
+// methods cannot, since UnpackLog copies the tuple into the event struct instead of its field.
+func unpackTupleEvent[T any](contract abi.ABI, name string, l *types.Log) (*T, error) {
+	values, err := contract.Events[name].Inputs.Unpack(l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(values[0], new(T)).(*T), nil
+}
+
+// getOrNewProvision returns the pending provision, or the persisted one, or a new one,
+// after taking its snapshot for the undo.
+func (k *KaiaBridgeModule) getOrNewProvision(u *blockUpdate, seq uint64) *kaiax_kaiabridge.Provision {
+	p, existed := k.pending[seq], true
+	if p == nil {
+		p = ReadProvision(k.ChainKv, k.BridgeAddr, seq)
+	}
+	if p == nil {
+		p, existed = &kaiax_kaiabridge.Provision{Seq: seq}, false
+	}
+	u.snapshot(p, existed)
+	return p
+}
+
+func (k *KaiaBridgeModule) markDirty(u *blockUpdate, p *kaiax_kaiabridge.Provision) {
+	u.dirty[p.Seq] = p
+	if p.Pending() {
+		k.pending[p.Seq] = p
+	} else {
+		delete(k.pending, p.Seq)
+	}
+}
+
+func (k *KaiaBridgeModule) sortedPending() []*kaiax_kaiabridge.Provision {
+	provisions := make([]*kaiax_kaiabridge.Provision, 0, len(k.pending))
+	for _, p := range k.pending {
+		provisions = append(provisions, p)
+	}
+	sort.Slice(provisions, func(i, j int) bool { return provisions[i].Seq < provisions[j].Seq })
+	return provisions
+}
+
+// persist writes the changed provisions, their undo and the state at once, and updates the metrics.
+// The undo records older than undoRetention blocks are pruned.
+func (k *KaiaBridgeModule) persist(u *blockUpdate) {
+	k.updatePendingSeqs()
+
+	batch := k.ChainKv.NewBatch()
+	if len(u.dirty) > 0 {
+		for _, p := range u.dirty {
+			WriteProvision(batch, k.BridgeAddr, p)
+		}
+		WriteUndo(batch, k.BridgeAddr, u.num, u.undo)
+	}
+	if u.num >= undoRetention && u.num-undoRetention >= k.state.UndoFrom {
+		DeleteUndo(batch, k.BridgeAddr, u.num-undoRetention)
+		k.state.UndoFrom = u.num - undoRetention + 1
+	}
+	WriteState(batch, k.BridgeAddr, k.state)
+	if err := batch.Write(); err != nil {
+		logger.Crit("Failed to write kaiabridge provisions", "lastBlock", k.state.LastBlock, "err", err)
+	}
+}
+
+// updatePendingSeqs updates the pending sequences in the state and the metrics.
+func (k *KaiaBridgeModule) updatePendingSeqs() {
+	k.state.Pending = make([]uint64, 0, len(k.pending))
+	numUnconfirmed := 0
+	for _, p := range k.sortedPending() {
+		k.state.Pending = append(k.state.Pending, p.Seq)
+		if p.ConfirmedBlock == 0 {
+			numUnconfirmed++
+		}
+	}
+	unconfirmedGauge.Update(int64(numUnconfirmed))
+	unclaimedGauge.Update(int64(len(k.pending) - numUnconfirmed))
+	lastBlockGauge.Update(int64(k.state.LastBlock))
+}
+
+// RewindTo restores the provisions changed after the new head by the undo records,
+// so that the blocks are watched again as they are re-inserted. If the undo records
+// are already pruned, the provisions are watched again from the start block.
+func (k *KaiaBridgeModule) RewindTo(newBlock *types.Block) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.rewind(newBlock.NumberU64())
+}
+
+// rewind restores the provisions to the end of the block num. The caller must hold mu.
+func (k *KaiaBridgeModule) rewind(num uint64) {
+	if num >= k.state.LastBlock {
+		return
+	}
+	if num+1 < k.state.UndoFrom {
+		logger.Warn("Rewinding kaiabridge provisions beyond the undo records", "num", num, "undoFrom", k.state.UndoFrom)
+		k.reset()
+		k.loadPending()
+		k.updatePendingSeqs()
+		return
+	}
+	undoFrom := k.state.UndoFrom
+
+	nums, undos := ReadUndosAfter(k.ChainKv, k.BridgeAddr, num)
+	batch := k.ChainKv.NewBatch()
+	for i := len(undos) - 1; i >= 0; i-- {
+		undo := undos[i]
+		for j, seq := range undo.Seqs {
+			if len(undo.Provisions[j]) == 0 {
+				DeleteProvision(batch, k.BridgeAddr, seq)
+			} else if err := batch.Put(provisionKey(k.BridgeAddr, seq), undo.Provisions[j]); err != nil {
+				logger.Crit("Failed to write kaiabridge provision", "seq", seq, "err", err)
+			}
+		}
+		DeleteUndo(batch, k.BridgeAddr, nums[i])
+		*k.state = undo.State
+	}
+	k.state.LastBlock = num
+	k.state.UndoFrom = undoFrom
+	WriteState(batch, k.BridgeAddr, k.state)
+	if err := batch.Write(); err != nil {
+		logger.Crit("Failed to rewind kaiabridge provisions", "num", num, "err", err)
+	}
+
+	k.loadPending()
+	k.updatePendingSeqs()
+}
+
+func (k *KaiaBridgeModule) RewindDelete(hash common.Hash, num uint64) {
+	// Nothing to do
+}
+
+func copyProvision(p *kaiax_kaiabridge.Provision) *kaiax_kaiabridge.Provision {
+	cpy := *p
+	if p.Amount != nil {
+		cpy.Amount = new(big.Int).Set(p.Amount)
+	}
+	cpy.Submissions = make([]*kaiax_kaiabridge.Submission, len(p.Submissions))
+	for i, s := range p.Submissions {
+		sCopy := *s
+		cpy.Submissions[i] = &sCopy
+	}
+	return &cpy
+}
@@ -0,0 +1,475 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/accounts/abi"
+	"github.com/kaiachain/kaia/accounts/abi/bind"
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// emitterCode is the runtime code that emits a log as the calldata describes:
+// the number of topics (1 to 3) as a 32-byte word, the topics, and the log data.
+const emitterCode = "0x60003580600214602457600314603c57604036036040600037602035604036036000a1005b606036036060600037604035602035606036036000a2005b608036036080600037606035604035602035608036036000a300"
+
+var (
+	testBridgeAddr   = common.HexToAddress("0x00000000000000000000000000000000000b4d9e")
+	testOperatorAddr = common.HexToAddress("0x00000000000000000000000000000000000096e4")
+	testOp1          = common.HexToAddress("0x1111")
+	testOp2          = common.HexToAddress("0x2222")
+	testReceiver     = common.HexToAddress("0xaaaa")
+	testNewReceiver  = common.HexToAddress("0xbbbb")
+)
+
+type testEnv struct {
+	t       *testing.T
+	db      database.DBManager
+	backend *backends.SimulatedBackend
+	opts    *bind.TransactOpts
+	module  *KaiaBridgeModule
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		key, _ = crypto.GenerateKey()
+		db     = database.NewMemoryDBManager()
+		alloc  = blockchain.GenesisAlloc{
+			crypto.PubkeyToAddress(key.PublicKey): {Balance: big.NewInt(params.KAIA)},
+			testBridgeAddr:                        {Code: common.FromHex(emitterCode), Balance: common.Big0},
+			testOperatorAddr:                      {Code: common.FromHex(emitterCode), Balance: common.Big0},
+		}
+		config = &params.ChainConfig{
+			ChainID:    common.Big1,
+			Governance: params.GetDefaultGovernanceConfig(),
+		}
+	)
+	backend := backends.NewSimulatedBackendWithDatabase(db, alloc, config)
+	t.Cleanup(func() { backend.Close() })
+
+	opts := bind.NewKeyedTransactor(key)
+	opts.GasLimit = 1_000_000
+
+	env := &testEnv{t: t, db: db, backend: backend, opts: opts}
+	env.module = env.newModule(0)
+	return env
+}
+
+func (env *testEnv) newModule(startBlock uint64) *KaiaBridgeModule {
+	m := NewKaiaBridgeModule()
+	require.NoError(env.t, m.Init(&InitOpts{
+		ChainKv:      env.db.GetMiscDB(),
+		Chain:        env.backend.BlockChain(),
+		BridgeAddr:   testBridgeAddr,
+		OperatorAddr: testOperatorAddr,
+		StartBlock:   startBlock,
+	}))
+	return m
+}
+
+// The events are encoded as declared in IBridge.sol and IOperator.sol, independently of the
+// contract ABI the watcher parses them with.
+var (
+	provisionSig                = "Provision((uint64,string,address,uint256,uint64,address))"
+	revocationSig               = "Revocation(address,uint64)"
+	provisionConfirmSig         = "ProvisionConfirm((uint64,string,address,uint256))"
+	removeProvisionSig          = "RemoveProvision((uint64,string,address,uint256))"
+	provisionReceiverChangedSig = "ProvisionReceiverChanged(address,address)"
+	claimSig                    = "Claim((uint64,string,address,uint256))"
+
+	provisionDataComponents = []abi.ArgumentMarshaling{
+		{Name: "seq", Type: "uint64"},
+		{Name: "sender", Type: "string"},
+		{Name: "receiver", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+	}
+	provisionIndividualComponents = append(append([]abi.ArgumentMarshaling{}, provisionDataComponents...),
+		abi.ArgumentMarshaling{Name: "txID", Type: "uint64"},
+		abi.ArgumentMarshaling{Name: "operator", Type: "address"},
+	)
+)
+
+// testProvisionData is IBridge.ProvisionData and IBridge.ProvisionConfirmedEvent.
+type testProvisionData struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// testProvisionIndividual is IBridge.ProvisionIndividualEvent.
+type testProvisionIndividual struct {
+	Seq      uint64
+	Sender   string
+	Receiver common.Address
+	Amount   *big.Int
+	TxID     uint64
+	Operator common.Address
+}
+
+// emit sends a transaction making the emitter at addr log the event of the signature,
+// with the topics and the tuple of the components as the log data.
+func (env *testEnv) emit(addr common.Address, sig string, topics []common.Hash, components []abi.ArgumentMarshaling, tuple interface{}) {
+	var data []byte
+	if components != nil {
+		typ, err := abi.NewType("tuple", "", components)
+		require.NoError(env.t, err)
+		data, err = abi.Arguments{{Type: typ}}.Pack(tuple)
+		require.NoError(env.t, err)
+	}
+
+	calldata := common.LeftPadBytes(big.NewInt(int64(len(topics)+1)).Bytes(), 32)
+	calldata = append(calldata, crypto.Keccak256([]byte(sig))...)
+	for _, topic := range topics {
+		calldata = append(calldata, topic.Bytes()...)
+	}
+	calldata = append(calldata, data...)
+
+	contract := bind.NewBoundContract(addr, abi.ABI{}, env.backend, env.backend, env.backend)
+	_, err := contract.RawTransact(env.opts, calldata)
+	require.NoError(env.t, err)
+}
+
+// commit mines the sent transactions and feeds the block to the module.
+func (env *testEnv) commit() uint64 {
+	env.backend.Commit()
+	block := env.backend.BlockChain().CurrentBlock()
+	require.NoError(env.t, env.module.PostInsertBlock(block))
+	return block.NumberU64()
+}
+
+func (env *testEnv) submit(operator common.Address, txID, seq uint64, sender string, receiver common.Address, amount int64) {
+	env.emit(testOperatorAddr, provisionSig, nil, provisionIndividualComponents, testProvisionIndividual{
+		Seq: seq, Sender: sender, Receiver: receiver, Amount: big.NewInt(amount), TxID: txID, Operator: operator,
+	})
+}
+
+func (env *testEnv) revoke(operator common.Address, txID uint64) {
+	env.emit(testOperatorAddr, revocationSig,
+		[]common.Hash{common.BytesToHash(operator.Bytes()), common.BigToHash(new(big.Int).SetUint64(txID))}, nil, nil)
+}
+
+func (env *testEnv) confirm(seq uint64, sender string, receiver common.Address, amount int64) {
+	env.emit(testBridgeAddr, provisionConfirmSig, nil, provisionDataComponents, testProvisionData{
+		Seq: seq, Sender: sender, Receiver: receiver, Amount: big.NewInt(amount),
+	})
+}
+
+func (env *testEnv) remove(seq uint64, sender string, receiver common.Address, amount int64) {
+	env.emit(testBridgeAddr, removeProvisionSig, nil, provisionDataComponents, testProvisionData{
+		Seq: seq, Sender: sender, Receiver: receiver, Amount: big.NewInt(amount),
+	})
+}
+
+func (env *testEnv) changeReceiver(before, after common.Address) {
+	env.emit(testBridgeAddr, provisionReceiverChangedSig,
+		[]common.Hash{common.BytesToHash(before.Bytes()), common.BytesToHash(after.Bytes())}, nil, nil)
+}
+
+// claim emits the Claim event, whose topic is the hash of the in-place encoded provision.
+func (env *testEnv) claim(seq uint64, sender string, receiver common.Address, amount int64) {
+	packed := common.LeftPadBytes(new(big.Int).SetUint64(seq).Bytes(), 32)
+	packed = append(packed, common.RightPadBytes([]byte(sender), (len(sender)+31)/32*32)...)
+	packed = append(packed, common.LeftPadBytes(receiver.Bytes(), 32)...)
+	packed = append(packed, common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)...)
+	env.emit(testBridgeAddr, claimSig, []common.Hash{crypto.Keccak256Hash(packed)}, nil, nil)
+}
+
+func seqs(provisions []*kaiax_kaiabridge.Provision) []uint64 {
+	ret := []uint64{}
+	for _, p := range provisions {
+		ret = append(ret, p.Seq)
+	}
+	return ret
+}
+
+func (env *testEnv) assertProvisions(unconfirmed, unclaimed []uint64, operator *common.Address) {
+	ps, err := env.module.GetUnconfirmedProvisions(operator)
+	assert.NoError(env.t, err)
+	assert.Equal(env.t, unconfirmed, seqs(ps), "unconfirmed")
+	ps, err = env.module.GetUnclaimedProvisions(operator)
+	assert.NoError(env.t, err)
+	assert.Equal(env.t, unclaimed, seqs(ps), "unclaimed")
+}
+
+func (env *testEnv) assertStatus(seq uint64, status kaiax_kaiabridge.ProvisionStatus) *kaiax_kaiabridge.Provision {
+	p, err := env.module.GetProvision(seq)
+	require.NoError(env.t, err)
+	assert.Equal(env.t, status, p.Status(), "seq %d", seq)
+	return p
+}
+
+func TestKaiaBridgeWatcher(t *testing.T) {
+	var (
+		env       = newTestEnv(t)
+		sender    = "link1zv3u5ffqpffpqvdvn6uadl8e3hqmeg8e6fzaqh"
+		op1, op2  = testOp1, testOp2
+		unknownOp = common.HexToAddress("0x3333")
+	)
+
+	// Operators submit 1, 2 and 3.
+	env.submit(op1, 10, 1, sender, testReceiver, 100)
+	env.submit(op2, 10, 1, sender, testReceiver, 100)
+	env.submit(op1, 11, 2, sender, testReceiver, 200)
+	env.submit(op1, 12, 3, sender, testReceiver, 300)
+	env.submit(op1, 12, 3, sender, testReceiver, 300) // duplicate
+	num1 := env.commit()
+
+	env.assertProvisions([]uint64{1, 2, 3}, []uint64{}, nil)
+	env.assertProvisions([]uint64{2, 3}, []uint64{}, &op2)
+	p := env.assertStatus(3, kaiax_kaiabridge.ProvisionSubmitted)
+	assert.Len(t, p.Submissions, 1)
+	assert.Equal(t, uint64(300), p.Amount.Uint64())
+
+	// op1 revokes 2, then the bridge confirms 1.
+	env.revoke(op1, 11)
+	env.confirm(1, sender, testReceiver, 100)
+	env.commit()
+
+	env.assertProvisions([]uint64{2, 3}, []uint64{1}, nil)
+	env.assertProvisions([]uint64{2, 3}, []uint64{}, &unknownOp)
+	env.assertProvisions([]uint64{2, 3}, []uint64{1}, &op2)
+	assert.Empty(t, env.assertStatus(2, kaiax_kaiabridge.ProvisionSubmitted).Submissions)
+
+	// 1 is claimed, 2 and 3 are confirmed.
+	env.claim(1, sender, testReceiver, 100)
+	env.confirm(2, sender, testReceiver, 200)
+	env.confirm(3, sender, testReceiver, 300)
+	num3 := env.commit()
+
+	assert.Equal(t, num3, env.assertStatus(1, kaiax_kaiabridge.ProvisionClaimed).ClaimedBlock)
+	env.assertProvisions([]uint64{}, []uint64{2, 3}, nil)
+
+	// The receiver of 2 is changed by the guardian and claimed, 3 is removed.
+	env.changeReceiver(testReceiver, testNewReceiver)
+	env.claim(2, sender, testNewReceiver, 200)
+	env.remove(3, sender, testReceiver, 300)
+	env.commit()
+
+	p = env.assertStatus(2, kaiax_kaiabridge.ProvisionClaimed)
+	assert.Equal(t, testNewReceiver, p.Receiver)
+	assert.Equal(t, testReceiver, p.PrevReceiver)
+	env.assertStatus(3, kaiax_kaiabridge.ProvisionRemoved)
+	env.assertProvisions([]uint64{}, []uint64{}, nil)
+
+	// 3 is provisioned again.
+	env.submit(op2, 13, 3, sender, testReceiver, 300)
+	env.commit()
+
+	p = env.assertStatus(3, kaiax_kaiabridge.ProvisionSubmitted)
+	assert.Len(t, p.Submissions, 1)
+	assert.Equal(t, op2, p.Submissions[0].Operator)
+
+	// The events from the other contracts are ignored.
+	env.emit(common.HexToAddress("0x000000000000000000000000000000000000cccc"), provisionConfirmSig, nil, provisionDataComponents, testProvisionData{
+		Seq: 3, Sender: sender, Receiver: testReceiver, Amount: big.NewInt(300),
+	})
+	last := env.commit()
+
+	status, err := env.module.GetBridgeStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, &kaiax_kaiabridge.BridgeStatus{
+		Bridge:               testBridgeAddr,
+		Operator:             testOperatorAddr,
+		LastBlock:            last,
+		GreatestSubmittedSeq: 3,
+		GreatestConfirmedSeq: 3,
+		NumUnconfirmed:       1,
+		NumUnclaimed:         0,
+	}, status)
+
+	_, err = env.module.GetProvision(4)
+	assert.Error(t, err)
+
+	// The state survives the restart.
+	env.module = env.newModule(0)
+	restarted, err := env.module.GetBridgeStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, status, restarted)
+	env.assertProvisions([]uint64{3}, []uint64{}, nil)
+
+	// The blocks already watched are skipped.
+	env.module.PostInsertBlock(env.backend.BlockChain().GetBlockByNumber(num1))
+	assert.Len(t, env.assertStatus(1, kaiax_kaiabridge.ProvisionClaimed).Submissions, 2)
+
+	// Rewind to the first block.
+	env.module.RewindTo(env.backend.BlockChain().GetBlockByNumber(num1))
+	status, err = env.module.GetBridgeStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, num1, status.LastBlock)
+	assert.Equal(t, uint64(3), status.GreatestSubmittedSeq)
+	assert.Equal(t, uint64(0), status.GreatestConfirmedSeq)
+	env.assertProvisions([]uint64{1, 2, 3}, []uint64{}, nil)
+	assert.Len(t, env.assertStatus(3, kaiax_kaiabridge.ProvisionSubmitted).Submissions, 1)
+}
+
+// waitLastBlock waits for the worker to catch up with the block.
+func (env *testEnv) waitLastBlock(num uint64) {
+	require.Eventually(env.t, func() bool {
+		status, err := env.module.GetBridgeStatus()
+		return err == nil && status.LastBlock == num
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestKaiaBridgeCatchUp(t *testing.T) {
+	var (
+		env    = newTestEnv(t)
+		sender = "link1zv3u5ffqpffpqvdvn6uadl8e3hqmeg8e6fzaqh"
+	)
+
+	// The events are emitted before the module is started.
+	env.submit(testOp1, 10, 1, sender, testReceiver, 100)
+	env.backend.Commit()
+	env.confirm(1, sender, testReceiver, 100)
+	env.backend.Commit()
+	env.submit(testOp1, 11, 2, sender, testReceiver, 200)
+	env.backend.Commit()
+
+	// The blocks before the start block are not watched.
+	env.module = env.newModule(3)
+	require.NoError(t, env.module.Start())
+	env.waitLastBlock(3)
+	env.module.Stop()
+	env.assertProvisions([]uint64{2}, []uint64{}, nil)
+	_, err := env.module.GetProvision(1)
+	assert.Error(t, err)
+
+	// The provisions are watched again from the earlier start block.
+	env.module = env.newModule(1)
+	require.NoError(t, env.module.Start())
+	defer env.module.Stop()
+	env.waitLastBlock(3)
+	env.assertProvisions([]uint64{2}, []uint64{1}, nil)
+	status, err := env.module.GetBridgeStatus()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), status.StartBlock)
+
+	// The blocks missed are caught up.
+	env.submit(testOp1, 12, 3, sender, testReceiver, 300)
+	env.backend.Commit()
+	env.backend.Commit()
+	require.NoError(t, env.module.PostInsertBlock(env.backend.BlockChain().CurrentBlock()))
+	env.waitLastBlock(5)
+	env.assertProvisions([]uint64{2, 3}, []uint64{1}, nil)
+}
+
+func TestKaiaBridgeUndoPruning(t *testing.T) {
+	defer func(retention uint64) { undoRetention = retention }(undoRetention)
+	undoRetention = 2
+
+	var (
+		env    = newTestEnv(t)
+		sender = "link1zv3u5ffqpffpqvdvn6uadl8e3hqmeg8e6fzaqh"
+		chain  = env.backend.BlockChain()
+	)
+	env.submit(testOp1, 10, 1, sender, testReceiver, 100)
+	env.commit()
+	env.confirm(1, sender, testReceiver, 100)
+	env.commit()
+	env.submit(testOp1, 11, 2, sender, testReceiver, 200)
+	env.commit()
+	env.submit(testOp1, 12, 3, sender, testReceiver, 300)
+	env.commit()
+
+	// Only the undo records of the last 2 blocks are kept.
+	nums, _ := ReadUndosAfter(env.db.GetMiscDB(), testBridgeAddr, 0)
+	assert.Equal(t, []uint64{3, 4}, nums)
+
+	// Rewinding within the undo records restores the provisions.
+	env.module.RewindTo(chain.GetBlockByNumber(2))
+	env.assertProvisions([]uint64{}, []uint64{1}, nil)
+
+	// Rewinding beyond them watches the provisions again from the start block.
+	env.module.RewindTo(chain.GetBlockByNumber(1))
+	_, err := env.module.GetProvision(1)
+	assert.Error(t, err)
+
+	require.NoError(t, env.module.Start())
+	defer env.module.Stop()
+	env.waitLastBlock(4)
+	env.assertProvisions([]uint64{2, 3}, []uint64{1}, nil)
+}
+
+// rewoundChain is the chain whose head is rewound while the module is not registered.
+type rewoundChain struct {
+	BlockChain
+	head uint64
+}
+
+func (c *rewoundChain) CurrentBlock() *types.Block {
+	return c.GetBlockByNumber(c.head)
+}
+
+func TestKaiaBridgeLoadAheadOfHead(t *testing.T) {
+	defer func(retention uint64) { undoRetention = retention }(undoRetention)
+	undoRetention = 2
+
+	var (
+		env    = newTestEnv(t)
+		sender = "link1zv3u5ffqpffpqvdvn6uadl8e3hqmeg8e6fzaqh"
+		chain  = env.backend.BlockChain()
+	)
+	env.submit(testOp1, 10, 1, sender, testReceiver, 100)
+	env.commit()
+	env.confirm(1, sender, testReceiver, 100)
+	env.commit()
+	env.submit(testOp1, 11, 2, sender, testReceiver, 200)
+	env.commit()
+
+	// The state ahead of the head is rewound by the undo records.
+	m := NewKaiaBridgeModule()
+	require.NoError(t, m.Init(&InitOpts{
+		ChainKv: env.db.GetMiscDB(), Chain: &rewoundChain{chain, 2}, BridgeAddr: testBridgeAddr, OperatorAddr: testOperatorAddr,
+	}))
+	assert.Equal(t, uint64(2), m.state.LastBlock)
+	env.module = m
+	env.assertProvisions([]uint64{}, []uint64{1}, nil)
+
+	// Beyond the undo records, the provisions are watched again from the start block.
+	m = NewKaiaBridgeModule()
+	require.NoError(t, m.Init(&InitOpts{
+		ChainKv: env.db.GetMiscDB(), Chain: &rewoundChain{chain, 0}, BridgeAddr: testBridgeAddr, OperatorAddr: testOperatorAddr,
+	}))
+	assert.Equal(t, uint64(0), m.state.LastBlock)
+	_, err := m.GetProvision(1)
+	assert.Error(t, err)
+}
+
+func TestKaiaBridgeInit(t *testing.T) {
+	m := NewKaiaBridgeModule()
+	assert.ErrorIs(t, m.Init(nil), kaiax_kaiabridge.ErrInitUnexpectedNil)
+
+	env := newTestEnv(t)
+	opts := &InitOpts{ChainKv: env.db.GetMiscDB(), Chain: env.backend.BlockChain(), OperatorAddr: testOperatorAddr}
+	assert.ErrorIs(t, m.Init(opts), kaiax_kaiabridge.ErrNoBridgeAddr)
+	opts.BridgeAddr, opts.OperatorAddr = testBridgeAddr, common.Address{}
+	assert.ErrorIs(t, m.Init(opts), kaiax_kaiabridge.ErrNoOperatorAddr)
+}
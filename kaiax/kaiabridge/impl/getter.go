@@ -0,0 +1,83 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/common"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+)
+
+func (k *KaiaBridgeModule) GetProvision(seq uint64) (*kaiax_kaiabridge.Provision, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if p, ok := k.pending[seq]; ok {
+		return copyProvision(p), nil
+	}
+	if p := ReadProvision(k.ChainKv, k.BridgeAddr, seq); p != nil {
+		return p, nil
+	}
+	return nil, kaiax_kaiabridge.ErrProvisionNotFound(seq)
+}
+
+func (k *KaiaBridgeModule) GetUnconfirmedProvisions(operator *common.Address) ([]*kaiax_kaiabridge.Provision, error) {
+	return k.filterPending(func(p *kaiax_kaiabridge.Provision) bool {
+		return p.ConfirmedBlock == 0 && (operator == nil || !p.SubmittedBy(*operator))
+	}), nil
+}
+
+func (k *KaiaBridgeModule) GetUnclaimedProvisions(operator *common.Address) ([]*kaiax_kaiabridge.Provision, error) {
+	return k.filterPending(func(p *kaiax_kaiabridge.Provision) bool {
+		return p.ConfirmedBlock != 0 && (operator == nil || p.SubmittedBy(*operator))
+	}), nil
+}
+
+func (k *KaiaBridgeModule) GetBridgeStatus() (*kaiax_kaiabridge.BridgeStatus, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	status := &kaiax_kaiabridge.BridgeStatus{
+		Bridge:               k.BridgeAddr,
+		Operator:             k.OperatorAddr,
+		StartBlock:           k.state.StartBlock,
+		LastBlock:            k.state.LastBlock,
+		GreatestSubmittedSeq: k.state.GreatestSubmittedSeq,
+		GreatestConfirmedSeq: k.state.GreatestConfirmedSeq,
+	}
+	for _, p := range k.pending {
+		if p.ConfirmedBlock == 0 {
+			status.NumUnconfirmed++
+		} else {
+			status.NumUnclaimed++
+		}
+	}
+	return status, nil
+}
+
+// filterPending returns the copies of the pending provisions that pass the filter, sorted by the sequence number.
+func (k *KaiaBridgeModule) filterPending(filter func(*kaiax_kaiabridge.Provision) bool) []*kaiax_kaiabridge.Provision {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	provisions := []*kaiax_kaiabridge.Provision{}
+	for _, p := range k.sortedPending() {
+		if filter(p) {
+			provisions = append(provisions, copyProvision(p))
+		}
+	}
+	return provisions
+}
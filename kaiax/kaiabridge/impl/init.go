@@ -0,0 +1,164 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"sync"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/contracts/contracts/system_contracts/kaiabridge"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var (
+	_ kaiax_kaiabridge.KaiaBridgeModule = &KaiaBridgeModule{}
+
+	logger = log.NewModuleLogger(log.KaiaxKaiaBridge)
+)
+
+type BlockChain interface {
+	CurrentBlock() *types.Block
+	GetBlockByNumber(number uint64) *types.Block
+	GetReceiptsByBlockHash(blockHash common.Hash) types.Receipts
+}
+
+type InitOpts struct {
+	ChainKv      database.Database
+	Chain        BlockChain
+	BridgeAddr   common.Address
+	OperatorAddr common.Address
+	StartBlock   uint64 // The blocks before are not watched, e.g. before the bridge is deployed
+}
+
+type KaiaBridgeModule struct {
+	InitOpts
+
+	bridge   *kaiabridge.IBridgeFilterer
+	operator *kaiabridge.IOperatorFilterer
+
+	// The state and the pending (neither claimed nor removed) provisions, loaded at Init
+	// and updated as the blocks are inserted. Guarded by mu.
+	mu      sync.RWMutex
+	state   *storedState
+	pending map[uint64]*kaiax_kaiabridge.Provision
+
+	// The blocks not watched yet are caught up in the background.
+	catchUpCh chan struct{}
+	quit      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func NewKaiaBridgeModule() *KaiaBridgeModule {
+	return &KaiaBridgeModule{
+		pending: make(map[uint64]*kaiax_kaiabridge.Provision),
+	}
+}
+
+func (k *KaiaBridgeModule) Init(opts *InitOpts) error {
+	if opts == nil || opts.ChainKv == nil || opts.Chain == nil {
+		return kaiax_kaiabridge.ErrInitUnexpectedNil
+	}
+	if common.EmptyAddress(opts.BridgeAddr) {
+		return kaiax_kaiabridge.ErrNoBridgeAddr
+	}
+	if common.EmptyAddress(opts.OperatorAddr) {
+		return kaiax_kaiabridge.ErrNoOperatorAddr
+	}
+	k.InitOpts = *opts
+
+	// The filterers are only used to parse the logs, hence no backend.
+	bridge, err := kaiabridge.NewIBridgeFilterer(opts.BridgeAddr, nil)
+	if err != nil {
+		return err
+	}
+	operator, err := kaiabridge.NewIOperatorFilterer(opts.OperatorAddr, nil)
+	if err != nil {
+		return err
+	}
+	k.bridge, k.operator = bridge, operator
+
+	return k.load()
+}
+
+// load reads the persisted state and the pending provisions of the bridge. If the state
+// was built from a later block than the start block, it is built again from the start block.
+// If the state is ahead of the chain head, e.g. the head was rewound while the module was
+// not registered, the provisions are rewound to the head.
+func (k *KaiaBridgeModule) load() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.state = ReadState(k.ChainKv, k.BridgeAddr)
+	if k.state != nil && k.state.StartBlock > k.StartBlock {
+		logger.Warn("Rebuilding kaiabridge provisions from the start block", "from", k.state.StartBlock, "to", k.StartBlock)
+		k.state = nil
+	}
+	if k.state == nil {
+		k.reset()
+	}
+	k.loadPending()
+	if head := k.Chain.CurrentBlock(); head != nil && k.state.LastBlock > head.NumberU64() {
+		logger.Warn("Rewinding kaiabridge provisions to the chain head", "lastBlock", k.state.LastBlock, "head", head.NumberU64())
+		k.rewind(head.NumberU64())
+	}
+	return nil
+}
+
+// reset deletes the persisted provisions, so that they are watched again from the start block.
+func (k *KaiaBridgeModule) reset() {
+	DeleteAll(k.ChainKv, k.BridgeAddr)
+
+	k.state = &storedState{StartBlock: k.StartBlock, UndoFrom: k.StartBlock}
+	if k.StartBlock > 0 {
+		k.state.LastBlock = k.StartBlock - 1
+	}
+}
+
+func (k *KaiaBridgeModule) loadPending() {
+	k.pending = make(map[uint64]*kaiax_kaiabridge.Provision, len(k.state.Pending))
+	for _, seq := range k.state.Pending {
+		p := ReadProvision(k.ChainKv, k.BridgeAddr, seq)
+		if p == nil {
+			logger.Error("Pending provision is missing", "seq", seq)
+			continue
+		}
+		k.pending[seq] = p
+	}
+}
+
+func (k *KaiaBridgeModule) Start() error {
+	logger.Info("Watching kaiabridge", "bridge", k.BridgeAddr, "operator", k.OperatorAddr,
+		"startBlock", k.state.StartBlock, "lastBlock", k.state.LastBlock, "pending", len(k.pending))
+
+	k.catchUpCh = make(chan struct{}, 1)
+	k.quit = make(chan struct{})
+	k.wg.Add(1)
+	go k.catchUpLoop(k.catchUpCh, k.quit)
+	k.catchUpCh <- struct{}{}
+	return nil
+}
+
+func (k *KaiaBridgeModule) Stop() {
+	if k.quit != nil {
+		close(k.quit)
+		k.wg.Wait()
+		k.quit = nil
+	}
+}
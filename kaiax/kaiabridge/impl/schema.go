@@ -0,0 +1,190 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"encoding/binary"
+
+	"github.com/kaiachain/kaia/common"
+	kaiax_kaiabridge "github.com/kaiachain/kaia/kaiax/kaiabridge"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var (
+	statePrefix     = []byte("kaiabridgeState")
+	provisionPrefix = []byte("kaiabridgeProvision")
+	undoPrefix      = []byte("kaiabridgeUndo")
+)
+
+// The keys are prefixed by the bridge address, so that switching the bridge does not
+// mix up the sequence numbers.
+func stateKey(bridge common.Address) []byte {
+	return append(append([]byte{}, statePrefix...), bridge.Bytes()...)
+}
+
+func provisionKey(bridge common.Address, seq uint64) []byte {
+	key := append(append([]byte{}, provisionPrefix...), bridge.Bytes()...)
+	return append(key, common.Int64ToByteBigEndian(seq)...)
+}
+
+func undoKeyPrefix(bridge common.Address) []byte {
+	return append(append([]byte{}, undoPrefix...), bridge.Bytes()...)
+}
+
+// undoKey is big-endian, so that the undo records are iterated in the block order.
+func undoKey(bridge common.Address, num uint64) []byte {
+	return append(undoKeyPrefix(bridge), common.Int64ToByteBigEndian(num)...)
+}
+
+// storedState is the sequence state of the bridge seen by the watcher.
+type storedState struct {
+	StartBlock           uint64 // The first block watched
+	UndoFrom             uint64 // The first block whose undo record is kept
+	LastBlock            uint64
+	GreatestSubmittedSeq uint64
+	GreatestConfirmedSeq uint64
+	Pending              []uint64 // The provisions neither claimed nor removed, sorted
+}
+
+// storedUndo is what a block has changed, to be restored when the block is rewound.
+type storedUndo struct {
+	State      storedState // The state before the block
+	Seqs       []uint64
+	Provisions [][]byte // The encoded provisions before the block, empty if not existed
+}
+
+func ReadState(db database.Database, bridge common.Address) *storedState {
+	b, err := db.Get(stateKey(bridge))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	var state storedState
+	if err := rlp.DecodeBytes(b, &state); err != nil {
+		logger.Error("Malformed kaiabridge state", "bridge", bridge, "err", err)
+		return nil
+	}
+	return &state
+}
+
+func WriteState(db database.KeyValueWriter, bridge common.Address, state *storedState) {
+	b, err := rlp.EncodeToBytes(state)
+	if err != nil {
+		logger.Error("Failed to encode kaiabridge state", "bridge", bridge, "err", err)
+		return
+	}
+	if err := db.Put(stateKey(bridge), b); err != nil {
+		logger.Crit("Failed to write kaiabridge state", "bridge", bridge, "err", err)
+	}
+}
+
+func ReadProvision(db database.Database, bridge common.Address, seq uint64) *kaiax_kaiabridge.Provision {
+	b, err := db.Get(provisionKey(bridge, seq))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+	return decodeProvision(b, seq)
+}
+
+func decodeProvision(b []byte, seq uint64) *kaiax_kaiabridge.Provision {
+	var p kaiax_kaiabridge.Provision
+	if err := rlp.DecodeBytes(b, &p); err != nil || p.Seq != seq {
+		logger.Error("Malformed kaiabridge provision", "seq", seq, "err", err)
+		return nil
+	}
+	return &p
+}
+
+func WriteProvision(db database.KeyValueWriter, bridge common.Address, p *kaiax_kaiabridge.Provision) {
+	b, err := rlp.EncodeToBytes(p)
+	if err != nil {
+		logger.Error("Failed to encode kaiabridge provision", "seq", p.Seq, "err", err)
+		return
+	}
+	if err := db.Put(provisionKey(bridge, p.Seq), b); err != nil {
+		logger.Crit("Failed to write kaiabridge provision", "seq", p.Seq, "err", err)
+	}
+}
+
+func DeleteProvision(db database.KeyValueWriter, bridge common.Address, seq uint64) {
+	if err := db.Delete(provisionKey(bridge, seq)); err != nil {
+		logger.Crit("Failed to delete kaiabridge provision", "seq", seq, "err", err)
+	}
+}
+
+func WriteUndo(db database.KeyValueWriter, bridge common.Address, num uint64, undo *storedUndo) {
+	b, err := rlp.EncodeToBytes(undo)
+	if err != nil {
+		logger.Error("Failed to encode kaiabridge undo", "num", num, "err", err)
+		return
+	}
+	if err := db.Put(undoKey(bridge, num), b); err != nil {
+		logger.Crit("Failed to write kaiabridge undo", "num", num, "err", err)
+	}
+}
+
+// ReadUndosAfter reads the undo records of the blocks after num, in the block order.
+func ReadUndosAfter(db database.Database, bridge common.Address, num uint64) ([]uint64, []*storedUndo) {
+	prefix := undoKeyPrefix(bridge)
+	it := db.NewIterator(prefix, common.Int64ToByteBigEndian(num+1))
+	defer it.Release()
+
+	var (
+		nums  []uint64
+		undos []*storedUndo
+	)
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+8 {
+			continue
+		}
+		var undo storedUndo
+		if err := rlp.DecodeBytes(it.Value(), &undo); err != nil || len(undo.Seqs) != len(undo.Provisions) {
+			logger.Error("Malformed kaiabridge undo", "key", it.Key(), "err", err)
+			continue
+		}
+		nums = append(nums, binary.BigEndian.Uint64(it.Key()[len(prefix):]))
+		undos = append(undos, &undo)
+	}
+	return nums, undos
+}
+
+func DeleteUndo(db database.KeyValueWriter, bridge common.Address, num uint64) {
+	if err := db.Delete(undoKey(bridge, num)); err != nil {
+		logger.Crit("Failed to delete kaiabridge undo", "num", num, "err", err)
+	}
+}
+
+// DeleteAll deletes the state, the provisions and the undo records of the bridge.
+func DeleteAll(db database.Database, bridge common.Address) {
+	batch := db.NewBatch()
+	for _, prefix := range [][]byte{provisionPrefix, undoPrefix} {
+		it := db.NewIterator(append(append([]byte{}, prefix...), bridge.Bytes()...), nil)
+		for it.Next() {
+			if err := batch.Delete(common.CopyBytes(it.Key())); err != nil {
+				logger.Crit("Failed to delete kaiabridge records", "key", it.Key(), "err", err)
+			}
+		}
+		it.Release()
+	}
+	if err := batch.Delete(stateKey(bridge)); err != nil {
+		logger.Crit("Failed to delete kaiabridge state", "bridge", bridge, "err", err)
+	}
+	if err := batch.Write(); err != nil {
+		logger.Crit("Failed to delete kaiabridge records", "bridge", bridge, "err", err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package kaiabridge
+
+import (
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/kaiax"
+)
+
+type KaiaBridgeModule interface {
+	kaiax.BaseModule
+	kaiax.JsonRpcModule
+	kaiax.ExecutionModule
+	kaiax.RewindableModule
+
+	// GetProvision returns the provision of the sequence number seen by the watcher.
+	GetProvision(seq uint64) (*Provision, error)
+
+	// GetUnconfirmedProvisions returns the provisions submitted by the operators but not
+	// confirmed by the bridge yet, sorted by the sequence number. If the operator is given,
+	// only the ones the operator has not submitted are returned.
+	GetUnconfirmedProvisions(operator *common.Address) ([]*Provision, error)
+
+	// GetUnclaimedProvisions returns the provisions confirmed by the bridge but not claimed
+	// yet, sorted by the sequence number. If the operator is given, only the ones the operator
+	// has submitted are returned.
+	GetUnclaimedProvisions(operator *common.Address) ([]*Provision, error)
+
+	// GetBridgeStatus returns the sequence state of the bridge seen by the watcher.
+	GetBridgeStatus() (*BridgeStatus, error)
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package kaiabridge
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+)
+
+type ProvisionStatus string
+
+const (
+	ProvisionSubmitted ProvisionStatus = "submitted" // Submitted by the operators, not confirmed yet
+	ProvisionConfirmed ProvisionStatus = "confirmed" // Confirmed by the bridge, not claimed yet
+	ProvisionClaimed   ProvisionStatus = "claimed"
+	ProvisionRemoved   ProvisionStatus = "removed" // Removed by the guardian after confirmed
+)
+
+// Submission is an operator's confirmation of a provision in the operator contract.
+type Submission struct {
+	Operator common.Address `json:"operator"`
+	TxID     uint64         `json:"txID"` // The transaction ID in the operator contract
+	Block    uint64         `json:"block"`
+}
+
+// Provision is a token swap from FNSA to KAIA, tracked by its sequence number.
+type Provision struct {
+	Seq      uint64         `json:"seq"`
+	Sender   string         `json:"sender"` // FNSA address
+	Receiver common.Address `json:"receiver"`
+	Amount   *big.Int       `json:"amount"`
+
+	Submissions    []*Submission  `json:"submissions"`
+	ConfirmedBlock uint64         `json:"confirmedBlock"` // Zero if not confirmed
+	ClaimedBlock   uint64         `json:"claimedBlock"`   // Zero if not claimed
+	RemovedBlock   uint64         `json:"removedBlock"`   // Zero if not removed
+	PrevReceiver   common.Address `json:"prevReceiver"`   // The receiver before resolved by the guardian, if any
+}
+
+func (p *Provision) Status() ProvisionStatus {
+	switch {
+	case p.RemovedBlock != 0:
+		return ProvisionRemoved
+	case p.ClaimedBlock != 0:
+		return ProvisionClaimed
+	case p.ConfirmedBlock != 0:
+		return ProvisionConfirmed
+	default:
+		return ProvisionSubmitted
+	}
+}
+
+// Pending is true if the provision is neither claimed nor removed.
+func (p *Provision) Pending() bool {
+	return p.ClaimedBlock == 0 && p.RemovedBlock == 0
+}
+
+func (p *Provision) SubmittedBy(operator common.Address) bool {
+	for _, s := range p.Submissions {
+		if s.Operator == operator {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSubmission records the operator's submission. It returns false if already recorded.
+func (p *Provision) AddSubmission(operator common.Address, txID, block uint64) bool {
+	for _, s := range p.Submissions {
+		if s.Operator == operator && s.TxID == txID {
+			return false
+		}
+	}
+	p.Submissions = append(p.Submissions, &Submission{Operator: operator, TxID: txID, Block: block})
+	return true
+}
+
+// RevokeSubmission removes the operator's submission. It returns false if not found.
+func (p *Provision) RevokeSubmission(operator common.Address, txID uint64) bool {
+	for i, s := range p.Submissions {
+		if s.Operator == operator && s.TxID == txID {
+			p.Submissions = append(p.Submissions[:i], p.Submissions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ClaimTopic returns the topic of the Claim event of the provision paid to the receiver.
+func (p *Provision) ClaimTopic(receiver common.Address) common.Hash {
+	return ClaimTopic(p.Seq, p.Sender, receiver, p.Amount)
+}
+
+func (p *Provision) MarshalJSON() ([]byte, error) {
+	type provision Provision
+	return json.Marshal(&struct {
+		*provision
+		Status ProvisionStatus `json:"status"`
+	}{(*provision)(p), p.Status()})
+}
+
+// ClaimTopic returns the topic of the Claim event. Since the event indexes the whole
+// ProvisionData struct, the topic is the hash of the struct's in-place encoding, where
+// every member is padded to a multiple of 32 bytes and the string has no length prefix.
+// It cannot be decoded, but can be matched against the known provisions.
+func ClaimTopic(seq uint64, sender string, receiver common.Address, amount *big.Int) common.Hash {
+	senderLen := (len(sender) + 31) / 32 * 32
+	buf := make([]byte, 0, 96+senderLen)
+	buf = append(buf, common.LeftPadBytes(new(big.Int).SetUint64(seq).Bytes(), 32)...)
+	buf = append(buf, common.RightPadBytes([]byte(sender), senderLen)...)
+	buf = append(buf, common.LeftPadBytes(receiver.Bytes(), 32)...)
+	buf = append(buf, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// BridgeStatus is the sequence state of the bridge seen by the watcher.
+type BridgeStatus struct {
+	Bridge               common.Address `json:"bridge"`
+	Operator             common.Address `json:"operator"`
+	StartBlock           uint64         `json:"startBlock"` // The first block watched
+	LastBlock            uint64         `json:"lastBlock"`  // The last block watched
+	GreatestSubmittedSeq uint64         `json:"greatestSubmittedSeq"`
+	GreatestConfirmedSeq uint64         `json:"greatestConfirmedSeq"`
+	NumUnconfirmed       int            `json:"numUnconfirmed"`
+	NumUnclaimed         int            `json:"numUnclaimed"`
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package kaiabridge
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvisionStatus(t *testing.T) {
+	var (
+		op1 = common.HexToAddress("0x1111")
+		op2 = common.HexToAddress("0x2222")
+		p   = &Provision{Seq: 1, Sender: "link1sender", Receiver: common.HexToAddress("0xaaaa"), Amount: big.NewInt(100)}
+	)
+	assert.Equal(t, ProvisionSubmitted, p.Status())
+
+	assert.True(t, p.AddSubmission(op1, 10, 1))
+	assert.False(t, p.AddSubmission(op1, 10, 2)) // duplicate
+	assert.True(t, p.AddSubmission(op2, 10, 2))
+	assert.True(t, p.SubmittedBy(op1))
+	assert.True(t, p.RevokeSubmission(op1, 10))
+	assert.False(t, p.RevokeSubmission(op1, 10))
+	assert.False(t, p.SubmittedBy(op1))
+	assert.True(t, p.SubmittedBy(op2))
+
+	p.ConfirmedBlock = 3
+	assert.Equal(t, ProvisionConfirmed, p.Status())
+	assert.True(t, p.Pending())
+
+	p.ClaimedBlock = 4
+	assert.Equal(t, ProvisionClaimed, p.Status())
+	assert.False(t, p.Pending())
+
+	p.ClaimedBlock, p.RemovedBlock = 0, 5
+	assert.Equal(t, ProvisionRemoved, p.Status())
+	assert.False(t, p.Pending())
+
+	b, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"status":"removed"`)
+	assert.Contains(t, string(b), `"seq":1`)
+}
+
+func TestClaimTopic(t *testing.T) {
+	receiver := common.HexToAddress("0x000000000000000000000000000000000000aaaa")
+	testcases := []struct {
+		sender string
+		packed string // The in-place encoding of (seq=7, sender, receiver, amount=1000)
+	}{
+		{
+			"link1short",
+			"0000000000000000000000000000000000000000000000000000000000000007" +
+				"6c696e6b3173686f727400000000000000000000000000000000000000000000" +
+				"000000000000000000000000000000000000000000000000000000000000aaaa" +
+				"00000000000000000000000000000000000000000000000000000000000003e8",
+		},
+		{
+			"link1" + strings.Repeat("q", 34), // 39 bytes, padded to 64
+			"0000000000000000000000000000000000000000000000000000000000000007" +
+				"6c696e6b31717171717171717171717171717171717171717171717171717171" +
+				"7171717171717100000000000000000000000000000000000000000000000000" +
+				"000000000000000000000000000000000000000000000000000000000000aaaa" +
+				"00000000000000000000000000000000000000000000000000000000000003e8",
+		},
+	}
+	for _, tc := range testcases {
+		expected := crypto.Keccak256Hash(common.FromHex(tc.packed))
+		assert.Equal(t, expected, ClaimTopic(7, tc.sender, receiver, big.NewInt(1000)), tc.sender)
+
+		p := &Provision{Seq: 7, Sender: tc.sender, Receiver: receiver, Amount: big.NewInt(1000)}
+		assert.Equal(t, expected, p.ClaimTopic(receiver), tc.sender)
+		assert.NotEqual(t, expected, p.ClaimTopic(common.HexToAddress("0xbbbb")), tc.sender)
+	}
+}
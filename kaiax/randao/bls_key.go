@@ -0,0 +1,196 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package randao
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/crypto/bls"
+)
+
+type BlsKeyState string
+
+const (
+	BlsKeyValid   BlsKeyState = "valid"
+	BlsKeyMissing BlsKeyState = "missing" // Not registered in the KIP-113 registry
+	BlsKeyInvalid BlsKeyState = "invalid" // Malformed public key or proof-of-possession
+)
+
+// BlsKeyStatus is the status of a council member's BLS public key in the KIP-113 registry.
+type BlsKeyStatus struct {
+	NodeId    common.Address `json:"nodeId"`
+	State     BlsKeyState    `json:"state"`
+	PublicKey hexutil.Bytes  `json:"publicKey,omitempty"`
+	Pop       hexutil.Bytes  `json:"pop,omitempty"`
+	VerifyErr string         `json:"verifyErr,omitempty"`
+}
+
+// BlsKeyReport is the status of the BLS public keys of the council at a block.
+type BlsKeyReport struct {
+	Number   uint64          `json:"number"`   // The block whose state the registry is read at
+	Registry common.Address  `json:"registry"` // The KIP-113 contract address
+	Council  []*BlsKeyStatus `json:"council"`  // Sorted by node ID
+	Missing  int             `json:"missing"`
+	Invalid  int             `json:"invalid"`
+}
+
+// Ok returns true if every council member has a valid BLS public key.
+func (r *BlsKeyReport) Ok() bool {
+	return r.Missing == 0 && r.Invalid == 0
+}
+
+func (r *BlsKeyReport) status(nodeId common.Address) *BlsKeyStatus {
+	for _, s := range r.Council {
+		if s.NodeId == nodeId {
+			return s
+		}
+	}
+	return nil
+}
+
+// MakeBlsKeyReport checks the BLS public keys of the council in the KIP-113 registry.
+func MakeBlsKeyReport(num uint64, registry common.Address, council []common.Address, infos system.BlsPublicKeyInfos) *BlsKeyReport {
+	report := &BlsKeyReport{
+		Number:   num,
+		Registry: registry,
+		Council:  make([]*BlsKeyStatus, 0, len(council)),
+	}
+	for _, nodeId := range council {
+		status := &BlsKeyStatus{NodeId: nodeId, State: BlsKeyValid}
+		if info, ok := infos[nodeId]; !ok {
+			status.State = BlsKeyMissing
+			report.Missing++
+		} else {
+			status.PublicKey = info.PublicKey
+			status.Pop = info.Pop
+			if info.VerifyErr != nil {
+				status.State = BlsKeyInvalid
+				status.VerifyErr = info.VerifyErr.Error()
+				report.Invalid++
+			}
+		}
+		report.Council = append(report.Council, status)
+	}
+	sort.Slice(report.Council, func(i, j int) bool {
+		return bytes.Compare(report.Council[i].NodeId.Bytes(), report.Council[j].NodeId.Bytes()) < 0
+	})
+	return report
+}
+
+type BlsKeyAlertKind string
+
+const (
+	BlsKeyAlertMissing BlsKeyAlertKind = "missing"
+	BlsKeyAlertInvalid BlsKeyAlertKind = "invalid"
+	BlsKeyAlertChanged BlsKeyAlertKind = "changed"
+)
+
+// BlsKeyAlert is raised when a council member's BLS public key becomes missing or invalid, or is changed.
+type BlsKeyAlert struct {
+	Number        uint64          `json:"number"`
+	NodeId        common.Address  `json:"nodeId"`
+	Kind          BlsKeyAlertKind `json:"kind"`
+	PublicKey     hexutil.Bytes   `json:"publicKey,omitempty"`
+	PrevPublicKey hexutil.Bytes   `json:"prevPublicKey,omitempty"`
+	VerifyErr     string          `json:"verifyErr,omitempty"`
+}
+
+// DiffBlsKeyReports returns the alerts raised by the transition from prev to curr.
+// If prev is nil, every missing or invalid key in curr raises an alert.
+// A problem is alerted only once; it is alerted again only after it is fixed.
+func DiffBlsKeyReports(prev, curr *BlsKeyReport) []*BlsKeyAlert {
+	var alerts []*BlsKeyAlert
+	for _, c := range curr.Council {
+		var p *BlsKeyStatus
+		if prev != nil {
+			p = prev.status(c.NodeId)
+		}
+
+		alert := &BlsKeyAlert{
+			Number:    curr.Number,
+			NodeId:    c.NodeId,
+			PublicKey: c.PublicKey,
+			VerifyErr: c.VerifyErr,
+		}
+		if p != nil {
+			alert.PrevPublicKey = p.PublicKey
+		}
+
+		switch c.State {
+		case BlsKeyMissing:
+			if p == nil || p.State != BlsKeyMissing {
+				alert.Kind = BlsKeyAlertMissing
+			}
+		case BlsKeyInvalid:
+			if p == nil || p.State != BlsKeyInvalid || !bytes.Equal(p.PublicKey, c.PublicKey) {
+				alert.Kind = BlsKeyAlertInvalid
+			}
+		case BlsKeyValid:
+			if p != nil && p.State != BlsKeyMissing && !bytes.Equal(p.PublicKey, c.PublicKey) {
+				alert.Kind = BlsKeyAlertChanged
+			}
+		}
+		if alert.Kind != "" {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+// LocalBlsKeyCheck is the result of checking the BLS key of this node against the KIP-113 registry.
+type LocalBlsKeyCheck struct {
+	Number              uint64         `json:"number"`
+	NodeId              common.Address `json:"nodeId"`
+	PublicKey           hexutil.Bytes  `json:"publicKey"` // The public key of the local BLS secret key
+	Pop                 hexutil.Bytes  `json:"pop"`       // The proof-of-possession to register
+	InCouncil           bool           `json:"inCouncil"`
+	Registered          bool           `json:"registered"`
+	RegisteredPublicKey hexutil.Bytes  `json:"registeredPublicKey,omitempty"`
+	Match               bool           `json:"match"` // The registered public key is the local one
+	VerifyErr           string         `json:"verifyErr,omitempty"`
+	Ok                  bool           `json:"ok"` // Registered, matched and verified
+}
+
+// CheckLocalBlsKey checks the local BLS key against the KIP-113 registry.
+func CheckLocalBlsKey(num uint64, nodeId common.Address, sk bls.SecretKey, council []common.Address, infos system.BlsPublicKeyInfos) *LocalBlsKeyCheck {
+	check := &LocalBlsKeyCheck{
+		Number:    num,
+		NodeId:    nodeId,
+		PublicKey: sk.PublicKey().Marshal(),
+		Pop:       bls.PopProve(sk).Marshal(),
+	}
+	for _, addr := range council {
+		if addr == nodeId {
+			check.InCouncil = true
+			break
+		}
+	}
+	if info, ok := infos[nodeId]; ok {
+		check.Registered = true
+		check.RegisteredPublicKey = info.PublicKey
+		check.Match = bytes.Equal(info.PublicKey, check.PublicKey)
+		if info.VerifyErr != nil {
+			check.VerifyErr = info.VerifyErr.Error()
+		}
+	}
+	check.Ok = check.Registered && check.Match && check.VerifyErr == ""
+	return check
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package randao
+
+import (
+	"testing"
+
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto/bls"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlsInfo(t *testing.T) (bls.SecretKey, system.BlsPublicKeyInfo) {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	return sk, system.BlsPublicKeyInfo{
+		PublicKey: sk.PublicKey().Marshal(),
+		Pop:       bls.PopProve(sk).Marshal(),
+	}
+}
+
+func TestBlsKeyReport(t *testing.T) {
+	var (
+		n1 = common.HexToAddress("0x01")
+		n2 = common.HexToAddress("0x02")
+		n3 = common.HexToAddress("0x03")
+		n4 = common.HexToAddress("0x04")
+
+		_, info1   = newTestBlsInfo(t)
+		_, info2   = newTestBlsInfo(t)
+		_, info2b  = newTestBlsInfo(t)
+		_, invalid = newTestBlsInfo(t)
+	)
+	invalid.VerifyErr = system.ErrKip113BadPop
+	council := []common.Address{n3, n2, n1}
+
+	// n3 is missing from the beginning. n4 is registered but not in the council.
+	report1 := MakeBlsKeyReport(10, common.Address{}, council, system.BlsPublicKeyInfos{n1: info1, n2: info2, n4: info1})
+	require.Len(t, report1.Council, 3)
+	assert.Equal(t, n1, report1.Council[0].NodeId)
+	assert.Equal(t, BlsKeyMissing, report1.Council[2].State)
+	assert.Equal(t, 1, report1.Missing)
+	assert.False(t, report1.Ok())
+	alerts := DiffBlsKeyReports(nil, report1)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, n3, alerts[0].NodeId)
+	assert.Equal(t, BlsKeyAlertMissing, alerts[0].Kind)
+
+	// Nothing changed, so no alert again.
+	report2 := MakeBlsKeyReport(11, common.Address{}, council, system.BlsPublicKeyInfos{n1: info1, n2: info2})
+	assert.Empty(t, DiffBlsKeyReports(report1, report2))
+
+	// n1 becomes invalid, n2 changes its key, n3 registers its key.
+	report3 := MakeBlsKeyReport(12, common.Address{}, council, system.BlsPublicKeyInfos{n1: invalid, n2: info2b, n3: info1})
+	assert.Equal(t, 0, report3.Missing)
+	assert.Equal(t, 1, report3.Invalid)
+	alerts = DiffBlsKeyReports(report2, report3)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, &BlsKeyAlert{
+		Number: 12, NodeId: n1, Kind: BlsKeyAlertInvalid,
+		PublicKey: invalid.PublicKey, PrevPublicKey: info1.PublicKey, VerifyErr: system.ErrKip113BadPop.Error(),
+	}, alerts[0])
+	assert.Equal(t, &BlsKeyAlert{
+		Number: 12, NodeId: n2, Kind: BlsKeyAlertChanged,
+		PublicKey: info2b.PublicKey, PrevPublicKey: info2.PublicKey,
+	}, alerts[1])
+
+	// n1 removes its key.
+	report4 := MakeBlsKeyReport(13, common.Address{}, council, system.BlsPublicKeyInfos{n2: info2b, n3: info1})
+	alerts = DiffBlsKeyReports(report3, report4)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, BlsKeyAlertMissing, alerts[0].Kind)
+}
+
+func TestCheckLocalBlsKey(t *testing.T) {
+	var (
+		nodeId     = common.HexToAddress("0x01")
+		sk, info   = newTestBlsInfo(t)
+		_, another = newTestBlsInfo(t)
+		council    = []common.Address{nodeId}
+	)
+
+	check := CheckLocalBlsKey(10, nodeId, sk, council, system.BlsPublicKeyInfos{nodeId: info})
+	assert.True(t, check.Ok)
+	assert.True(t, check.InCouncil)
+	assert.Equal(t, info.PublicKey, []byte(check.PublicKey))
+	assert.Equal(t, info.Pop, []byte(check.Pop))
+
+	// Not registered
+	check = CheckLocalBlsKey(10, nodeId, sk, nil, system.BlsPublicKeyInfos{})
+	assert.False(t, check.Ok)
+	assert.False(t, check.InCouncil)
+	assert.False(t, check.Registered)
+
+	// Registered with another key
+	check = CheckLocalBlsKey(10, nodeId, sk, council, system.BlsPublicKeyInfos{nodeId: another})
+	assert.False(t, check.Ok)
+	assert.True(t, check.Registered)
+	assert.False(t, check.Match)
+
+	// Registered with a bad proof-of-possession
+	info.VerifyErr = system.ErrKip113BadPop
+	check = CheckLocalBlsKey(10, nodeId, sk, council, system.BlsPublicKeyInfos{nodeId: info})
+	assert.False(t, check.Ok)
+	assert.True(t, check.Match)
+	assert.NotEmpty(t, check.VerifyErr)
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kaiachain/kaia/kaiax/randao"
+	"github.com/kaiachain/kaia/networks/rpc"
+)
+
+func (r *RandaoModule) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "kaia",
+			Version:   "1.0",
+			Service:   newRandaoAPI(r),
+			Public:    true,
+		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   newRandaoAdminAPI(r),
+			Public:    false,
+		},
+	}
+}
+
+// alertChanSize is the number of the alerts buffered for a subscription.
+const alertChanSize = 64
+
+type randaoAPI struct {
+	r *RandaoModule
+}
+
+func newRandaoAPI(r *RandaoModule) *randaoAPI {
+	return &randaoAPI{r}
+}
+
+// GetBlsKeyReport returns the status of the council's BLS public keys in the KIP-113 registry
+// at the state of the given block, which are used to verify the next block.
+func (api *randaoAPI) GetBlsKeyReport(num rpc.BlockNumber) (*randao.BlsKeyReport, error) {
+	return api.r.GetBlsKeyReport(resolveBlockNumber(api.r, num))
+}
+
+// BlsKeyAlerts creates a subscription that is notified when a council member's BLS
+// public key becomes missing or invalid, or is changed.
+func (api *randaoAPI) BlsKeyAlerts(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		alerts := make(chan *randao.BlsKeyAlert, alertChanSize)
+		sub := api.r.SubscribeBlsKeyAlert(alerts)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case alert := <-alerts:
+				notifier.Notify(rpcSub.ID, alert)
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+type randaoAdminAPI struct {
+	r *RandaoModule
+}
+
+func newRandaoAdminAPI(r *RandaoModule) *randaoAdminAPI {
+	return &randaoAdminAPI{r}
+}
+
+// CheckBlsKey checks the BLS key of this node against the KIP-113 registry at the given block.
+func (api *randaoAdminAPI) CheckBlsKey(num *rpc.BlockNumber) (*randao.LocalBlsKeyCheck, error) {
+	n := rpc.LatestBlockNumber
+	if num != nil {
+		n = *num
+	}
+	return api.r.CheckLocalBlsKey(resolveBlockNumber(api.r, n))
+}
+
+func resolveBlockNumber(r *RandaoModule, num rpc.BlockNumber) uint64 {
+	if num == rpc.LatestBlockNumber || num == rpc.PendingBlockNumber {
+		return r.Chain.CurrentBlock().NumberU64()
+	}
+	return num.Uint64()
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"time"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax/randao"
+	"github.com/rcrowley/go-metrics"
+)
+
+// The blocks older than this are regarded as being synced, and their BLS keys are not watched.
+const watchMaxBlockAge = 10 * time.Minute
+
+var (
+	blsKeyMissingGauge = metrics.NewRegisteredGauge("kaiax/randao/bls/missing", nil)
+	blsKeyInvalidGauge = metrics.NewRegisteredGauge("kaiax/randao/bls/invalid", nil)
+	blsKeyAlertCounter = metrics.NewRegisteredCounter("kaiax/randao/bls/alerts", nil)
+	localBlsKeyOkGauge = metrics.NewRegisteredGauge("kaiax/randao/bls/localOk", nil)
+)
+
+// PostInsertBlock wakes up the worker watching the BLS public keys of the council that
+// verifies the next block. The keys are watched outside of the block insertion.
+func (r *RandaoModule) PostInsertBlock(block *types.Block) error {
+	// Skip the blocks inserted to the side chains.
+	if current := r.Chain.CurrentBlock(); current == nil || current.Hash() != block.Hash() {
+		return nil
+	}
+	if time.Since(time.Unix(block.Time().Int64(), 0)) > watchMaxBlockAge {
+		return nil
+	}
+
+	select {
+	case r.watchCh <- struct{}{}:
+	default: // Already woken up
+	}
+	return nil
+}
+
+// watchLoop watches the BLS keys at the head whenever woken up.
+func (r *RandaoModule) watchLoop(watchCh <-chan struct{}, quit <-chan struct{}) {
+	defer r.wg.Done()
+
+	for {
+		select {
+		case <-watchCh:
+			header := r.Chain.CurrentHeader()
+			if err := r.watchBlsKeys(header); err != nil {
+				logger.Debug("Failed to watch BLS keys", "number", header.Number.Uint64(), "err", err)
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// blsWatchKey identifies the inputs of a BLS key report. The report is made again only
+// if the council, the KIP-113 contract or its storage has changed.
+type blsWatchKey struct {
+	council     common.Hash
+	kip113Addr  common.Address
+	storageRoot common.ExtHash
+}
+
+func councilHash(council []common.Address) common.Hash {
+	addrs := make([][]byte, len(council))
+	for i, addr := range council {
+		addrs[i] = addr.Bytes()
+	}
+	return crypto.Keccak256Hash(addrs...)
+}
+
+// watchBlsKeys reports the BLS keys of the council at the header, and raises alerts through
+// the logs, the metrics and the subscriptions, if the council or the KIP-113 registry has changed.
+func (r *RandaoModule) watchBlsKeys(header *types.Header) error {
+	num := header.Number.Uint64()
+	council, err := r.Engine.GetCouncilFromSnapshot(r.Chain, num, header.Hash())
+	if err != nil {
+		return err
+	}
+	backend := backends.NewBlockchainContractBackend(r.Chain, nil, nil)
+	kip113Addr, err := r.getKip113Address(backend, num)
+	if err != nil {
+		return err
+	}
+	statedb, err := r.Chain.StateAt(header.Root)
+	if err != nil {
+		return err
+	}
+	storageRoot, err := statedb.GetContractStorageRoot(kip113Addr)
+	if err != nil {
+		return err
+	}
+	key := blsWatchKey{
+		council:     councilHash(council),
+		kip113Addr:  kip113Addr,
+		storageRoot: storageRoot,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lastReport != nil && r.lastKey == key {
+		return nil
+	}
+
+	infos, err := system.ReadKip113All(backend, kip113Addr, header.Number)
+	if err != nil {
+		return err
+	}
+	report := randao.MakeBlsKeyReport(num, kip113Addr, council, infos)
+
+	blsKeyMissingGauge.Update(int64(report.Missing))
+	blsKeyInvalidGauge.Update(int64(report.Invalid))
+	for _, alert := range randao.DiffBlsKeyReports(r.lastReport, report) {
+		blsKeyAlertCounter.Inc(1)
+		switch alert.Kind {
+		case randao.BlsKeyAlertChanged:
+			logger.Warn("BLS public key of a council member is changed", "number", num, "nodeId", alert.NodeId,
+				"publicKey", alert.PublicKey, "prevPublicKey", alert.PrevPublicKey)
+		default:
+			logger.Warn("BLS public key of a council member is "+string(alert.Kind), "number", num, "nodeId", alert.NodeId,
+				"publicKey", alert.PublicKey, "verifyErr", alert.VerifyErr)
+		}
+		r.alertFeed.Send(alert)
+	}
+	r.lastReport = report
+	r.lastKey = key
+
+	if r.BlsSecretKey != nil {
+		r.watchLocalBlsKey(randao.CheckLocalBlsKey(num, r.NodeId, r.BlsSecretKey, council, infos))
+	}
+	return nil
+}
+
+// watchLocalBlsKey logs the problem of the local BLS key once while this node is in the council.
+func (r *RandaoModule) watchLocalBlsKey(check *randao.LocalBlsKeyCheck) {
+	if check.Ok {
+		localBlsKeyOkGauge.Update(1)
+	} else {
+		localBlsKeyOkGauge.Update(0)
+	}
+	wasOk := r.lastLocal == nil || r.lastLocal.Ok || !r.lastLocal.InCouncil
+	if check.InCouncil && !check.Ok && wasOk {
+		logger.Error("BLS key of this node does not match the KIP-113 registry", "number", check.Number, "nodeId", check.NodeId,
+			"registered", check.Registered, "match", check.Match, "verifyErr", check.VerifyErr,
+			"publicKey", check.PublicKey, "pop", check.Pop)
+	}
+	r.lastLocal = check
+}
+
+func (r *RandaoModule) RewindTo(newBlock *types.Block) {
+	// Nothing to do
+}
+
+func (r *RandaoModule) RewindDelete(hash common.Hash, num uint64) {
+	// Nothing to do
+}
+
+// SubscribeBlsKeyAlert subscribes to the BLS key alerts, raised as the blocks are inserted.
+func (r *RandaoModule) SubscribeBlsKeyAlert(ch chan<- *randao.BlsKeyAlert) event.Subscription {
+	return r.alertScope.Track(r.alertFeed.Subscribe(ch))
+}
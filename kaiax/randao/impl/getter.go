@@ -0,0 +1,91 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/kaiax/randao"
+)
+
+func (r *RandaoModule) GetBlsKeyReport(num uint64) (*randao.BlsKeyReport, error) {
+	header := r.Chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, randao.ErrBlockNotFound(num)
+	}
+	kip113Addr, council, infos, err := r.readBlsKeys(header)
+	if err != nil {
+		return nil, err
+	}
+	return randao.MakeBlsKeyReport(num, kip113Addr, council, infos), nil
+}
+
+func (r *RandaoModule) CheckLocalBlsKey(num uint64) (*randao.LocalBlsKeyCheck, error) {
+	if r.BlsSecretKey == nil {
+		return nil, randao.ErrNoBlsKey
+	}
+	header := r.Chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, randao.ErrBlockNotFound(num)
+	}
+	_, council, infos, err := r.readBlsKeys(header)
+	if err != nil {
+		return nil, err
+	}
+	return randao.CheckLocalBlsKey(num, r.NodeId, r.BlsSecretKey, council, infos), nil
+}
+
+// readBlsKeys reads the council and the KIP-113 registry at the state of the given header,
+// which are used to verify the next block.
+func (r *RandaoModule) readBlsKeys(header *types.Header) (common.Address, []common.Address, system.BlsPublicKeyInfos, error) {
+	num := header.Number.Uint64()
+	council, err := r.Engine.GetCouncilFromSnapshot(r.Chain, num, header.Hash())
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	backend := backends.NewBlockchainContractBackend(r.Chain, nil, nil)
+	kip113Addr, err := r.getKip113Address(backend, num)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	infos, err := system.ReadKip113All(backend, kip113Addr, header.Number)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return kip113Addr, council, infos, nil
+}
+
+// getKip113Address returns the KIP-113 contract address used to verify the block num+1.
+// Until the Registry is installed at the Randao hardfork, the address in ChainConfig is used,
+// so that the keys can be checked before the hardfork requires them.
+func (r *RandaoModule) getKip113Address(backend *backends.BlockchainContractBackend, num uint64) (common.Address, error) {
+	next := new(big.Int).SetUint64(num + 1)
+	if r.ChainConfig.IsRandaoForkEnabled(next) && !r.ChainConfig.IsRandaoForkBlock(next) {
+		return system.ReadActiveAddressFromRegistry(backend, system.Kip113Name, new(big.Int).SetUint64(num))
+	}
+	if r.ChainConfig.RandaoRegistry != nil {
+		if addr, ok := r.ChainConfig.RandaoRegistry.Records[system.Kip113Name]; ok {
+			return addr, nil
+		}
+	}
+	return common.Address{}, randao.ErrNoKip113Address
+}
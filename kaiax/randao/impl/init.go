@@ -0,0 +1,100 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"sync"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/consensus"
+	"github.com/kaiachain/kaia/crypto/bls"
+	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax"
+	"github.com/kaiachain/kaia/kaiax/randao"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
+)
+
+var (
+	_ randao.RandaoModule   = &RandaoModule{}
+	_ kaiax.ExecutionModule = &RandaoModule{}
+
+	logger = log.NewModuleLogger(log.KaiaxRandao)
+)
+
+type Engine interface {
+	GetCouncilFromSnapshot(chain consensus.ChainReader, number uint64, hash common.Hash) ([]common.Address, error)
+}
+
+type InitOpts struct {
+	ChainConfig  *params.ChainConfig
+	Chain        consensus.ChainReader
+	Engine       Engine
+	NodeId       common.Address
+	BlsSecretKey bls.SecretKey // Optional. The local key is not checked if nil.
+}
+
+type RandaoModule struct {
+	InitOpts
+
+	// The BLS key watcher states, reset at Start.
+	mu         sync.Mutex
+	lastReport *randao.BlsKeyReport
+	lastKey    blsWatchKey // The inputs of lastReport
+	lastLocal  *randao.LocalBlsKeyCheck
+
+	alertFeed  event.Feed
+	alertScope event.SubscriptionScope
+
+	watchCh chan struct{} // Wakes up the BLS key watcher
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewRandaoModule() *RandaoModule {
+	return &RandaoModule{}
+}
+
+func (r *RandaoModule) Init(opts *InitOpts) error {
+	if opts == nil || opts.ChainConfig == nil || opts.Chain == nil || opts.Engine == nil {
+		return randao.ErrInitUnexpectedNil
+	}
+	r.InitOpts = *opts
+	return nil
+}
+
+func (r *RandaoModule) Start() error {
+	// This module may have restarted after a rewind. Check the keys from scratch.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastReport = nil
+	r.lastLocal = nil
+
+	r.watchCh = make(chan struct{}, 1)
+	r.quit = make(chan struct{})
+	r.wg.Add(1)
+	go r.watchLoop(r.watchCh, r.quit)
+	return nil
+}
+
+func (r *RandaoModule) Stop() {
+	if r.quit != nil {
+		close(r.quit)
+		r.wg.Wait()
+		r.quit = nil
+	}
+}
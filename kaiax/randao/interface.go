@@ -0,0 +1,41 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package randao
+
+import (
+	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax"
+)
+
+type RandaoModule interface {
+	kaiax.BaseModule
+	kaiax.JsonRpcModule
+	kaiax.ExecutionModule
+	kaiax.RewindableModule
+
+	// GetBlsKeyReport checks the BLS public keys of the council in the KIP-113 registry
+	// at the state of the given block. The keys are used to verify the next block.
+	GetBlsKeyReport(num uint64) (*BlsKeyReport, error)
+
+	// CheckLocalBlsKey checks the BLS key of this node against the KIP-113 registry
+	// at the state of the given block.
+	CheckLocalBlsKey(num uint64) (*LocalBlsKeyCheck, error)
+
+	// SubscribeBlsKeyAlert subscribes to the alerts raised when a council member's
+	// BLS public key becomes missing or invalid, or is changed.
+	SubscribeBlsKeyAlert(ch chan<- *BlsKeyAlert) event.Subscription
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/common"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
+	"github.com/kaiachain/kaia/networks/rpc"
+	"github.com/kaiachain/kaia/reward"
+)
+
+func (r *RewardModule) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "kaia",
+			Version:   "1.0",
+			Service:   newRewardAPI(r),
+			Public:    true,
+		},
+	}
+}
+
+type rewardAPI struct {
+	r *RewardModule
+}
+
+func newRewardAPI(r *RewardModule) *rewardAPI {
+	return &rewardAPI{r}
+}
+
+// GetRewardsInRange returns the rewards of the blocks in [from, to] with their
+// sum, where only the rewards paid to `addr` are listed if given. The rewards of
+// a single block are served by kaia_getRewards of the governance API.
+func (api *rewardAPI) GetRewardsInRange(from, to *rpc.BlockNumber, addr *common.Address) (*kaiax_reward.RewardsResponse, error) {
+	fromNum := api.resolve(from)
+	toNum := fromNum
+	if to != nil {
+		toNum = api.resolve(to)
+	}
+	if fromNum > toNum {
+		return nil, kaiax_reward.ErrInvalidRange
+	}
+	if toNum-fromNum+1 > kaiax_reward.MaxRewardsRange {
+		return nil, kaiax_reward.ErrRangeTooLarge
+	}
+
+	resp := &kaiax_reward.RewardsResponse{
+		From:   fromNum,
+		To:     toNum,
+		Total:  reward.NewRewardSpec(),
+		Blocks: []*kaiax_reward.BlockRewards{},
+	}
+	for num := fromNum; num <= toNum; num++ {
+		header := api.r.Chain.GetHeaderByNumber(num)
+		if header == nil {
+			return nil, kaiax_reward.ErrBlockNotFound(num)
+		}
+		spec, err := api.r.GetBlockReward(num)
+		if err != nil {
+			return nil, err
+		}
+		resp.Total.Add(spec)
+		if addr != nil {
+			if _, ok := spec.Rewards[*addr]; !ok {
+				continue
+			}
+		}
+		resp.Blocks = append(resp.Blocks, kaiax_reward.NewBlockRewards(num, header.Rewardbase, spec, addr))
+	}
+	return resp, nil
+}
+
+// resolve returns the block number, where nil and the special numbers mean the
+// latest block.
+func (api *rewardAPI) resolve(num *rpc.BlockNumber) uint64 {
+	if num == nil || *num < 0 {
+		return api.r.Chain.CurrentBlock().NumberU64()
+	}
+	return num.Uint64()
+}
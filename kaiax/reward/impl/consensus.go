@@ -0,0 +1,70 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/reward"
+	"github.com/kaiachain/kaia/rlp"
+)
+
+func (r *RewardModule) VerifyHeader(header *types.Header) error {
+	return nil
+}
+
+func (r *RewardModule) PrepareHeader(header *types.Header) error {
+	return nil
+}
+
+func (r *RewardModule) FinalizeHeader(header *types.Header, state *state.StateDB, txs []*types.Transaction, receipts []*types.Receipt) error {
+	return nil
+}
+
+// FinalizeReward keeps the rewards the engine distributed at the block being
+// finalized, with the fees paid to the proposer during the execution if not
+// deferred, in memory until the block is inserted. Nothing is persisted here
+// since the block may not be confirmed.
+func (r *RewardModule) FinalizeReward(header *types.Header, txs []*types.Transaction, receipts []*types.Receipt, deferred *reward.RewardSpec) error {
+	pset, err := r.GovModule.EffectiveParams(header.Number.Uint64())
+	if err != nil {
+		return err
+	}
+	spec := reward.NewRewardSpec()
+	spec.Add(deferred)
+	if err := reward.AddPaidTxFee(spec, header, txs, receipts, r.ChainConfig.Rules(header.Number), pset); err != nil {
+		// The proposer of a block being mined is not known before the seal. The
+		// rewards are calculated again at PostInsertBlock.
+		logger.Trace("Skip keeping the rewards at finalize", "num", header.Number, "err", err)
+		return nil
+	}
+	r.finalized.Add(finalizedKey(header, txs), spec)
+	return nil
+}
+
+func finalizedKey(header *types.Header, txs []*types.Transaction) common.Hash {
+	b, _ := rlp.EncodeToBytes([]interface{}{
+		header.Number,
+		header.ParentHash,
+		header.Rewardbase,
+		header.GasUsed,
+		types.DeriveSha(types.Transactions(txs), header.Number),
+	})
+	return crypto.Keccak256Hash(b)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/reward"
+)
+
+// PostInsertBlock persists the rewards of the canonical block, which are kept at
+// FinalizeReward or calculated here from the receipts of the block.
+func (r *RewardModule) PostInsertBlock(block *types.Block) error {
+	var spec *reward.RewardSpec
+	key := finalizedKey(block.Header(), block.Transactions())
+	cached, ok := r.finalized.Get(key)
+	r.finalized.Remove(key)
+
+	// The rewards of the blocks on the side chains aren't persisted. If one of
+	// them becomes canonical by a reorg, its rewards are calculated on demand.
+	if header := r.Chain.GetHeaderByNumber(block.NumberU64()); header == nil || header.Hash() != block.Hash() {
+		return nil
+	}
+	if ok {
+		spec = cached.(*reward.RewardSpec)
+	} else {
+		var err error
+		receipts := r.Chain.GetReceiptsByBlockHash(block.Hash())
+		if spec, err = r.calcBlockReward(block.Header(), block.Transactions(), receipts); err != nil {
+			return err
+		}
+	}
+	WriteRewardSpec(r.ChainKv, block.NumberU64(), block.Hash(), spec)
+	return nil
+}
+
+func (r *RewardModule) RewindTo(newBlock *types.Block) {
+	// Nothing to do
+}
+
+func (r *RewardModule) RewindDelete(hash common.Hash, num uint64) {
+	DeleteRewardSpec(r.ChainKv, num)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"github.com/kaiachain/kaia/blockchain/types"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
+	"github.com/kaiachain/kaia/reward"
+)
+
+func (r *RewardModule) GetBlockReward(num uint64) (*reward.RewardSpec, error) {
+	header := r.Chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, kaiax_reward.ErrBlockNotFound(num)
+	}
+	if spec := ReadRewardSpec(r.ChainKv, num, header.Hash()); spec != nil {
+		return spec, nil
+	}
+
+	// Not persisted, e.g. the blocks inserted before this module is enabled.
+	block := r.Chain.GetBlockByNumber(num)
+	if block == nil {
+		return nil, kaiax_reward.ErrBlockNotFound(num)
+	}
+	receipts := r.Chain.GetReceiptsByBlockHash(block.Hash())
+	return r.calcBlockReward(block.Header(), block.Transactions(), receipts)
+}
+
+// calcBlockReward calculates the rewards paid at the block, in the same way as
+// governance_getRewards.
+func (r *RewardModule) calcBlockReward(header *types.Header, txs []*types.Transaction, receipts []*types.Receipt) (*reward.RewardSpec, error) {
+	num := header.Number.Uint64()
+	rules := r.ChainConfig.Rules(header.Number)
+	pset, err := r.GovModule.EffectiveParams(num)
+	if err != nil {
+		return nil, err
+	}
+	rewardParamNum := reward.CalcRewardParamBlock(num, pset.Epoch(), rules)
+	rewardParamSet, err := r.GovModule.EffectiveParams(rewardParamNum)
+	if err != nil {
+		return nil, err
+	}
+
+	var stakingInfo *reward.StakingInfo
+	if !reward.IsRewardSimple(rewardParamSet) {
+		si, err := r.StakingModule.GetStakingInfo(num)
+		if err != nil {
+			return nil, err
+		}
+		stakingInfo = reward.FromKaiax(si)
+	}
+	return reward.GetBlockReward(header, txs, receipts, rules, rewardParamSet, stakingInfo)
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/kaiachain/kaia/blockchain"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
+	staking_mock "github.com/kaiachain/kaia/kaiax/staking/mock"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/networks/rpc"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/reward"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var minted, _ = new(big.Int).SetString("9600000000000000000", 10)
+
+type testChain struct {
+	blocks []*types.Block
+}
+
+func (c *testChain) CurrentBlock() *types.Block { return c.blocks[len(c.blocks)-1] }
+
+func (c *testChain) GetHeaderByNumber(num uint64) *types.Header {
+	if block := c.GetBlockByNumber(num); block != nil {
+		return block.Header()
+	}
+	return nil
+}
+
+func (c *testChain) GetBlockByNumber(num uint64) *types.Block {
+	if num >= uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[num]
+}
+
+func (c *testChain) GetReceiptsByBlockHash(hash common.Hash) types.Receipts { return nil }
+
+type testGov struct {
+	pset *params.GovParamSet
+}
+
+func (g *testGov) EffectiveParams(num uint64) (*params.GovParamSet, error) { return g.pset, nil }
+
+// newTestRewardModule returns a module over a chain of n empty blocks, where the
+// block i is proposed by the address i, and all the minted coins go to the proposer.
+func newTestRewardModule(t *testing.T, n int) (*RewardModule, *testChain) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	config := &params.ChainConfig{
+		ChainID:    common.Big1,
+		Istanbul:   &params.IstanbulConfig{Epoch: 30, ProposerPolicy: uint64(params.RoundRobin)},
+		Governance: params.GetDefaultGovernanceConfig(),
+	}
+	config.Governance.Reward.MintingAmount = minted
+	config.Governance.Reward.DeferredTxFee = true
+	pset, err := params.NewGovParamSetChainConfig(config)
+	require.NoError(t, err)
+	blockchain.InitDeriveSha(config)
+
+	chain := &testChain{}
+	for i := 0; i < n; i++ {
+		chain.blocks = append(chain.blocks, types.NewBlockWithHeader(&types.Header{
+			Number:     big.NewInt(int64(i)),
+			Rewardbase: common.BigToAddress(big.NewInt(int64(i))),
+		}))
+	}
+
+	r := NewRewardModule()
+	require.NoError(t, r.Init(&InitOpts{
+		ChainKv:       database.NewMemoryDBManager().GetMiscDB(),
+		ChainConfig:   config,
+		Chain:         chain,
+		GovModule:     &testGov{pset},
+		StakingModule: staking_mock.NewMockStakingModule(gomock.NewController(t)),
+	}))
+	return r, chain
+}
+
+func TestRewardSpecSchema(t *testing.T) {
+	db := database.NewMemoryDBManager().GetMiscDB()
+	hash := common.HexToHash("0x1234")
+	spec := &reward.RewardSpec{
+		Minted:   big.NewInt(100),
+		TotalFee: big.NewInt(20),
+		BurntFee: big.NewInt(10),
+		Proposer: big.NewInt(30),
+		Stakers:  big.NewInt(40),
+		KIF:      big.NewInt(25),
+		KEF:      big.NewInt(15),
+		Rewards: map[common.Address]*big.Int{
+			common.HexToAddress("0xb"): big.NewInt(70),
+			common.HexToAddress("0xa"): big.NewInt(40),
+		},
+	}
+
+	assert.Nil(t, ReadRewardSpec(db, 1, hash))
+	WriteRewardSpec(db, 1, hash, spec)
+	assert.Equal(t, spec, ReadRewardSpec(db, 1, hash))
+	assert.Nil(t, ReadRewardSpec(db, 1, common.HexToHash("0x5678")))
+
+	// The rewards of the block replaced by a reorg are overwritten.
+	WriteRewardSpec(db, 1, common.HexToHash("0x5678"), spec)
+	assert.Nil(t, ReadRewardSpec(db, 1, hash))
+	assert.Equal(t, spec, ReadRewardSpec(db, 1, common.HexToHash("0x5678")))
+
+	DeleteRewardSpec(db, 1)
+	assert.Nil(t, ReadRewardSpec(db, 1, common.HexToHash("0x5678")))
+}
+
+func TestPostInsertBlock(t *testing.T) {
+	r, chain := newTestRewardModule(t, 3)
+	block := chain.blocks[2]
+	proposer := block.Rewardbase()
+
+	// The rewards given by the engine at FinalizeReward are persisted at
+	// PostInsertBlock, without being calculated again.
+	doubled := new(big.Int).Mul(minted, big.NewInt(2)) // differs from the calculated rewards
+	deferred := reward.NewRewardSpec()
+	deferred.Minted.Set(doubled)
+	deferred.Proposer.Set(doubled)
+	deferred.Rewards[proposer] = doubled
+	require.NoError(t, r.FinalizeReward(block.Header(), nil, nil, deferred))
+	assert.Equal(t, 1, r.finalized.Len())
+	require.NoError(t, r.PostInsertBlock(block))
+	assert.Equal(t, 0, r.finalized.Len())
+
+	stored := ReadRewardSpec(r.ChainKv, 2, block.Hash())
+	require.NotNil(t, stored)
+	assert.Equal(t, deferred, stored)
+
+	// The block on a side chain isn't persisted.
+	side := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2), Rewardbase: common.HexToAddress("0xff")})
+	require.NoError(t, r.PostInsertBlock(side))
+	assert.Nil(t, ReadRewardSpec(r.ChainKv, 2, side.Hash()))
+	assert.Equal(t, stored, ReadRewardSpec(r.ChainKv, 2, block.Hash()))
+
+	spec, err := r.GetBlockReward(2)
+	require.NoError(t, err)
+	assert.Equal(t, stored, spec)
+
+	// Calculated if not persisted.
+	spec, err = r.GetBlockReward(1)
+	require.NoError(t, err)
+	assert.Equal(t, minted, spec.Proposer)
+	assert.Nil(t, ReadRewardSpec(r.ChainKv, 1, chain.blocks[1].Hash()))
+
+	_, err = r.GetBlockReward(3)
+	assert.Error(t, err)
+
+	// Deleted by rewind.
+	r.RewindDelete(block.Hash(), 2)
+	assert.Nil(t, ReadRewardSpec(r.ChainKv, 2, block.Hash()))
+}
+
+func TestGetRewardsInRange(t *testing.T) {
+	r, chain := newTestRewardModule(t, 5)
+	for _, block := range chain.blocks {
+		require.NoError(t, r.PostInsertBlock(block))
+	}
+	api := newRewardAPI(r)
+	bn := func(n int64) *rpc.BlockNumber {
+		num := rpc.BlockNumber(n)
+		return &num
+	}
+
+	// A single block without the end of the range.
+	resp, err := api.GetRewardsInRange(bn(2), nil, nil)
+	require.NoError(t, err)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, minted, resp.Total.Proposer)
+
+	// Sums over the range.
+	resp, err = api.GetRewardsInRange(bn(1), bn(3), nil)
+	require.NoError(t, err)
+	total := new(big.Int).Mul(minted, big.NewInt(3))
+	assert.Equal(t, uint64(1), resp.From)
+	assert.Equal(t, uint64(3), resp.To)
+	assert.Equal(t, total, resp.Total.Minted)
+	assert.Equal(t, total, resp.Total.Proposer)
+	assert.Len(t, resp.Total.Rewards, 3)
+	assert.Len(t, resp.Blocks, 3)
+	assert.Equal(t, chain.blocks[3].Rewardbase(), resp.Blocks[2].Proposer)
+
+	// Only the blocks paying the address.
+	addr := chain.blocks[3].Rewardbase()
+	resp, err = api.GetRewardsInRange(bn(0), bn(rpc.LatestBlockNumber.Int64()), &addr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), resp.To)
+	require.Len(t, resp.Blocks, 1)
+	assert.Equal(t, uint64(3), resp.Blocks[0].Number)
+	assert.Equal(t, map[common.Address]*big.Int{addr: minted}, resp.Blocks[0].Rewards)
+
+	// Invalid ranges.
+	_, err = api.GetRewardsInRange(bn(3), bn(1), nil)
+	assert.ErrorIs(t, err, kaiax_reward.ErrInvalidRange)
+	_, err = api.GetRewardsInRange(bn(0), bn(kaiax_reward.MaxRewardsRange), nil)
+	assert.ErrorIs(t, err, kaiax_reward.ErrRangeTooLarge)
+	_, err = api.GetRewardsInRange(bn(3), bn(9), nil)
+	assert.Error(t, err)
+}
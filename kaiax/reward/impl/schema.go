@@ -0,0 +1,121 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/reward"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var rewardSpecPrefix = []byte("rewardSpec")
+
+// rewardSpecKey is keyed by the number only, so that the rewards of a block
+// replaced by a reorg are overwritten rather than left behind.
+func rewardSpecKey(num uint64) []byte {
+	return append(rewardSpecPrefix, common.Int64ToByteLittleEndian(num)...)
+}
+
+// storedRewardSpec is the compact RLP encoding of reward.RewardSpec. The rewards
+// map is flattened into the lists sorted by the recipient. The hash of the block
+// is stored to tell whether the rewards are of the canonical block.
+type storedRewardSpec struct {
+	Hash       common.Hash
+	Minted     *big.Int
+	TotalFee   *big.Int
+	BurntFee   *big.Int
+	Proposer   *big.Int
+	Stakers    *big.Int
+	KIF        *big.Int
+	KEF        *big.Int
+	Recipients []common.Address
+	Amounts    []*big.Int
+}
+
+func ReadRewardSpec(db database.Database, num uint64, hash common.Hash) *reward.RewardSpec {
+	b, err := db.Get(rewardSpecKey(num))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	var stored storedRewardSpec
+	if err := rlp.DecodeBytes(b, &stored); err != nil || len(stored.Recipients) != len(stored.Amounts) {
+		logger.Error("Malformed reward spec", "num", num, "hash", hash, "err", err)
+		return nil
+	}
+	if stored.Hash != hash {
+		return nil
+	}
+	spec := &reward.RewardSpec{
+		Minted:   stored.Minted,
+		TotalFee: stored.TotalFee,
+		BurntFee: stored.BurntFee,
+		Proposer: stored.Proposer,
+		Stakers:  stored.Stakers,
+		KIF:      stored.KIF,
+		KEF:      stored.KEF,
+		Rewards:  make(map[common.Address]*big.Int, len(stored.Recipients)),
+	}
+	for i, addr := range stored.Recipients {
+		spec.Rewards[addr] = stored.Amounts[i]
+	}
+	return spec
+}
+
+func WriteRewardSpec(db database.Database, num uint64, hash common.Hash, spec *reward.RewardSpec) {
+	stored := storedRewardSpec{
+		Hash:       hash,
+		Minted:     spec.Minted,
+		TotalFee:   spec.TotalFee,
+		BurntFee:   spec.BurntFee,
+		Proposer:   spec.Proposer,
+		Stakers:    spec.Stakers,
+		KIF:        spec.KIF,
+		KEF:        spec.KEF,
+		Recipients: make([]common.Address, 0, len(spec.Rewards)),
+		Amounts:    make([]*big.Int, 0, len(spec.Rewards)),
+	}
+	for addr := range spec.Rewards {
+		stored.Recipients = append(stored.Recipients, addr)
+	}
+	sort.Slice(stored.Recipients, func(i, j int) bool {
+		return bytes.Compare(stored.Recipients[i].Bytes(), stored.Recipients[j].Bytes()) < 0
+	})
+	for _, addr := range stored.Recipients {
+		stored.Amounts = append(stored.Amounts, spec.Rewards[addr])
+	}
+
+	b, err := rlp.EncodeToBytes(&stored)
+	if err != nil {
+		logger.Error("Failed to encode RewardSpec", "num", num, "hash", hash, "err", err)
+		return
+	}
+	if err := db.Put(rewardSpecKey(num), b); err != nil {
+		logger.Crit("Failed to write RewardSpec", "num", num, "hash", hash, "err", err)
+	}
+}
+
+func DeleteRewardSpec(db database.Database, num uint64) {
+	if err := db.Delete(rewardSpecKey(num)); err != nil {
+		logger.Crit("Failed to delete RewardSpec", "num", num, "err", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/kaiax"
+	"github.com/kaiachain/kaia/reward"
+)
+
+type RewardModule interface {
+	kaiax.BaseModule
+	kaiax.JsonRpcModule
+	kaiax.ConsensusModule
+	kaiax.ExecutionModule
+	kaiax.RewindableModule
+
+	// GetBlockReward returns the rewards paid at the canonical block of the given
+	// number. It is read from the database if persisted, or calculated otherwise.
+	GetBlockReward(num uint64) (*reward.RewardSpec, error)
+
+	FinalizeRewardHook
+}
+
+// FinalizeRewardHook is implemented by the consensus modules which need the
+// deferred rewards the consensus engine distributed at Finalize, so that they
+// don't calculate the rewards again.
+type FinalizeRewardHook interface {
+	// FinalizeReward is called after the engine distributed the rewards of the
+	// spec, which is calculated by CalcDeferredReward or CalcDeferredRewardSimple.
+	// It must not modify the spec.
+	FinalizeReward(header *types.Header, txs []*types.Transaction, receipts []*types.Receipt, spec *reward.RewardSpec) error
+}
+
+type RewardModuleHost interface {
+	RegisterRewardModule(module RewardModule)
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/reward"
+)
+
+// MaxRewardsRange is the maximum number of blocks kaia_getRewardsInRange returns at once.
+const MaxRewardsRange = 10000
+
+// BlockRewards is the rewards paid at a block, in the response of kaia_getRewardsInRange.
+type BlockRewards struct {
+	Number   uint64         `json:"number"`
+	Proposer common.Address `json:"proposer"` // Rewardbase of the block
+
+	Minted         *big.Int                    `json:"minted"`
+	TotalFee       *big.Int                    `json:"totalFee"`
+	BurntFee       *big.Int                    `json:"burntFee"`
+	ProposerReward *big.Int                    `json:"proposerReward"`
+	StakersReward  *big.Int                    `json:"stakersReward"`
+	KIFReward      *big.Int                    `json:"kifReward"`
+	KEFReward      *big.Int                    `json:"kefReward"`
+	Rewards        map[common.Address]*big.Int `json:"rewards"` // Only the given address if filtered
+}
+
+// RewardsResponse is the response of kaia_getRewardsInRange.
+type RewardsResponse struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+
+	// Sums over the range.
+	// Minted + TotalFee - BurntFee = Proposer + Stakers + KIF + KEF
+	Total *reward.RewardSpec `json:"total"`
+
+	// Blocks are the rewards of each block. If an address is given, only the
+	// blocks paying it are included.
+	Blocks []*BlockRewards `json:"blocks"`
+}
+
+// NewBlockRewards returns the BlockRewards of the spec, with the rewards of addr
+// only if addr is not nil.
+func NewBlockRewards(num uint64, proposer common.Address, spec *reward.RewardSpec, addr *common.Address) *BlockRewards {
+	rewards := spec.Rewards
+	if addr != nil {
+		rewards = make(map[common.Address]*big.Int)
+		if amount, ok := spec.Rewards[*addr]; ok {
+			rewards[*addr] = amount
+		}
+	}
+	return &BlockRewards{
+		Number:         num,
+		Proposer:       proposer,
+		Minted:         spec.Minted,
+		TotalFee:       spec.TotalFee,
+		BurntFee:       spec.BurntFee,
+		ProposerReward: spec.Proposer,
+		StakersReward:  spec.Stakers,
+		KIFReward:      spec.KIF,
+		KEFReward:      spec.KEF,
+		Rewards:        rewards,
+	}
+}
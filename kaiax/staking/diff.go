@@ -0,0 +1,202 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"github.com/kaiachain/kaia/common"
+)
+
+// ValidatorState is the staking status of a council node in a StakingInfo.
+type ValidatorState struct {
+	NodeId          common.Address `json:"nodeId"`
+	StakingContract common.Address `json:"stakingContract"`
+	RewardAddr      common.Address `json:"rewardAddr"`
+	StakingAmount   uint64         `json:"stakingAmount"`
+
+	// The other nodes consolidated with this node by sharing the RewardAddr, and
+	// the staking amount summed over them. See consolidatedNode.
+	ConsolidatedWith          []common.Address `json:"consolidatedWith"`
+	ConsolidatedStakingAmount uint64           `json:"consolidatedStakingAmount"`
+}
+
+// AddressChange is a change of an address field.
+type AddressChange struct {
+	From common.Address `json:"from"`
+	To   common.Address `json:"to"`
+}
+
+// AmountChange is a change of a staking amount, in KAIA.
+type AmountChange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// ConsolidationChange is a change of the nodes consolidated with a node.
+type ConsolidationChange struct {
+	From []common.Address `json:"from"`
+	To   []common.Address `json:"to"`
+}
+
+// ValidatorDiff is the changes of a council node existing in both StakingInfos.
+// The unchanged fields are nil.
+type ValidatorDiff struct {
+	NodeId           common.Address       `json:"nodeId"`
+	StakingContract  *AddressChange       `json:"stakingContract,omitempty"`
+	RewardAddr       *AddressChange       `json:"rewardAddr,omitempty"`
+	StakingAmount    *AmountChange        `json:"stakingAmount,omitempty"`
+	ConsolidatedWith *ConsolidationChange `json:"consolidatedWith,omitempty"`
+}
+
+// StakingInfoDiff is the difference between two consecutive StakingInfos.
+type StakingInfoDiff struct {
+	FromBlockNum uint64 `json:"fromBlockNum"` // SourceBlockNum of the previous StakingInfo
+	ToBlockNum   uint64 `json:"toBlockNum"`   // SourceBlockNum of the next StakingInfo
+
+	Added   []*ValidatorState `json:"added"`   // Council nodes only in the next StakingInfo
+	Removed []*ValidatorState `json:"removed"` // Council nodes only in the previous StakingInfo
+	Changed []*ValidatorDiff  `json:"changed"`
+
+	KEFAddr *AddressChange `json:"kefAddr,omitempty"`
+	KIFAddr *AddressChange `json:"kifAddr,omitempty"`
+}
+
+// Validator returns the staking status of the council node, or nil if the node
+// is not in the StakingInfo.
+func (si *StakingInfo) Validator(nodeId common.Address) *ValidatorState {
+	for i, id := range si.NodeIds {
+		if id != nodeId {
+			continue
+		}
+		v := &ValidatorState{
+			NodeId:           id,
+			StakingContract:  si.StakingContracts[i],
+			RewardAddr:       si.RewardAddrs[i],
+			StakingAmount:    si.StakingAmounts[i],
+			ConsolidatedWith: []common.Address{},
+		}
+		for _, cn := range si.ConsolidatedNodes() {
+			if cn.RewardAddr != v.RewardAddr {
+				continue
+			}
+			for _, other := range cn.NodeIds {
+				if other != id {
+					v.ConsolidatedWith = append(v.ConsolidatedWith, other)
+				}
+			}
+			v.ConsolidatedStakingAmount = cn.StakingAmount
+		}
+		return v
+	}
+	return nil
+}
+
+// Diff returns the changes of the validator from prev. Nil is returned if
+// nothing changed.
+func (v *ValidatorState) Diff(prev *ValidatorState) *ValidatorDiff {
+	d := &ValidatorDiff{NodeId: v.NodeId}
+	changed := false
+	if prev.StakingContract != v.StakingContract {
+		d.StakingContract = &AddressChange{prev.StakingContract, v.StakingContract}
+		changed = true
+	}
+	if prev.RewardAddr != v.RewardAddr {
+		d.RewardAddr = &AddressChange{prev.RewardAddr, v.RewardAddr}
+		changed = true
+	}
+	if prev.StakingAmount != v.StakingAmount {
+		d.StakingAmount = &AmountChange{prev.StakingAmount, v.StakingAmount}
+		changed = true
+	}
+	if !sameAddrs(prev.ConsolidatedWith, v.ConsolidatedWith) {
+		d.ConsolidatedWith = &ConsolidationChange{prev.ConsolidatedWith, v.ConsolidatedWith}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return d
+}
+
+// DiffStakingInfo returns the changes from prev to next.
+func DiffStakingInfo(prev, next *StakingInfo) *StakingInfoDiff {
+	d := &StakingInfoDiff{
+		FromBlockNum: prev.SourceBlockNum,
+		ToBlockNum:   next.SourceBlockNum,
+		Added:        []*ValidatorState{},
+		Removed:      []*ValidatorState{},
+		Changed:      []*ValidatorDiff{},
+	}
+	for _, id := range prev.NodeIds {
+		if next.Validator(id) == nil {
+			d.Removed = append(d.Removed, prev.Validator(id))
+		}
+	}
+	for _, id := range next.NodeIds {
+		v := next.Validator(id)
+		if p := prev.Validator(id); p == nil {
+			d.Added = append(d.Added, v)
+		} else if vd := v.Diff(p); vd != nil {
+			d.Changed = append(d.Changed, vd)
+		}
+	}
+	if prev.KEFAddr != next.KEFAddr {
+		d.KEFAddr = &AddressChange{prev.KEFAddr, next.KEFAddr}
+	}
+	if prev.KIFAddr != next.KIFAddr {
+		d.KIFAddr = &AddressChange{prev.KIFAddr, next.KIFAddr}
+	}
+	return d
+}
+
+// Empty returns true if nothing changed.
+func (d *StakingInfoDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 &&
+		d.KEFAddr == nil && d.KIFAddr == nil
+}
+
+func sameAddrs(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatorHistoryEntry is the snapshot of the staking status of a council node
+// taken at BlockNum. After Kaia hardfork, the status may have changed up to a
+// staking interval earlier than BlockNum.
+type ValidatorHistoryEntry struct {
+	BlockNum       uint64          `json:"blockNum"`       // The snapshot block, the first of the queried range or the one after a staking interval
+	SourceBlockNum uint64          `json:"sourceBlockNum"` // SourceBlockNum of the StakingInfo
+	Validator      *ValidatorState `json:"validator"`      // Nil if not a council node
+	Diff           *ValidatorDiff  `json:"diff,omitempty"` // Changes from the previous entry, if the node was a council node in both
+}
+
+// ValidatorHistory is the changes of the staking status of a council node over
+// a block range, observed by the snapshots taken every SnapshotInterval blocks.
+// An entry is added only if the status changes between the snapshots.
+type ValidatorHistory struct {
+	NodeId           common.Address           `json:"nodeId"`
+	From             uint64                   `json:"from"`
+	To               uint64                   `json:"to"`
+	SnapshotInterval uint64                   `json:"snapshotInterval"` // The staking interval
+	Entries          []*ValidatorHistoryEntry `json:"entries"`
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffStakingInfo(t *testing.T) {
+	var (
+		n1, n2, n3 = common.HexToAddress("0xa0"), common.HexToAddress("0xb0"), common.HexToAddress("0xc0")
+		s1, s2, s3 = common.HexToAddress("0xa1"), common.HexToAddress("0xb1"), common.HexToAddress("0xc1")
+		r1, r2, r3 = common.HexToAddress("0xa2"), common.HexToAddress("0xb2"), common.HexToAddress("0xc2")
+		kef, kif   = common.HexToAddress("0xd0"), common.HexToAddress("0xd1")
+		kef2       = common.HexToAddress("0xd2")
+	)
+	prev := &StakingInfo{
+		SourceBlockNum:   100,
+		NodeIds:          []common.Address{n1, n2},
+		StakingContracts: []common.Address{s1, s2},
+		RewardAddrs:      []common.Address{r1, r2},
+		KEFAddr:          kef,
+		KIFAddr:          kif,
+		StakingAmounts:   []uint64{5_000_000, 7_000_000},
+	}
+
+	// Nothing changed
+	same := *prev
+	same.SourceBlockNum = 200
+	d := DiffStakingInfo(prev, &same)
+	assert.True(t, d.Empty())
+	assert.Equal(t, uint64(100), d.FromBlockNum)
+	assert.Equal(t, uint64(200), d.ToBlockNum)
+
+	// n1 consolidated into n2 with more stake, n3 added, KEF changed
+	next := &StakingInfo{
+		SourceBlockNum:   200,
+		NodeIds:          []common.Address{n1, n2, n3},
+		StakingContracts: []common.Address{s1, s2, s3},
+		RewardAddrs:      []common.Address{r2, r2, r3},
+		KEFAddr:          kef2,
+		KIFAddr:          kif,
+		StakingAmounts:   []uint64{6_000_000, 7_000_000, 1_000_000},
+	}
+	d = DiffStakingInfo(prev, next)
+	assert.False(t, d.Empty())
+	assert.Equal(t, []*ValidatorState{{
+		NodeId:                    n3,
+		StakingContract:           s3,
+		RewardAddr:                r3,
+		StakingAmount:             1_000_000,
+		ConsolidatedWith:          []common.Address{},
+		ConsolidatedStakingAmount: 1_000_000,
+	}}, d.Added)
+	assert.Empty(t, d.Removed)
+	assert.Equal(t, []*ValidatorDiff{
+		{
+			NodeId:           n1,
+			RewardAddr:       &AddressChange{r1, r2},
+			StakingAmount:    &AmountChange{5_000_000, 6_000_000},
+			ConsolidatedWith: &ConsolidationChange{[]common.Address{}, []common.Address{n2}},
+		},
+		{
+			NodeId:           n2,
+			ConsolidatedWith: &ConsolidationChange{[]common.Address{}, []common.Address{n1}},
+		},
+	}, d.Changed)
+	assert.Equal(t, &AddressChange{kef, kef2}, d.KEFAddr)
+	assert.Nil(t, d.KIFAddr)
+
+	// Reversed, n3 removed
+	d = DiffStakingInfo(next, prev)
+	assert.Empty(t, d.Added)
+	assert.Len(t, d.Removed, 1)
+	assert.Equal(t, n3, d.Removed[0].NodeId)
+	assert.Len(t, d.Changed, 2)
+}
@@ -19,14 +19,22 @@ package staking
 import (
 	"errors"
 	"fmt"
+
+	"github.com/kaiachain/kaia/common"
 )
 
 var (
 	ErrInitUnexpectedNil   = errors.New("unexpected nil during module init")
 	ErrZeroStakingInterval = errors.New("staking interval cannot be zero")
 	ErrAddressBookResult   = errors.New("invalid result from AddressBook")
+	ErrInvalidRange        = errors.New("the last block number should be equal or larger than the first block number")
+	ErrHistoryTooLong      = fmt.Errorf("the range should contain equal or less than %d staking info snapshots", MaxHistorySnapshots)
 )
 
 func ErrAddressBookCall(err error) error {
 	return fmt.Errorf("error calling AddressBook: %w", err)
 }
+
+func ErrPublicDelegationCall(stakingContract common.Address, err error) error {
+	return fmt.Errorf("error reading PublicDelegation of %s: %w", stakingContract.Hex(), err)
+}
@@ -17,8 +17,10 @@
 package impl
 
 import (
+	"context"
 	"math/big"
 
+	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/networks/rpc"
 )
@@ -37,6 +39,12 @@ func (s *StakingModule) APIs() []rpc.API {
 			Service:   newStakingAPI(s),
 			Public:    true,
 		},
+		{
+			Namespace: "staking",
+			Version:   "1.0",
+			Service:   newStakingHistoryAPI(s),
+			Public:    true,
+		},
 	}
 }
 
@@ -68,3 +76,67 @@ func (api *stakingAPI) GetStakingInfo(num rpc.BlockNumber) (*staking.StakingInfo
 	// Calculate Gini coefficient regardless of useGini flag
 	return si.ToResponse(useGini, api.s.stakingInterval), nil
 }
+
+// GetPublicDelegationInfo returns the status of the PublicDelegation contracts
+// captured at the last staking interval at or before the block `num`.
+func (api *stakingAPI) GetPublicDelegationInfo(num rpc.BlockNumber) (*staking.PublicDelegationInfo, error) {
+	if num == rpc.LatestBlockNumber || num == rpc.PendingBlockNumber {
+		num = rpc.BlockNumber(api.s.Chain.CurrentBlock().NumberU64())
+	}
+	return api.s.GetPublicDelegationInfo(num.Uint64())
+}
+
+type stakingHistoryAPI struct {
+	s *StakingModule
+}
+
+func newStakingHistoryAPI(s *StakingModule) *stakingHistoryAPI {
+	return &stakingHistoryAPI{s}
+}
+
+// GetValidatorHistory returns the changes of the stake, the reward address and
+// the consolidation of the council node over the blocks [from, to], observed by
+// the snapshots taken every staking interval.
+func (api *stakingHistoryAPI) GetValidatorHistory(nodeId common.Address, from, to rpc.BlockNumber) (*staking.ValidatorHistory, error) {
+	current := api.s.Chain.CurrentBlock().NumberU64()
+	if from == rpc.LatestBlockNumber || from == rpc.PendingBlockNumber {
+		from = rpc.BlockNumber(current)
+	}
+	if to == rpc.LatestBlockNumber || to == rpc.PendingBlockNumber {
+		to = rpc.BlockNumber(current)
+	}
+	return api.s.GetValidatorHistory(nodeId, from.Uint64(), to.Uint64())
+}
+
+// StakingInfoChanges creates a subscription that is notified of the changes
+// between the consecutive StakingInfos, i.e. the council nodes added or removed,
+// and the changes of the staking amounts, the reward addresses and the
+// consolidations.
+func (api *stakingHistoryAPI) StakingInfoChanges(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		diffs := make(chan *staking.StakingInfoDiff, diffQueueSize)
+		sub := api.s.SubscribeStakingInfoDiff(diffs)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case diff := <-diffs:
+				notifier.Notify(rpcSub.ID, diff)
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
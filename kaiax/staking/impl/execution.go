@@ -19,12 +19,101 @@ package impl
 import (
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax/staking"
 )
 
+// PostInsertBlock wakes up the worker tracking the delegators of the
+// PublicDelegation contracts and capturing their status every staking interval.
+// It also queues the block to send the changes of the StakingInfo to the
+// subscribers, if any.
+func (s *StakingModule) PostInsertBlock(block *types.Block) error {
+	// Skip the blocks inserted to the side chains.
+	if current := s.Chain.CurrentBlock(); current == nil || current.Hash() != block.Hash() {
+		return nil
+	}
+
+	select {
+	case s.trackCh <- struct{}{}:
+	default: // Already woken up
+	}
+	if s.diffScope.Count() > 0 {
+		// Never block the insertion. A dropped block is covered by the diff
+		// made at the next block, since the diff is made from the last sent StakingInfo.
+		select {
+		case s.diffCh <- block.NumberU64():
+		default:
+			logger.Debug("Staking info diff queue is full", "num", block.NumberU64())
+		}
+	}
+	return nil
+}
+
+// diffLoop sends the changes of the StakingInfo to be used for the block after
+// each queued block, outside of the block insertion.
+func (s *StakingModule) diffLoop(diffCh <-chan uint64, quit <-chan struct{}) {
+	defer s.wg.Done()
+
+	var last *staking.StakingInfo // The StakingInfo the next diff is made from
+	for {
+		select {
+		case num := <-diffCh:
+			// Without subscribers, make the next diff from the latest StakingInfo.
+			if s.diffScope.Count() == 0 {
+				last = nil
+				continue
+			}
+			diff, next, err := s.makeStakingInfoDiff(last, num)
+			if err != nil {
+				logger.Warn("Failed to make staking info diff", "num", num, "err", err)
+				continue
+			}
+			last = next
+			if diff != nil {
+				s.diffFeed.Send(diff)
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// makeStakingInfoDiff returns the changes from the StakingInfo last (that of the
+// block num if nil) to the one to be used for the block num+1, and the latter.
+// The diff is nil if nothing changed.
+func (s *StakingModule) makeStakingInfoDiff(last *staking.StakingInfo, num uint64) (*staking.StakingInfoDiff, *staking.StakingInfo, error) {
+	if last == nil {
+		si, err := s.GetStakingInfo(num)
+		if err != nil {
+			return nil, nil, err
+		}
+		last = si
+	}
+	next, err := s.GetStakingInfo(num + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if next.SourceBlockNum == last.SourceBlockNum {
+		return nil, last, nil
+	}
+
+	if diff := staking.DiffStakingInfo(last, next); !diff.Empty() {
+		return diff, next, nil
+	}
+	return nil, next, nil
+}
+
+// SubscribeStakingInfoDiff subscribes to the changes between the consecutive
+// StakingInfos, sent as the blocks are inserted.
+func (s *StakingModule) SubscribeStakingInfoDiff(ch chan<- *staking.StakingInfoDiff) event.Subscription {
+	return s.diffScope.Track(s.diffFeed.Subscribe(ch))
+}
+
 func (s *StakingModule) RewindTo(newBlock *types.Block) {
 	// Nothing to do
 }
 
 func (s *StakingModule) RewindDelete(hash common.Hash, num uint64) {
 	DeleteStakingInfo(s.ChainKv, num)
+	DeletePublicDelegationInfo(s.ChainKv, num)
 }
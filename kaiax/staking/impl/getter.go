@@ -80,16 +80,24 @@ func (s *StakingModule) GetStakingInfoFromDB(sourceNum uint64) (*staking.Staking
 
 // Read the staking status from the blockchain state.
 func (s *StakingModule) getFromStateByNumber(num uint64) (*staking.StakingInfo, error) {
+	header, statedb, err := s.stateAt(num)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getFromState(header, statedb)
+}
+
+func (s *StakingModule) stateAt(num uint64) (*types.Header, *state.StateDB, error) {
 	header := s.Chain.GetHeaderByNumber(num)
 	if header == nil {
-		return nil, fmt.Errorf("failed to get header for block number %d", num)
+		return nil, nil, fmt.Errorf("failed to get header for block number %d", num)
 	}
 	statedb, err := s.Chain.StateAt(header.Root)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get state for block number %d: %v", num, err)
+		return nil, nil, fmt.Errorf("failed to get state for block number %d: %v", num, err)
 	}
-
-	return s.getFromState(header, statedb)
+	return header, statedb, nil
 }
 
 // Efficiently read addresses and balances from the AddressBook in one EVM call.
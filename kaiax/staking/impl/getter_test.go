@@ -19,11 +19,13 @@ package impl
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
 	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/system"
 	"github.com/kaiachain/kaia/common"
+	testcontract "github.com/kaiachain/kaia/contracts/contracts/testing/reward"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/params"
@@ -94,6 +96,65 @@ func TestGetStakingInfo_Uncached(t *testing.T) {
 	assert.Equal(t, expected, si)
 }
 
+func TestGetStakingInfo_SimulatedStaking(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		db     = database.NewMemoryDBManager()
+		config = &params.ChainConfig{
+			ChainID:    common.Big1,
+			Governance: params.GetDefaultGovernanceConfig(),
+		}
+		simStaking = backends.SimulatedStaking{
+			AddressBookCode: common.FromHex(testcontract.AddressBookMockBinRuntime),
+			Validators: []backends.SimulatedValidator{
+				{
+					NodeId:          common.HexToAddress("0xa0"),
+					StakingContract: common.HexToAddress("0xa1"),
+					RewardAddr:      common.HexToAddress("0xa2"),
+					StakingAmount:   new(big.Int).Mul(big.NewInt(5_000_000), big.NewInt(params.KAIA)),
+				},
+				{
+					NodeId:          common.HexToAddress("0xb0"),
+					StakingContract: common.HexToAddress("0xb1"),
+					RewardAddr:      common.HexToAddress("0xb2"),
+					StakingAmount:   new(big.Int).Mul(big.NewInt(7_000_000), big.NewInt(params.KAIA)),
+				},
+			},
+			KIFAddr: common.HexToAddress("0xc0"),
+			KEFAddr: common.HexToAddress("0xc1"),
+		}
+
+		// The staking info seeded by backends.WithStaking
+		expected = &staking.StakingInfo{
+			SourceBlockNum:   0,
+			NodeIds:          []common.Address{common.HexToAddress("0xa0"), common.HexToAddress("0xb0")},
+			StakingContracts: []common.Address{common.HexToAddress("0xa1"), common.HexToAddress("0xb1")},
+			RewardAddrs:      []common.Address{common.HexToAddress("0xa2"), common.HexToAddress("0xb2")},
+			KIFAddr:          common.HexToAddress("0xc0"),
+			KEFAddr:          common.HexToAddress("0xc1"),
+			StakingAmounts:   []uint64{5_000_000, 7_000_000},
+		}
+	)
+
+	backend, err := backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithDatabase(db),
+		backends.WithChainConfig(config),
+		backends.WithStaking(simStaking),
+	)
+	assert.NoError(t, err)
+	defer backend.Close()
+
+	mStaking := NewStakingModule()
+	mStaking.Init(&InitOpts{
+		ChainKv:     db.GetMiscDB(),
+		ChainConfig: config,
+		Chain:       backend.BlockChain(),
+	})
+	si, err := mStaking.GetStakingInfo(0)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, si)
+}
+
 func TestSourceBlockNum(t *testing.T) {
 	testcases := []struct {
 		num      uint64
@@ -126,3 +187,155 @@ func TestSourceBlockNum(t *testing.T) {
 		assert.Equal(t, tc.expected, actual, i)
 	}
 }
+
+func TestNextSourceChange(t *testing.T) {
+	s := NewStakingModule()
+	s.ChainConfig = &params.ChainConfig{KaiaCompatibleBlock: big.NewInt(55)}
+	s.stakingInterval = 10
+
+	testcases := []struct {
+		num      uint64
+		expected uint64
+	}{
+		{0, 21},
+		{20, 21},
+		{21, 31},
+		{30, 31},
+		{41, 51},
+		{51, 55}, // Kaia hardfork
+		{55, 61},
+		{60, 61},
+		{61, 71},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, s.nextSourceChange(tc.num), tc.num)
+		isKaia := s.ChainConfig.IsKaiaForkEnabled(new(big.Int).SetUint64(tc.num))
+		assert.NotEqual(t,
+			sourceBlockNum(tc.num, isKaia, s.stakingInterval),
+			sourceBlockNum(tc.expected, s.ChainConfig.IsKaiaForkEnabled(new(big.Int).SetUint64(tc.expected)), s.stakingInterval),
+			tc.num)
+	}
+}
+
+func TestGetValidatorHistory(t *testing.T) {
+	var (
+		n1, n2 = common.HexToAddress("0xa0"), common.HexToAddress("0xb0")
+		s1, s2 = common.HexToAddress("0xa1"), common.HexToAddress("0xb1")
+		r1, r2 = common.HexToAddress("0xa2"), common.HexToAddress("0xb2")
+
+		both = func(num, amount1 uint64, reward1 common.Address) *staking.StakingInfo {
+			return &staking.StakingInfo{
+				SourceBlockNum:   num,
+				NodeIds:          []common.Address{n1, n2},
+				StakingContracts: []common.Address{s1, s2},
+				RewardAddrs:      []common.Address{reward1, r2},
+				StakingAmounts:   []uint64{amount1, 7_000_000},
+			}
+		}
+		onlyN2 = func(num uint64) *staking.StakingInfo {
+			return &staking.StakingInfo{
+				SourceBlockNum:   num,
+				NodeIds:          []common.Address{n2},
+				StakingContracts: []common.Address{s2},
+				RewardAddrs:      []common.Address{r2},
+				StakingAmounts:   []uint64{7_000_000},
+			}
+		}
+	)
+
+	s := NewStakingModule()
+	s.ChainConfig = &params.ChainConfig{KaiaCompatibleBlock: big.NewInt(55)}
+	s.stakingInterval = 10
+
+	// Seed the cache by the source block numbers instead of the states.
+	for _, si := range []*staking.StakingInfo{
+		both(0, 5_000_000, r1),
+		both(10, 5_000_000, r1), // unchanged
+		both(20, 6_000_000, r1), // amount changed, used from block 31
+		onlyN2(30),              // removed, used from block 41
+		both(40, 6_000_000, r2), // added back, consolidated with n2, used from block 51
+		both(54, 6_000_000, r2), // unchanged, used at block 55
+		both(60, 6_000_000, r1), // reward address changed, used at block 61
+	} {
+		s.stakingInfoCache.Add(si.SourceBlockNum, si)
+	}
+
+	history, err := s.GetValidatorHistory(n1, 0, 61)
+	assert.NoError(t, err)
+	assert.Equal(t, n1, history.NodeId)
+	assert.Equal(t, uint64(10), history.SnapshotInterval)
+
+	type entry struct {
+		blockNum, sourceNum uint64
+		exists              bool
+		diff                *staking.ValidatorDiff
+	}
+	expected := []entry{
+		{0, 0, true, nil},
+		{31, 20, true, &staking.ValidatorDiff{NodeId: n1, StakingAmount: &staking.AmountChange{From: 5_000_000, To: 6_000_000}}},
+		{41, 30, false, nil},
+		{51, 40, true, nil},
+		{61, 60, true, &staking.ValidatorDiff{
+			NodeId:           n1,
+			RewardAddr:       &staking.AddressChange{From: r2, To: r1},
+			ConsolidatedWith: &staking.ConsolidationChange{From: []common.Address{n2}, To: []common.Address{}},
+		}},
+	}
+	assert.Len(t, history.Entries, len(expected))
+	for i, e := range history.Entries {
+		assert.Equal(t, expected[i].blockNum, e.BlockNum, i)
+		assert.Equal(t, expected[i].sourceNum, e.SourceBlockNum, i)
+		assert.Equal(t, expected[i].exists, e.Validator != nil, i)
+		assert.Equal(t, expected[i].diff, e.Diff, i)
+	}
+	assert.Equal(t, []common.Address{n2}, history.Entries[3].Validator.ConsolidatedWith)
+	assert.Equal(t, uint64(13_000_000), history.Entries[3].Validator.ConsolidatedStakingAmount)
+
+	_, err = s.GetValidatorHistory(n1, 10, 9)
+	assert.ErrorIs(t, err, staking.ErrInvalidRange)
+}
+
+func TestDiffLoop(t *testing.T) {
+	var (
+		n1, n2 = common.HexToAddress("0xa0"), common.HexToAddress("0xb0")
+		si     = func(num uint64, nodeIds ...common.Address) *staking.StakingInfo {
+			info := &staking.StakingInfo{SourceBlockNum: num}
+			for _, nodeId := range nodeIds {
+				info.NodeIds = append(info.NodeIds, nodeId)
+				info.StakingContracts = append(info.StakingContracts, nodeId)
+				info.RewardAddrs = append(info.RewardAddrs, nodeId)
+				info.StakingAmounts = append(info.StakingAmounts, 5_000_000)
+			}
+			return info
+		}
+	)
+
+	s := NewStakingModule()
+	s.ChainConfig = &params.ChainConfig{}
+	s.stakingInterval = 10
+	s.stakingInfoCache.Add(uint64(0), si(0, n1))
+	s.stakingInfoCache.Add(uint64(10), si(10, n1, n2))
+
+	s.diffCh = make(chan uint64, diffQueueSize)
+	s.quit = make(chan struct{})
+	s.wg.Add(1)
+	go s.diffLoop(s.diffCh, s.quit)
+	defer s.Stop()
+
+	diffs := make(chan *staking.StakingInfoDiff, 1)
+	sub := s.SubscribeStakingInfoDiff(diffs)
+	defer sub.Unsubscribe()
+
+	// Block 21 is the first one using the StakingInfo of block 10.
+	s.diffCh <- 19
+	s.diffCh <- 20
+	select {
+	case diff := <-diffs:
+		assert.Equal(t, uint64(0), diff.FromBlockNum)
+		assert.Equal(t, uint64(10), diff.ToBlockNum)
+		assert.Len(t, diff.Added, 1)
+		assert.Equal(t, n2, diff.Added[0].NodeId)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+}
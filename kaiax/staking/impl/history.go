@@ -0,0 +1,98 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/kaiax/staking"
+)
+
+// GetValidatorHistory returns the changes of the staking status of the node over
+// the blocks [from, to]. The entries are the snapshots of the StakingInfos at the
+// staking intervals, so a change within a staking interval after Kaia hardfork
+// is reported at the next snapshot, up to a staking interval late.
+func (s *StakingModule) GetValidatorHistory(nodeId common.Address, from, to uint64) (*staking.ValidatorHistory, error) {
+	if from > to {
+		return nil, staking.ErrInvalidRange
+	}
+
+	history := &staking.ValidatorHistory{
+		NodeId:           nodeId,
+		From:             from,
+		To:               to,
+		SnapshotInterval: s.stakingInterval,
+		Entries:          []*staking.ValidatorHistoryEntry{},
+	}
+	var (
+		prev  *staking.ValidatorHistoryEntry
+		count = 0
+	)
+	for num := from; num <= to; num = s.nextSourceChange(num) {
+		if count++; count > staking.MaxHistorySnapshots {
+			return nil, staking.ErrHistoryTooLong
+		}
+		si, err := s.GetStakingInfo(num)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &staking.ValidatorHistoryEntry{
+			BlockNum:       num,
+			SourceBlockNum: si.SourceBlockNum,
+			Validator:      si.Validator(nodeId),
+		}
+		if prev != nil {
+			switch {
+			case prev.Validator == nil && entry.Validator == nil:
+				continue
+			case prev.Validator != nil && entry.Validator != nil:
+				if entry.Diff = entry.Validator.Diff(prev.Validator); entry.Diff == nil {
+					continue
+				}
+			}
+		}
+		history.Entries = append(history.Entries, entry)
+		prev = entry
+	}
+	return history, nil
+}
+
+// nextSourceChange returns the first block after num whose staking info is
+// sourced from the next staking interval block, or from the block before Kaia
+// hardfork.
+func (s *StakingModule) nextSourceChange(num uint64) uint64 {
+	if s.ChainConfig.IsKaiaForkEnabled(new(big.Int).SetUint64(num)) {
+		if num == 0 {
+			return s.stakingInterval + 1
+		}
+		return roundDown(num-1, s.stakingInterval) + s.stakingInterval + 1
+	}
+
+	var next uint64
+	if num <= 2*s.stakingInterval {
+		next = 2*s.stakingInterval + 1
+	} else {
+		next = roundDown(num-1, s.stakingInterval) + s.stakingInterval + 1
+	}
+	// The source block changes at Kaia hardfork to the previous block.
+	if kaia := s.ChainConfig.KaiaCompatibleBlock; kaia != nil && kaia.Uint64() > num && kaia.Uint64() < next {
+		next = kaia.Uint64()
+	}
+	return next
+}
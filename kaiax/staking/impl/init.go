@@ -18,9 +18,12 @@ package impl
 
 import (
 	"math/big"
+	"sync"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/params"
@@ -29,6 +32,7 @@ import (
 
 var (
 	_ staking.StakingModule = &StakingModule{}
+	_ kaiax.ExecutionModule = &StakingModule{}
 
 	logger = log.NewModuleLogger(log.KaiaxStaking)
 )
@@ -37,6 +41,11 @@ type InitOpts struct {
 	ChainKv     database.Database
 	ChainConfig *params.ChainConfig
 	Chain       backends.BlockChainForCaller
+
+	// The first block whose PublicDelegation delegators are tracked, e.g. where
+	// the first PublicDelegation is deployed. If 0, the tracking starts from the
+	// head when it first starts.
+	DelegatorsStartBlock uint64
 }
 
 type StakingModule struct {
@@ -48,12 +57,32 @@ type StakingModule struct {
 	minimumStake    *big.Int
 
 	stakingInfoCache *lru.ARCCache // cached by sourceNum
+	pdInfoCache      *lru.ARCCache // cached by sourceNum
+	pdContractCache  *lru.ARCCache // whether an address is a PublicDelegation
+
+	// Feed of the changes between the consecutive StakingInfos
+	diffFeed  event.Feed
+	diffScope event.SubscriptionScope
+	diffCh    chan uint64 // The inserted block numbers to make the diffs at
+
+	trackCh chan struct{} // Wakes up the worker tracking the delegators
+
+	quit chan struct{}
+	wg   sync.WaitGroup
 }
 
+// diffQueueSize is the number of the inserted blocks that can wait for their
+// StakingInfo diffs to be made.
+const diffQueueSize = 64
+
 func NewStakingModule() *StakingModule {
 	cache, _ := lru.NewARC(128)
+	pdInfoCache, _ := lru.NewARC(128)
+	pdContractCache, _ := lru.NewARC(1024)
 	return &StakingModule{
 		stakingInfoCache: cache,
+		pdInfoCache:      pdInfoCache,
+		pdContractCache:  pdContractCache,
 	}
 }
 
@@ -79,8 +108,23 @@ func (s *StakingModule) Init(opts *InitOpts) error {
 func (s *StakingModule) Start() error {
 	// This module may have restarted after a rewind. Purge the cache.
 	s.stakingInfoCache.Purge()
+	s.pdInfoCache.Purge()
+
+	s.diffCh = make(chan uint64, diffQueueSize)
+	s.trackCh = make(chan struct{}, 1)
+	s.quit = make(chan struct{})
+	s.wg.Add(2)
+	go s.diffLoop(s.diffCh, s.quit)
+	go s.trackLoop(s.trackCh, s.quit)
+	s.trackCh <- struct{}{} // Catch up with the blocks inserted while stopped
+
 	return nil
 }
 
 func (s *StakingModule) Stop() {
+	if s.quit != nil {
+		close(s.quit)
+		s.wg.Wait()
+		s.quit = nil
+	}
 }
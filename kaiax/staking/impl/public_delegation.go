@@ -0,0 +1,398 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind"
+	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/system"
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/contracts/contracts/system_contracts/consensus"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/kaiax/staking"
+)
+
+const publicDelegationContractType = "PublicDelegation"
+
+// transferEventSig is the signature of the ERC20 Transfer event, emitted by
+// PublicDelegation as its shares are minted, transferred and burnt.
+var transferEventSig = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// receiptsReader is implemented by the full blockchain, to track the delegators
+// from the logs.
+type receiptsReader interface {
+	GetReceiptsByBlockHash(blockHash common.Hash) types.Receipts
+}
+
+// GetPublicDelegationInfo returns the status of the PublicDelegation contracts of
+// the council, captured at the last multiple of the staking interval at or before
+// the block `num`.
+func (s *StakingModule) GetPublicDelegationInfo(num uint64) (*staking.PublicDelegationInfo, error) {
+	sourceNum := roundDown(num, s.stakingInterval)
+
+	if pi, ok := s.pdInfoCache.Get(sourceNum); ok {
+		return pi.(*staking.PublicDelegationInfo), nil
+	}
+	if pi := ReadPublicDelegationInfo(s.ChainKv, sourceNum); pi != nil {
+		s.pdInfoCache.Add(sourceNum, pi)
+		return pi, nil
+	}
+
+	pi, err := s.getPublicDelegationFromState(sourceNum)
+	if err != nil {
+		return nil, err
+	}
+	// Persist only if the delegators are tracked up to the block. Otherwise the
+	// delegators who received the shares in the untracked blocks would be missing.
+	if cursor := ReadDelegatorsCursor(s.ChainKv); cursor != nil && cursor.Num >= sourceNum {
+		WritePublicDelegationInfo(s.ChainKv, sourceNum, pi)
+		s.pdInfoCache.Add(sourceNum, pi)
+	}
+	return pi, nil
+}
+
+// getPublicDelegationFromState reads the PublicDelegation contracts of the
+// council at the block `num` from the state. The delegators are the accounts
+// ever holding the shares, tracked by trackDelegators. The contracts are read on
+// a single copy of the state through the MultiCall contract caller.
+func (s *StakingModule) getPublicDelegationFromState(num uint64) (*staking.PublicDelegationInfo, error) {
+	header, statedb, err := s.stateAt(num)
+	if err != nil {
+		return nil, err
+	}
+	si, err := s.stakingInfoAt(header, statedb)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		caller = system.NewContractCallerForMultiCall(statedb, s.Chain, header)
+		opts   = &bind.CallOpts{BlockNumber: header.Number}
+		pi     = &staking.PublicDelegationInfo{
+			SourceBlockNum:    num,
+			PublicDelegations: []*staking.PublicDelegation{},
+		}
+	)
+	if trackedFrom := ReadDelegatorsTrackedFrom(s.ChainKv); trackedFrom != nil {
+		pi.DelegatorsTrackedFrom = *trackedFrom
+		pi.DelegatorsComplete = *trackedFrom <= 1 // The genesis block has no transactions
+	}
+	for i, addr := range si.StakingContracts {
+		cnV3, err := consensus.NewCnStakingV3Caller(addr, caller)
+		if err != nil {
+			return nil, err
+		}
+		// The older staking contracts revert as they don't have the function.
+		if enabled, err := cnV3.IsPublicDelegationEnabled(opts); err != nil || !enabled {
+			continue
+		}
+		pd, err := s.readPublicDelegation(caller, opts, cnV3)
+		if err != nil {
+			return nil, staking.ErrPublicDelegationCall(addr, err)
+		}
+		pd.NodeId = si.NodeIds[i]
+		pd.StakingContract = addr
+		pi.PublicDelegations = append(pi.PublicDelegations, pd)
+	}
+	return pi, nil
+}
+
+// stakingInfoAt returns the staking info captured at the block of the header.
+func (s *StakingModule) stakingInfoAt(header *types.Header, statedb *state.StateDB) (*staking.StakingInfo, error) {
+	if si, ok := s.stakingInfoCache.Get(header.Number.Uint64()); ok {
+		return si.(*staking.StakingInfo), nil
+	}
+	return s.getFromState(header, statedb)
+}
+
+func (s *StakingModule) readPublicDelegation(backend bind.ContractCaller, opts *bind.CallOpts, cnV3 *consensus.CnStakingV3Caller) (*staking.PublicDelegation, error) {
+	pdAddr, err := cnV3.PublicDelegation(opts)
+	if err != nil {
+		return nil, err
+	}
+	pdCaller, err := consensus.NewPublicDelegationCaller(pdAddr, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := &staking.PublicDelegation{Address: pdAddr, Delegators: []*staking.Delegator{}}
+	if pd.CommissionTo, err = pdCaller.CommissionTo(opts); err != nil {
+		return nil, err
+	}
+	rate, err := pdCaller.CommissionRate(opts)
+	if err != nil {
+		return nil, err
+	}
+	pd.CommissionRate = rate.Uint64()
+	if pd.RedelegationEnabled, err = cnV3.IsRedelegationEnabled(opts); err != nil {
+		return nil, err
+	}
+	if pd.TotalAssets, err = pdCaller.TotalAssets(opts); err != nil {
+		return nil, err
+	}
+	if pd.TotalShares, err = pdCaller.TotalSupply(opts); err != nil {
+		return nil, err
+	}
+	staked, err := cnV3.Staking(opts)
+	if err != nil {
+		return nil, err
+	}
+	if pd.PendingWithdrawals, err = cnV3.Unstaking(opts); err != nil {
+		return nil, err
+	}
+	pd.EffectiveStake = new(big.Int).Sub(staked, pd.PendingWithdrawals)
+	lockup, err := cnV3.STAKELOCKUP(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range ReadPublicDelegators(s.ChainKv, pdAddr) {
+		d, err := readDelegator(opts, cnV3, pdCaller, addr, lockup)
+		if err != nil {
+			return nil, err
+		}
+		if d.Shares.Sign() == 0 && len(d.PendingWithdrawals) == 0 {
+			continue
+		}
+		if pd.TotalShares.Sign() > 0 {
+			d.VotingPower = new(big.Int).Mul(pd.EffectiveStake, d.Shares)
+			d.VotingPower.Div(d.VotingPower, pd.TotalShares)
+		} else {
+			d.VotingPower = big.NewInt(0)
+		}
+		pd.Delegators = append(pd.Delegators, d)
+	}
+	return pd, nil
+}
+
+func readDelegator(opts *bind.CallOpts, cnV3 *consensus.CnStakingV3Caller, pdCaller *consensus.PublicDelegationCaller, addr common.Address, lockup *big.Int) (*staking.Delegator, error) {
+	d := &staking.Delegator{Address: addr, PendingWithdrawals: []*staking.WithdrawalRequest{}}
+
+	var err error
+	if d.Shares, err = pdCaller.BalanceOf(opts, addr); err != nil {
+		return nil, err
+	}
+	if d.Assets, err = pdCaller.ConvertToAssets(opts, d.Shares); err != nil {
+		return nil, err
+	}
+
+	ids, err := pdCaller.GetUserRequestIds(opts, addr)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		state, err := pdCaller.GetCurrentWithdrawalRequestState(opts, id)
+		if err != nil {
+			return nil, err
+		}
+		if !staking.IsPendingWithdrawal(state) {
+			continue
+		}
+		req, err := cnV3.GetApprovedStakingWithdrawalInfo(opts, id)
+		if err != nil {
+			return nil, err
+		}
+		d.PendingWithdrawals = append(d.PendingWithdrawals, &staking.WithdrawalRequest{
+			Id:               id.Uint64(),
+			Amount:           req.Value,
+			WithdrawableFrom: req.WithdrawableFrom.Uint64(),
+			State:            state,
+		})
+	}
+
+	last, err := cnV3.LastRedelegation(opts, addr)
+	if err != nil {
+		return nil, err
+	}
+	if d.LastRedelegation = last.Uint64(); d.LastRedelegation != 0 {
+		d.RedelegationLockedUntil = d.LastRedelegation + lockup.Uint64()
+	}
+	return d, nil
+}
+
+// trackLoop tracks the delegators of the canonical blocks and captures the
+// PublicDelegationInfo every staking interval, outside of the block insertion.
+func (s *StakingModule) trackLoop(trackCh <-chan struct{}, quit <-chan struct{}) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-trackCh:
+			s.trackCanonical(quit)
+		case <-quit:
+			return
+		}
+	}
+}
+
+// trackCanonical tracks the delegators of the canonical blocks after the cursor
+// up to the head. The tracking starts from DelegatorsStartBlock, or from the head
+// if not given. If the tracking started after DelegatorsStartBlock, it restarts
+// from DelegatorsStartBlock to catch up with the blocks in between.
+func (s *StakingModule) trackCanonical(quit <-chan struct{}) {
+	head := s.Chain.CurrentBlock()
+	if head == nil {
+		return
+	}
+
+	cursor := ReadDelegatorsCursor(s.ChainKv)
+	if trackedFrom := ReadDelegatorsTrackedFrom(s.ChainKv); cursor == nil || trackedFrom == nil ||
+		(s.DelegatorsStartBlock > 0 && s.DelegatorsStartBlock <= head.NumberU64() && s.DelegatorsStartBlock < *trackedFrom) {
+		cursor = s.startTracking(head, cursor)
+	}
+	s.rewindCursor(cursor)
+
+	// Whether a contract is a PublicDelegation is checked at the head.
+	headState, err := s.Chain.StateAt(head.Root())
+	if err != nil {
+		logger.Warn("Failed to track public delegators", "num", head.NumberU64(), "err", err)
+		return
+	}
+	caller := system.NewContractCallerForMultiCall(headState, s.Chain, head.Header())
+
+	for num := cursor.Num + 1; num <= head.NumberU64(); num++ {
+		select {
+		case <-quit:
+			return
+		default:
+		}
+
+		header := s.Chain.GetHeaderByNumber(num)
+		if header == nil {
+			return
+		}
+		s.trackDelegators(header, caller)
+		cursor = &delegatorsCursor{Num: num, Hash: header.Hash()}
+		WriteDelegatorsCursor(s.ChainKv, cursor)
+
+		if num%s.stakingInterval == 0 {
+			if _, err := s.GetPublicDelegationInfo(num); err != nil {
+				logger.Warn("Failed to capture public delegation info", "num", num, "err", err)
+			}
+		}
+	}
+}
+
+// startTracking starts tracking the delegators from DelegatorsStartBlock, or
+// from the head if not given or not inserted yet, and returns the cursor before
+// the start. If the tracking restarts from an earlier block, the captured
+// PublicDelegationInfos up to the previous cursor are deleted, since they lack
+// the delegators of the blocks in between. The delegators tracked so far are
+// kept as they are tracked again anyway.
+func (s *StakingModule) startTracking(head *types.Block, prev *delegatorsCursor) *delegatorsCursor {
+	var (
+		trackedFrom = head.NumberU64() + 1
+		cursor      = &delegatorsCursor{Num: head.NumberU64(), Hash: head.Hash()}
+	)
+	if head.NumberU64() == 0 {
+		trackedFrom = 0
+	}
+	if start := s.DelegatorsStartBlock; start > 0 && start <= head.NumberU64() {
+		if parent := s.Chain.GetHeaderByNumber(start - 1); parent != nil {
+			trackedFrom = start
+			cursor = &delegatorsCursor{Num: start - 1, Hash: parent.Hash()}
+		}
+	}
+
+	if prev != nil {
+		for num := roundDown(trackedFrom+s.stakingInterval-1, s.stakingInterval); num <= prev.Num; num += s.stakingInterval {
+			DeletePublicDelegationInfo(s.ChainKv, num)
+		}
+		s.pdInfoCache.Purge()
+	}
+	logger.Info("Start tracking public delegators", "from", trackedFrom, "head", head.NumberU64())
+	WriteDelegatorsTrackedFrom(s.ChainKv, trackedFrom)
+	WriteDelegatorsCursor(s.ChainKv, cursor)
+	return cursor
+}
+
+// rewindCursor moves the cursor back to the last canonical block, if the blocks
+// at the cursor have been replaced by a reorg or deleted by a rewind.
+func (s *StakingModule) rewindCursor(cursor *delegatorsCursor) {
+	for cursor.Num > 0 {
+		if header := s.Chain.GetHeaderByNumber(cursor.Num); header != nil && header.Hash() == cursor.Hash {
+			return
+		}
+		if header := s.Chain.GetHeader(cursor.Hash, cursor.Num); header != nil {
+			cursor.Hash = header.ParentHash
+		} else if parent := s.Chain.GetHeaderByNumber(cursor.Num - 1); parent != nil {
+			cursor.Hash = parent.Hash() // The descendants of a canonical block are deleted by a rewind.
+		}
+		cursor.Num--
+	}
+}
+
+// trackDelegators records the accounts receiving the shares of the
+// PublicDelegation contracts in the block, since the delegators cannot be
+// enumerated from the contracts. The contracts emitting Transfer events are
+// classified once by their CONTRACT_TYPE.
+func (s *StakingModule) trackDelegators(header *types.Header, caller bind.ContractCaller) {
+	chain, ok := s.Chain.(receiptsReader)
+	if !ok {
+		return
+	}
+
+	received := make(map[common.Address][]common.Address)
+	for _, receipt := range chain.GetReceiptsByBlockHash(header.Hash()) {
+		for _, log := range receipt.Logs {
+			if len(log.Topics) != 3 || log.Topics[0] != transferEventSig {
+				continue
+			}
+			if !s.isPublicDelegation(caller, log.Address) {
+				continue
+			}
+			if to := common.BytesToAddress(log.Topics[2].Bytes()); !common.EmptyAddress(to) {
+				received[log.Address] = append(received[log.Address], to)
+			}
+		}
+	}
+
+	for pdAddr, addrs := range received {
+		delegators := ReadPublicDelegators(s.ChainKv, pdAddr)
+		known := make(map[common.Address]bool, len(delegators))
+		for _, addr := range delegators {
+			known[addr] = true
+		}
+		changed := false
+		for _, addr := range addrs {
+			if !known[addr] {
+				known[addr] = true
+				delegators = append(delegators, addr)
+				changed = true
+			}
+		}
+		if changed {
+			WritePublicDelegators(s.ChainKv, pdAddr, delegators)
+		}
+	}
+}
+
+func (s *StakingModule) isPublicDelegation(caller bind.ContractCaller, addr common.Address) bool {
+	if isPD, ok := s.pdContractCache.Get(addr); ok {
+		return isPD.(bool)
+	}
+	isPD := false
+	if pd, err := consensus.NewPublicDelegationCaller(addr, caller); err == nil {
+		ty, err := pd.CONTRACTTYPE(&bind.CallOpts{})
+		isPD = err == nil && ty == publicDelegationContractType
+	}
+	s.pdContractCache.Add(addr, isPD)
+	return isPD
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package impl
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
+	"github.com/kaiachain/kaia/common"
+	testcontract "github.com/kaiachain/kaia/contracts/contracts/testing/reward"
+	"github.com/kaiachain/kaia/kaiax/staking"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicDelegationSchema(t *testing.T) {
+	var (
+		db = database.NewMemoryDBManager().GetMiscDB()
+		pd = common.HexToAddress("0xa3")
+		pi = &staking.PublicDelegationInfo{
+			SourceBlockNum: 86400,
+			PublicDelegations: []*staking.PublicDelegation{{
+				NodeId:             common.HexToAddress("0xa0"),
+				StakingContract:    common.HexToAddress("0xa1"),
+				Address:            pd,
+				CommissionTo:       common.HexToAddress("0xa4"),
+				CommissionRate:     1000,
+				TotalAssets:        big.NewInt(300),
+				TotalShares:        big.NewInt(200),
+				EffectiveStake:     big.NewInt(250),
+				PendingWithdrawals: big.NewInt(50),
+				Delegators: []*staking.Delegator{{
+					Address:     common.HexToAddress("0xd0"),
+					Shares:      big.NewInt(200),
+					Assets:      big.NewInt(300),
+					VotingPower: big.NewInt(250),
+					PendingWithdrawals: []*staking.WithdrawalRequest{
+						{Id: 1, Amount: big.NewInt(50), WithdrawableFrom: 1000, State: staking.WithdrawalRequested},
+					},
+					LastRedelegation:        100,
+					RedelegationLockedUntil: 700,
+				}},
+			}},
+		}
+		delegators = []common.Address{common.HexToAddress("0xd0"), common.HexToAddress("0xd1")}
+	)
+
+	assert.Nil(t, ReadPublicDelegationInfo(db, 86400))
+	WritePublicDelegationInfo(db, 86400, pi)
+	assert.Equal(t, pi, ReadPublicDelegationInfo(db, 86400))
+	DeletePublicDelegationInfo(db, 86400)
+	assert.Nil(t, ReadPublicDelegationInfo(db, 86400))
+
+	assert.Nil(t, ReadPublicDelegators(db, pd))
+	WritePublicDelegators(db, pd, delegators)
+	assert.Equal(t, delegators, ReadPublicDelegators(db, pd))
+
+	cursor := &delegatorsCursor{Num: 86400, Hash: common.HexToHash("0x1234")}
+	assert.Nil(t, ReadDelegatorsCursor(db))
+	WriteDelegatorsCursor(db, cursor)
+	assert.Equal(t, cursor, ReadDelegatorsCursor(db))
+
+	assert.Nil(t, ReadDelegatorsTrackedFrom(db))
+	WriteDelegatorsTrackedFrom(db, 86401)
+	assert.Equal(t, uint64(86401), *ReadDelegatorsTrackedFrom(db))
+}
+
+func TestGetPublicDelegationInfo_NoPublicDelegation(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		db     = database.NewMemoryDBManager()
+		config = &params.ChainConfig{
+			ChainID:    common.Big1,
+			Governance: params.GetDefaultGovernanceConfig(),
+		}
+		simStaking = backends.SimulatedStaking{
+			AddressBookCode: common.FromHex(testcontract.AddressBookMockBinRuntime),
+			Validators: []backends.SimulatedValidator{
+				{
+					NodeId:          common.HexToAddress("0xa0"),
+					StakingContract: common.HexToAddress("0xa1"),
+					RewardAddr:      common.HexToAddress("0xa2"),
+					StakingAmount:   new(big.Int).Mul(big.NewInt(5_000_000), big.NewInt(params.KAIA)),
+				},
+			},
+			KIFAddr: common.HexToAddress("0xc0"),
+			KEFAddr: common.HexToAddress("0xc1"),
+		}
+	)
+
+	backend, err := backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithDatabase(db),
+		backends.WithChainConfig(config),
+		backends.WithStaking(simStaking),
+	)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	mStaking := NewStakingModule()
+	require.NoError(t, mStaking.Init(&InitOpts{
+		ChainKv:     db.GetMiscDB(),
+		ChainConfig: config,
+		Chain:       backend.BlockChain(),
+	}))
+
+	// The tracking starts from the genesis, hence the delegators are complete.
+	quit := make(chan struct{})
+	mStaking.trackCanonical(quit)
+	genesis := backend.BlockChain().CurrentBlock()
+	assert.Equal(t, &delegatorsCursor{Num: 0, Hash: genesis.Hash()}, ReadDelegatorsCursor(db.GetMiscDB()))
+
+	// The staking contract is not a CnStakingV3, hence no PublicDelegation.
+	pi, err := mStaking.GetPublicDelegationInfo(0)
+	require.NoError(t, err)
+	assert.Equal(t, &staking.PublicDelegationInfo{
+		SourceBlockNum:        0,
+		PublicDelegations:     []*staking.PublicDelegation{},
+		DelegatorsTrackedFrom: 0,
+		DelegatorsComplete:    true,
+	}, pi)
+
+	// Persisted as the delegators are tracked up to the block.
+	assert.Equal(t, pi, ReadPublicDelegationInfo(db.GetMiscDB(), 0))
+
+	// Blocks without PublicDelegation events track nothing, but move the cursor.
+	backend.Commit()
+	mStaking.trackCanonical(quit)
+	head := backend.BlockChain().CurrentBlock()
+	assert.Equal(t, &delegatorsCursor{Num: 1, Hash: head.Hash()}, ReadDelegatorsCursor(db.GetMiscDB()))
+
+	// The cursor at a replaced block moves back to the canonical block.
+	cursor := &delegatorsCursor{Num: 1, Hash: common.HexToHash("0x1234")}
+	mStaking.rewindCursor(cursor)
+	assert.Equal(t, &delegatorsCursor{Num: 0, Hash: genesis.Hash()}, cursor)
+	cursor = &delegatorsCursor{Num: 1, Hash: head.Hash()}
+	mStaking.rewindCursor(cursor)
+	assert.Equal(t, &delegatorsCursor{Num: 1, Hash: head.Hash()}, cursor)
+}
+
+func TestTrackCanonical_StartBlock(t *testing.T) {
+	log.EnableLogForTest(log.LvlCrit, log.LvlWarn)
+	var (
+		db     = database.NewMemoryDBManager()
+		config = &params.ChainConfig{
+			ChainID:    common.Big1,
+			Governance: params.GetDefaultGovernanceConfig(),
+		}
+	)
+	config.Governance.Reward.StakingUpdateInterval = 2
+
+	backend, err := backends.NewSimulatedBackendWithOptions(nil,
+		backends.WithDatabase(db),
+		backends.WithChainConfig(config),
+		backends.WithStaking(backends.SimulatedStaking{
+			AddressBookCode: common.FromHex(testcontract.AddressBookMockBinRuntime),
+			Validators: []backends.SimulatedValidator{{
+				NodeId:          common.HexToAddress("0xa0"),
+				StakingContract: common.HexToAddress("0xa1"),
+				RewardAddr:      common.HexToAddress("0xa2"),
+				StakingAmount:   new(big.Int).Mul(big.NewInt(5_000_000), big.NewInt(params.KAIA)),
+			}},
+		}),
+	)
+	require.NoError(t, err)
+	defer backend.Close()
+	for i := 0; i < 3; i++ {
+		backend.Commit()
+	}
+	chain := backend.BlockChain()
+	head := chain.CurrentBlock()
+
+	newModule := func(startBlock uint64) *StakingModule {
+		mStaking := NewStakingModule()
+		require.NoError(t, mStaking.Init(&InitOpts{
+			ChainKv:              db.GetMiscDB(),
+			ChainConfig:          config,
+			Chain:                chain,
+			DelegatorsStartBlock: startBlock,
+		}))
+		return mStaking
+	}
+	quit := make(chan struct{})
+
+	// Without the start block, the tracking starts after the head.
+	newModule(0).trackCanonical(quit)
+	assert.Equal(t, uint64(4), *ReadDelegatorsTrackedFrom(db.GetMiscDB()))
+	assert.Equal(t, &delegatorsCursor{Num: 3, Hash: head.Hash()}, ReadDelegatorsCursor(db.GetMiscDB()))
+
+	// A later start block doesn't change the tracking.
+	newModule(10).trackCanonical(quit)
+	assert.Equal(t, uint64(4), *ReadDelegatorsTrackedFrom(db.GetMiscDB()))
+
+	// An earlier start block restarts the tracking and catches up to the head,
+	// capturing the PublicDelegationInfos again.
+	WritePublicDelegationInfo(db.GetMiscDB(), 2, &staking.PublicDelegationInfo{SourceBlockNum: 2, DelegatorsTrackedFrom: 4})
+	newModule(1).trackCanonical(quit)
+	assert.Equal(t, uint64(1), *ReadDelegatorsTrackedFrom(db.GetMiscDB()))
+	assert.Equal(t, &delegatorsCursor{Num: 3, Hash: head.Hash()}, ReadDelegatorsCursor(db.GetMiscDB()))
+	pi := ReadPublicDelegationInfo(db.GetMiscDB(), 2)
+	require.NotNil(t, pi)
+	assert.Equal(t, uint64(1), pi.DelegatorsTrackedFrom)
+	assert.True(t, pi.DelegatorsComplete)
+}
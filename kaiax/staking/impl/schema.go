@@ -17,14 +17,29 @@
 package impl
 
 import (
+	"encoding/binary"
 	"encoding/json"
 
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/kaiax/staking"
+	"github.com/kaiachain/kaia/rlp"
 	"github.com/kaiachain/kaia/storage/database"
 )
 
-var stakingInfoPrefix = []byte("stakingInfo")
+var (
+	stakingInfoPrefix          = []byte("stakingInfo")
+	publicDelegationInfoPrefix = []byte("publicDelegationInfo")
+	publicDelegatorsPrefix     = []byte("publicDelegators")
+
+	publicDelegatorsTrackedKey     = []byte("publicDelegatorsTracked")
+	publicDelegatorsTrackedFromKey = []byte("publicDelegatorsTrackedFrom")
+)
+
+// delegatorsCursor is the last block whose delegators are tracked.
+type delegatorsCursor struct {
+	Num  uint64
+	Hash common.Hash
+}
 
 func stakingInfoKey(num uint64) []byte {
 	return append(stakingInfoPrefix, common.Int64ToByteLittleEndian(num)...)
@@ -61,3 +76,115 @@ func DeleteStakingInfo(db database.Database, num uint64) {
 		logger.Crit("Failed to delete StakingInfo", "num", num, "err", err)
 	}
 }
+
+func publicDelegationInfoKey(num uint64) []byte {
+	return append(publicDelegationInfoPrefix, common.Int64ToByteLittleEndian(num)...)
+}
+
+func ReadPublicDelegationInfo(db database.Database, num uint64) *staking.PublicDelegationInfo {
+	b, err := db.Get(publicDelegationInfoKey(num))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	pi := new(staking.PublicDelegationInfo)
+	if err := json.Unmarshal(b, pi); err != nil {
+		logger.Error("Malformed public delegation info", "num", num, "err", err)
+		return nil
+	}
+	return pi
+}
+
+func WritePublicDelegationInfo(db database.Database, num uint64, pi *staking.PublicDelegationInfo) {
+	b, err := json.Marshal(pi)
+	if err != nil {
+		logger.Error("Failed to marshal PublicDelegationInfo", "num", num, "err", err)
+		return
+	}
+
+	if err := db.Put(publicDelegationInfoKey(num), b); err != nil {
+		logger.Crit("Failed to write PublicDelegationInfo", "num", num, "err", err)
+	}
+}
+
+func DeletePublicDelegationInfo(db database.Database, num uint64) {
+	if err := db.Delete(publicDelegationInfoKey(num)); err != nil {
+		logger.Crit("Failed to delete PublicDelegationInfo", "num", num, "err", err)
+	}
+}
+
+func publicDelegatorsKey(pd common.Address) []byte {
+	return append(publicDelegatorsPrefix, pd.Bytes()...)
+}
+
+// ReadPublicDelegators returns the accounts ever holding the shares of the
+// PublicDelegation, in the order they first received.
+func ReadPublicDelegators(db database.Database, pd common.Address) []common.Address {
+	b, err := db.Get(publicDelegatorsKey(pd))
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	var delegators []common.Address
+	if err := rlp.DecodeBytes(b, &delegators); err != nil {
+		logger.Error("Malformed public delegators", "pd", pd, "err", err)
+		return nil
+	}
+	return delegators
+}
+
+func WritePublicDelegators(db database.Database, pd common.Address, delegators []common.Address) {
+	b, err := rlp.EncodeToBytes(delegators)
+	if err != nil {
+		logger.Error("Failed to encode public delegators", "pd", pd, "err", err)
+		return
+	}
+
+	if err := db.Put(publicDelegatorsKey(pd), b); err != nil {
+		logger.Crit("Failed to write public delegators", "pd", pd, "err", err)
+	}
+}
+
+// ReadDelegatorsCursor returns the last block whose delegators are tracked, or
+// nil if the tracking has never started.
+func ReadDelegatorsCursor(db database.Database) *delegatorsCursor {
+	b, err := db.Get(publicDelegatorsTrackedKey)
+	if err != nil || len(b) == 0 {
+		return nil
+	}
+
+	cursor := new(delegatorsCursor)
+	if err := rlp.DecodeBytes(b, cursor); err != nil {
+		logger.Error("Malformed public delegators cursor", "err", err)
+		return nil
+	}
+	return cursor
+}
+
+func WriteDelegatorsCursor(db database.Database, cursor *delegatorsCursor) {
+	b, err := rlp.EncodeToBytes(cursor)
+	if err != nil {
+		logger.Error("Failed to encode public delegators cursor", "err", err)
+		return
+	}
+
+	if err := db.Put(publicDelegatorsTrackedKey, b); err != nil {
+		logger.Crit("Failed to write public delegators cursor", "err", err)
+	}
+}
+
+// ReadDelegatorsTrackedFrom returns the first block whose delegators are tracked.
+func ReadDelegatorsTrackedFrom(db database.Database) *uint64 {
+	b, err := db.Get(publicDelegatorsTrackedFromKey)
+	if err != nil || len(b) != 8 {
+		return nil
+	}
+	num := binary.LittleEndian.Uint64(b)
+	return &num
+}
+
+func WriteDelegatorsTrackedFrom(db database.Database, num uint64) {
+	if err := db.Put(publicDelegatorsTrackedFromKey, common.Int64ToByteLittleEndian(num)); err != nil {
+		logger.Crit("Failed to write public delegators tracked from", "num", num, "err", err)
+	}
+}
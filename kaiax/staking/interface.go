@@ -20,6 +20,10 @@ import (
 	"github.com/kaiachain/kaia/kaiax"
 )
 
+// MaxHistorySnapshots is the maximum number of StakingInfos read to answer a
+// history query, i.e. the number of staking intervals in the range.
+const MaxHistorySnapshots = 1000
+
 //go:generate mockgen -destination=mock/staking.go -package=mock github.com/kaiachain/kaia/kaiax/staking StakingModule
 type StakingModule interface {
 	kaiax.BaseModule
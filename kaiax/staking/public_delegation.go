@@ -0,0 +1,102 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+// The states of the withdrawal requests of PublicDelegation.
+// See IPublicDelegation.WithdrawalRequestState.
+const (
+	WithdrawalUndefined uint8 = iota
+	WithdrawalRequested
+	WithdrawalWithdrawable
+	WithdrawalWithdrawn
+	WithdrawalPendingCancel
+	WithdrawalCanceled
+)
+
+// PublicDelegationInfo is the status of the PublicDelegation contracts of the
+// council captured at the block SourceBlockNum, which is a multiple of the
+// staking interval.
+type PublicDelegationInfo struct {
+	SourceBlockNum    uint64              `json:"blockNum"`
+	PublicDelegations []*PublicDelegation `json:"publicDelegations"`
+
+	// The delegators are tracked from the blocks since DelegatorsTrackedFrom.
+	// If the tracking started after genesis, the delegators who have not
+	// received any shares since then are missing, and DelegatorsComplete is false.
+	DelegatorsTrackedFrom uint64 `json:"delegatorsTrackedFrom"`
+	DelegatorsComplete    bool   `json:"delegatorsComplete"`
+}
+
+// PublicDelegation is the status of a PublicDelegation contract managing the
+// stakes of a CnStakingV3 on behalf of the delegators. All amounts are in kei.
+type PublicDelegation struct {
+	NodeId          common.Address `json:"nodeId"`
+	StakingContract common.Address `json:"stakingContract"` // The CnStakingV3
+	Address         common.Address `json:"address"`         // The PublicDelegation
+
+	CommissionTo        common.Address `json:"commissionTo"`
+	CommissionRate      uint64         `json:"commissionRate"` // In basis points
+	RedelegationEnabled bool           `json:"redelegationEnabled"`
+
+	TotalAssets *big.Int `json:"totalAssets"` // The delegated amount including the rewards to be compounded
+	TotalShares *big.Int `json:"totalShares"`
+
+	// EffectiveStake is the stake counted for the voting power of the node,
+	// i.e. the staking amount of the CnStakingV3 less the withdrawals requested.
+	EffectiveStake     *big.Int `json:"effectiveStake"`
+	PendingWithdrawals *big.Int `json:"pendingWithdrawals"`
+
+	Delegators []*Delegator `json:"delegators"`
+}
+
+// Delegator is the delegation of an account to a PublicDelegation.
+type Delegator struct {
+	Address common.Address `json:"address"`
+	Shares  *big.Int       `json:"shares"`
+	Assets  *big.Int       `json:"assets"` // The amount the shares are worth
+
+	// VotingPower is the share of the EffectiveStake of the node.
+	VotingPower *big.Int `json:"votingPower"`
+
+	// The withdrawals requested and not yet withdrawn or canceled.
+	PendingWithdrawals []*WithdrawalRequest `json:"pendingWithdrawals"`
+
+	// The time of the last redelegation to this node, and the time until which
+	// the delegator cannot redelegate again. Zero if never redelegated.
+	LastRedelegation        uint64 `json:"lastRedelegation"`
+	RedelegationLockedUntil uint64 `json:"redelegationLockedUntil"`
+}
+
+// WithdrawalRequest is a withdrawal requested by a delegator.
+type WithdrawalRequest struct {
+	Id               uint64   `json:"id"`
+	Amount           *big.Int `json:"amount"`
+	WithdrawableFrom uint64   `json:"withdrawableFrom"`
+	State            uint8    `json:"state"` // One of the Withdrawal* states
+}
+
+// IsPendingWithdrawal returns true if the withdrawal request is neither
+// withdrawn nor canceled.
+func IsPendingWithdrawal(state uint8) bool {
+	return state == WithdrawalRequested || state == WithdrawalWithdrawable || state == WithdrawalPendingCancel
+}
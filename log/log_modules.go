@@ -129,6 +129,10 @@ const (
 	FORK
 	NodeCnGasPrice
 	KaiaxStaking
+	StoragePathDB
+	KaiaxReward
+	KaiaxRandao
+	KaiaxKaiaBridge
 
 	// ModuleNameLen should be placed at the end of the list.
 	ModuleNameLen
@@ -207,4 +211,8 @@ var moduleNames = [ModuleNameLen]string{
 	"fork",
 	"node/cn/gasprice",
 	"kaiax/staking",
+	"storage/pathdb",
+	"kaiax/reward",
+	"kaiax/randao",
+	"kaiax/kaiabridge",
 }
@@ -35,6 +35,8 @@ import (
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -50,6 +52,36 @@ type Listener struct {
 type grpcReadWriteNopCloser struct {
 	io.Reader
 	io.Writer
+	remote string
+}
+
+// RemoteAddr returns the address of the gRPC peer for the RPC request log.
+func (t *grpcReadWriteNopCloser) RemoteAddr() string { return t.remote }
+
+// withAPIKey returns ctx with the API key of the client in the metadata of the
+// gRPC context, which identifies the client for the RPC rate limit if it is one
+// of the allowlisted keys.
+func withAPIKey(ctx context.Context, grpcCtx context.Context) context.Context {
+	header := rpc.RateLimitAPIKeyHeader()
+	if header == "" {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(grpcCtx)
+	if !ok {
+		return ctx
+	}
+	if keys := md.Get(header); len(keys) > 0 && keys[0] != "" {
+		return context.WithValue(ctx, "apikey", keys[0])
+	}
+	return ctx
+}
+
+// peerAddr returns the address of the gRPC peer of the context.
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
 }
 
 func (t *grpcReadWriteNopCloser) SetWriteDeadline(time.Time) error { return nil }
@@ -128,10 +160,10 @@ func (kns *kaiaServer) BiCall(stream KlaytnNode_BiCallServer) error {
 			return dec.Decode(v)
 		}
 
-		ctx := context.Background()
+		ctx := withAPIKey(context.Background(), stream.Context())
 
 		reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
-		kns.handler.ServeSingleRequest(ctx, rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, nil}}, encoder, decoder))
+		kns.handler.ServeSingleRequest(ctx, rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, nil}, peerAddr(stream.Context())}, encoder, decoder))
 	}
 }
 
@@ -168,10 +200,10 @@ func (kns *kaiaServer) Subscribe(request *RPCRequest, stream KlaytnNode_Subscrib
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := withAPIKey(context.Background(), stream.Context())
 
 	reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
-	kns.handler.ServeSingleRequest(ctx, rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, writeErr}}, encoder, decoder))
+	kns.handler.ServeSingleRequest(ctx, rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, &grpcWriter{stream, writeErr}, peerAddr(stream.Context())}, encoder, decoder))
 
 	var err error
 loop:
@@ -227,7 +259,7 @@ func (kns *kaiaServer) Call(ctx context.Context, request *RPCRequest) (*RPCRespo
 	}
 
 	reader := bufio.NewReaderSize(preader, common.MaxRequestContentLength)
-	kns.handler.ServeSingleRequest(ctx, rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, writer}, encoder, decoder))
+	kns.handler.ServeSingleRequest(withAPIKey(ctx, ctx), rpc.NewFuncCodec(&grpcReadWriteNopCloser{reader, writer, peerAddr(ctx)}, encoder, decoder))
 loop:
 	for {
 		select {
@@ -97,6 +97,7 @@ type dialstate struct {
 	maxDynDials int
 	ntab        discover.Discovery
 	netrestrict *netutil.Netlist
+	banned      func(discover.NodeID) bool // reports the nodes banned by the reputation if set
 
 	lookupRunning      bool
 	typedLookupRunning map[dialType]bool
@@ -439,6 +440,7 @@ var (
 	errExpired            = errors.New("is expired")
 	errExceedMaxTypedDial = errors.New("exceeded max typed dial")
 	errUpdateDial         = errors.New("updated to be multichannel peer")
+	errBanned             = errors.New("is banned")
 )
 
 func (s *dialstate) checkDial(n *discover.Node, peers map[discover.NodeID]*Peer) error {
@@ -452,6 +454,8 @@ func (s *dialstate) checkDial(n *discover.Node, peers map[discover.NodeID]*Peer)
 		return errSelf
 	case s.netrestrict != nil && !s.netrestrict.Contains(n.IP):
 		return errNotWhitelisted
+	case s.banned != nil && s.banned(n.ID):
+		return errBanned
 	case s.hist.contains(n.ID):
 		return errRecentlyDialed
 	}
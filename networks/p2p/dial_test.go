@@ -391,6 +391,34 @@ func TestDialStateNetRestrict(t *testing.T) {
 	})
 }
 
+// This test checks that banned nodes are not dialed.
+func TestDialStateBanned(t *testing.T) {
+	wantStatic := []*discover.Node{
+		{ID: uintID(1)},
+		{ID: uintID(2)},
+	}
+	table := fakeTable{
+		{ID: uintID(3), IP: net.ParseIP("127.0.0.3")},
+		{ID: uintID(4), IP: net.ParseIP("127.0.0.4")},
+	}
+	banned := map[discover.NodeID]bool{uintID(1): true, uintID(3): true}
+	state := newDialState(wantStatic, nil, table, 10, nil, nil, nil)
+	state.banned = func(id discover.NodeID) bool { return banned[id] }
+
+	runDialTest(t, dialtest{
+		init: state,
+		rounds: []round{
+			{
+				new: []task{
+					&dialTask{flags: staticDialedConn, dest: &discover.Node{ID: uintID(2)}, dialType: DT_UNLIMITED},
+					&dialTask{flags: dynDialedConn, dest: table[1]},
+					&discoverTask{},
+				},
+			},
+		},
+	})
+}
+
 // This test checks that static dials are launched.
 func TestDialStateStaticDial(t *testing.T) {
 	wantStatic := []*discover.Node{
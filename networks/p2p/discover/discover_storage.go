@@ -72,6 +72,16 @@ func deleteNode(list []*Node, n *Node) []*Node {
 	return list
 }
 
+// containsNode reports whether list has the node of the id.
+func containsNode(list []*Node, id NodeID) bool {
+	for _, n := range list {
+		if n.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 // nodeTypeName converts NodeType to string.
 func nodeTypeName(nt NodeType) string { // TODO-Kaia-Node Consolidate p2p.NodeType and common.ConnType
 	switch nt {
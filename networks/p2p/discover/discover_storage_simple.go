@@ -112,7 +112,13 @@ func (s *simpleStorage) getNodes(max int) []*Node {
 	s.nodesMutex.Unlock()
 
 	if len(ret) < max {
-		ret = s.lookup(NodeID{}, true, s.targetType)
+		// Keep the stored nodes, which might be found by the topic discovery rather
+		// than by the lookup through the bootnodes.
+		for _, n := range s.lookup(NodeID{}, true, s.targetType) {
+			if !containsNode(ret, n.ID) {
+				ret = append(ret, n)
+			}
+		}
 	}
 
 	if len(ret) < max {
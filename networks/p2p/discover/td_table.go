@@ -0,0 +1,362 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"errors"
+	"fmt"
+	mrand "math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/networks/p2p/enr"
+)
+
+const (
+	recordBuckets = len(common.Hash{}) * 8 // one bucket per log distance
+
+	topicRegLifetime   = 15 * time.Minute // lifetime of a topic registration
+	maxTopicAdvertiser = 100              // maximum number of advertisers of a topic
+	maxTopicEntries    = 10000            // maximum number of registrations of all topics
+)
+
+// recordEntry is a node in the record table with its record.
+type recordEntry struct {
+	*Node
+	rec *enr.Record
+}
+
+// recordTable is the Kademlia table of the topic discovery. The nodes are kept in the
+// buckets of their log distance from the local node.
+type recordTable struct {
+	mu      sync.Mutex
+	self    common.Hash // sha of the local node id
+	buckets [recordBuckets + 1][]*recordEntry
+	rand    *mrand.Rand
+}
+
+func newRecordTable(self NodeID) *recordTable {
+	return &recordTable{
+		self: crypto.Keccak256Hash(self[:]),
+		rand: mrand.New(mrand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// add adds the node to the bucket of its distance or updates the record if the node
+// is already in the table. It returns false if the bucket is full.
+func (tab *recordTable) add(e *recordEntry) bool {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	d := logdist(tab.self, e.sha)
+	if d == 0 {
+		return false // the local node
+	}
+	b := tab.buckets[d]
+	for i := range b {
+		if b[i].ID == e.ID {
+			if e.rec.Seq() >= b[i].rec.Seq() {
+				b[i] = e
+			}
+			return true
+		}
+	}
+	if len(b) >= bucketSize {
+		return false
+	}
+	e.addedAt = time.Now()
+	tab.buckets[d] = append(b, e)
+	return true
+}
+
+// remove deletes the node from the table.
+func (tab *recordTable) remove(id NodeID) {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	d := logdist(tab.self, crypto.Keccak256Hash(id[:]))
+	b := tab.buckets[d]
+	for i := range b {
+		if b[i].ID == id {
+			tab.buckets[d] = append(b[:i:i], b[i+1:]...)
+			return
+		}
+	}
+}
+
+// get returns the node of the id, or nil if the node isn't in the table.
+func (tab *recordTable) get(id NodeID) *recordEntry {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	for _, e := range tab.buckets[logdist(tab.self, crypto.Keccak256Hash(id[:]))] {
+		if e.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// getBySha returns the node of the sha, or nil if the node isn't in the table.
+func (tab *recordTable) getBySha(sha common.Hash) *recordEntry {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	for _, e := range tab.buckets[logdist(tab.self, sha)] {
+		if e.sha == sha {
+			return e
+		}
+	}
+	return nil
+}
+
+// atDistance returns at most max records of the nodes at the given log distance.
+func (tab *recordTable) atDistance(d uint, max int) []*enr.Record {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	if d == 0 || d > uint(recordBuckets) {
+		return nil
+	}
+	var recs []*enr.Record
+	for _, e := range tab.buckets[d] {
+		if len(recs) >= max {
+			break
+		}
+		recs = append(recs, e.rec)
+	}
+	return recs
+}
+
+// closest returns at most n nodes closest to the target.
+func (tab *recordTable) closest(target common.Hash, n int) []*recordEntry {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	var all []*recordEntry
+	for _, b := range tab.buckets {
+		all = append(all, b...)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return distcmp(target, all[i].sha, all[j].sha) < 0
+	})
+	if len(all) > n {
+		all = all[:n]
+	}
+	return all
+}
+
+// random returns a random node of the table, or nil if the table is empty.
+func (tab *recordTable) random() *recordEntry {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	var all []*recordEntry
+	for _, b := range tab.buckets {
+		all = append(all, b...)
+	}
+	if len(all) == 0 {
+		return nil
+	}
+	return all[tab.rand.Intn(len(all))]
+}
+
+// len returns the number of the nodes in the table.
+func (tab *recordTable) len() int {
+	tab.mu.Lock()
+	defer tab.mu.Unlock()
+
+	n := 0
+	for _, b := range tab.buckets {
+		n += len(b)
+	}
+	return n
+}
+
+// Topic returns the topic under which the nodes of the given type in the network
+// advertise themselves. For example, the proxy nodes of the network 8217 advertise
+// the topic "kaia/8217/pn".
+func Topic(nType NodeType, networkID uint64) string {
+	return fmt.Sprintf("kaia/%d/%s", networkID, StringNodeType(nType))
+}
+
+// topicHash returns the position of the topic in the node id space. The advertisers
+// of a topic register themselves to the nodes closest to the topic hash.
+func topicHash(topic string) common.Hash {
+	return crypto.Keccak256Hash([]byte(topic))
+}
+
+// topicEntry is a registration of a topic advertiser.
+type topicEntry struct {
+	rec     *enr.Record
+	expires time.Time
+}
+
+// topicTable keeps the topic registrations received by the local node.
+type topicTable struct {
+	mu     sync.Mutex
+	topics map[string]map[NodeID]*topicEntry
+	count  int
+}
+
+func newTopicTable() *topicTable {
+	return &topicTable{topics: make(map[string]map[NodeID]*topicEntry)}
+}
+
+// register adds or renews the registration of the node. It returns false if the
+// topic or the table is full.
+func (tt *topicTable) register(topic string, id NodeID, rec *enr.Record, now time.Time) bool {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	tt.expire(now)
+	entries := tt.topics[topic]
+	if e, ok := entries[id]; ok {
+		e.rec, e.expires = rec, now.Add(topicRegLifetime)
+		return true
+	}
+	if len(entries) >= maxTopicAdvertiser || tt.count >= maxTopicEntries {
+		return false
+	}
+	if entries == nil {
+		entries = make(map[NodeID]*topicEntry)
+		tt.topics[topic] = entries
+	}
+	entries[id] = &topicEntry{rec: rec, expires: now.Add(topicRegLifetime)}
+	tt.count++
+	return true
+}
+
+// query returns the records of at most max advertisers of the topic.
+func (tt *topicTable) query(topic string, max int, now time.Time) []*enr.Record {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	tt.expire(now)
+	var recs []*enr.Record
+	for _, e := range tt.topics[topic] {
+		if len(recs) >= max {
+			break
+		}
+		recs = append(recs, e.rec)
+	}
+	return recs
+}
+
+// expire removes the expired registrations. The caller must hold tt.mu.
+func (tt *topicTable) expire(now time.Time) {
+	for topic, entries := range tt.topics {
+		for id, e := range entries {
+			if now.After(e.expires) {
+				delete(entries, id)
+				tt.count--
+			}
+		}
+		if len(entries) == 0 {
+			delete(tt.topics, topic)
+		}
+	}
+}
+
+// nodeFromRecord returns the node described by the record. If addr is nil, the
+// record must have the endpoint of the discovery protocol. Otherwise, the node is
+// at the given address regardless of the endpoint of the record.
+func nodeFromRecord(rec *enr.Record, addr *net.UDPAddr) (*Node, error) {
+	pub, err := rec.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ip    enr.IP
+		udp   enr.UDP
+		tcp   enr.TCP
+		ports enr.Ports
+		ct    = enr.ConnType(common.UNKNOWNNODE)
+	)
+	if addr != nil {
+		ip, udp = enr.IP(addr.IP), enr.UDP(addr.Port)
+	} else {
+		if err := rec.Load(&ip); err != nil {
+			return nil, err
+		}
+		if err := rec.Load(&udp); err != nil {
+			return nil, err
+		}
+	}
+	if err := rec.Load(&tcp); err != nil && !enr.IsNotFound(err) {
+		return nil, err
+	}
+	if err := rec.Load(&ports); err != nil && !enr.IsNotFound(err) {
+		return nil, err
+	}
+	if err := rec.Load(&ct); err != nil && !enr.IsNotFound(err) {
+		return nil, err
+	}
+	if net.IP(ip).IsUnspecified() || net.IP(ip).IsMulticast() {
+		return nil, errors.New("invalid IP (multicast/unspecified)")
+	}
+	if tcp == 0 {
+		tcp = enr.TCP(udp)
+	}
+	return NewNode(PubkeyID(pub), net.IP(ip), uint16(udp), uint16(tcp), ports, nodeTypeOf(common.ConnType(ct))), nil
+}
+
+// recordNetworkID returns the network id of the record, or false if the record
+// doesn't have one.
+func recordNetworkID(rec *enr.Record) (uint64, bool) {
+	var nid enr.NetworkID
+	if err := rec.Load(&nid); err != nil {
+		return 0, false
+	}
+	return uint64(nid), true
+}
+
+// nodeTypeOf converts the connection type of a node record to NodeType.
+func nodeTypeOf(ct common.ConnType) NodeType { // TODO-Kaia-Node Consolidate p2p.NodeType and common.ConnType
+	switch ct {
+	case common.CONSENSUSNODE:
+		return NodeTypeCN
+	case common.PROXYNODE:
+		return NodeTypePN
+	case common.ENDPOINTNODE:
+		return NodeTypeEN
+	case common.BOOTNODE:
+		return NodeTypeBN
+	default:
+		return NodeTypeUnknown
+	}
+}
+
+// connTypeOf converts NodeType to the connection type of a node record.
+func connTypeOf(nType NodeType) common.ConnType {
+	switch nType {
+	case NodeTypeCN:
+		return common.CONSENSUSNODE
+	case NodeTypePN:
+		return common.PROXYNODE
+	case NodeTypeEN:
+		return common.ENDPOINTNODE
+	case NodeTypeBN:
+		return common.BOOTNODE
+	default:
+		return common.UNKNOWNNODE
+	}
+}
@@ -0,0 +1,928 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"crypto/ecdsa"
+	crand "crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/networks/p2p/enr"
+	"github.com/kaiachain/kaia/networks/p2p/netutil"
+	"github.com/kaiachain/kaia/rlp"
+)
+
+const (
+	tdRespTimeout = 500 * time.Millisecond
+	maxTopicSize  = 64 // maximum length of a topic in bytes
+	topicRegNodes = 8  // number of the nodes a topic is registered to
+)
+
+var (
+	// These intervals are variables to be adjusted in tests.
+	topicRegInterval    = 5 * time.Minute  // interval of the topic registration, shorter than topicRegLifetime
+	topicSearchInterval = 30 * time.Second // interval of the search of the topics the node looks for
+
+	errTDBadRecord = errors.New("record doesn't match the sender")
+	errTDBadTopic  = errors.New("invalid topic")
+)
+
+// tdCall is a pending request waiting for its response.
+type tdCall struct {
+	node      *Node
+	ptype     byte
+	req       interface{}
+	nonce     [tdNonceSize]byte // nonce of the last packet of the request
+	handshake bool              // the request has been resent in a handshake
+	respType  byte
+	handle    func(msg interface{}) (done bool) // called in the read loop
+	done      chan error
+}
+
+// TopicUDP implements the topic discovery protocol on the discovery v5.1 wire protocol.
+// The local node is described by a signed node record which carries the connection
+// type, the multichannel ports and the network id of the node.
+//
+// As the packets can be sent with a forged source address, the messages are accepted
+// only in the sessions agreed by the handshake, which is answered at the endpoint
+// the challenge is sent to. The nodes are added to the table by a handshake, and
+// only if the endpoint of their record is the one the handshake is made at.
+//
+// Besides the Kademlia lookup, a node advertises the topic of its node type (see
+// Topic) by registering itself to the nodes closest to the topic hash, and searches
+// the topics of the node types it connects to. The advertisers found by a search
+// are added to the v4 table, so that the dialer connects to them. For example, an
+// EN finds PNs without the static list of PNs.
+type TopicUDP struct {
+	conn        conn
+	shared      bool // the connection is shared with the v4 protocol
+	priv        *ecdsa.PrivateKey
+	self        NodeID
+	codec       *tdCodec
+	networkID   uint64
+	netrestrict *netutil.Netlist
+	bootnodes   []*Node
+	v4          Discovery // the advertisers found by topic searches are added to it
+
+	recMu sync.Mutex
+	rec   *enr.Record
+
+	tab    *recordTable
+	topics *topicTable
+
+	advertise      []string
+	search         map[string]NodeType
+	regInterval    time.Duration
+	searchInterval time.Duration
+
+	pendMu  sync.Mutex
+	pending map[string]*tdCall
+
+	foundMu sync.Mutex
+	found   map[string][]*Node // the advertisers found by the last search of each topic
+
+	closeOnce sync.Once
+	closing   chan struct{}
+	wg        sync.WaitGroup
+}
+
+// ListenTopic starts the topic discovery on the connection of the configuration. If packets
+// is not nil, the packets are read from it instead of the connection, which is the
+// case when the v4 protocol shares the connection and forwards the unhandled packets.
+// If v4 is not nil, the advertisers found by topic searches are added to it.
+func ListenTopic(cfg *Config, packets <-chan ReadPacket, v4 Discovery) (*TopicUDP, error) {
+	realaddr := cfg.Addr
+	if cfg.AnnounceAddr != nil {
+		realaddr = cfg.AnnounceAddr
+	}
+	t := &TopicUDP{
+		conn:           cfg.Conn,
+		shared:         packets != nil,
+		priv:           cfg.PrivateKey,
+		self:           PubkeyID(&cfg.PrivateKey.PublicKey),
+		codec:          newTDCodec(cfg.PrivateKey),
+		networkID:      cfg.NetworkID,
+		netrestrict:    cfg.NetRestrict,
+		bootnodes:      cfg.Bootnodes,
+		v4:             v4,
+		tab:            newRecordTable(PubkeyID(&cfg.PrivateKey.PublicKey)),
+		topics:         newTopicTable(),
+		search:         make(map[string]NodeType),
+		regInterval:    topicRegInterval,
+		searchInterval: topicSearchInterval,
+		pending:        make(map[string]*tdCall),
+		found:          make(map[string][]*Node),
+		closing:        make(chan struct{}),
+	}
+
+	// Build the local record.
+	rec := new(enr.Record)
+	if ip := realaddr.IP; ip != nil && !ip.IsUnspecified() {
+		rec.Set(enr.IP(ip))
+	}
+	ports := cfg.Ports
+	if len(ports) == 0 {
+		ports = []uint16{uint16(realaddr.Port)}
+	}
+	rec.Set(enr.UDP(realaddr.Port))
+	rec.Set(enr.TCP(ports[0]))
+	rec.Set(enr.Ports(ports))
+	rec.Set(enr.ConnType(connTypeOf(cfg.NodeType)))
+	rec.Set(enr.NetworkID(cfg.NetworkID))
+	rec.SetSeq(1) // zero in a challenge requests the record, so the sequence number starts from one
+	if err := enr.SignV4(rec, cfg.PrivateKey); err != nil {
+		return nil, err
+	}
+	t.rec = rec
+
+	// The nodes advertise their own type, and search the types they connect to.
+	if cfg.NodeType != NodeTypeBN && cfg.NodeType != NodeTypeUnknown {
+		t.advertise = append(t.advertise, Topic(cfg.NodeType, cfg.NetworkID))
+	}
+	for _, nType := range tdSearchTypes(cfg.NodeType) {
+		t.search[Topic(nType, cfg.NetworkID)] = nType
+	}
+
+	t.wg.Add(2)
+	if packets != nil {
+		go t.dispatchLoop(packets)
+	} else {
+		go t.readLoop()
+	}
+	go t.loop()
+	logger.Info("UDP topic discovery listener up", "self", rec.String(), "advertise", t.advertise)
+	return t, nil
+}
+
+// tdSearchTypes returns the node types whose topics the node of the given type searches.
+// They are the types the dialer connects to (see p2p.BaseServer.getTypeStatics).
+func tdSearchTypes(nType NodeType) []NodeType {
+	switch nType {
+	case NodeTypeCN:
+		return []NodeType{NodeTypeCN}
+	case NodeTypePN:
+		return []NodeType{NodeTypePN}
+	case NodeTypeEN:
+		return []NodeType{NodeTypePN}
+	default:
+		return nil
+	}
+}
+
+// Close stops the topic discovery. The connection is closed if it isn't shared.
+func (t *TopicUDP) Close() {
+	t.closeOnce.Do(func() {
+		close(t.closing)
+		if !t.shared {
+			t.conn.Close()
+		}
+		t.wg.Wait()
+	})
+}
+
+// LocalRecord returns the signed record of the local node.
+func (t *TopicUDP) LocalRecord() *enr.Record {
+	t.recMu.Lock()
+	defer t.recMu.Unlock()
+	return t.rec
+}
+
+// Lookup finds the nodes closest to the target.
+func (t *TopicUDP) Lookup(target NodeID) []*Node {
+	return t.lookup(crypto.Keccak256Hash(target[:]))
+}
+
+// Advertisers returns the advertisers of the topic found by the last search.
+func (t *TopicUDP) Advertisers(topic string) []*Node {
+	t.foundMu.Lock()
+	defer t.foundMu.Unlock()
+	return append([]*Node{}, t.found[topic]...)
+}
+
+// loop bootstraps the table, and runs the periodic revalidation, topic registration
+// and topic search.
+func (t *TopicUDP) loop() {
+	defer t.wg.Done()
+
+	t.bootstrap()
+	var (
+		revalidate = time.NewTimer(0)
+		register   = time.NewTimer(0)
+		search     = time.NewTimer(0)
+	)
+	defer revalidate.Stop()
+	defer register.Stop()
+	defer search.Stop()
+	<-revalidate.C
+	revalidate.Reset(revalidateInterval)
+
+	for {
+		select {
+		case <-revalidate.C:
+			t.revalidate()
+			revalidate.Reset(revalidateInterval)
+		case <-register.C:
+			for _, topic := range t.advertise {
+				t.RegisterTopic(topic)
+			}
+			register.Reset(t.regInterval)
+		case <-search.C:
+			for topic, nType := range t.search {
+				nodes := t.SearchTopic(topic, nType)
+				if t.v4 != nil {
+					for _, n := range nodes {
+						t.v4.CreateUpdateNodeOnTable(n)
+					}
+				}
+			}
+			search.Reset(t.searchInterval)
+		case <-t.closing:
+			return
+		}
+	}
+}
+
+// bootstrap adds the bootstrap nodes to the table and fills the table by the lookup
+// of the local node.
+func (t *TopicUDP) bootstrap() {
+	for _, n := range t.bootnodes {
+		if n.ID == t.self {
+			continue
+		}
+		if err := t.addVerified(n); err != nil {
+			logger.Debug("Failed to add topic discovery bootnode", "node", n, "err", err)
+		}
+	}
+	t.lookup(crypto.Keccak256Hash(t.self[:]))
+}
+
+// revalidate checks the liveness of a random node, and removes it if it doesn't respond.
+func (t *TopicUDP) revalidate() {
+	e := t.tab.random()
+	if e == nil {
+		return
+	}
+	seq, err := t.ping(e.Node)
+	if err != nil {
+		logger.Trace("Removing unresponsive topic discovery node", "node", e.ID, "err", err)
+		t.tab.remove(e.ID)
+		return
+	}
+	if seq > e.rec.Seq() {
+		if rec, err := t.requestRecord(e.Node); err == nil {
+			t.tab.add(&recordEntry{Node: e.Node, rec: rec})
+		}
+	}
+}
+
+// lookup finds the nodes closest to the target hash. The nodes responded to the
+// queries are added to the table.
+func (t *TopicUDP) lookup(target common.Hash) []*Node {
+	var (
+		result  = nodesByDistance{target: target}
+		asked   = map[NodeID]bool{t.self: true}
+		seen    = map[NodeID]bool{t.self: true}
+		reply   = make(chan []*Node, alpha)
+		pending = 0
+	)
+	for _, e := range t.tab.closest(target, bucketSize) {
+		seen[e.ID] = true
+		result.push(e.Node, bucketSize)
+	}
+	for {
+		for i := 0; i < len(result.entries) && pending < alpha; i++ {
+			n := result.entries[i]
+			if !asked[n.ID] {
+				asked[n.ID] = true
+				pending++
+				go func() {
+					nodes, _ := t.findnode(n, lookupDistances(target, n.sha))
+					reply <- nodes
+				}()
+			}
+		}
+		if pending == 0 {
+			break
+		}
+		select {
+		case nodes := <-reply:
+			for _, n := range nodes {
+				if !seen[n.ID] {
+					seen[n.ID] = true
+					result.push(n, bucketSize)
+				}
+			}
+			pending--
+		case <-t.closing:
+			return nil
+		}
+	}
+	return result.entries
+}
+
+// lookupDistances returns the log distances from the node, at which the nodes close
+// to the target are.
+func lookupDistances(target, sha common.Hash) []uint {
+	d := logdist(target, sha)
+	dists := []uint{uint(d)}
+	if d < recordBuckets {
+		dists = append(dists, uint(d+1))
+	}
+	if d > 1 {
+		dists = append(dists, uint(d-1))
+	}
+	return dists
+}
+
+// RegisterTopic registers the local node as an advertiser of the topic to the nodes
+// closest to the topic hash. It returns the number of the accepted registrations.
+func (t *TopicUDP) RegisterTopic(topic string) int {
+	var (
+		nodes    = t.lookup(topicHash(topic))
+		accepted = 0
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	if len(nodes) > topicRegNodes {
+		nodes = nodes[:topicRegNodes]
+	}
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			if err := t.regtopic(n, topic); err != nil {
+				logger.Trace("Failed to register topic", "topic", topic, "node", n.ID, "err", err)
+				return
+			}
+			mu.Lock()
+			accepted++
+			mu.Unlock()
+		}(n)
+	}
+	wg.Wait()
+	logger.Debug("Registered topic", "topic", topic, "nodes", len(nodes), "accepted", accepted)
+	return accepted
+}
+
+// SearchTopic returns the advertisers of the topic, whose type is the given node type.
+// The advertisers are queried to the nodes closest to the topic hash.
+func (t *TopicUDP) SearchTopic(topic string, nType NodeType) []*Node {
+	var (
+		nodes = t.lookup(topicHash(topic))
+		found = make(map[NodeID]*Node)
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+	)
+	for _, n := range nodes {
+		wg.Add(1)
+		go func(n *Node) {
+			defer wg.Done()
+			advertisers, err := t.topicQuery(n, topic)
+			if err != nil {
+				logger.Trace("Failed to query topic", "topic", topic, "node", n.ID, "err", err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, a := range advertisers {
+				if a.NType == nType && a.ID != t.self {
+					found[a.ID] = a
+				}
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	result := make([]*Node, 0, len(found))
+	for _, n := range found {
+		result = append(result, n)
+	}
+	t.foundMu.Lock()
+	t.found[topic] = result
+	t.foundMu.Unlock()
+	logger.Debug("Searched topic", "topic", topic, "queried", len(nodes), "found", len(result))
+	return result
+}
+
+// ping sends a ping to the node and returns the sequence number of its record.
+// The local endpoint observed by the node is set to the local record if the local
+// record doesn't have an IP address.
+func (t *TopicUDP) ping(n *Node) (uint64, error) {
+	var pong *tdPong
+	req := &tdPing{ENRSeq: t.LocalRecord().Seq()}
+	err := t.call(n, tdPingMsg, req, &req.ReqID, tdPongMsg, func(msg interface{}) bool {
+		pong = msg.(*tdPong)
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	t.updateEndpoint(pong.ToIP)
+	return pong.ENRSeq, nil
+}
+
+// findnode sends a findnode to the node, and returns the nodes at the given distances
+// from it. The node is added to the table first.
+func (t *TopicUDP) findnode(n *Node, distances []uint) ([]*Node, error) {
+	if err := t.addVerified(n); err != nil {
+		return nil, err
+	}
+	recs, err := t.requestNodes(n, distances)
+	if err != nil {
+		return nil, err
+	}
+	return t.nodesFromRecords(n, recs), nil
+}
+
+// addVerified adds the node learned from a record to the table if it isn't in the
+// table. The record is requested from the node, which makes a session with it.
+func (t *TopicUDP) addVerified(n *Node) error {
+	if t.tab.get(n.ID) != nil {
+		return nil
+	}
+	rec, err := t.requestRecord(n)
+	if err != nil {
+		return err
+	}
+	t.tab.add(&recordEntry{Node: n, rec: rec})
+	return nil
+}
+
+// requestRecord requests the record of the node.
+func (t *TopicUDP) requestRecord(n *Node) (*enr.Record, error) {
+	recs, err := t.requestNodes(n, []uint{0})
+	if err != nil {
+		return nil, err
+	}
+	if len(recs) != 1 {
+		return nil, errTDBadRecord
+	}
+	if pub, err := recs[0].PublicKey(); err != nil || PubkeyID(pub) != n.ID {
+		return nil, errTDBadRecord
+	}
+	if nid, ok := recordNetworkID(recs[0]); !ok || nid != t.networkID {
+		return nil, errMismatchNetwork
+	}
+	return recs[0], nil
+}
+
+// requestNodes sends a findnode to the node and collects the records of the replies.
+func (t *TopicUDP) requestNodes(n *Node, distances []uint) ([]*enr.Record, error) {
+	var (
+		recs     []*enr.Record
+		received uint8
+	)
+	req := &tdFindnode{Distances: distances}
+	err := t.call(n, tdFindnodeMsg, req, &req.ReqID, tdNodesMsg, func(msg interface{}) bool {
+		resp := msg.(*tdNodes)
+		recs = append(recs, resp.Nodes...)
+		received++
+		return received >= resp.Total
+	})
+	return recs, err
+}
+
+// talk sends a request of the topic protocol to the node, and returns the response.
+// The response is empty if the node doesn't speak the topic protocol.
+func (t *TopicUDP) talk(n *Node, ptype byte, msg interface{}) ([]byte, error) {
+	b, err := rlp.EncodeToBytes(msg)
+	if err != nil {
+		return nil, err
+	}
+	var resp []byte
+	req := &tdTalkRequest{Protocol: tdTopicProtocol, Message: append([]byte{ptype}, b...)}
+	err = t.call(n, tdTalkRequestMsg, req, &req.ReqID, tdTalkResponseMsg, func(msg interface{}) bool {
+		resp = msg.(*tdTalkResponse).Message
+		return true
+	})
+	return resp, err
+}
+
+// regtopic registers the local node as an advertiser of the topic to the node.
+func (t *TopicUDP) regtopic(n *Node, topic string) error {
+	if err := t.addVerified(n); err != nil {
+		return err
+	}
+	resp, err := t.talk(n, tdRegtopicMsg, &tdRegtopic{Topic: topic, ENR: t.LocalRecord()})
+	if err != nil {
+		return err
+	}
+	var conf tdRegconfirmation
+	if len(resp) == 0 {
+		return errors.New("topic protocol not supported")
+	} else if err := rlp.DecodeBytes(resp, &conf); err != nil {
+		return err
+	}
+	if conf.Lifetime == 0 {
+		return errors.New("registration rejected")
+	}
+	return nil
+}
+
+// topicQuery queries the advertisers of the topic to the node.
+func (t *TopicUDP) topicQuery(n *Node, topic string) ([]*Node, error) {
+	if err := t.addVerified(n); err != nil {
+		return nil, err
+	}
+	resp, err := t.talk(n, tdTopicQueryMsg, &tdTopicQuery{Topic: topic})
+	if err != nil || len(resp) == 0 {
+		return nil, err
+	}
+	var nodes tdTopicNodes
+	if err := rlp.DecodeBytes(resp, &nodes); err != nil {
+		return nil, err
+	}
+	return t.nodesFromRecords(n, nodes.Nodes), nil
+}
+
+// nodesFromRecords converts the records received from the node to the nodes.
+// The invalid records and the records of the other networks are dropped.
+func (t *TopicUDP) nodesFromRecords(from *Node, recs []*enr.Record) []*Node {
+	nodes := make([]*Node, 0, len(recs))
+	for _, rec := range recs {
+		if nid, ok := recordNetworkID(rec); !ok || nid != t.networkID {
+			continue
+		}
+		n, err := nodeFromRecord(rec, nil)
+		if err != nil {
+			logger.Trace("Invalid node record received", "from", from.ID, "err", err)
+			continue
+		}
+		if err := netutil.CheckRelayIP(from.IP, n.IP); err != nil {
+			continue
+		}
+		if t.netrestrict != nil && !t.netrestrict.Contains(n.IP) {
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes
+}
+
+// updateEndpoint sets the IP address observed by the other node to the local record
+// if the local record doesn't have one.
+func (t *TopicUDP) updateEndpoint(ip net.IP) {
+	if ip == nil || ip.IsUnspecified() {
+		return
+	}
+	t.recMu.Lock()
+	defer t.recMu.Unlock()
+
+	var cur enr.IP
+	if err := t.rec.Load(&cur); err == nil {
+		return
+	}
+	rec := *t.rec
+	rec.Set(enr.IP(ip))
+	if err := enr.SignV4(&rec, t.priv); err != nil {
+		logger.Warn("Failed to sign the local record", "err", err)
+		return
+	}
+	t.rec = &rec
+	logger.Info("Updated the local record with the observed IP", "ip", ip, "seq", rec.Seq())
+}
+
+// call sends the request to the node, and waits until the handler reports that all
+// the responses have been received. If the node challenges the request, the request
+// is resent in the handshake packet.
+func (t *TopicUDP) call(n *Node, ptype byte, req interface{}, reqID *[]byte, respType byte, handle func(interface{}) bool) error {
+	id := make([]byte, 8)
+	crand.Read(id)
+	*reqID = id
+
+	packet, nonce, err := t.codec.encode(n.ID, n.addr(), ptype, req, nil, nil)
+	if err != nil {
+		return err
+	}
+	c := &tdCall{node: n, ptype: ptype, req: req, nonce: nonce, respType: respType, handle: handle, done: make(chan error, 1)}
+	t.pendMu.Lock()
+	t.pending[string(id)] = c
+	t.pendMu.Unlock()
+	defer func() {
+		t.pendMu.Lock()
+		delete(t.pending, string(id))
+		t.pendMu.Unlock()
+	}()
+
+	if err := t.write(n.addr(), ptype, packet); err != nil {
+		return err
+	}
+	timeout := time.NewTimer(tdRespTimeout)
+	defer timeout.Stop()
+	select {
+	case err := <-c.done:
+		return err
+	case <-timeout.C:
+		return errTimeout
+	case <-t.closing:
+		return errClosed
+	}
+}
+
+// send sends the message to the node in the session with it.
+func (t *TopicUDP) send(to NodeID, toaddr *net.UDPAddr, ptype byte, msg interface{}) error {
+	packet, _, err := t.codec.encode(to, toaddr, ptype, msg, nil, nil)
+	if err != nil {
+		return err
+	}
+	return t.write(toaddr, ptype, packet)
+}
+
+func (t *TopicUDP) write(toaddr *net.UDPAddr, ptype byte, packet []byte) error {
+	_, err := t.conn.WriteToUDP(packet, toaddr)
+	logger.Trace(">> "+tdMsgName(ptype), "addr", toaddr, "err", err)
+	return err
+}
+
+// readLoop reads the packets from the connection if it isn't shared.
+func (t *TopicUDP) readLoop() {
+	defer t.wg.Done()
+	buf := make([]byte, tdMaxPacketSize)
+	for {
+		nbytes, from, err := t.conn.ReadFromUDP(buf)
+		if netutil.IsTemporaryError(err) {
+			logger.Debug("Temporary UDP read error", "err", err)
+			continue
+		} else if err != nil {
+			logger.Debug("UDP topic discovery read error", "err", err)
+			return
+		}
+		t.handlePacket(from, buf[:nbytes])
+	}
+}
+
+// dispatchLoop handles the packets forwarded by the v4 protocol.
+func (t *TopicUDP) dispatchLoop(packets <-chan ReadPacket) {
+	defer t.wg.Done()
+	for {
+		select {
+		case p, ok := <-packets:
+			if !ok {
+				return
+			}
+			t.handlePacket(p.Addr, p.Data)
+		case <-t.closing:
+			return
+		}
+	}
+}
+
+func (t *TopicUDP) handlePacket(from *net.UDPAddr, buf []byte) {
+	fromID, fromNode, ptype, msg, rec, err := t.codec.decode(buf, from)
+	if err != nil {
+		logger.Debug("Bad topic discovery packet", "addr", from, "err", err)
+		return
+	}
+
+	switch m := msg.(type) {
+	case *tdWhoareyou:
+		logger.Trace("<< WHOAREYOU/v5", "addr", from)
+		err = t.handleWhoareyou(from, m)
+	case *tdUnknown:
+		logger.Trace("<< UNKNOWN/v5", "addr", from, "id", fromID)
+		err = t.handleUnknown(from, fromID, m)
+	default:
+		logger.Trace("<< "+tdMsgName(ptype), "addr", from, "id", fromNode)
+		if rec != nil {
+			if err := t.handleRecord(from, fromNode, rec); err != nil {
+				logger.Trace("Topic discovery node not added", "addr", from, "err", err)
+			}
+		}
+		switch m := msg.(type) {
+		case *tdPing:
+			err = t.handlePing(from, fromNode, m)
+		case *tdFindnode:
+			err = t.handleFindnode(from, fromNode, m)
+		case *tdTalkRequest:
+			err = t.handleTalkRequest(from, fromNode, m)
+		default:
+			err = t.handleResponse(fromNode, ptype, msg)
+		}
+	}
+	if err != nil {
+		logger.Trace("Failed to handle topic discovery packet", "type", tdMsgName(ptype), "addr", from, "err", err)
+	}
+}
+
+// handleResponse passes the response to the pending call of the request id.
+func (t *TopicUDP) handleResponse(fromID NodeID, ptype byte, msg interface{}) error {
+	t.pendMu.Lock()
+	defer t.pendMu.Unlock()
+
+	c := t.pending[string(tdReqID(msg))]
+	if c == nil || c.node.ID != fromID || c.respType != ptype {
+		return errUnsolicitedReply
+	}
+	if c.handle(msg) {
+		delete(t.pending, string(tdReqID(msg)))
+		c.done <- nil
+	}
+	return nil
+}
+
+// handleWhoareyou resends the challenged request in a handshake packet. A request is
+// resent only once.
+func (t *TopicUDP) handleWhoareyou(from *net.UDPAddr, w *tdWhoareyou) error {
+	t.pendMu.Lock()
+	var c *tdCall
+	for _, p := range t.pending {
+		if p.nonce == w.Nonce && p.node.IP.Equal(from.IP) && int(p.node.UDP) == from.Port {
+			c = p
+			break
+		}
+	}
+	if c == nil || c.handshake {
+		t.pendMu.Unlock()
+		return errUnsolicitedReply
+	}
+	c.handshake = true
+	packet, nonce, err := t.codec.encode(c.node.ID, from, c.ptype, c.req, w, t.LocalRecord())
+	if err == nil {
+		c.nonce = nonce
+	}
+	t.pendMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.write(from, c.ptype, packet)
+}
+
+// handleUnknown challenges the sender of the packet which couldn't be decrypted.
+// The record of the sender is requested in the challenge if it isn't in the table.
+func (t *TopicUDP) handleUnknown(from *net.UDPAddr, fromID common.Hash, u *tdUnknown) error {
+	if t.netrestrict != nil && !t.netrestrict.Contains(from.IP) {
+		return errUnauthorized
+	}
+	var rec *enr.Record
+	if e := t.tab.getBySha(fromID); e != nil {
+		rec = e.rec
+	}
+	packet, err := t.codec.encodeWhoareyou(fromID, from, u.Nonce, rec)
+	if err != nil {
+		return err
+	}
+	_, err = t.conn.WriteToUDP(packet, from)
+	logger.Trace(">> WHOAREYOU/v5", "addr", from, "err", err)
+	return err
+}
+
+// handleRecord adds the node of the record received in a handshake to the table.
+// The handshake proves the endpoint of the sender, which must be the endpoint of the
+// record as the record is handed to the other nodes. If the record doesn't have an
+// IP address yet, the node is added with the endpoint of the handshake.
+func (t *TopicUDP) handleRecord(from *net.UDPAddr, fromID NodeID, rec *enr.Record) error {
+	if nid, ok := recordNetworkID(rec); !ok || nid != t.networkID {
+		return errMismatchNetwork
+	}
+	if t.netrestrict != nil && !t.netrestrict.Contains(from.IP) {
+		return errUnauthorized
+	}
+	var ip enr.IP
+	addr := from
+	if err := rec.Load(&ip); err == nil {
+		addr = nil
+	}
+	n, err := nodeFromRecord(rec, addr)
+	if err != nil {
+		return err
+	}
+	var udp enr.UDP
+	if n.ID != fromID || !n.IP.Equal(from.IP) || rec.Load(&udp) != nil || int(udp) != from.Port {
+		return errTDBadRecord
+	}
+	t.tab.add(&recordEntry{Node: n, rec: rec})
+	return nil
+}
+
+func (t *TopicUDP) handlePing(from *net.UDPAddr, fromID NodeID, req *tdPing) error {
+	t.send(fromID, from, tdPongMsg, &tdPong{
+		ReqID:  req.ReqID,
+		ENRSeq: t.LocalRecord().Seq(),
+		ToIP:   from.IP,
+		ToPort: uint16(from.Port),
+	})
+
+	// The record of the sender is renewed if the sender has a newer one.
+	if e := t.tab.get(fromID); e != nil && req.ENRSeq > e.rec.Seq() {
+		go func() {
+			if rec, err := t.requestRecord(e.Node); err == nil {
+				t.tab.add(&recordEntry{Node: e.Node, rec: rec})
+			}
+		}()
+	}
+	return nil
+}
+
+func (t *TopicUDP) handleFindnode(from *net.UDPAddr, fromID NodeID, req *tdFindnode) error {
+	var recs []*enr.Record
+	for _, d := range req.Distances {
+		if d == 0 {
+			recs = append(recs, t.LocalRecord())
+		} else {
+			recs = append(recs, t.tab.atDistance(d, bucketSize-len(recs))...)
+		}
+		if len(recs) >= bucketSize {
+			break
+		}
+	}
+	t.sendNodes(fromID, from, req.ReqID, recs)
+	return nil
+}
+
+// handleTalkRequest handles the requests of the topic protocol. The requests of the
+// other protocols are answered with an empty response.
+func (t *TopicUDP) handleTalkRequest(from *net.UDPAddr, fromID NodeID, req *tdTalkRequest) error {
+	var (
+		resp interface{}
+		err  error
+	)
+	if req.Protocol == tdTopicProtocol && len(req.Message) > 0 {
+		switch req.Message[0] {
+		case tdRegtopicMsg:
+			var m tdRegtopic
+			if err = rlp.DecodeBytes(req.Message[1:], &m); err == nil {
+				var lifetime uint64
+				lifetime, err = t.handleRegtopic(from, fromID, &m)
+				resp = &tdRegconfirmation{Lifetime: lifetime}
+			}
+		case tdTopicQueryMsg:
+			var m tdTopicQuery
+			if err = rlp.DecodeBytes(req.Message[1:], &m); err == nil {
+				resp = &tdTopicNodes{Nodes: t.topics.query(m.Topic, tdNodesPerMsg, time.Now())}
+			}
+		default:
+			err = fmt.Errorf("unknown topic message type: %d", req.Message[0])
+		}
+	}
+	var msg []byte
+	if resp != nil {
+		msg, _ = rlp.EncodeToBytes(resp)
+	}
+	t.send(fromID, from, tdTalkResponseMsg, &tdTalkResponse{ReqID: req.ReqID, Message: msg})
+	return err
+}
+
+// handleRegtopic registers the sender as an advertiser of the topic. It returns the
+// lifetime of the registration in seconds, which is zero if the registration is rejected.
+func (t *TopicUDP) handleRegtopic(from *net.UDPAddr, fromID NodeID, req *tdRegtopic) (uint64, error) {
+	if len(req.Topic) == 0 || len(req.Topic) > maxTopicSize {
+		return 0, errTDBadTopic
+	}
+	if req.ENR == nil {
+		return 0, errTDBadRecord
+	}
+	if pub, err := req.ENR.PublicKey(); err != nil || PubkeyID(pub) != fromID {
+		return 0, errTDBadRecord
+	}
+	if nid, ok := recordNetworkID(req.ENR); !ok || nid != t.networkID {
+		return 0, errMismatchNetwork
+	}
+	// The advertiser must be reachable at the endpoint of its record, as the record
+	// is handed to the other nodes.
+	n, err := nodeFromRecord(req.ENR, nil)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IP.Equal(from.IP) || int(n.UDP) != from.Port {
+		return 0, errTDBadRecord
+	}
+	if !t.topics.register(req.Topic, fromID, req.ENR, time.Now()) {
+		return 0, nil
+	}
+	return uint64(topicRegLifetime / time.Second), nil
+}
+
+// sendNodes sends the records in chunks to stay below the packet size limit.
+// At least one packet is sent even if there is no record.
+func (t *TopicUDP) sendNodes(to NodeID, toaddr *net.UDPAddr, reqID []byte, recs []*enr.Record) {
+	total := (len(recs) + tdNodesPerMsg - 1) / tdNodesPerMsg
+	if total == 0 {
+		total = 1
+	}
+	for i := 0; i < total; i++ {
+		end := (i + 1) * tdNodesPerMsg
+		if end > len(recs) {
+			end = len(recs)
+		}
+		t.send(to, toaddr, tdNodesMsg, &tdNodes{ReqID: reqID, Total: uint8(total), Nodes: recs[i*tdNodesPerMsg : end]})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/networks/p2p/enr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTDNetworkID = 1001
+
+// newTDConfig returns the configuration of a node listening on the loopback address.
+func newTDConfig(t *testing.T, nType NodeType, networkID uint64, bootnodes ...*Node) *Config {
+	key, _ := crypto.GenerateKey()
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	addr := conn.LocalAddr().(*net.UDPAddr)
+	return &Config{
+		PrivateKey: key,
+		Id:         PubkeyID(&key.PublicKey),
+		Conn:       conn,
+		Addr:       addr,
+		NodeType:   nType,
+		NetworkID:  networkID,
+		Bootnodes:  bootnodes,
+		Ports:      []uint16{uint16(addr.Port), uint16(addr.Port + 1)},
+	}
+}
+
+func startTD(t *testing.T, nType NodeType, networkID uint64, bootnodes ...*Node) *TopicUDP {
+	udp, err := ListenTopic(newTDConfig(t, nType, networkID, bootnodes...), nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(udp.Close)
+	return udp
+}
+
+func tdNode(udp *TopicUDP) *Node {
+	n, err := nodeFromRecord(udp.LocalRecord(), nil)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func TestTopicUDP_Record(t *testing.T) {
+	udp := startTD(t, NodeTypePN, testTDNetworkID)
+
+	n := tdNode(udp)
+	assert.Equal(t, udp.self, n.ID)
+	assert.Equal(t, NodeTypePN, n.NType)
+	assert.Len(t, n.TCPs, 2)
+	assert.Equal(t, n.TCPs[0], n.TCP)
+	nid, ok := recordNetworkID(udp.LocalRecord())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(testTDNetworkID), nid)
+}
+
+func TestTopicUDP_TopicSearch(t *testing.T) {
+	bn := startTD(t, NodeTypeBN, testTDNetworkID)
+	pn := startTD(t, NodeTypePN, testTDNetworkID, tdNode(bn))
+	en := startTD(t, NodeTypeEN, testTDNetworkID, tdNode(bn))
+	other := startTD(t, NodeTypePN, testTDNetworkID+1, tdNode(bn))
+
+	// The ENs find the PN of the same network through the bootnode.
+	topic := Topic(NodeTypePN, testTDNetworkID)
+	require.Eventually(t, func() bool {
+		return pn.RegisterTopic(topic) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	var found []*Node
+	require.Eventually(t, func() bool {
+		found = en.SearchTopic(topic, NodeTypePN)
+		return len(found) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+	require.Len(t, found, 1)
+	assert.Equal(t, pn.self, found[0].ID)
+	assert.Equal(t, tdNode(pn).TCPs, found[0].TCPs)
+	assert.Equal(t, found, en.Advertisers(topic))
+
+	// The node of the other network isn't added to the table of the bootnode.
+	assert.Nil(t, bn.tab.get(other.self))
+	assert.NotNil(t, bn.tab.get(pn.self))
+	assert.Zero(t, other.RegisterTopic(Topic(NodeTypePN, testTDNetworkID+1)))
+}
+
+func TestTopicUDP_SharedConn(t *testing.T) {
+	defer func(d time.Duration) { topicSearchInterval = d }(topicSearchInterval)
+	topicSearchInterval = 100 * time.Millisecond
+
+	bn := startTD(t, NodeTypeBN, testTDNetworkID)
+	pn := startTD(t, NodeTypePN, testTDNetworkID, tdNode(bn))
+	require.Eventually(t, func() bool {
+		return pn.RegisterTopic(Topic(NodeTypePN, testTDNetworkID)) > 0
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// The EN runs the v4 and the topic discovery on the same connection, and the PN found by the
+	// topic search is added to the v4 table.
+	cfg := newTDConfig(t, NodeTypeEN, testTDNetworkID, tdNode(bn))
+	unhandled := make(chan ReadPacket, 16)
+	cfg.Unhandled = unhandled
+	v4, err := ListenUDP(cfg)
+	require.NoError(t, err)
+	defer v4.Close()
+	en, err := ListenTopic(cfg, unhandled, v4)
+	require.NoError(t, err)
+	defer en.Close()
+
+	require.Eventually(t, func() bool {
+		nodes := v4.GetNodes(NodeTypePN, 2)
+		return len(nodes) == 1 && nodes[0].ID == pn.self
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestTopicUDP_Regtopic(t *testing.T) {
+	bn := startTD(t, NodeTypeBN, testTDNetworkID)
+	pn := startTD(t, NodeTypePN, testTDNetworkID)
+	en := startTD(t, NodeTypeEN, testTDNetworkID)
+
+	// The record not reachable at its endpoint is rejected.
+	rec := *pn.LocalRecord()
+	rec.Set(enr.UDP(1))
+	require.NoError(t, enr.SignV4(&rec, pn.priv))
+	from := pn.conn.LocalAddr().(*net.UDPAddr)
+	req := &tdRegtopic{Topic: "kaia/1001/pn", ENR: &rec}
+	_, err := bn.handleRegtopic(from, pn.self, req)
+	assert.Equal(t, errTDBadRecord, err)
+
+	// The record of the other node is rejected.
+	req.ENR = pn.LocalRecord()
+	_, err = bn.handleRegtopic(en.conn.LocalAddr().(*net.UDPAddr), en.self, req)
+	assert.Equal(t, errTDBadRecord, err)
+
+	lifetime, err := bn.handleRegtopic(from, pn.self, req)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(topicRegLifetime/time.Second), lifetime)
+	assert.Len(t, bn.topics.query(req.Topic, bucketSize, time.Now()), 1)
+
+	// The registration is made over TALKREQ, and the other protocols get an empty response.
+	require.NoError(t, en.regtopic(tdNode(bn), Topic(NodeTypeEN, testTDNetworkID)))
+	assert.Len(t, bn.topics.query(Topic(NodeTypeEN, testTDNetworkID), bucketSize, time.Now()), 1)
+	var resp []byte
+	talk := &tdTalkRequest{Protocol: "other", Message: []byte{1}}
+	err = en.call(tdNode(bn), tdTalkRequestMsg, talk, &talk.ReqID, tdTalkResponseMsg, func(msg interface{}) bool {
+		resp = msg.(*tdTalkResponse).Message
+		return true
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+}
+
+func TestTopicUDP_Handshake(t *testing.T) {
+	bn := startTD(t, NodeTypeBN, testTDNetworkID)
+	pn := startTD(t, NodeTypePN, testTDNetworkID)
+	bnAddr := bn.conn.LocalAddr().(*net.UDPAddr)
+	pnAddr := pn.conn.LocalAddr().(*net.UDPAddr)
+
+	// The first request is challenged, and the node is added to the table of the
+	// other by the handshake answering the challenge.
+	_, err := pn.ping(tdNode(bn))
+	require.NoError(t, err)
+	assert.NotNil(t, pn.codec.session(tdNodeID(bn.self), bnAddr))
+	assert.NotNil(t, bn.codec.session(tdNodeID(pn.self), pnAddr))
+	assert.NotNil(t, bn.tab.get(pn.self))
+
+	// A packet from a forged source address is challenged at the forged address,
+	// and no session is made with it.
+	forged, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IP{127, 0, 0, 1}})
+	require.NoError(t, err)
+	defer forged.Close()
+	forgedAddr := forged.LocalAddr().(*net.UDPAddr)
+	packet, _, err := pn.codec.encode(bn.self, bnAddr, tdFindnodeMsg, &tdFindnode{ReqID: []byte{1}, Distances: []uint{256}}, nil, nil)
+	require.NoError(t, err)
+	_, err = forged.WriteToUDP(packet, bnAddr)
+	require.NoError(t, err)
+
+	buf := make([]byte, tdMaxPacketSize)
+	forged.SetReadDeadline(time.Now().Add(time.Second))
+	nbytes, _, err := forged.ReadFromUDP(buf)
+	require.NoError(t, err)
+	_, _, _, msg, _, err := pn.codec.decode(buf[:nbytes], bnAddr)
+	require.NoError(t, err)
+	challenge, ok := msg.(*tdWhoareyou)
+	require.True(t, ok)
+	bn.codec.mu.Lock()
+	assert.NotNil(t, bn.codec.challenges[tdSessionKey{tdNodeID(pn.self), forgedAddr.String()}])
+	bn.codec.mu.Unlock()
+	assert.Nil(t, bn.codec.session(tdNodeID(pn.self), forgedAddr))
+
+	// The challenge can't be answered from the other address.
+	packet, _, err = pn.codec.encode(bn.self, bnAddr, tdPingMsg, &tdPing{ReqID: []byte{2}}, challenge, pn.LocalRecord())
+	require.NoError(t, err)
+	_, _, _, _, _, err = bn.codec.decode(packet, pnAddr)
+	assert.Equal(t, errTDNoChallenge, err)
+}
+
+func TestTopicTable(t *testing.T) {
+	var (
+		tt  = newTopicTable()
+		now = time.Now()
+		rec = new(enr.Record)
+	)
+	for i := 0; i < maxTopicAdvertiser; i++ {
+		var id NodeID
+		id[0], id[1] = byte(i), byte(i>>8)
+		assert.True(t, tt.register("topic", id, rec, now))
+	}
+	assert.False(t, tt.register("topic", NodeID{0xff, 0xff}, rec, now))
+	assert.True(t, tt.register("topic", NodeID{}, rec, now)) // renewal
+	assert.True(t, tt.register("other", NodeID{}, rec, now))
+	assert.Len(t, tt.query("topic", bucketSize, now), bucketSize)
+	assert.Equal(t, maxTopicAdvertiser+1, tt.count)
+
+	// The registrations expire after the lifetime.
+	later := now.Add(topicRegLifetime + time.Second)
+	assert.Empty(t, tt.query("topic", bucketSize, later))
+	assert.Zero(t, tt.count)
+	assert.True(t, tt.register("topic", NodeID{0xff, 0xff}, rec, later))
+}
+
+func TestLookupDistances(t *testing.T) {
+	var target, sha common.Hash
+	sha[31] = 1
+	assert.Equal(t, []uint{1, 2}, lookupDistances(target, sha))
+	sha[0] = 0x80
+	assert.Equal(t, []uint{256, 255}, lookupDistances(target, sha))
+}
@@ -0,0 +1,673 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/math"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/networks/p2p/enr"
+	"github.com/kaiachain/kaia/rlp"
+	"golang.org/x/crypto/hkdf"
+)
+
+// The topic discovery speaks the wire protocol of discovery v5.1. A packet is
+//
+//	packet        = masking-iv || masked-header || message
+//	masked-header = aesctr_encrypt(dest-id[:16], masking-iv, header)
+//	header        = static-header || authdata
+//	static-header = "discv5" || version || flag || nonce || authdata-size
+//	message       = aesgcm_encrypt(session-key, nonce, message-type || rlp(message-data), masking-iv || header)
+//
+// where the id of a node is the keccak256 hash of its public key. The session keys
+// are agreed by the WHOAREYOU challenge and the handshake packet answering it, which
+// proves the identity of the initiator and carries its ephemeral key. As a session is
+// bound to the endpoint it's agreed at, a message decrypted with a session comes from
+// the endpoint of the sender.
+//
+// The messages are the ones of discovery v5.1. The topic advertisement is carried by
+// the TALKREQ and TALKRESP messages of the "kaia-topic" protocol, which the other
+// discovery v5 nodes answer with an empty response.
+const (
+	tdFlagMessage = iota
+	tdFlagWhoareyou
+	tdFlagHandshake
+)
+
+const (
+	tdPingMsg = iota + 1
+	tdPongMsg
+	tdFindnodeMsg
+	tdNodesMsg
+	tdTalkRequestMsg
+	tdTalkResponseMsg
+)
+
+// The messages of the topic advertisement carried by TALKREQ. The request is the
+// message type followed by the rlp of the message, and the response is the rlp of
+// tdRegconfirmation or tdTopicNodes.
+const (
+	tdRegtopicMsg = iota + 1
+	tdTopicQueryMsg
+)
+
+const tdTopicProtocol = "kaia-topic"
+
+const (
+	tdVersion          = 1
+	tdMaskingIVSize    = 16
+	tdStaticHeaderSize = 23 // protocol id, version, flag, nonce and authdata size
+	tdNonceSize        = 12
+	tdIDNonceSize      = 16
+	tdWhoareyouSize    = tdIDNonceSize + 8
+	tdHandshakeHead    = common.HashLength + 2 // source id, signature size and key size
+	tdMinPacketSize    = tdMaskingIVSize + tdStaticHeaderSize + tdWhoareyouSize
+	tdMaxPacketSize    = 1280
+	tdNodesPerMsg      = 3 // three maximum sized records fit in a packet
+
+	tdHandshakeTimeout = time.Second // time a WHOAREYOU challenge is answerable
+	tdMaxSessions      = 1024        // maximum number of the sessions and the challenges
+)
+
+const (
+	tdIDProofPrefix  = "discovery v5 identity proof"
+	tdKeyAgreePrefix = "discovery v5 key agreement"
+)
+
+var tdProtocolID = [6]byte{'d', 'i', 's', 'c', 'v', '5'}
+
+var (
+	errTDBadHeader    = errors.New("invalid packet header")
+	errTDNoChallenge  = errors.New("no challenge for the handshake")
+	errTDBadSignature = errors.New("invalid id signature")
+	errTDUnknownKey   = errors.New("unknown public key of the handshake")
+)
+
+type (
+	// tdPing checks the liveness of a node. ENRSeq is the sequence number of the
+	// sender's record.
+	tdPing struct {
+		ReqID  []byte
+		ENRSeq uint64
+	}
+
+	// tdPong is the reply to tdPing. ToIP and ToPort contain the endpoint of the
+	// ping sender observed by the recipient.
+	tdPong struct {
+		ReqID  []byte
+		ENRSeq uint64
+		ToIP   net.IP
+		ToPort uint16
+	}
+
+	// tdFindnode is a query for the nodes at the given log distances from the
+	// recipient. Distance zero requests the record of the recipient.
+	tdFindnode struct {
+		ReqID     []byte
+		Distances []uint
+	}
+
+	// tdNodes is the reply to tdFindnode. The reply is split into Total packets.
+	tdNodes struct {
+		ReqID []byte
+		Total uint8
+		Nodes []*enr.Record
+	}
+
+	// tdTalkRequest is a request of an application protocol over the discovery.
+	tdTalkRequest struct {
+		ReqID    []byte
+		Protocol string
+		Message  []byte
+	}
+
+	// tdTalkResponse is the reply to tdTalkRequest. It's empty if the recipient
+	// doesn't speak the protocol.
+	tdTalkResponse struct {
+		ReqID   []byte
+		Message []byte
+	}
+
+	// tdRegtopic registers the sender as an advertiser of the topic.
+	tdRegtopic struct {
+		Topic string
+		ENR   *enr.Record
+		Rest  []rlp.RawValue `rlp:"tail"`
+	}
+
+	// tdRegconfirmation is the reply to tdRegtopic. Lifetime is the number of seconds
+	// the registration lasts, and zero if the registration is rejected.
+	tdRegconfirmation struct {
+		Lifetime uint64
+		Rest     []rlp.RawValue `rlp:"tail"`
+	}
+
+	// tdTopicQuery is a query for the advertisers of the topic.
+	tdTopicQuery struct {
+		Topic string
+		Rest  []rlp.RawValue `rlp:"tail"`
+	}
+
+	// tdTopicNodes is the reply to tdTopicQuery.
+	tdTopicNodes struct {
+		Nodes []*enr.Record
+		Rest  []rlp.RawValue `rlp:"tail"`
+	}
+
+	// tdWhoareyou is the challenge to the sender of a packet which couldn't be
+	// decrypted. Nonce is the nonce of that packet.
+	tdWhoareyou struct {
+		Nonce     [tdNonceSize]byte
+		IDNonce   [tdIDNonceSize]byte
+		RecordSeq uint64
+
+		challengeData []byte // masking-iv || header of the WHOAREYOU packet
+	}
+
+	// tdUnknown is a message packet which couldn't be decrypted. It's answered
+	// with a WHOAREYOU challenge.
+	tdUnknown struct {
+		Nonce [tdNonceSize]byte
+	}
+)
+
+// tdMsgName returns the name of the message type for logging.
+func tdMsgName(ptype byte) string {
+	switch ptype {
+	case tdPingMsg:
+		return "PING/v5"
+	case tdPongMsg:
+		return "PONG/v5"
+	case tdFindnodeMsg:
+		return "FINDNODE/v5"
+	case tdNodesMsg:
+		return "NODES/v5"
+	case tdTalkRequestMsg:
+		return "TALKREQ/v5"
+	case tdTalkResponseMsg:
+		return "TALKRESP/v5"
+	default:
+		return "UNKNOWN/v5"
+	}
+}
+
+// tdReqID returns the request id of the message.
+func tdReqID(msg interface{}) []byte {
+	switch m := msg.(type) {
+	case *tdPing:
+		return m.ReqID
+	case *tdPong:
+		return m.ReqID
+	case *tdFindnode:
+		return m.ReqID
+	case *tdNodes:
+		return m.ReqID
+	case *tdTalkRequest:
+		return m.ReqID
+	case *tdTalkResponse:
+		return m.ReqID
+	}
+	return nil
+}
+
+// tdNewMessage returns an empty message of the type.
+func tdNewMessage(ptype byte) (interface{}, error) {
+	switch ptype {
+	case tdPingMsg:
+		return new(tdPing), nil
+	case tdPongMsg:
+		return new(tdPong), nil
+	case tdFindnodeMsg:
+		return new(tdFindnode), nil
+	case tdNodesMsg:
+		return new(tdNodes), nil
+	case tdTalkRequestMsg:
+		return new(tdTalkRequest), nil
+	case tdTalkResponseMsg:
+		return new(tdTalkResponse), nil
+	default:
+		return nil, fmt.Errorf("unknown type: %d", ptype)
+	}
+}
+
+// tdNodeID returns the discovery v5 id of the node, the keccak256 hash of its public key.
+func tdNodeID(id NodeID) common.Hash {
+	return crypto.Keccak256Hash(id[:])
+}
+
+// tdSessionKey identifies a session by the id and the endpoint of the remote node.
+type tdSessionKey struct {
+	id   common.Hash
+	addr string
+}
+
+// tdSession holds the keys agreed with a remote node.
+type tdSession struct {
+	node     NodeID
+	writeKey []byte
+	readKey  []byte
+}
+
+// tdChallenge is a WHOAREYOU challenge sent by the local node.
+type tdChallenge struct {
+	data []byte      // challenge-data signed by the remote node
+	rec  *enr.Record // record of the remote node known locally, nil if unknown
+	sent time.Time
+}
+
+// tdCodec encodes and decodes the packets, and keeps the sessions.
+type tdCodec struct {
+	priv *ecdsa.PrivateKey
+	id   common.Hash
+
+	mu         sync.Mutex
+	sessions   map[tdSessionKey]*tdSession
+	challenges map[tdSessionKey]*tdChallenge
+}
+
+func newTDCodec(priv *ecdsa.PrivateKey) *tdCodec {
+	return &tdCodec{
+		priv:       priv,
+		id:         tdNodeID(PubkeyID(&priv.PublicKey)),
+		sessions:   make(map[tdSessionKey]*tdSession),
+		challenges: make(map[tdSessionKey]*tdChallenge),
+	}
+}
+
+func (c *tdCodec) session(id common.Hash, addr *net.UDPAddr) *tdSession {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sessions[tdSessionKey{id, addr.String()}]
+}
+
+func (c *tdCodec) storeSession(id common.Hash, addr *net.UDPAddr, s *tdSession) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.sessions) >= tdMaxSessions {
+		for k := range c.sessions {
+			delete(c.sessions, k) // Drop a random session, which is agreed again if needed.
+			break
+		}
+	}
+	c.sessions[tdSessionKey{id, addr.String()}] = s
+}
+
+// encode encodes the message to the node. If challenge is not nil, the message is
+// sent in a handshake packet answering it. Without a session, a message packet which
+// can't be decrypted is sent to get a challenge. It returns the nonce of the packet.
+func (c *tdCodec) encode(to NodeID, addr *net.UDPAddr, ptype byte, msg interface{}, challenge *tdWhoareyou, rec *enr.Record) ([]byte, [tdNonceSize]byte, error) {
+	var nonce [tdNonceSize]byte
+	if _, err := crand.Read(nonce[:]); err != nil {
+		return nil, nonce, err
+	}
+	pt := new(bytes.Buffer)
+	pt.WriteByte(ptype)
+	if err := rlp.Encode(pt, msg); err != nil {
+		return nil, nonce, err
+	}
+
+	var (
+		toID     = tdNodeID(to)
+		flag     = byte(tdFlagMessage)
+		authdata = c.id[:]
+		key      []byte
+	)
+	switch s := c.session(toID, addr); {
+	case challenge != nil:
+		var err error
+		flag = tdFlagHandshake
+		if authdata, s, err = c.makeHandshake(to, challenge, rec); err != nil {
+			return nil, nonce, err
+		}
+		c.storeSession(toID, addr, s)
+		key = s.writeKey
+	case s != nil:
+		key = s.writeKey
+	default:
+		// The random key makes the message undecryptable, so that the node sends a challenge.
+		key = make([]byte, 16)
+		crand.Read(key)
+	}
+	packet, err := c.seal(toID, flag, nonce, authdata, key, pt.Bytes())
+	return packet, nonce, err
+}
+
+// encodeWhoareyou encodes a WHOAREYOU challenge to the sender of the packet of the
+// given nonce, and stores the challenge to verify the handshake answering it. The
+// record of the sender is requested if rec is nil.
+func (c *tdCodec) encodeWhoareyou(toID common.Hash, addr *net.UDPAddr, nonce [tdNonceSize]byte, rec *enr.Record) ([]byte, error) {
+	authdata := make([]byte, tdWhoareyouSize)
+	if _, err := crand.Read(authdata[:tdIDNonceSize]); err != nil {
+		return nil, err
+	}
+	if rec != nil {
+		binary.BigEndian.PutUint64(authdata[tdIDNonceSize:], rec.Seq())
+	}
+	packet, err := c.seal(toID, tdFlagWhoareyou, nonce, authdata, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, ch := range c.challenges {
+		if now.Sub(ch.sent) > tdHandshakeTimeout {
+			delete(c.challenges, k)
+		}
+	}
+	if len(c.challenges) >= tdMaxSessions {
+		return nil, errors.New("too many pending handshakes")
+	}
+	// The challenge data is the unmasked packet.
+	data := append([]byte{}, packet...)
+	c.unmask(data, toID)
+	c.challenges[tdSessionKey{toID, addr.String()}] = &tdChallenge{data: data, rec: rec, sent: now}
+	return packet, nil
+}
+
+// seal builds the packet of the header and the message encrypted with the key.
+// The header is masked with the id of the destination.
+func (c *tdCodec) seal(toID common.Hash, flag byte, nonce [tdNonceSize]byte, authdata, key, pt []byte) ([]byte, error) {
+	b := new(bytes.Buffer)
+	iv := make([]byte, tdMaskingIVSize)
+	if _, err := crand.Read(iv); err != nil {
+		return nil, err
+	}
+	b.Write(iv)
+	b.Write(tdProtocolID[:])
+	binary.Write(b, binary.BigEndian, uint16(tdVersion))
+	b.WriteByte(flag)
+	b.Write(nonce[:])
+	binary.Write(b, binary.BigEndian, uint16(len(authdata)))
+	b.Write(authdata)
+
+	packet := b.Bytes()
+	if key != nil {
+		aead, err := newTDGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		packet = aead.Seal(packet, nonce[:], pt, packet)
+	}
+	if len(packet) > tdMaxPacketSize {
+		return nil, fmt.Errorf("packet too big: %d bytes", len(packet))
+	}
+	headerSize := tdMaskingIVSize + tdStaticHeaderSize + len(authdata)
+	c.mask(packet[:headerSize], toID)
+	return packet, nil
+}
+
+// mask masks the header of the packet with the given destination id.
+func (c *tdCodec) mask(header []byte, toID common.Hash) {
+	block, _ := aes.NewCipher(toID[:16])
+	cipher.NewCTR(block, header[:tdMaskingIVSize]).XORKeyStream(header[tdMaskingIVSize:], header[tdMaskingIVSize:])
+}
+
+// unmask unmasks the header of a packet sent to the given id. The stream of AES-CTR
+// is symmetric, so it's the same as mask.
+func (c *tdCodec) unmask(header []byte, toID common.Hash) {
+	c.mask(header, toID)
+}
+
+// makeHandshake returns the authdata of the handshake packet answering the challenge,
+// and the session agreed by it.
+func (c *tdCodec) makeHandshake(to NodeID, challenge *tdWhoareyou, rec *enr.Record) ([]byte, *tdSession, error) {
+	toPub, err := to.Pubkey()
+	if err != nil {
+		return nil, nil, err
+	}
+	ephKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	ephPub := crypto.CompressPubkey(&ephKey.PublicKey)
+	toID := tdNodeID(to)
+	sig, err := crypto.Sign(tdIDProofHash(challenge.challengeData, ephPub, toID), c.priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	sig = sig[:64] // remove the recovery id
+
+	initiatorKey, recipientKey := tdDeriveKeys(ephKey, toPub, c.id, toID, challenge.challengeData)
+	s := &tdSession{node: to, writeKey: initiatorKey, readKey: recipientKey}
+
+	authdata := new(bytes.Buffer)
+	authdata.Write(c.id[:])
+	authdata.WriteByte(byte(len(sig)))
+	authdata.WriteByte(byte(len(ephPub)))
+	authdata.Write(sig)
+	authdata.Write(ephPub)
+	if rec != nil && challenge.RecordSeq < rec.Seq() {
+		if err := rlp.Encode(authdata, rec); err != nil {
+			return nil, nil, err
+		}
+	}
+	return authdata.Bytes(), s, nil
+}
+
+// decode decodes a packet received from the address. It returns the id of the sender
+// and one of the following:
+//
+//   - a message with the node of the sender, and the record of the sender attached to
+//     a handshake packet if any,
+//   - *tdWhoareyou, whose sender isn't known,
+//   - *tdUnknown for a message packet which couldn't be decrypted.
+func (c *tdCodec) decode(buf []byte, addr *net.UDPAddr) (fromID common.Hash, from NodeID, ptype byte, msg interface{}, rec *enr.Record, err error) {
+	if len(buf) < tdMinPacketSize {
+		return fromID, from, 0, nil, nil, errPacketTooSmall
+	}
+	// Unmask the static header first to find the size of the authdata.
+	header := append([]byte{}, buf[:tdMaskingIVSize+tdStaticHeaderSize]...)
+	block, _ := aes.NewCipher(c.id[:16])
+	stream := cipher.NewCTR(block, header[:tdMaskingIVSize])
+	stream.XORKeyStream(header[tdMaskingIVSize:], header[tdMaskingIVSize:])
+
+	static := header[tdMaskingIVSize:]
+	if !bytes.Equal(static[:6], tdProtocolID[:]) || binary.BigEndian.Uint16(static[6:8]) != tdVersion {
+		return fromID, from, 0, nil, nil, errTDBadHeader
+	}
+	var (
+		flag     = static[8]
+		nonce    [tdNonceSize]byte
+		authsize = int(binary.BigEndian.Uint16(static[21:23]))
+	)
+	copy(nonce[:], static[9:21])
+	if len(buf) < len(header)+authsize {
+		return fromID, from, 0, nil, nil, errTDBadHeader
+	}
+	authdata := make([]byte, authsize)
+	stream.XORKeyStream(authdata, buf[len(header):len(header)+authsize])
+	header = append(header, authdata...)
+	ct := buf[len(header):]
+
+	switch flag {
+	case tdFlagWhoareyou:
+		if authsize != tdWhoareyouSize || len(ct) != 0 {
+			return fromID, from, 0, nil, nil, errTDBadHeader
+		}
+		w := &tdWhoareyou{Nonce: nonce, RecordSeq: binary.BigEndian.Uint64(authdata[tdIDNonceSize:]), challengeData: header}
+		copy(w.IDNonce[:], authdata)
+		return fromID, from, 0, w, nil, nil
+
+	case tdFlagMessage:
+		if authsize != common.HashLength {
+			return fromID, from, 0, nil, nil, errTDBadHeader
+		}
+		fromID = common.BytesToHash(authdata)
+		s := c.session(fromID, addr)
+		if s == nil {
+			return fromID, from, 0, &tdUnknown{Nonce: nonce}, nil, nil
+		}
+		ptype, msg, err = c.open(s.readKey, nonce, ct, header)
+		if err != nil {
+			return fromID, from, 0, &tdUnknown{Nonce: nonce}, nil, nil
+		}
+		return fromID, s.node, ptype, msg, nil, nil
+
+	case tdFlagHandshake:
+		if authsize < tdHandshakeHead {
+			return fromID, from, 0, nil, nil, errTDBadHeader
+		}
+		fromID = common.BytesToHash(authdata[:common.HashLength])
+		s, rec, err := c.verifyHandshake(fromID, addr, authdata)
+		if err != nil {
+			return fromID, from, 0, nil, nil, err
+		}
+		if ptype, msg, err = c.open(s.readKey, nonce, ct, header); err != nil {
+			return fromID, from, 0, nil, nil, err
+		}
+		c.storeSession(fromID, addr, s)
+		return fromID, s.node, ptype, msg, rec, nil
+
+	default:
+		return fromID, from, 0, nil, nil, errTDBadHeader
+	}
+}
+
+// verifyHandshake verifies the handshake answering the challenge sent to the node,
+// and returns the session agreed by it and the record attached to it.
+func (c *tdCodec) verifyHandshake(fromID common.Hash, addr *net.UDPAddr, authdata []byte) (*tdSession, *enr.Record, error) {
+	key := tdSessionKey{fromID, addr.String()}
+	c.mu.Lock()
+	challenge := c.challenges[key]
+	delete(c.challenges, key)
+	c.mu.Unlock()
+	if challenge == nil || time.Since(challenge.sent) > tdHandshakeTimeout {
+		return nil, nil, errTDNoChallenge
+	}
+
+	sigSize, keySize := int(authdata[common.HashLength]), int(authdata[common.HashLength+1])
+	if len(authdata) < tdHandshakeHead+sigSize+keySize {
+		return nil, nil, errTDBadHeader
+	}
+	sig := authdata[tdHandshakeHead : tdHandshakeHead+sigSize]
+	ephPub := authdata[tdHandshakeHead+sigSize : tdHandshakeHead+sigSize+keySize]
+
+	// The record is attached if the challenge has requested it. Otherwise, the record
+	// known locally is used.
+	rec := challenge.rec
+	var attached *enr.Record
+	if raw := authdata[tdHandshakeHead+sigSize+keySize:]; len(raw) > 0 {
+		attached = new(enr.Record)
+		if err := rlp.DecodeBytes(raw, attached); err != nil {
+			return nil, nil, err
+		}
+		if rec == nil || attached.Seq() > rec.Seq() {
+			rec = attached
+		}
+	}
+	if rec == nil {
+		return nil, nil, errTDUnknownKey
+	}
+	pub, err := rec.PublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	from := PubkeyID(pub)
+	if tdNodeID(from) != fromID {
+		return nil, nil, errTDBadRecord
+	}
+	if len(sig) != 64 || !crypto.VerifySignature(crypto.CompressPubkey(pub), tdIDProofHash(challenge.data, ephPub, c.id), sig) {
+		return nil, nil, errTDBadSignature
+	}
+	eph, err := crypto.DecompressPubkey(ephPub)
+	if err != nil {
+		return nil, nil, err
+	}
+	initiatorKey, recipientKey := tdDeriveKeys(c.priv, eph, fromID, c.id, challenge.data)
+	return &tdSession{node: from, writeKey: recipientKey, readKey: initiatorKey}, attached, nil
+}
+
+// open decrypts the message with the key.
+func (c *tdCodec) open(key []byte, nonce [tdNonceSize]byte, ct, header []byte) (byte, interface{}, error) {
+	aead, err := newTDGCM(key)
+	if err != nil {
+		return 0, nil, err
+	}
+	pt, err := aead.Open(nil, nonce[:], ct, header)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(pt) == 0 {
+		return 0, nil, errPacketTooSmall
+	}
+	msg, err := tdNewMessage(pt[0])
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := rlp.DecodeBytes(pt[1:], msg); err != nil {
+		return 0, nil, err
+	}
+	return pt[0], msg, nil
+}
+
+func newTDGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// tdIDProofHash returns the hash signed by the initiator of a handshake to prove its identity.
+func tdIDProofHash(challengeData, ephPub []byte, destID common.Hash) []byte {
+	h := sha256.New()
+	h.Write([]byte(tdIDProofPrefix))
+	h.Write(challengeData)
+	h.Write(ephPub)
+	h.Write(destID[:])
+	return h.Sum(nil)
+}
+
+// tdDeriveKeys derives the session keys of the initiator and the recipient from the
+// shared secret of the given keys.
+func tdDeriveKeys(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey, initiator, recipient common.Hash, challengeData []byte) ([]byte, []byte) {
+	info := make([]byte, 0, len(tdKeyAgreePrefix)+2*common.HashLength)
+	info = append(info, tdKeyAgreePrefix...)
+	info = append(info, initiator[:]...)
+	info = append(info, recipient[:]...)
+
+	keys := make([]byte, 32)
+	kdf := hkdf.New(sha256.New, tdECDH(priv, pub), challengeData, info)
+	kdf.Read(keys)
+	return keys[:16], keys[16:]
+}
+
+// tdECDH returns the shared secret of the keys as a compressed point.
+func tdECDH(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey) []byte {
+	x, y := crypto.S256().ScalarMult(pub.X, pub.Y, priv.D.Bytes())
+	if x == nil {
+		return nil
+	}
+	sec := make([]byte, 33)
+	sec[0] = 0x02 | byte(y.Bit(0))
+	math.ReadBits(x, sec[1:])
+	return sec
+}
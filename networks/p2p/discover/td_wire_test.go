@@ -0,0 +1,142 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package discover
+
+import (
+	"crypto/ecdsa"
+	"net"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/networks/p2p/enr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The test vectors of the discovery v5.1 wire protocol specification.
+var (
+	tdTestIDA           = common.HexToHash("0xaaaa8419e9f49d0083561b48287df592939a8d19947d8c0ef88f2a4856a69fbb")
+	tdTestIDB           = common.HexToHash("0xbbbb9d047f0488c0b5a93c1c3f2d8bafc7c8ff337024a55434a0d0555de64db9")
+	tdTestChallengeData = hexutil.MustDecode("0x000000000000000000000000000000006469736376350001010102030405060708090a0b0c00180102030405060708090a0b0c0d0e0f100000000000000000")
+	tdTestEphKey, _     = crypto.HexToECDSA("fb757dc581730490a1d7a00deea65e9b1936924caaea8f44d476014856b68736")
+)
+
+func TestTDCodec_DecodeVectors(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	c := newTDCodec(key)
+	c.id = tdTestIDB
+	addr := &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 30303}
+
+	// A message packet of node A, whose session keys are zero.
+	ping := hexutil.MustDecode("0x00000000000000000000000000000000088b3d4342774649325f313964a39e55ea96c005ad52be8c7560413a7008f16c9e6d2f43bbea8814a546b7409ce783d34c4f53245d08dab84102ed931f66d1492acb308fa1c6715b9d139b81acbdcc")
+	fromID, _, _, msg, _, err := c.decode(ping, addr)
+	require.NoError(t, err)
+	assert.Equal(t, tdTestIDA, fromID)
+	assert.IsType(t, &tdUnknown{}, msg) // no session
+
+	c.storeSession(tdTestIDA, addr, &tdSession{writeKey: make([]byte, 16), readKey: make([]byte, 16)})
+	_, _, ptype, msg, _, err := c.decode(ping, addr)
+	require.NoError(t, err)
+	assert.Equal(t, byte(tdPingMsg), ptype)
+	assert.Equal(t, &tdPing{ReqID: []byte{0, 0, 0, 1}, ENRSeq: 2}, msg)
+
+	// A WHOAREYOU packet to node B.
+	whoareyou := hexutil.MustDecode("0x00000000000000000000000000000000088b3d434277464933a1ccc59f5967ad1d6035f15e528627dde75cd68292f9e6c27d6b66c8100a873fcbaed4e16b8d")
+	_, _, _, msg, _, err = c.decode(whoareyou, addr)
+	require.NoError(t, err)
+	w, ok := msg.(*tdWhoareyou)
+	require.True(t, ok)
+	assert.Equal(t, [tdNonceSize]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, w.Nonce)
+	assert.Equal(t, [tdIDNonceSize]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, w.IDNonce)
+	assert.Zero(t, w.RecordSeq)
+}
+
+func TestTDCodec_CryptoVectors(t *testing.T) {
+	// ECDH
+	pub, err := crypto.DecompressPubkey(hexutil.MustDecode("0x039961e4c2356d61bedb83052c115d311acb3a96f5777296dcf297351130266231"))
+	require.NoError(t, err)
+	assert.Equal(t, "0x033b11a2a1f214567e1537ce5e509ffd9b21373247f2a3ff6841f4976f53165e7e", hexutil.Encode(tdECDH(tdTestEphKey, pub)))
+
+	// Key derivation
+	destPub, err := crypto.DecompressPubkey(hexutil.MustDecode("0x0317931e6e0840220642f230037d285d122bc59063221ef3226b1f403ddc69ca91"))
+	require.NoError(t, err)
+	initiatorKey, recipientKey := tdDeriveKeys(tdTestEphKey, destPub, tdTestIDA, tdTestIDB, tdTestChallengeData)
+	assert.Equal(t, "0xdccc82d81bd610f4f76d3ebe97a40571", hexutil.Encode(initiatorKey))
+	assert.Equal(t, "0xac74bb8773749920b0d3a8881c173ec5", hexutil.Encode(recipientKey))
+
+	// ID nonce signing
+	ephPub := hexutil.MustDecode("0x039961e4c2356d61bedb83052c115d311acb3a96f5777296dcf297351130266231")
+	sig, err := crypto.Sign(tdIDProofHash(tdTestChallengeData, ephPub, tdTestIDB), tdTestEphKey)
+	require.NoError(t, err)
+	assert.Equal(t, "0x94852a1e2318c4e5e9d422c98eaf19d1d90d876b29cd06ca7cb7546d0fff7b484fe86c09a064fe72bdbef73ba8e9c34df0cd2b53e9d65528c2c7f336d5dfc6e6", hexutil.Encode(sig[:64]))
+}
+
+func TestTDCodec_Handshake(t *testing.T) {
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	a, b := newTDCodec(keyA), newTDCodec(keyB)
+	idA, idB := PubkeyID(&keyA.PublicKey), PubkeyID(&keyB.PublicKey)
+	addrA := &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 1}
+	addrB := &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 2}
+	rec := newTestRecord(t, keyA)
+
+	// The message without a session is challenged.
+	ping := &tdPing{ReqID: []byte{1}, ENRSeq: 1}
+	packet, nonce, err := a.encode(idB, addrB, tdPingMsg, ping, nil, nil)
+	require.NoError(t, err)
+	fromID, _, _, msg, _, err := b.decode(packet, addrA)
+	require.NoError(t, err)
+	require.Equal(t, &tdUnknown{Nonce: nonce}, msg)
+	packet, err = b.encodeWhoareyou(fromID, addrA, nonce, nil)
+	require.NoError(t, err)
+	assert.Len(t, packet, tdMinPacketSize)
+
+	// The handshake carries the record requested by the challenge.
+	_, _, _, msg, _, err = a.decode(packet, addrB)
+	require.NoError(t, err)
+	packet, _, err = a.encode(idB, addrB, tdPingMsg, ping, msg.(*tdWhoareyou), rec)
+	require.NoError(t, err)
+	_, from, ptype, msg, got, err := b.decode(packet, addrA)
+	require.NoError(t, err)
+	assert.Equal(t, idA, from)
+	assert.Equal(t, byte(tdPingMsg), ptype)
+	assert.Equal(t, ping, msg)
+	assert.Equal(t, rec.Seq(), got.Seq())
+
+	// The handshake can't be replayed, and the messages are exchanged in the session.
+	_, _, _, _, _, err = b.decode(packet, addrA)
+	assert.Equal(t, errTDNoChallenge, err)
+	pong := &tdPong{ReqID: []byte{1}, ENRSeq: 1, ToIP: addrA.IP, ToPort: uint16(addrA.Port)}
+	packet, _, err = b.encode(idA, addrA, tdPongMsg, pong, nil, nil)
+	require.NoError(t, err)
+	_, from, _, msg, _, err = a.decode(packet, addrB)
+	require.NoError(t, err)
+	assert.Equal(t, idB, from)
+	assert.Equal(t, pong, msg)
+}
+
+func newTestRecord(t *testing.T, key *ecdsa.PrivateKey) *enr.Record {
+	rec := new(enr.Record)
+	rec.Set(enr.IP(net.IP{127, 0, 0, 1}))
+	rec.Set(enr.UDP(1))
+	rec.Set(enr.NetworkID(testTDNetworkID))
+	rec.SetSeq(1)
+	require.NoError(t, enr.SignV4(rec, key))
+	return rec
+}
@@ -281,6 +281,10 @@ type Config struct {
 	// These settings are required for discovery packet control
 	MaxNeighborsNode uint
 	AuthorizedNodes  []*Node
+
+	// Ports are the TCP ports of the multichannel listeners announced in the
+	// node record of the topic discovery.
+	Ports []uint16
 }
 
 // ListenUDP returns a new table that listens for UDP packets on laddr.
@@ -617,9 +621,15 @@ func (t *udp) readLoop(unhandled chan<- ReadPacket) {
 			logger.Warn("UDP read error", "err", err)
 			return
 		}
-		if t.handlePacket(from, buf[:nbytes]) != nil && unhandled != nil {
+		// The packets without the v4 packet hash, such as the topic discovery packets,
+		// are passed to the unhandled channel without being handled as v4 packets.
+		other := unhandled != nil && !isV4Packet(buf[:nbytes])
+		if (other || t.handlePacket(from, buf[:nbytes]) != nil) && unhandled != nil {
+			// The buffer is reused for the next packet, so the packet is copied.
+			data := make([]byte, nbytes)
+			copy(data, buf[:nbytes])
 			select {
-			case unhandled <- ReadPacket{buf[:nbytes], from}:
+			case unhandled <- ReadPacket{data, from}:
 			default:
 			}
 		}
@@ -640,6 +650,11 @@ func (t *udp) handlePacket(from *net.UDPAddr, buf []byte) error {
 	return err
 }
 
+// isV4Packet reports whether the packet starts with the hash of the v4 packet.
+func isV4Packet(buf []byte) bool {
+	return len(buf) >= headSize+1 && bytes.Equal(buf[:macSize], crypto.Keccak256(buf[macSize:]))
+}
+
 func decodePacket(buf []byte) (packet, NodeID, []byte, error) {
 	if len(buf) < headSize+1 {
 		return nil, NodeID{}, nil, errPacketTooSmall
@@ -0,0 +1,129 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"net"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The example record of EIP-778.
+const (
+	eipKey    = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+	eipRecord = "enr:-IS4QHCYrYZbAKWCBRlAy5zzaDZXJBGkcnh4MHcBFZntXNFrdvJjX04jRzjzCBOonrkTfj499SZuOh8R33Ls8RRcy5wBgmlkgnY0gmlwhH8AAAGJc2VjcDI1NmsxoQPKY0yuDUmstAHYpMa2_oxVtw0RW_QAdpzBQA8yWM0xOIN1ZHCCdl8"
+)
+
+func TestRecord_EIPExample(t *testing.T) {
+	key, _ := crypto.HexToECDSA(eipKey)
+
+	r, err := Parse(eipRecord)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), r.Seq())
+
+	var (
+		ip  IP
+		udp UDP
+	)
+	require.NoError(t, r.Load(&ip))
+	require.NoError(t, r.Load(&udp))
+	assert.Equal(t, net.IP{127, 0, 0, 1}, net.IP(ip))
+	assert.Equal(t, UDP(30303), udp)
+	pub, err := r.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey, *pub)
+
+	// Signing the same content reproduces the record.
+	var r2 Record
+	r2.SetSeq(1)
+	r2.Set(IP(net.IP{127, 0, 0, 1}))
+	r2.Set(UDP(30303))
+	require.NoError(t, SignV4(&r2, key))
+	assert.Equal(t, eipRecord, r2.String())
+}
+
+func TestRecord_KaiaEntries(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+
+	var r Record
+	r.Set(IP(net.IP{10, 0, 0, 1}))
+	r.Set(TCP(32323))
+	r.Set(UDP(32323))
+	r.Set(ConnType(common.PROXYNODE))
+	r.Set(Ports{32323, 32324})
+	r.Set(NetworkID(8217))
+
+	// An unsigned record can't be encoded.
+	_, err := rlp.EncodeToBytes(&r)
+	assert.Equal(t, errEncodeUnsigned, err)
+
+	require.NoError(t, SignV4(&r, key))
+	assert.Equal(t, uint64(0), r.Seq())
+	blob, err := rlp.EncodeToBytes(&r)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(blob), SizeLimit)
+
+	var dec Record
+	require.NoError(t, rlp.DecodeBytes(blob, &dec))
+	var (
+		ct    ConnType
+		ports Ports
+		nid   NetworkID
+	)
+	require.NoError(t, dec.Load(&ct))
+	require.NoError(t, dec.Load(&ports))
+	require.NoError(t, dec.Load(&nid))
+	assert.Equal(t, ConnType(common.PROXYNODE), ct)
+	assert.Equal(t, Ports{32323, 32324}, ports)
+	assert.Equal(t, NetworkID(8217), nid)
+
+	assert.True(t, IsNotFound(dec.Load(WithEntry("missing", new(uint64)))))
+
+	// Updating a signed record increments the sequence number.
+	r.Set(NetworkID(1001))
+	assert.False(t, r.Signed())
+	require.NoError(t, SignV4(&r, key))
+	assert.Equal(t, uint64(1), r.Seq())
+
+	// A tampered record fails the signature check.
+	blob, _ = rlp.EncodeToBytes(&r)
+	blob[len(blob)-1]++
+	assert.Error(t, rlp.DecodeBytes(blob, &dec))
+}
+
+func TestRecord_DecodeErrors(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sign := func(pairs ...interface{}) []byte {
+		content, _ := rlp.EncodeToBytes(append([]interface{}{uint64(0)}, pairs...))
+		sig, _ := crypto.Sign(crypto.Keccak256(content), key)
+		blob, _ := rlp.EncodeToBytes(append([]interface{}{sig[:64], uint64(0)}, pairs...))
+		return blob
+	}
+	pub := crypto.CompressPubkey(&key.PublicKey)
+
+	var r Record
+	assert.NoError(t, rlp.DecodeBytes(sign("id", "v4", "secp256k1", pub), &r))
+	assert.Equal(t, errNotSorted, rlp.DecodeBytes(sign("secp256k1", pub, "id", "v4"), &r))
+	assert.Equal(t, errDuplicateKey, rlp.DecodeBytes(sign("id", "v4", "id", "v4", "secp256k1", pub), &r))
+	assert.Equal(t, errNoID, rlp.DecodeBytes(sign("id", "v9", "secp256k1", pub), &r))
+	assert.Equal(t, errTooBig, rlp.DecodeBytes(sign("id", "v4", "secp256k1", pub, "z", make([]byte, SizeLimit)), &r))
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package enr
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/rlp"
+)
+
+// IDv4 is the name of the "v4" identity scheme.
+const IDv4 = "v4"
+
+// identityScheme verifies the signature of a record.
+type identityScheme interface {
+	verify(r *Record, sig []byte) error
+}
+
+var schemes = map[string]identityScheme{
+	IDv4: v4ID{},
+}
+
+// v4ID is the "v4" identity scheme. The signature is the 64 byte [R || S] secp256k1
+// signature of the keccak256 hash of the RLP encoded [seq, k, v, ...] content.
+type v4ID struct{}
+
+func (v4ID) verify(r *Record, sig []byte) error {
+	var entry Secp256k1
+	if err := r.Load(&entry); err != nil {
+		return err
+	}
+	hash, err := signingHash(r)
+	if err != nil {
+		return err
+	}
+	if len(sig) != 64 || !crypto.VerifySignature(crypto.CompressPubkey((*ecdsa.PublicKey)(&entry)), hash, sig) {
+		return errInvalidSig
+	}
+	return nil
+}
+
+// SignV4 signs the record with the given private key using the "v4" identity scheme.
+// The "id" and "secp256k1" keys are set by SignV4. If the record was signed before,
+// the sequence number is incremented.
+func SignV4(r *Record, priv *ecdsa.PrivateKey) error {
+	// Copy r to avoid modifying it if signing fails.
+	cpy := *r
+	cpy.Set(ID(IDv4))
+	cpy.Set(Secp256k1(priv.PublicKey))
+
+	hash, err := signingHash(&cpy)
+	if err != nil {
+		return err
+	}
+	sig, err := crypto.Sign(hash, priv)
+	if err != nil {
+		return err
+	}
+	if err := cpy.setSig(sig[:len(sig)-1]); err != nil { // remove the recovery id
+		return err
+	}
+	*r = cpy
+	return nil
+}
+
+// PublicKey returns the public key of the record signed with the "v4" identity scheme.
+func (r *Record) PublicKey() (*ecdsa.PublicKey, error) {
+	var entry Secp256k1
+	if err := r.Load(&entry); err != nil {
+		return nil, err
+	}
+	return (*ecdsa.PublicKey)(&entry), nil
+}
+
+func signingHash(r *Record) ([]byte, error) {
+	content, err := rlp.EncodeToBytes(r.appendElements(nil))
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(content), nil
+}
@@ -124,6 +124,12 @@ type Peer struct {
 
 	// events receives message send / receive events if set
 	events *event.Feed
+
+	// reputation tracks the score of the peer if set
+	reputation *reputation
+
+	// traffic accounts the messages of the protocols by the message code
+	traffic *peerTraffic
 }
 
 // NewPeer returns a peer for testing purposes.
@@ -184,6 +190,34 @@ func (p *Peer) Disconnect(reason DiscReason) {
 	}
 }
 
+// Penalize lowers the score of the peer for the misbehavior. The peer is banned
+// and disconnected if the score falls below BanThreshold, unless it is trusted.
+// Static and CN peers are not banned for timeouts, since a slow but honest peer
+// of them must not be cut off from the node.
+func (p *Peer) Penalize(m Misbehavior) {
+	if p.reputation == nil {
+		return
+	}
+	exempt := false
+	for _, rw := range p.rws {
+		exempt = exempt || rw.is(trustedConn)
+		if m == MisbehaviorTimeout {
+			exempt = exempt || rw.is(staticDialedConn) || rw.conntype == common.CONSENSUSNODE
+		}
+	}
+	if p.reputation.penalize(p.ID(), m, exempt) {
+		p.Disconnect(DiscBanned)
+	}
+}
+
+// Score returns the reputation score of the peer.
+func (p *Peer) Score() int {
+	if p.reputation == nil {
+		return 0
+	}
+	return p.reputation.score(p.ID())
+}
+
 // String implements fmt.Stringer.
 func (p *Peer) String() string {
 	return fmt.Sprintf("Peer %x %v", p.rws[ConnDefault].id[:8], p.RemoteAddr())
@@ -226,10 +260,22 @@ func newPeer(conns []*conn, protocols []Protocol, tc RWTimerConfig) (*Peer, erro
 		protoErr: make(chan error, len(protomap)+len(conns)), // protocols + pingLoop
 		closed:   make(chan struct{}),
 		logger:   logger.NewWith("id", conns[ConnDefault].id, "conn", conns[ConnDefault].flags),
+		traffic:  newPeerTraffic(),
+	}
+	for _, rws := range protomap {
+		for _, rw := range rws {
+			rw.traffic = p.traffic
+		}
 	}
 	return p, nil
 }
 
+// Traffic returns the messages exchanged with the peer by the protocol name and
+// the message code.
+func (p *Peer) Traffic() map[string]ProtocolTraffic {
+	return p.traffic.snapshot()
+}
+
 func (p *Peer) Log() log.Logger {
 	return p.logger
 }
@@ -596,12 +642,15 @@ type protoRW struct {
 	w      MsgWriter
 	count  uint64 // count the number of WriteMsg calls
 	tc     RWTimerConfig
+
+	traffic *peerTraffic // accounts the messages if set
 }
 
 func (rw *protoRW) WriteMsg(msg Msg) (err error) {
 	if msg.Code >= rw.Length {
 		return newPeerError(errInvalidMsgCode, "not handled, (code %x) (size %d)", msg.Code, msg.Size)
 	}
+	code, size := msg.Code, msg.Size
 	msg.Code += rw.offset
 	rwCount := atomic.AddUint64(&rw.count, 1)
 	if rwCount%rw.tc.Interval == 0 {
@@ -630,6 +679,9 @@ func (rw *protoRW) WriteMsg(msg Msg) (err error) {
 			return err
 		}
 	}
+	if err == nil && rw.traffic != nil {
+		rw.traffic.egress(rw.Name, code, size)
+	}
 	select {
 	case rw.werr <- err:
 	default:
@@ -641,6 +693,9 @@ func (rw *protoRW) ReadMsg() (Msg, error) {
 	select {
 	case msg := <-rw.in:
 		msg.Code -= rw.offset
+		if rw.traffic != nil {
+			rw.traffic.ingress(rw.Name, msg.Code, msg.Size)
+		}
 		return msg, nil
 	case <-rw.closed:
 		return Msg{}, io.EOF
@@ -666,6 +721,7 @@ type PeerInfo struct {
 	Caps      []string               `json:"caps"`      // Sum-protocols advertised by this particular peer
 	Networks  []NetworkInfo          `json:"networks"`  // Networks is all the NetworkInfo associated with the peer
 	Protocols map[string]interface{} `json:"protocols"` // Sub-protocol specific metadata fields
+	Score     int                    `json:"score"`     // Reputation score of the peer, banned below BanThreshold
 }
 
 // Info gathers and returns a collection of metadata known about a peer.
@@ -681,6 +737,7 @@ func (p *Peer) Info() *PeerInfo {
 		Name:      p.Name(),
 		Caps:      caps,
 		Protocols: make(map[string]interface{}),
+		Score:     p.Score(),
 	}
 
 	for _, rw := range p.rws {
@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/kaiachain/kaia/common/math"
+	"github.com/kaiachain/kaia/rlp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -172,6 +173,45 @@ func TestPeerProtoEncodeMsg(t *testing.T) {
 	}
 }
 
+func TestPeerTraffic(t *testing.T) {
+	done := make(chan struct{})
+	proto := Protocol{
+		Name:   "a",
+		Length: 5,
+		Run: func(peer *Peer, rw MsgReadWriter) error {
+			defer close(done)
+			for i := 0; i < 2; i++ {
+				if err := ExpectMsg(rw, 2, []uint{1}); err != nil {
+					t.Error(err)
+				}
+			}
+			if err := SendItems(rw, 4, "foo"); err != nil {
+				t.Error(err)
+			}
+			return nil
+		},
+	}
+	closer, rw, peer, _ := testPeer([]Protocol{proto})
+	defer closer()
+
+	Send(rw, baseProtocolLength+2, []uint{1})
+	Send(rw, baseProtocolLength+2, []uint{1})
+	if err := ExpectMsg(rw, baseProtocolLength+4, []string{"foo"}); err != nil {
+		t.Fatal(err)
+	}
+	<-done
+
+	ingress, _ := rlp.EncodeToBytes([]uint{1})
+	egress, _ := rlp.EncodeToBytes([]string{"foo"})
+	traffic := peer.Traffic()
+	assert.Equal(t, map[string]ProtocolTraffic{
+		"a": {
+			2: {IngressMsgs: 2, IngressBytes: 2 * uint64(len(ingress))},
+			4: {EgressMsgs: 1, EgressBytes: uint64(len(egress))},
+		},
+	}, traffic)
+}
+
 func TestPeerPing(t *testing.T) {
 	closer, rw, _, _ := testPeer(nil)
 	defer closer()
@@ -0,0 +1,313 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/networks/p2p/discover"
+)
+
+const (
+	// BanThreshold is the score below which a peer is banned.
+	BanThreshold = -100
+
+	// DefaultBanDuration is the duration of the ban made by the reputation.
+	DefaultBanDuration = time.Hour
+
+	// scoreRecoveryInterval is the interval at which a point of the score is
+	// recovered, so that occasional misbehaviors of an honest peer are forgiven.
+	scoreRecoveryInterval = 6 * time.Second
+)
+
+// Misbehavior is a kind of the misbehavior of a peer lowering its score.
+type Misbehavior uint8
+
+const (
+	MisbehaviorInvalidBlock      Misbehavior = iota // The peer sent an invalid block or header.
+	MisbehaviorUselessTx                            // The peer sent the transactions it knows that we have.
+	MisbehaviorTimeout                              // The peer stalled or failed the requests of the downloader.
+	MisbehaviorProtocolViolation                    // The peer sent a malformed or unexpected message.
+)
+
+var misbehaviorPenalty = [...]int{
+	MisbehaviorInvalidBlock:      50,
+	MisbehaviorUselessTx:         1,
+	MisbehaviorTimeout:           20,
+	MisbehaviorProtocolViolation: 50,
+}
+
+var misbehaviorToString = [...]string{
+	MisbehaviorInvalidBlock:      "invalid block",
+	MisbehaviorUselessTx:         "useless transactions",
+	MisbehaviorTimeout:           "request timeout",
+	MisbehaviorProtocolViolation: "protocol violation",
+}
+
+func (m Misbehavior) String() string {
+	if uint(len(misbehaviorToString)) <= uint(m) {
+		return fmt.Sprintf("unknown misbehavior %d", m)
+	}
+	return misbehaviorToString[m]
+}
+
+func (m Misbehavior) penalty() int {
+	if uint(len(misbehaviorPenalty)) <= uint(m) {
+		return 0
+	}
+	return misbehaviorPenalty[m]
+}
+
+// BanInfo represents a banned node.
+type BanInfo struct {
+	ID      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+}
+
+type peerScore struct {
+	score   int
+	updated time.Time
+}
+
+// recover adds the points recovered since the last update to the score.
+func (s *peerScore) recover(now time.Time) {
+	if s.score >= 0 {
+		s.updated = now
+		return
+	}
+	n := int(now.Sub(s.updated) / scoreRecoveryInterval)
+	if n >= -s.score {
+		s.score, s.updated = 0, now
+		return
+	}
+	s.score += n
+	s.updated = s.updated.Add(time.Duration(n) * scoreRecoveryInterval)
+}
+
+// reputation tracks the scores of the peers and the temporary bans. The bans are
+// persisted to the file if the path is given, so that they survive restarts.
+type reputation struct {
+	mu     sync.Mutex
+	scores map[discover.NodeID]*peerScore
+	bans   map[discover.NodeID]time.Time
+	file   string
+	now    func() time.Time
+	logger log.Logger
+}
+
+func newReputation(file string, logger log.Logger) *reputation {
+	r := &reputation{
+		scores: make(map[discover.NodeID]*peerScore),
+		bans:   make(map[discover.NodeID]time.Time),
+		file:   file,
+		now:    time.Now,
+		logger: logger,
+	}
+	r.load()
+	return r
+}
+
+// penalize lowers the score of the node by the penalty of the misbehavior, and
+// bans the node if the score falls below the threshold. It returns whether the
+// node is banned.
+func (r *reputation) penalize(id discover.NodeID, m Misbehavior, exempt bool) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.prune(now)
+	s := r.scores[id]
+	if s == nil {
+		s = &peerScore{updated: now}
+		r.scores[id] = s
+	}
+	s.recover(now)
+	s.score -= m.penalty()
+	peerPenaltyCounter.Inc(1)
+	r.logger.Debug("Penalized peer", "id", id, "misbehavior", m, "score", s.score)
+
+	if exempt || s.score > BanThreshold {
+		return false
+	}
+	// The score is reset, so the node has to misbehave again after the ban.
+	delete(r.scores, id)
+	r.bans[id] = now.Add(DefaultBanDuration)
+	peerBanCounter.Inc(1)
+	r.logger.Warn("Banned misbehaving peer", "id", id, "misbehavior", m, "duration", DefaultBanDuration)
+	r.save()
+	return true
+}
+
+// score returns the current score of the node.
+func (r *reputation) score(id discover.NodeID) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.scores[id]
+	if s == nil {
+		return 0
+	}
+	s.recover(r.now())
+	return s.score
+}
+
+// prune removes the scores fully recovered. The caller must hold r.mu.
+func (r *reputation) prune(now time.Time) {
+	for id, s := range r.scores {
+		if s.recover(now); s.score == 0 {
+			delete(r.scores, id)
+		}
+	}
+}
+
+// ban bans the node for the given duration.
+func (r *reputation) ban(id discover.NodeID, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.scores, id)
+	r.bans[id] = r.now().Add(duration)
+	r.save()
+}
+
+// unban lifts the ban of the node. It returns false if the node wasn't banned.
+func (r *reputation) unban(id discover.NodeID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.bans[id]; !ok {
+		return false
+	}
+	delete(r.bans, id)
+	delete(r.scores, id)
+	r.save()
+	return true
+}
+
+// isBanned returns whether the node is banned now.
+func (r *reputation) isBanned(id discover.NodeID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expires, ok := r.bans[id]
+	if !ok {
+		return false
+	}
+	if r.now().Before(expires) {
+		return true
+	}
+	delete(r.bans, id)
+	r.save()
+	return false
+}
+
+// banned returns the nodes banned now, sorted by the expiry.
+func (r *reputation) banned() []*BanInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire(r.now())
+	ret := make([]*BanInfo, 0, len(r.bans))
+	for id, expires := range r.bans {
+		ret = append(ret, &BanInfo{ID: id.String(), Expires: expires})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if !ret[i].Expires.Equal(ret[j].Expires) {
+			return ret[i].Expires.Before(ret[j].Expires)
+		}
+		return ret[i].ID < ret[j].ID
+	})
+	return ret
+}
+
+// expire removes the bans expired. The caller must hold r.mu.
+func (r *reputation) expire(now time.Time) {
+	for id, expires := range r.bans {
+		if !now.Before(expires) {
+			delete(r.bans, id)
+		}
+	}
+}
+
+// load reads the bans from the file. The bans expired while the node was down
+// are dropped.
+func (r *reputation) load() {
+	if r.file == "" {
+		return
+	}
+	blob, err := os.ReadFile(r.file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			r.logger.Error("Failed to read the banned nodes", "file", r.file, "err", err)
+		}
+		return
+	}
+	var list []*BanInfo
+	if err := json.Unmarshal(blob, &list); err != nil {
+		r.logger.Error("Failed to parse the banned nodes", "file", r.file, "err", err)
+		return
+	}
+	now := r.now()
+	for _, b := range list {
+		id, err := discover.HexID(b.ID)
+		if err != nil {
+			r.logger.Error("Invalid banned node", "id", b.ID, "err", err)
+			continue
+		}
+		if now.Before(b.Expires) {
+			r.bans[id] = b.Expires
+		}
+	}
+	r.logger.Info("Loaded the banned nodes", "count", len(r.bans))
+}
+
+// save writes the bans to the file. The caller must hold r.mu.
+func (r *reputation) save() {
+	if r.file == "" {
+		return
+	}
+	r.expire(r.now())
+	list := make([]*BanInfo, 0, len(r.bans))
+	for id, expires := range r.bans {
+		list = append(list, &BanInfo{ID: id.String(), Expires: expires})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	blob, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		r.logger.Error("Failed to encode the banned nodes", "err", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.file), 0o700); err != nil {
+		r.logger.Error("Failed to create the directory of the banned nodes", "file", r.file, "err", err)
+		return
+	}
+	tmp := r.file + ".tmp"
+	if err := os.WriteFile(tmp, blob, 0o600); err != nil {
+		r.logger.Error("Failed to write the banned nodes", "file", r.file, "err", err)
+		return
+	}
+	if err := os.Rename(tmp, r.file); err != nil {
+		r.logger.Error("Failed to write the banned nodes", "file", r.file, "err", err)
+	}
+}
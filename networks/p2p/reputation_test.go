@@ -0,0 +1,132 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/networks/p2p/discover"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestReputation(file string, now *time.Time) *reputation {
+	r := &reputation{
+		scores: make(map[discover.NodeID]*peerScore),
+		bans:   make(map[discover.NodeID]time.Time),
+		file:   file,
+		now:    func() time.Time { return *now },
+		logger: logger.NewWith(),
+	}
+	r.load()
+	return r
+}
+
+func TestReputation_Penalize(t *testing.T) {
+	var (
+		now = time.Unix(1700000000, 0)
+		r   = newTestReputation("", &now)
+		id  = discover.NodeID{1}
+	)
+	assert.False(t, r.penalize(id, MisbehaviorInvalidBlock, false))
+	assert.Equal(t, -50, r.score(id))
+
+	// The score recovers over time.
+	now = now.Add(10 * scoreRecoveryInterval)
+	assert.Equal(t, -40, r.score(id))
+	assert.False(t, r.penalize(id, MisbehaviorTimeout, false))
+	assert.Equal(t, -60, r.score(id))
+
+	// The trusted node isn't banned.
+	assert.False(t, r.penalize(id, MisbehaviorProtocolViolation, true))
+	assert.False(t, r.isBanned(id))
+
+	// The node is banned below the threshold, and the ban expires.
+	assert.True(t, r.penalize(id, MisbehaviorProtocolViolation, false))
+	assert.True(t, r.isBanned(id))
+	assert.Zero(t, r.score(id))
+	now = now.Add(DefaultBanDuration)
+	assert.False(t, r.isBanned(id))
+
+	// The fully recovered scores are pruned.
+	r.penalize(id, MisbehaviorUselessTx, false)
+	now = now.Add(scoreRecoveryInterval)
+	r.penalize(discover.NodeID{2}, MisbehaviorUselessTx, false)
+	assert.Len(t, r.scores, 1)
+}
+
+func TestPeer_PenalizeExempt(t *testing.T) {
+	var (
+		now = time.Unix(1700000000, 0)
+		r   = newTestReputation("", &now)
+	)
+	newPeer := func(id discover.NodeID, flags connFlag, conntype common.ConnType) *Peer {
+		return &Peer{
+			rws:        []*conn{{id: id, flags: flags, conntype: conntype}},
+			disc:       make(chan DiscReason, 1),
+			closed:     make(chan struct{}),
+			reputation: r,
+		}
+	}
+	penalize := func(p *Peer, m Misbehavior, n int) {
+		for i := 0; i < n; i++ {
+			p.Penalize(m)
+		}
+	}
+
+	// Static and CN peers aren't banned for timeouts, but for the other misbehaviors.
+	static := newPeer(discover.NodeID{1}, staticDialedConn, common.ENDPOINTNODE)
+	penalize(static, MisbehaviorTimeout, 10)
+	assert.False(t, r.isBanned(static.ID()))
+	penalize(static, MisbehaviorInvalidBlock, 1)
+	assert.True(t, r.isBanned(static.ID()))
+
+	cn := newPeer(discover.NodeID{2}, inboundConn, common.CONSENSUSNODE)
+	penalize(cn, MisbehaviorTimeout, 10)
+	assert.False(t, r.isBanned(cn.ID()))
+
+	// The other peers are banned for timeouts.
+	en := newPeer(discover.NodeID{3}, inboundConn, common.ENDPOINTNODE)
+	penalize(en, MisbehaviorTimeout, 6)
+	assert.True(t, r.isBanned(en.ID()))
+	assert.Equal(t, DiscBanned, <-en.disc)
+}
+
+func TestReputation_Persist(t *testing.T) {
+	var (
+		now  = time.Unix(1700000000, 0)
+		file = filepath.Join(t.TempDir(), "banned-nodes.json")
+		r    = newTestReputation(file, &now)
+	)
+	r.ban(discover.NodeID{1}, time.Minute)
+	r.ban(discover.NodeID{2}, time.Hour)
+	r.ban(discover.NodeID{3}, time.Hour)
+	assert.True(t, r.unban(discover.NodeID{3}))
+	assert.False(t, r.unban(discover.NodeID{3}))
+
+	// The bans are loaded after the restart, except the expired one.
+	now = now.Add(2 * time.Minute)
+	r = newTestReputation(file, &now)
+	banned := r.banned()
+	assert.Len(t, banned, 1)
+	assert.Equal(t, discover.NodeID{2}.String(), banned[0].ID)
+	assert.True(t, banned[0].Expires.Equal(now.Add(time.Hour-2*time.Minute)))
+	assert.False(t, r.isBanned(discover.NodeID{1}))
+	assert.True(t, r.isBanned(discover.NodeID{2}))
+}
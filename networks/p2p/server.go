@@ -88,6 +88,11 @@ type Config struct {
 	// Disabling is useful for protocol debugging (manual topology).
 	NoDiscovery bool
 
+	// TopicDiscovery specifies whether the topic discovery runs alongside the v4 on
+	// the same UDP port. The nodes found by the topic advertisement are connected as
+	// the nodes found by the v4. It is a Kaia-specific protocol, not the discv5.
+	TopicDiscovery bool `toml:",omitempty"`
+
 	// Name sets the node name of this server.
 	// Use common.MakeName to create a name that follows existing conventions.
 	Name string `toml:"-"`
@@ -118,6 +123,11 @@ type Config struct {
 	// live nodes in the network.
 	NodeDatabase string `toml:",omitempty"`
 
+	// BannedNodesFile is the path to the file persisting the nodes banned for their
+	// misbehaviors, so that the bans survive restarts. The bans are kept in memory
+	// only if it is empty.
+	BannedNodesFile string `toml:",omitempty"`
+
 	// Protocols should contain the protocols supported
 	// by the server. Matching protocols are launched for
 	// each peer.
@@ -273,6 +283,15 @@ type Server interface {
 	// Peers returns all connected peers.
 	Peers() []*Peer
 
+	// BanPeer bans the node for the given duration and disconnects it.
+	BanPeer(id discover.NodeID, duration time.Duration)
+
+	// UnbanPeer lifts the ban of the node. It returns false if the node isn't banned.
+	UnbanPeer(id discover.NodeID) bool
+
+	// BannedPeers returns the nodes banned now.
+	BannedPeers() []*BanInfo
+
 	// NodeDialer is used to connect to nodes in the network, typically by using
 	// an underlying net.Dialer but also using net.Pipe in tests.
 	NodeDialer
@@ -300,6 +319,7 @@ func (srv *MultiChannelServer) Start() (err error) {
 		srv.logger = logger.NewWith()
 	}
 	srv.logger.Info("Starting P2P networking")
+	srv.reputation = newReputation(srv.BannedNodesFile, srv.logger)
 
 	// static fields
 	if srv.PrivateKey == nil {
@@ -332,6 +352,9 @@ func (srv *MultiChannelServer) Start() (err error) {
 		unhandled chan discover.ReadPacket
 	)
 
+	if !srv.NoDiscovery && srv.TopicDiscovery {
+		unhandled = make(chan discover.ReadPacket, 100)
+	}
 	if !srv.NoDiscovery {
 		addr, err := net.ResolveUDPAddr("udp", srv.ListenAddrs[ConnDefault])
 		if err != nil {
@@ -374,9 +397,17 @@ func (srv *MultiChannelServer) Start() (err error) {
 			return err
 		}
 		srv.ntab = ntab
+
+		if srv.TopicDiscovery {
+			cfg.Ports = listenPorts(srv.ListenAddrs)
+			if srv.TopicDisc, err = discover.ListenTopic(&cfg, unhandled, ntab); err != nil {
+				return err
+			}
+		}
 	}
 
 	dialer := newDialState(srv.StaticNodes, srv.BootstrapNodes, srv.ntab, srv.maxDialedConns(), srv.NetRestrict, srv.PrivateKey, srv.getTypeStatics())
+	dialer.banned = srv.reputation.isBanned
 
 	// handshake
 	srv.ourHandshake = &protoHandshake{Version: baseProtocolVersion, Name: srv.Name(), ID: discover.PubkeyID(&srv.PrivateKey.PublicKey), Multichannel: true}
@@ -735,6 +766,7 @@ running:
 				if e != nil {
 					srv.logger.Error("Fail make a new peer", "err", e)
 				} else if p != nil {
+					p.reputation = srv.reputation
 					// If message events are enabled, pass the peerFeed
 					// to the peer
 					if srv.EnableMsgEvents {
@@ -779,9 +811,9 @@ running:
 	if srv.ntab != nil {
 		srv.ntab.Close()
 	}
-	//if srv.DiscV5 != nil {
-	//	srv.DiscV5.Close()
-	//}
+	if srv.TopicDisc != nil {
+		srv.TopicDisc.Close()
+	}
 	// Disconnect all peers.
 	for _, p := range peers {
 		p.Disconnect(DiscQuitting)
@@ -924,7 +956,8 @@ type BaseServer struct {
 	ourHandshake *protoHandshake
 	lastLookup   time.Time
 	lastLookupMu sync.Mutex
-	// DiscV5       *discv5.Network
+	TopicDisc    *discover.TopicUDP
+	reputation   *reputation
 
 	// These are for Peers, PeerCount (and nothing else).
 	peerOp     chan peerOpFunc
@@ -1205,6 +1238,7 @@ func (srv *BaseServer) Start() (err error) {
 		srv.logger = logger.NewWith()
 	}
 	srv.logger.Info("Starting P2P networking")
+	srv.reputation = newReputation(srv.BannedNodesFile, srv.logger)
 
 	// static fields
 	if srv.PrivateKey == nil {
@@ -1237,6 +1271,9 @@ func (srv *BaseServer) Start() (err error) {
 		unhandled chan discover.ReadPacket
 	)
 
+	if !srv.NoDiscovery && srv.TopicDiscovery {
+		unhandled = make(chan discover.ReadPacket, 100)
+	}
 	if !srv.NoDiscovery {
 		addr, err := net.ResolveUDPAddr("udp", srv.ListenAddr)
 		if err != nil {
@@ -1284,9 +1321,17 @@ func (srv *BaseServer) Start() (err error) {
 			return err
 		}
 		srv.ntab = ntab
+
+		if srv.TopicDiscovery {
+			cfg.Ports = listenPorts([]string{srv.ListenAddr})
+			if srv.TopicDisc, err = discover.ListenTopic(&cfg, unhandled, ntab); err != nil {
+				return err
+			}
+		}
 	}
 
 	dialer := newDialState(srv.StaticNodes, srv.BootstrapNodes, srv.ntab, srv.maxDialedConns(), srv.NetRestrict, srv.PrivateKey, srv.getTypeStatics())
+	dialer.banned = srv.reputation.isBanned
 
 	// handshake
 	srv.ourHandshake = &protoHandshake{Version: baseProtocolVersion, Name: srv.Name(), ID: discover.PubkeyID(&srv.PrivateKey.PublicKey), Multichannel: false}
@@ -1448,6 +1493,7 @@ running:
 				if err != nil {
 					srv.logger.Error("Fail make a new peer", "err", err)
 				} else {
+					p.reputation = srv.reputation
 					// If message events are enabled, pass the peerFeed
 					// to the peer
 					if srv.EnableMsgEvents {
@@ -1501,9 +1547,9 @@ running:
 	if srv.ntab != nil {
 		srv.ntab.Close()
 	}
-	//if srv.DiscV5 != nil {
-	//	srv.DiscV5.Close()
-	//}
+	if srv.TopicDisc != nil {
+		srv.TopicDisc.Close()
+	}
 	// Disconnect all peers.
 	for _, p := range peers {
 		p.Disconnect(DiscQuitting)
@@ -1538,6 +1584,8 @@ func (srv *BaseServer) encHandshakeChecks(peers map[discover.NodeID]*Peer, inbou
 		return DiscAlreadyConnected
 	case c.id == srv.Self().ID:
 		return DiscSelf
+	case !c.is(trustedConn) && srv.reputation != nil && srv.reputation.isBanned(c.id):
+		return DiscBanned
 	default:
 		return nil
 	}
@@ -1857,6 +1905,31 @@ func (srv *BaseServer) Disconnect(destID discover.NodeID) {
 	srv.discpeer <- destID
 }
 
+// BanPeer bans the node for the given duration and disconnects it.
+func (srv *BaseServer) BanPeer(id discover.NodeID, duration time.Duration) {
+	srv.reputation.ban(id, duration)
+	srv.logger.Info("Banned peer", "id", id, "duration", duration)
+	select {
+	case srv.peerOp <- func(peers map[discover.NodeID]*Peer) {
+		if p, ok := peers[id]; ok {
+			p.Disconnect(DiscBanned)
+		}
+	}:
+		<-srv.peerOpDone
+	case <-srv.quit:
+	}
+}
+
+// UnbanPeer lifts the ban of the node. It returns false if the node isn't banned.
+func (srv *BaseServer) UnbanPeer(id discover.NodeID) bool {
+	return srv.reputation.unban(id)
+}
+
+// BannedPeers returns the nodes banned now.
+func (srv *BaseServer) BannedPeers() []*BanInfo {
+	return srv.reputation.banned()
+}
+
 // CheckNilNetworkTable returns whether network table is nil.
 func (srv *BaseServer) CheckNilNetworkTable() bool {
 	return srv.ntab == nil
@@ -1891,6 +1964,22 @@ func (srv *BaseServer) MaxPeers() int {
 	return srv.Config.MaxPhysicalConnections
 }
 
+// listenPorts returns the ports of the listen addresses. The addresses with the
+// port to be picked by the operating system are skipped.
+func listenPorts(addrs []string) []uint16 {
+	var ports []uint16
+	for _, addr := range addrs {
+		_, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			continue
+		}
+		if p, err := strconv.ParseUint(port, 10, 16); err == nil && p != 0 {
+			ports = append(ports, uint16(p))
+		}
+	}
+	return ports
+}
+
 func ConvertNodeType(ct common.ConnType) discover.NodeType {
 	switch ct {
 	case common.CONSENSUSNODE:
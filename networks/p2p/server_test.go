@@ -27,6 +27,8 @@ import (
 	"errors"
 	"math/rand"
 	"net"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -594,6 +596,58 @@ func TestServerSetupConn(t *testing.T) {
 	}
 }
 
+func TestServerBanPeer(t *testing.T) {
+	var (
+		id   = discover.PubkeyID(&newkey().PublicKey)
+		file = filepath.Join(t.TempDir(), "banned-nodes.json")
+		tt   *setupTransport
+	)
+	srv := &SingleChannelServer{
+		&BaseServer{
+			Config: Config{
+				PrivateKey:             newkey(),
+				MaxPhysicalConnections: 10,
+				NoDial:                 true,
+				Protocols:              []Protocol{discard},
+				ConnectionType:         1, // ENDPOINTNODE
+				BannedNodesFile:        file,
+			},
+			newTransport: func(fd net.Conn, dialDest *ecdsa.PublicKey) transport { return tt },
+			logger:       logger.NewWith(),
+		},
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("couldn't start server: %v", err)
+	}
+	defer srv.Stop()
+
+	// The banned node is rejected after the encryption handshake.
+	srv.BanPeer(id, time.Hour)
+	tt = &setupTransport{id: id, phs: &protoHandshake{ID: id}}
+	p1, _ := net.Pipe()
+	srv.SetupConn(p1, inboundConn, nil)
+	if tt.closeErr != DiscBanned || tt.calls != "doEncHandshake,close," {
+		t.Errorf("banned node not rejected: err %q, calls %q", tt.closeErr, tt.calls)
+	}
+	if banned := srv.BannedPeers(); len(banned) != 1 || banned[0].ID != id.String() {
+		t.Errorf("banned peers mismatch: %v", banned)
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("banned nodes not persisted: %v", err)
+	}
+
+	// The node passes the checks after the ban is lifted.
+	if !srv.UnbanPeer(id) {
+		t.Error("UnbanPeer returned false for the banned node")
+	}
+	tt = &setupTransport{id: id, phs: &protoHandshake{ID: id}}
+	p2, _ := net.Pipe()
+	srv.SetupConn(p2, inboundConn, nil)
+	if tt.closeErr != DiscUselessPeer {
+		t.Errorf("unbanned node rejected: err %q, calls %q", tt.closeErr, tt.calls)
+	}
+}
+
 type setupTransport struct {
 	id              discover.NodeID
 	encHandshakeErr error
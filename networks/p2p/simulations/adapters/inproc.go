@@ -93,14 +93,23 @@ func (s *SimAdapter) NewNode(config *NodeConfig) (Node, error) {
 		}
 	}
 
+	p2pCfg := p2p.Config{
+		PrivateKey:             config.PrivateKey,
+		MaxPhysicalConnections: math.MaxInt32,
+		ConnectionType:         config.ConnectionType,
+		NoDiscovery:            true,
+		Dialer:                 s,
+		EnableMsgEvents:        config.EnableMsgEvents,
+	}
+	if config.TopicDiscovery {
+		p2pCfg.NoDiscovery = false
+		p2pCfg.TopicDiscovery = true
+		p2pCfg.ListenAddr = fmt.Sprintf("127.0.0.1:%d", config.Port)
+		p2pCfg.NetworkID = config.NetworkID
+		p2pCfg.BootstrapNodes = config.BootstrapNodes
+	}
 	n, err := node.New(&node.Config{
-		P2P: p2p.Config{
-			PrivateKey:             config.PrivateKey,
-			MaxPhysicalConnections: math.MaxInt32,
-			NoDiscovery:            true,
-			Dialer:                 s,
-			EnableMsgEvents:        config.EnableMsgEvents,
-		},
+		P2P:    p2pCfg,
 		Logger: logger.NewWith("node.id", id.String()),
 	})
 	if err != nil {
@@ -33,6 +33,7 @@ import (
 
 	"github.com/docker/docker/pkg/reexec"
 	"github.com/gorilla/websocket"
+	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/networks/p2p"
 	"github.com/kaiachain/kaia/networks/p2p/discover"
@@ -115,6 +116,17 @@ type NodeConfig struct {
 	Reachable func(id discover.NodeID) bool
 
 	Port uint16
+
+	// ConnectionType is the type of the node such as common.PROXYNODE.
+	// The zero value is common.CONSENSUSNODE.
+	ConnectionType common.ConnType
+
+	// If TopicDiscovery is true, the node runs the discovery v4 and the topic discovery on the UDP
+	// port of Port, and the nodes found by the discovery are dialed through the
+	// adapter. It is supported by SimAdapter.
+	TopicDiscovery bool
+	NetworkID      uint64
+	BootstrapNodes []*discover.Node
 }
 
 // nodeConfigJSON is used to encode and decode NodeConfig as JSON by encoding
@@ -28,6 +28,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/networks/p2p"
 	"github.com/kaiachain/kaia/networks/p2p/discover"
 	"github.com/kaiachain/kaia/networks/p2p/simulations/adapters"
 )
@@ -164,3 +166,74 @@ func triggerChecks(ctx context.Context, ids []discover.NodeID, trigger chan disc
 		}
 	}
 }
+
+// TestTopicDiscoverySimulation starts a bootnode, PNs and ENs using the topic discovery,
+// and checks that the ENs connect to the PNs found by the topic search.
+func TestTopicDiscoverySimulation(t *testing.T) {
+	const networkID = 1001
+
+	adapter := adapters.NewSimAdapter(adapters.Services{
+		"test": newTestService,
+	})
+	network := NewNetwork(adapter, &NetworkConfig{
+		DefaultService: "test",
+	})
+	defer network.Shutdown()
+
+	newNode := func(connType common.ConnType, bootnodes ...*discover.Node) *adapters.SimNode {
+		conf := adapters.RandomNodeConfig()
+		conf.ConnectionType = connType
+		conf.TopicDiscovery = true
+		conf.NetworkID = networkID
+		conf.BootstrapNodes = bootnodes
+		node, err := network.NewNodeWithConfig(conf)
+		if err != nil {
+			t.Fatalf("error creating node: %s", err)
+		}
+		if err := network.Start(node.ID()); err != nil {
+			t.Fatalf("error starting node: %s", err)
+		}
+		return node.Node.(*adapters.SimNode)
+	}
+
+	bn := newNode(common.BOOTNODE)
+	bootnode := bn.Server().(*p2p.SingleChannelServer).Self()
+
+	pns := make(map[discover.NodeID]bool)
+	for i := 0; i < 2; i++ {
+		pn := newNode(common.PROXYNODE, bootnode)
+		td := pn.Server().(*p2p.SingleChannelServer).TopicDisc
+		topic := discover.Topic(discover.NodeTypePN, networkID)
+		deadline := time.Now().Add(10 * time.Second)
+		for td.RegisterTopic(topic) == 0 {
+			if time.Now().After(deadline) {
+				t.Fatalf("PN %s failed to register the topic", pn.ID)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		pns[pn.ID] = true
+	}
+
+	var ens []*adapters.SimNode
+	for i := 0; i < 2; i++ {
+		ens = append(ens, newNode(common.ENDPOINTNODE, bootnode))
+	}
+
+	connected := func(en *adapters.SimNode) bool {
+		for _, p := range en.Server().Peers() {
+			if pns[p.ID()] {
+				return true
+			}
+		}
+		return false
+	}
+	deadline := time.Now().Add(30 * time.Second)
+	for _, en := range ens {
+		for !connected(en) {
+			if time.Now().After(deadline) {
+				t.Fatalf("EN %s failed to connect to the PNs", en.ID)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+}
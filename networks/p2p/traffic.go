@@ -0,0 +1,138 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+// MsgTraffic is the traffic of a message code exchanged with a peer.
+type MsgTraffic struct {
+	IngressMsgs  uint64 `json:"ingressMsgs"`
+	IngressBytes uint64 `json:"ingressBytes"`
+	EgressMsgs   uint64 `json:"egressMsgs"`
+	EgressBytes  uint64 `json:"egressBytes"`
+}
+
+// ProtocolTraffic is the traffic of a protocol by the message code.
+type ProtocolTraffic map[uint64]*MsgTraffic
+
+type msgKey struct {
+	proto string
+	code  uint64
+}
+
+// peerTraffic accounts the messages exchanged with a peer by the protocol and
+// the message code. The aggregated traffic of all peers is exported as metrics.
+type peerTraffic struct {
+	mu    sync.Mutex
+	codes map[msgKey]*MsgTraffic
+}
+
+func newPeerTraffic() *peerTraffic {
+	return &peerTraffic{codes: make(map[msgKey]*MsgTraffic)}
+}
+
+// get returns the traffic of the message code. The caller must hold t.mu.
+func (t *peerTraffic) get(key msgKey) *MsgTraffic {
+	mt := t.codes[key]
+	if mt == nil {
+		mt = new(MsgTraffic)
+		t.codes[key] = mt
+	}
+	return mt
+}
+
+func (t *peerTraffic) ingress(proto string, code uint64, size uint32) {
+	key := msgKey{proto, code}
+	t.mu.Lock()
+	mt := t.get(key)
+	mt.IngressMsgs++
+	mt.IngressBytes += uint64(size)
+	t.mu.Unlock()
+
+	m := trafficMetersOf(key)
+	m.ingressMsgs.Mark(1)
+	m.ingressBytes.Mark(int64(size))
+}
+
+func (t *peerTraffic) egress(proto string, code uint64, size uint32) {
+	key := msgKey{proto, code}
+	t.mu.Lock()
+	mt := t.get(key)
+	mt.EgressMsgs++
+	mt.EgressBytes += uint64(size)
+	t.mu.Unlock()
+
+	m := trafficMetersOf(key)
+	m.egressMsgs.Mark(1)
+	m.egressBytes.Mark(int64(size))
+}
+
+// snapshot returns a copy of the traffic by the protocol name.
+func (t *peerTraffic) snapshot() map[string]ProtocolTraffic {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ret := make(map[string]ProtocolTraffic)
+	for key, mt := range t.codes {
+		if ret[key.proto] == nil {
+			ret[key.proto] = make(ProtocolTraffic)
+		}
+		cpy := *mt
+		ret[key.proto][key.code] = &cpy
+	}
+	return ret
+}
+
+type trafficMeters struct {
+	ingressMsgs, ingressBytes metrics.Meter
+	egressMsgs, egressBytes   metrics.Meter
+}
+
+// trafficMeterMap caches the meters of the message codes, registered on the first use.
+var (
+	trafficMetersMu sync.RWMutex
+	trafficMeterMap = make(map[msgKey]*trafficMeters)
+)
+
+func trafficMetersOf(key msgKey) *trafficMeters {
+	trafficMetersMu.RLock()
+	m := trafficMeterMap[key]
+	trafficMetersMu.RUnlock()
+	if m != nil {
+		return m
+	}
+
+	trafficMetersMu.Lock()
+	defer trafficMetersMu.Unlock()
+	if m = trafficMeterMap[key]; m != nil {
+		return m
+	}
+	prefix := fmt.Sprintf("p2p/traffic/%s/0x%02x/", key.proto, key.code)
+	m = &trafficMeters{
+		ingressMsgs:  metrics.GetOrRegisterMeter(prefix+"ingress/msgs", nil),
+		ingressBytes: metrics.GetOrRegisterMeter(prefix+"ingress/bytes", nil),
+		egressMsgs:   metrics.GetOrRegisterMeter(prefix+"egress/msgs", nil),
+		egressBytes:  metrics.GetOrRegisterMeter(prefix+"egress/bytes", nil),
+	}
+	trafficMeterMap[key] = m
+	return m
+}
@@ -360,6 +360,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// single request.
 	ctx := r.Context()
 	ctx = context.WithValue(ctx, "remote", r.RemoteAddr)
+	if header := RateLimitAPIKeyHeader(); header != "" && r.Header.Get(header) != "" {
+		ctx = context.WithValue(ctx, "apikey", r.Header.Get(header))
+	}
 	ctx = context.WithValue(ctx, "scheme", r.Proto)
 	ctx = context.WithValue(ctx, "local", r.Host)
 	if ua := r.Header.Get("User-Agent"); ua != "" {
@@ -396,6 +399,9 @@ func (srv *Server) HandleFastHTTP(requestCtx *fasthttp.RequestCtx) {
 	var ctx context.Context
 	ctx = requestCtx
 	ctx = context.WithValue(ctx, "remote", requestCtx.RemoteAddr().String())
+	if header := RateLimitAPIKeyHeader(); header != "" && len(requestCtx.Request.Header.Peek(header)) > 0 {
+		ctx = context.WithValue(ctx, "apikey", string(requestCtx.Request.Header.Peek(header)))
+	}
 	ctx = context.WithValue(ctx, "scheme", string(requestCtx.URI().Scheme()))
 	ctx = context.WithValue(ctx, "local", requestCtx.LocalAddr().String())
 
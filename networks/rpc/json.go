@@ -199,6 +199,7 @@ func (c connWithRemoteAddr) RemoteAddr() string { return c.addr }
 // support for parsing arguments and serializing (result) objects.
 type jsonCodec struct {
 	remote  string
+	apiKey  string                    // API key of the client for the rate limit, if given
 	closer  sync.Once                 // close closed channel once
 	closeCh chan interface{}          // closed on Close
 	decode  func(v interface{}) error // decoder to allow multiple transports
@@ -0,0 +1,368 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultAPIKeyHeader is the HTTP header identifying the clients sharing a
+	// rate limit by one of RateLimitConfig.APIKeys. The other clients are
+	// identified by their IP.
+	DefaultAPIKeyHeader = "X-API-Key"
+
+	// rateLimitSweepInterval is the interval of dropping the buckets of the
+	// clients which have been idle long enough to refill them.
+	rateLimitSweepInterval = time.Minute
+)
+
+var (
+	// DefaultMethodCosts are the costs of the heavy methods. The other methods
+	// cost 1.
+	DefaultMethodCosts = map[string]int{
+		"debug_trace*":     100,
+		"kaia_call":        5,
+		"eth_call":         5,
+		"kaia_estimateGas": 5,
+		"eth_estimateGas":  5,
+		"kaia_getLogs":     10,
+		"eth_getLogs":      10,
+	}
+
+	rpcRateLimitedCounter = metrics.NewRegisteredCounter("rpc/counts/ratelimited", nil)
+
+	// rateLimiterPtr holds the *rateLimiter set by SetRateLimit, nil if disabled.
+	rateLimiterPtr atomic.Value
+)
+
+// RateLimitConfig is the configuration of the rate limit of the RPC requests,
+// shared by all the transports.
+type RateLimitConfig struct {
+	// Rate is the cost of the requests a client may make per second. 0 disables
+	// the rate limit.
+	Rate float64
+	// Burst is the cost a client may spend at once after being idle. It must be
+	// at least the largest cost of the methods, not to deny them forever. If 0,
+	// the cost of the requests of a second is used, or the largest cost of the
+	// methods if larger.
+	Burst int
+	// APIKeyHeader is the HTTP header, or the gRPC metadata, with the API key of
+	// the client. If empty, DefaultAPIKeyHeader is used.
+	APIKeyHeader string
+	// APIKeys are the API keys identifying the clients instead of their IPs. The
+	// key of a request is ignored unless listed, so that a client can't get a new
+	// bucket by making up a key. If empty, the clients are identified by their IPs.
+	APIKeys []string
+	// MethodCosts are the costs of the methods overriding DefaultMethodCosts. A
+	// name ending with '*' matches the methods with the prefix, and the longest
+	// match wins.
+	MethodCosts map[string]int
+}
+
+// RateLimitState is the state of the rate limit of a client.
+type RateLimitState struct {
+	Client   string  `json:"client"`
+	Tokens   float64 `json:"tokens"` // Cost the client may spend now
+	Burst    int     `json:"burst"`
+	Rate     float64 `json:"rate"`
+	Requests uint64  `json:"requests"`
+	Denied   uint64  `json:"denied"`
+}
+
+// rateLimitedError is returned to the requests over the rate limit.
+type rateLimitedError struct {
+	retryAfter time.Duration // 0 if the request can never be served
+}
+
+func (e *rateLimitedError) ErrorCode() int { return -32005 }
+
+func (e *rateLimitedError) Error() string {
+	if e.retryAfter == 0 {
+		return "request exceeds the rate limit"
+	}
+	return fmt.Sprintf("rate limit exceeded, retry after %v", e.retryAfter)
+}
+
+func (e *rateLimitedError) ErrorData() interface{} {
+	if e.retryAfter == 0 {
+		return nil
+	}
+	// Rounded up in seconds as the Retry-After HTTP header.
+	return map[string]interface{}{"retryAfter": int(math.Ceil(e.retryAfter.Seconds()))}
+}
+
+type clientBucket struct {
+	limiter  *rate.Limiter
+	requests uint64
+	denied   uint64
+}
+
+// rateLimiter limits the requests of each client with a token bucket, which the
+// requests consume by the costs of their methods.
+type rateLimiter struct {
+	config  RateLimitConfig
+	costs   map[string]int
+	apiKeys map[string]bool
+
+	mu        sync.Mutex
+	clients   map[string]*clientBucket
+	lastSweep time.Time
+}
+
+// SetRateLimit enables the rate limit of the RPC requests by the config, or
+// disables it if config.Rate is 0. The states of the clients are reset. An
+// error is returned if a method costs more than the burst.
+func SetRateLimit(config RateLimitConfig) error {
+	if config.Rate <= 0 {
+		rateLimiterPtr.Store((*rateLimiter)(nil))
+		return nil
+	}
+	l, err := newRateLimiter(config)
+	if err != nil {
+		return err
+	}
+	rateLimiterPtr.Store(l)
+	return nil
+}
+
+// RateLimitStates returns the states of the clients whose requests are limited.
+// If client is not empty, only the state of the client is returned. The client
+// is given as an IP or "key:<API key>". The clients with API keys are reported
+// by the hashes of the keys, so that the keys aren't disclosed.
+func RateLimitStates(client string) []RateLimitState {
+	l := getRateLimiter()
+	if l == nil {
+		return []RateLimitState{}
+	}
+	if key := strings.TrimPrefix(client, "key:"); len(key) < len(client) {
+		client = apiKeyClient(key)
+	}
+	return l.states(client)
+}
+
+func getRateLimiter() *rateLimiter {
+	l, _ := rateLimiterPtr.Load().(*rateLimiter)
+	return l
+}
+
+func newRateLimiter(config RateLimitConfig) (*rateLimiter, error) {
+	if config.APIKeyHeader == "" {
+		config.APIKeyHeader = DefaultAPIKeyHeader
+	}
+	costs := make(map[string]int, len(DefaultMethodCosts)+len(config.MethodCosts))
+	for method, cost := range DefaultMethodCosts {
+		costs[method] = cost
+	}
+	for method, cost := range config.MethodCosts {
+		costs[method] = cost
+	}
+
+	maxMethod, maxCost := "", 1
+	for method, cost := range costs {
+		if cost > maxCost || (cost == maxCost && method < maxMethod) {
+			maxMethod, maxCost = method, cost
+		}
+	}
+	if config.Burst <= 0 {
+		config.Burst = int(math.Max(float64(maxCost), math.Ceil(config.Rate)))
+	} else if config.Burst < maxCost {
+		return nil, fmt.Errorf("the burst %d is less than the cost %d of %s", config.Burst, maxCost, maxMethod)
+	}
+
+	apiKeys := make(map[string]bool, len(config.APIKeys))
+	for _, key := range config.APIKeys {
+		if key != "" {
+			apiKeys[key] = true
+		}
+	}
+	return &rateLimiter{
+		config:    config,
+		costs:     costs,
+		apiKeys:   apiKeys,
+		clients:   make(map[string]*clientBucket),
+		lastSweep: time.Now(),
+	}, nil
+}
+
+// cost returns the cost of the method.
+func (l *rateLimiter) cost(method string) int {
+	if cost, ok := l.costs[method]; ok {
+		return cost
+	}
+	cost, matched := 1, 0
+	for pattern, c := range l.costs {
+		prefix := strings.TrimSuffix(pattern, "*")
+		if len(prefix) < len(pattern) && len(prefix) > matched && strings.HasPrefix(method, prefix) {
+			cost, matched = c, len(prefix)
+		}
+	}
+	return cost
+}
+
+// allow consumes the cost of the method from the bucket of the client, or
+// returns the error telling when the client may retry.
+func (l *rateLimiter) allow(client, method string) error {
+	cost := l.cost(method)
+	if cost <= 0 {
+		return nil
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+	b, ok := l.clients[client]
+	if !ok {
+		b = &clientBucket{limiter: rate.NewLimiter(rate.Limit(l.config.Rate), l.config.Burst)}
+		l.clients[client] = b
+	}
+	b.requests++
+	if b.limiter.AllowN(now, cost) {
+		return nil
+	}
+	b.denied++
+	rpcRateLimitedCounter.Inc(1)
+
+	if cost > l.config.Burst {
+		return &rateLimitedError{}
+	}
+	r := b.limiter.ReserveN(now, cost)
+	retryAfter := r.DelayFrom(now)
+	r.CancelAt(now)
+	return &rateLimitedError{retryAfter: retryAfter}
+}
+
+// sweep drops the buckets which are full, since they are the same as new ones.
+func (l *rateLimiter) sweep(now time.Time) {
+	for client, b := range l.clients {
+		if b.limiter.TokensAt(now) >= float64(l.config.Burst) {
+			delete(l.clients, client)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (l *rateLimiter) states(client string) []RateLimitState {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	states := make([]RateLimitState, 0, len(l.clients))
+	for c, b := range l.clients {
+		if client != "" && c != client {
+			continue
+		}
+		states = append(states, RateLimitState{
+			Client:   c,
+			Tokens:   b.limiter.TokensAt(now),
+			Burst:    l.config.Burst,
+			Rate:     l.config.Rate,
+			Requests: b.requests,
+			Denied:   b.denied,
+		})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Client < states[j].Client })
+	return states
+}
+
+// ParseMethodCosts parses the method costs in the form of "method=cost,...",
+// e.g. "debug_trace*=200,kaia_call=10".
+func ParseMethodCosts(s string) (map[string]int, error) {
+	costs := make(map[string]int)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		kv := strings.SplitN(entry, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid method cost %q", entry)
+		}
+		cost, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid cost of %s: %q", kv[0], kv[1])
+		}
+		costs[strings.TrimSpace(kv[0])] = cost
+	}
+	return costs, nil
+}
+
+// checkRateLimit returns an error if the client of the request is over the rate
+// limit. The clients are identified by the allowlisted API key, or the IP of
+// the remote address. The requests over the local transports without a remote address,
+// i.e. IPC and in-process, are not limited.
+func (h *handler) checkRateLimit(ctx context.Context, method string) error {
+	l := getRateLimiter()
+	if l == nil {
+		return nil
+	}
+	client := h.rateLimitClient(ctx, l)
+	if client == "" {
+		return nil
+	}
+	return l.allow(client, method)
+}
+
+func (h *handler) rateLimitClient(ctx context.Context, l *rateLimiter) string {
+	key, _ := ctx.Value("apikey").(string)
+	if c, ok := h.conn.(*jsonCodec); ok && key == "" {
+		key = c.apiKey
+	}
+	if l.apiKeys[key] {
+		return apiKeyClient(key)
+	}
+	addr := h.remoteAddr(ctx)
+	if addr == "" {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// apiKeyClient returns the client identified by the API key. The key is hashed
+// since the clients are listed by admin_rpcRateLimits.
+func apiKeyClient(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return "key:" + hex.EncodeToString(hash[:8])
+}
+
+// RateLimitAPIKeyHeader returns the header with the API key of the clients, or
+// an empty string if no API key identifies the clients.
+func RateLimitAPIKeyHeader() string {
+	if l := getRateLimiter(); l != nil && len(l.apiKeys) > 0 {
+		return l.config.APIKeyHeader
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Cost(t *testing.T) {
+	l, err := newRateLimiter(RateLimitConfig{
+		Rate: 1,
+		MethodCosts: map[string]int{
+			"debug_*":              20,
+			"debug_traceBlock*":    200,
+			"kaia_call":            0,
+			"kaia_getBlockByHash*": 3,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, l.cost("kaia_blockNumber"))
+	assert.Equal(t, 0, l.cost("kaia_call"))                // overrides the default
+	assert.Equal(t, 5, l.cost("eth_call"))                 // default
+	assert.Equal(t, 100, l.cost("debug_traceTransaction")) // longest prefix
+	assert.Equal(t, 200, l.cost("debug_traceBlockByNumber"))
+	assert.Equal(t, 20, l.cost("debug_getModifiedAccountsByNumber"))
+	assert.Equal(t, 3, l.cost("kaia_getBlockByHash"))
+	assert.Equal(t, 200, l.config.Burst) // the largest cost
+}
+
+func TestRateLimiter_Burst(t *testing.T) {
+	// The default burst covers the costliest method, debug_trace* by default.
+	l, err := newRateLimiter(RateLimitConfig{Rate: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 100, l.config.Burst)
+	require.NoError(t, l.allow("a", "debug_traceTransaction"))
+
+	l, err = newRateLimiter(RateLimitConfig{Rate: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, 1000, l.config.Burst)
+
+	// The burst under the costliest method is rejected.
+	_, err = newRateLimiter(RateLimitConfig{Rate: 10, Burst: 10})
+	assert.ErrorContains(t, err, "debug_trace*")
+	_, err = newRateLimiter(RateLimitConfig{Rate: 10, Burst: 10, MethodCosts: map[string]int{"debug_trace*": 10}})
+	assert.NoError(t, err)
+	assert.Error(t, SetRateLimit(RateLimitConfig{Rate: 1, Burst: 3}))
+	assert.Nil(t, getRateLimiter())
+}
+
+func TestParseMethodCosts(t *testing.T) {
+	costs, err := ParseMethodCosts(" debug_trace*=200, kaia_call=10,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"debug_trace*": 200, "kaia_call": 10}, costs)
+
+	costs, err = ParseMethodCosts("")
+	require.NoError(t, err)
+	assert.Empty(t, costs)
+
+	for _, s := range []string{"kaia_call", "kaia_call=x", "kaia_call=-1"} {
+		_, err := ParseMethodCosts(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	require.NoError(t, SetRateLimit(RateLimitConfig{
+		Rate: 1, Burst: 100, APIKeys: []string{"key1", "key2"},
+		MethodCosts: map[string]int{"test_echo": 33, "test_sleep": 100},
+	}))
+	defer SetRateLimit(RateLimitConfig{})
+
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+
+	dial := func(apiKey string) *Client {
+		client, err := DialHTTP(httpsrv.URL)
+		require.NoError(t, err)
+		if apiKey != "" {
+			client.SetHeader(DefaultAPIKeyHeader, apiKey)
+		}
+		t.Cleanup(client.Close)
+		return client
+	}
+	echo := func(client *Client) error {
+		var res Result
+		return client.Call(&res, "test_echo", "x", 1, &Args{"y"})
+	}
+
+	// The requests over the burst are denied with the time to retry.
+	byIP := dial("")
+	for i := 0; i < 3; i++ {
+		require.NoError(t, echo(byIP))
+	}
+	err := echo(byIP)
+	require.Error(t, err)
+	var rpcErr Error
+	require.True(t, errors.As(err, &rpcErr))
+	assert.Equal(t, -32005, rpcErr.ErrorCode())
+	var dataErr DataError
+	require.True(t, errors.As(err, &dataErr))
+	assert.Equal(t, map[string]interface{}{"retryAfter": float64(32)}, dataErr.ErrorData())
+
+	// The clients with API keys have their own buckets.
+	byKey := dial("key1")
+	require.NoError(t, echo(byKey))
+	require.NoError(t, echo(dial("key2")))
+
+	// The request costing more than the tokens left waits for them.
+	err = byKey.Call(nil, "test_sleep", time.Millisecond)
+	require.Error(t, err)
+	require.True(t, errors.As(err, &dataErr))
+	assert.Equal(t, map[string]interface{}{"retryAfter": float64(33)}, dataErr.ErrorData())
+
+	// The key not allowlisted doesn't escape the bucket of the IP.
+	require.Error(t, echo(dial("key3")))
+
+	// The in-process clients are not limited.
+	inproc := DialInProc(server)
+	defer inproc.Close()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, echo(inproc))
+	}
+
+	// The API keys are listed by their hashes.
+	states := RateLimitStates("")
+	require.Len(t, states, 3)
+	assert.Equal(t, "127.0.0.1", states[0].Client)
+	assert.Equal(t, uint64(5), states[0].Requests)
+	assert.Equal(t, uint64(2), states[0].Denied)
+	for _, state := range states {
+		assert.NotContains(t, state.Client, "key1")
+		assert.NotContains(t, state.Client, "key2")
+	}
+	states = RateLimitStates("key:key1")
+	require.Len(t, states, 1)
+	assert.Equal(t, apiKeyClient("key1"), states[0].Client)
+	assert.Equal(t, uint64(2), states[0].Requests)
+	assert.Equal(t, uint64(1), states[0].Denied)
+	assert.Len(t, RateLimitStates("key:key2"), 1)
+
+	// The disabled limit allows all.
+	require.NoError(t, SetRateLimit(RateLimitConfig{}))
+	require.NoError(t, echo(byIP))
+	assert.Empty(t, RateLimitStates(""))
+}
+
+func TestRateLimiter_Sweep(t *testing.T) {
+	l, err := newRateLimiter(RateLimitConfig{Rate: 1000, Burst: 100})
+	require.NoError(t, err)
+	require.NoError(t, l.allow("a", "test_echo"))
+	require.Len(t, l.clients, 1)
+
+	// The bucket refilled is dropped.
+	l.sweep(time.Now().Add(time.Second))
+	assert.Empty(t, l.clients)
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	// RequestLogEnabled enables the log of each served request with its method,
+	// latency, response size, error code and remote address, and the method
+	// statistics returned by admin_rpcStats.
+	// It can be overwritten by rpc.log flag
+	RequestLogEnabled = false
+
+	// RequestLogSampleRate is the ratio of the requests written to the request log.
+	// It can be overwritten by rpc.log.samplerate flag
+	RequestLogSampleRate = 1.0
+
+	// SlowRequestThreshold is the latency above which a request is logged with its
+	// full params regardless of RequestLogEnabled and RequestLogSampleRate. 0 disables
+	// the slow request log.
+	// It can be overwritten by rpc.log.slowthreshold flag
+	SlowRequestThreshold time.Duration = 0
+
+	// latencyBuckets are the upper bounds of the latency histogram of admin_rpcStats.
+	latencyBuckets = []time.Duration{
+		time.Millisecond,
+		5 * time.Millisecond,
+		10 * time.Millisecond,
+		50 * time.Millisecond,
+		100 * time.Millisecond,
+		500 * time.Millisecond,
+		time.Second,
+		5 * time.Second,
+		10 * time.Second,
+	}
+
+	requestStats = newStatsRegistry()
+)
+
+// unknownMethodStats is the method under which the calls of the methods not
+// served by the node are recorded together, not to let the callers grow the
+// statistics without bound.
+const unknownMethodStats = "unknown"
+
+// MethodStats is the statistics of the requests to a method served since the
+// node started.
+type MethodStats struct {
+	Count        uint64          `json:"count"`
+	Errors       uint64          `json:"errors"`
+	AvgLatency   string          `json:"avgLatency"`
+	MaxLatency   string          `json:"maxLatency"`
+	ResponseSize uint64          `json:"responseSize"` // Total bytes of the results
+	Histogram    []LatencyBucket `json:"histogram"`
+}
+
+// LatencyBucket is the number of the requests served within Le, and longer than
+// the previous bucket. The last bucket has no bound.
+type LatencyBucket struct {
+	Le    string `json:"le"`
+	Count uint64 `json:"count"`
+}
+
+type statsRegistry struct {
+	mu      sync.Mutex
+	methods map[string]*methodStats
+}
+
+type methodStats struct {
+	count        uint64
+	errors       uint64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+	responseSize uint64
+	buckets      []uint64 // len(latencyBuckets)+1, the last one for the latencies over all bounds
+}
+
+func newStatsRegistry() *statsRegistry {
+	return &statsRegistry{methods: make(map[string]*methodStats)}
+}
+
+func (r *statsRegistry) record(method string, latency time.Duration, size int, failed bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.methods[method]
+	if !ok {
+		s = &methodStats{buckets: make([]uint64, len(latencyBuckets)+1)}
+		r.methods[method] = s
+	}
+	s.count++
+	if failed {
+		s.errors++
+	}
+	s.totalLatency += latency
+	if latency > s.maxLatency {
+		s.maxLatency = latency
+	}
+	s.responseSize += uint64(size)
+
+	i := 0
+	for i < len(latencyBuckets) && latency > latencyBuckets[i] {
+		i++
+	}
+	s.buckets[i]++
+}
+
+func (r *statsRegistry) snapshot() map[string]*MethodStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make(map[string]*MethodStats, len(r.methods))
+	for method, s := range r.methods {
+		histogram := make([]LatencyBucket, len(s.buckets))
+		for i, count := range s.buckets {
+			le := "+Inf"
+			if i < len(latencyBuckets) {
+				le = latencyBuckets[i].String()
+			}
+			histogram[i] = LatencyBucket{Le: le, Count: count}
+		}
+		stats[method] = &MethodStats{
+			Count:        s.count,
+			Errors:       s.errors,
+			AvgLatency:   (s.totalLatency / time.Duration(s.count)).String(),
+			MaxLatency:   s.maxLatency.String(),
+			ResponseSize: s.responseSize,
+			Histogram:    histogram,
+		}
+	}
+	return stats
+}
+
+// RequestStats returns the latency histograms and the error counts of the methods
+// served by the node while the request log is enabled, over all the transports.
+func RequestStats() map[string]*MethodStats {
+	return requestStats.snapshot()
+}
+
+// logRequest records the served call to the method statistics and writes it to
+// the request log if enabled, the latter only if sampled. The slow calls are
+// logged with their params in any case.
+func (h *handler) logRequest(ctx context.Context, msg, resp *jsonrpcMessage, latency time.Duration) {
+	size := len(resp.Result)
+	if RequestLogEnabled {
+		method := msg.Method
+		if !h.servesMethod(msg) {
+			method = unknownMethodStats
+		}
+		requestStats.record(method, latency, size, resp.Error != nil)
+	}
+
+	slow := SlowRequestThreshold > 0 && latency >= SlowRequestThreshold
+	if !slow && (!RequestLogEnabled || !sampled(RequestLogSampleRate)) {
+		return
+	}
+
+	fields := []interface{}{"method", msg.Method, "reqid", idForLog{msg.ID}, "duration", latency, "size", size, "remote", h.remoteAddr(ctx)}
+	if resp.Error != nil {
+		fields = append(fields, "code", resp.Error.Code, "err", resp.Error.Message)
+	}
+	if slow {
+		fields = append(fields, "params", string(msg.Params))
+		logger.Warn("Served slow RPC request", fields...)
+	} else {
+		logger.Info("Served RPC request", fields...)
+	}
+}
+
+// servesMethod returns whether the method of the call is served by the node. The
+// subscriptions are served if the namespace is.
+func (h *handler) servesMethod(msg *jsonrpcMessage) bool {
+	if msg.isSubscribe() || msg.isUnsubscribe() {
+		return h.reg.hasService(msg.namespace())
+	}
+	return h.reg.callback(msg.Method) != nil
+}
+
+// remoteAddr returns the address of the caller. The fasthttp server passes it
+// through the context since its codec is not bound to the connection.
+func (h *handler) remoteAddr(ctx context.Context) string {
+	if addr := h.conn.remoteAddr(); addr != "" {
+		return addr
+	}
+	if addr, ok := ctx.Value("remote").(string); ok {
+		return addr
+	}
+	return ""
+}
+
+func sampled(rate float64) bool {
+	return rate >= 1 || (rate > 0 && rand.Float64() < rate)
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestStats(t *testing.T) {
+	requestStats = newStatsRegistry()
+	RequestLogEnabled = true
+	defer func() {
+		requestStats = newStatsRegistry()
+		RequestLogEnabled = false
+	}()
+
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+	client, err := DialHTTP(httpsrv.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var res Result
+	for i := 0; i < 3; i++ {
+		require.NoError(t, client.Call(&res, "test_echo", "x", i, &Args{"y"}))
+	}
+	require.NoError(t, client.Call(nil, "test_sleep", 20*time.Millisecond))
+	assert.Error(t, client.Call(nil, "test_echo", "x")) // missing params
+	assert.Error(t, client.Call(nil, "test_noSuchMethod1"))
+	assert.Error(t, client.Call(nil, "test_noSuchMethod2"))
+	assert.Error(t, client.Call(nil, "nosuchns_subscribe", "newHeads"))
+
+	stats := RequestStats()
+	echo := stats["test_echo"]
+	require.NotNil(t, echo)
+	assert.Equal(t, uint64(4), echo.Count)
+	assert.Equal(t, uint64(1), echo.Errors)
+	assert.NotZero(t, echo.ResponseSize)
+	require.Len(t, echo.Histogram, len(latencyBuckets)+1)
+	assert.Equal(t, "+Inf", echo.Histogram[len(latencyBuckets)].Le)
+
+	// The methods not served are recorded together.
+	assert.NotContains(t, stats, "test_noSuchMethod1")
+	assert.NotContains(t, stats, "nosuchns_subscribe")
+	unknown := stats[unknownMethodStats]
+	require.NotNil(t, unknown)
+	assert.Equal(t, uint64(3), unknown.Count)
+	assert.Equal(t, uint64(3), unknown.Errors)
+	assert.Len(t, stats, 3)
+
+	sleep := stats["test_sleep"]
+	require.NotNil(t, sleep)
+	assert.Equal(t, uint64(1), sleep.Count)
+	maxLatency, err := time.ParseDuration(sleep.MaxLatency)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, maxLatency, 20*time.Millisecond)
+	for _, bucket := range sleep.Histogram {
+		if bucket.Le == "50ms" {
+			assert.Equal(t, uint64(1), bucket.Count)
+		} else if bucket.Le != "100ms" { // tolerate slow machines
+			assert.Zero(t, bucket.Count, bucket.Le)
+		}
+	}
+}
+
+func TestRequestStats_Disabled(t *testing.T) {
+	requestStats = newStatsRegistry()
+	defer func() { requestStats = newStatsRegistry() }()
+
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+	httpsrv := httptest.NewServer(server)
+	defer httpsrv.Close()
+	client, err := DialHTTP(httpsrv.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// The statistics aren't recorded unless the request log is enabled.
+	var res Result
+	require.NoError(t, client.Call(&res, "test_echo", "x", 1, &Args{"y"}))
+	assert.Empty(t, RequestStats())
+}
+
+func TestRequestLog_RemoteAddr(t *testing.T) {
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+
+	// The address is taken from the codec, or from the context of the HTTP servers.
+	codec := NewFuncCodec(connWithRemoteAddr{addr: "192.0.2.1:1234"}, nil, nil)
+	h := newHandler(context.Background(), codec, randomIDGenerator(), &server.services)
+	assert.Equal(t, "192.0.2.1:1234", h.remoteAddr(context.Background()))
+
+	codec = newHTTPServerConn(httptest.NewRequest("POST", "/", nil), httptest.NewRecorder())
+	h = newHandler(context.Background(), codec, randomIDGenerator(), &server.services)
+	ctx := context.WithValue(context.Background(), "remote", "192.0.2.2:1234")
+	assert.Equal(t, "192.0.2.2:1234", h.remoteAddr(ctx))
+}
+
+func TestSampled(t *testing.T) {
+	assert.True(t, sampled(1))
+	assert.False(t, sampled(0))
+
+	n := 0
+	for i := 0; i < 10000; i++ {
+		if sampled(0.1) {
+			n++
+		}
+	}
+	assert.InDelta(t, 1000, n, 300)
+}
@@ -121,6 +121,18 @@ func (r *serviceRegistry) callback(method string) *callback {
 	return r.services[namespace].callbacks[method]
 }
 
+// hasService returns whether the service of the given namespace is registered.
+func (r *serviceRegistry) hasService(namespace string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if namespace == "klay" {
+		namespace = "kaia"
+	}
+	_, ok := r.services[namespace]
+	return ok
+}
+
 // subscription returns a subscription callback in the given service.
 func (r *serviceRegistry) subscription(service, name string) *callback {
 	r.mu.Lock()
@@ -59,7 +59,9 @@ func newWebsocketCodec(conn *websocket.Conn) ServerCodec {
 	if WebsocketWriteDeadline != 0 {
 		conn.SetWriteDeadline(time.Now().Add(time.Duration(WebsocketWriteDeadline) * time.Second))
 	}
-	return NewFuncCodec(conn, conn.WriteJSON, conn.ReadJSON)
+	codec := NewFuncCodec(conn, conn.WriteJSON, conn.ReadJSON).(*jsonCodec)
+	codec.remote = conn.RemoteAddr().String()
+	return codec
 }
 
 // WebsocketHandler returns a handler that serves JSON-RPC to WebSocket connections.
@@ -88,6 +90,9 @@ func (srv *Server) WebsocketHandler(allowedOrigins []string) http.Handler {
 			return
 		}
 		codec := newWebsocketCodec(conn)
+		if header := RateLimitAPIKeyHeader(); header != "" {
+			codec.(*jsonCodec).apiKey = r.Header.Get(header)
+		}
 		srv.ServeCodec(codec, 0)
 	})
 }
@@ -104,6 +109,12 @@ func (srv *Server) FastWebsocketHandler(ctx *fasthttp.RequestCtx) {
 		ctx.Response.Header.Set("Sec-WebSocket-Protocol", string(protocol))
 	}
 
+	remote := ctx.RemoteAddr().String()
+	var apiKey string
+	if header := RateLimitAPIKeyHeader(); header != "" {
+		apiKey = string(ctx.Request.Header.Peek(header))
+	}
+
 	err := upgrader.Upgrade(ctx, func(conn *fastws.Conn) {
 		if atomic.LoadInt32(&srv.wsConnCount) >= MaxWebsocketConnections {
 			return
@@ -143,7 +154,9 @@ func (srv *Server) FastWebsocketHandler(ctx *fasthttp.RequestCtx) {
 		}
 
 		reader := bufio.NewReaderSize(bytes.NewReader(ctx.Request.Body()), common.MaxRequestContentLength)
-		srv.ServeCodec(NewFuncCodec(&httpReadWriteNopCloser{reader, ctx.Response.BodyWriter()}, encoder, decoder), 0)
+		codec := NewFuncCodec(&httpReadWriteNopCloser{reader, ctx.Response.BodyWriter()}, encoder, decoder).(*jsonCodec)
+		codec.remote, codec.apiKey = remote, apiKey
+		srv.ServeCodec(codec, 0)
 	})
 	if err != nil {
 		logger.Error("FastWebsocketHandler fail to upgrade message", "err", err)
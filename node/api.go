@@ -25,6 +25,7 @@ package node
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -93,6 +94,61 @@ func (api *PrivateAdminAPI) RemovePeer(url string) (bool, error) {
 	return true, nil
 }
 
+// BanPeer bans a remote node for the given duration in seconds and disconnects
+// it. The node is given as a kni url or a hex node id. If the duration is not
+// given, p2p.DefaultBanDuration is used.
+func (api *PrivateAdminAPI) BanPeer(id string, duration *uint64) (bool, error) {
+	// Make sure the server is running, fail otherwise
+	server := api.node.Server()
+	if server == nil {
+		return false, ErrNodeStopped
+	}
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		return false, err
+	}
+	d := p2p.DefaultBanDuration
+	if duration != nil {
+		if *duration == 0 {
+			return false, errors.New("zero ban duration")
+		}
+		d = time.Duration(*duration) * time.Second
+	}
+	server.BanPeer(nodeID, d)
+	return true, nil
+}
+
+// UnbanPeer lifts the ban of a remote node given as a kni url or a hex node id.
+// It returns false if the node isn't banned.
+func (api *PrivateAdminAPI) UnbanPeer(id string) (bool, error) {
+	// Make sure the server is running, fail otherwise
+	server := api.node.Server()
+	if server == nil {
+		return false, ErrNodeStopped
+	}
+	nodeID, err := parseNodeID(id)
+	if err != nil {
+		return false, err
+	}
+	return server.UnbanPeer(nodeID), nil
+}
+
+// parseNodeID parses a kni url or a hex node id.
+func parseNodeID(id string) (discover.NodeID, error) {
+	if strings.HasPrefix(id, "kni://") {
+		node, err := discover.ParseNode(id)
+		if err != nil {
+			return discover.NodeID{}, fmt.Errorf("invalid kni: %v", err)
+		}
+		return node.ID, nil
+	}
+	nodeID, err := discover.HexID(id)
+	if err != nil {
+		return discover.NodeID{}, fmt.Errorf("invalid node id: %v", err)
+	}
+	return nodeID, nil
+}
+
 // PeerEvents creates an RPC subscription which receives peer events from the
 // node's p2p.Server
 func (api *PrivateAdminAPI) PeerEvents(ctx context.Context) (*rpc.Subscription, error) {
@@ -273,6 +329,12 @@ func (api *PrivateAdminAPI) SetMaxSubscriptionPerWSConn(num int32) {
 	rpc.MaxSubscriptionPerWSConn = num
 }
 
+// RpcStats retrieves the latency histograms and the error counts of the RPC
+// methods served while the request log is enabled.
+func (api *PrivateAdminAPI) RpcStats() map[string]*rpc.MethodStats {
+	return rpc.RequestStats()
+}
+
 // PublicAdminAPI is the collection of administrative API methods exposed over
 // both secure and unsecure RPC channels.
 type PublicAdminAPI struct {
@@ -295,6 +357,52 @@ func (api *PublicAdminAPI) Peers() ([]*p2p.PeerInfo, error) {
 	return server.PeersInfo(), nil
 }
 
+// PeerTraffic retrieves the messages exchanged with each peer by the protocol
+// and the message code. If the id is given as a kni url or a hex node id, only
+// the traffic of the peer is returned.
+func (api *PublicAdminAPI) PeerTraffic(id *string) (map[string]map[string]p2p.ProtocolTraffic, error) {
+	server := api.node.Server()
+	if server == nil {
+		return nil, ErrNodeStopped
+	}
+	var filter *discover.NodeID
+	if id != nil {
+		nodeID, err := parseNodeID(*id)
+		if err != nil {
+			return nil, err
+		}
+		filter = &nodeID
+	}
+	ret := make(map[string]map[string]p2p.ProtocolTraffic)
+	for _, p := range server.Peers() {
+		if filter != nil && p.ID() != *filter {
+			continue
+		}
+		ret[p.ID().String()] = p.Traffic()
+	}
+	return ret, nil
+}
+
+// RpcRateLimits retrieves the states of the rate limits of the RPC clients. If
+// the client is given as an IP or "key:<API key>", only its state is returned.
+// The clients with API keys are listed by the hashes of the keys.
+func (api *PublicAdminAPI) RpcRateLimits(client *string) []rpc.RateLimitState {
+	if client != nil {
+		return rpc.RateLimitStates(*client)
+	}
+	return rpc.RateLimitStates("")
+}
+
+// BannedPeers retrieves the nodes banned for their misbehaviors or by
+// admin_banPeer, with the expiry of the bans.
+func (api *PublicAdminAPI) BannedPeers() ([]*p2p.BanInfo, error) {
+	server := api.node.Server()
+	if server == nil {
+		return nil, ErrNodeStopped
+	}
+	return server.BannedPeers(), nil
+}
+
 // BlsPublicKeyInfoOutput has string fields unlike system.BlsPublicKeyInfo.
 type BlsPublicKeyInfoOutput struct {
 	PublicKey string `json:"publicKey"`
@@ -35,11 +35,14 @@ import (
 
 	"github.com/kaiachain/kaia/blockchain"
 	"github.com/kaiachain/kaia/blockchain/state"
+	"github.com/kaiachain/kaia/blockchain/system"
 	"github.com/kaiachain/kaia/blockchain/types"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/common/hexutil"
+	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/reward"
 	"github.com/kaiachain/kaia/rlp"
 	"github.com/kaiachain/kaia/storage/statedb"
 	"github.com/kaiachain/kaia/work"
@@ -61,6 +64,20 @@ func (api *PublicKaiaAPI) Rewardbase() (common.Address, error) {
 	return api.cn.Rewardbase()
 }
 
+// SimulateTreasuryRebalance runs the KIP-103 or KIP-160 treasury rebalance of the given contract
+// on top of the state of the given block, without committing anything. It reports the balance changes,
+// the burnt amount, the failed validations and the memo that would result if the next block were the rebalance block.
+func (api *PublicKaiaAPI) SimulateTreasuryRebalance(ctx context.Context, contractAddr common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*system.RebalanceSimulation, error) {
+	state, header, err := api.cn.APIBackend.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil || header == nil {
+		return nil, fmt.Errorf("state not found")
+	}
+	return system.SimulateRebalanceTreasury(state, api.cn.blockchain, header, contractAddr)
+}
+
 // PrivateAdminAPI is the collection of CN full node-related APIs
 // exposed over the private admin endpoint.
 type PrivateAdminAPI struct {
@@ -215,6 +232,12 @@ func (api *PrivateAdminAPI) SaveTrieNodeCacheToDisk() error {
 	return api.cn.BlockChain().SaveTrieNodeCacheToDisk()
 }
 
+// LoadTrieNodeCacheFromDisk warms up the trie node cache with a cache dump exported by
+// admin_saveTrieNodeCacheToDisk. The dump is verified with its version and checksum.
+func (api *PrivateAdminAPI) LoadTrieNodeCacheFromDisk(filePath string) error {
+	return api.cn.BlockChain().LoadTrieNodeCacheFromDisk(filePath)
+}
+
 func (api *PrivateAdminAPI) SpamThrottlerConfig(ctx context.Context) (*blockchain.ThrottlerConfig, error) {
 	throttler := blockchain.GetSpamThrottler()
 	if throttler == nil {
@@ -406,6 +429,40 @@ func (api *PrivateDebugAPI) Preimage(ctx context.Context, hash common.Hash) (hex
 	return nil, errors.New("unknown preimage")
 }
 
+// AuditTotalSupply verifies at each given block that the tracked total supply,
+// i.e. genesis + minted - burnt, equals the sum of all account balances.
+// It walks the entire state trie of each block, so it is extremely expensive and the states must be available.
+func (api *PrivateDebugAPI) AuditTotalSupply(ctx context.Context, blockNums []rpc.BlockNumber) ([]*reward.SupplyAudit, error) {
+	audits := make([]*reward.SupplyAudit, 0, len(blockNums))
+	for _, blockNum := range blockNums {
+		header, err := api.cn.APIBackend.HeaderByNumber(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		num := header.Number.Uint64()
+		audit, err := api.cn.supplyManager.AuditTotalSupply(ctx, num)
+		if err != nil {
+			return nil, fmt.Errorf("audit failed at block %d: %w", num, err)
+		}
+		audits = append(audits, audit)
+	}
+	return audits, nil
+}
+
+// GetMintBreakdown returns the amount minted in the blocks [from, to],
+// broken down by the proposer, stakers, KIF and KEF.
+func (api *PrivateDebugAPI) GetMintBreakdown(ctx context.Context, from, to rpc.BlockNumber) (*reward.MintBreakdown, error) {
+	fromHeader, err := api.cn.APIBackend.HeaderByNumber(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toHeader, err := api.cn.APIBackend.HeaderByNumber(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	return api.cn.supplyManager.GetMintBreakdown(ctx, fromHeader.Number.Uint64(), toHeader.Number.Uint64())
+}
+
 // TODO-Kaia: Rearrange PublicDebugAPI and PrivateDebugAPI receivers
 // GetBadBLocks returns a list of the last 'bad blocks' that the client has seen on the network
 // and returns them as a JSON list of block-hashes
@@ -593,11 +650,12 @@ func (api *PublicDebugAPI) getModifiedStorageNodes(contractAddr common.Address,
 	}
 
 	trieDB := api.cn.blockchain.StateCache().TrieDB()
-	oldTrie, err := statedb.NewSecureStorageTrie(startBlockRoot, trieDB, nil)
+	owner := crypto.Keccak256Hash(contractAddr.Bytes())
+	oldTrie, err := statedb.NewSecureStorageTrie(startBlockRoot, trieDB, &statedb.TrieOpts{Owner: owner, StateRoot: startBlock.Root()})
 	if err != nil {
 		return 0, err
 	}
-	newTrie, err := statedb.NewSecureStorageTrie(endBlockRoot, trieDB, nil)
+	newTrie, err := statedb.NewSecureStorageTrie(endBlockRoot, trieDB, &statedb.TrieOpts{Owner: owner, StateRoot: endBlock.Root()})
 	if err != nil {
 		return 0, err
 	}
@@ -162,6 +162,9 @@ func (b *CNAPIBackend) BlockByNumber(ctx context.Context, blockNr rpc.BlockNumbe
 	}
 	block := b.cn.blockchain.GetBlockByNumber(uint64(blockNr))
 	if block == nil {
+		if err := b.historyPrunedError(uint64(blockNr)); err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("the block does not exist (block number: %d)", blockNr)
 	}
 	return block, nil
@@ -217,11 +220,25 @@ func (b *CNAPIBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNr
 func (b *CNAPIBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
 	block := b.cn.blockchain.GetBlockByHash(hash)
 	if block == nil {
+		if number := b.cn.chainDB.ReadHeaderNumber(hash); number != nil {
+			if err := b.historyPrunedError(*number); err != nil {
+				return nil, err
+			}
+		}
 		return nil, fmt.Errorf("the block does not exist (block hash: %s)", hash.String())
 	}
 	return block, nil
 }
 
+// historyPrunedError returns a HistoryPrunedError if the body of the given block
+// has been deleted by history expiry. Otherwise it returns nil.
+func (b *CNAPIBackend) historyPrunedError(number uint64) error {
+	if tail := b.cn.chainDB.ReadHistoryTail(); number < tail {
+		return &blockchain.HistoryPrunedError{Tail: tail}
+	}
+	return nil
+}
+
 // GetTxAndLookupInfo retrieves a tx and lookup info for a given transaction hash.
 func (b *CNAPIBackend) GetTxAndLookupInfo(hash common.Hash) (*types.Transaction, common.Hash, uint64, uint64) {
 	return b.cn.blockchain.GetTxAndLookupInfo(hash)
@@ -35,6 +35,7 @@ import (
 	"github.com/kaiachain/kaia/consensus/istanbul/backend"
 	mocks3 "github.com/kaiachain/kaia/event/mocks"
 	"github.com/kaiachain/kaia/governance"
+	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"github.com/kaiachain/kaia/node/cn/gasprice"
 	mocks2 "github.com/kaiachain/kaia/node/cn/mocks"
@@ -52,7 +53,7 @@ func newCNAPIBackend(t *testing.T) (*gomock.Controller, *mocks.MockBlockChain, *
 	mockBlockChain := mocks.NewMockBlockChain(mockCtrl)
 	mockMiner := mocks2.NewMockMiner(mockCtrl)
 
-	cn := &CN{blockchain: mockBlockChain, miner: mockMiner}
+	cn := &CN{blockchain: mockBlockChain, miner: mockMiner, chainDB: database.NewMemoryDBManager()}
 
 	return mockCtrl, mockBlockChain, mockMiner, &CNAPIBackend{cn: cn}
 }
@@ -185,6 +186,17 @@ func (sm *testSupplyManager) GetTotalSupply(num uint64) (*reward.TotalSupply, er
 	return &reward.TotalSupply{}, nil
 }
 
+func (sm *testSupplyManager) AuditTotalSupply(ctx context.Context, num uint64) (*reward.SupplyAudit, error) {
+	return &reward.SupplyAudit{}, nil
+}
+
+func (sm *testSupplyManager) GetMintBreakdown(ctx context.Context, from, to uint64) (*reward.MintBreakdown, error) {
+	return &reward.MintBreakdown{}, nil
+}
+
+func (sm *testSupplyManager) RegisterStakingModule(stakingModule staking.StakingModule) {
+}
+
 func TestCNAPIBackend_SetHead(t *testing.T) {
 	mockCtrl, mockBlockChain, _, api := newCNAPIBackend(t)
 	defer mockCtrl.Finish()
@@ -371,6 +383,18 @@ func TestCNAPIBackend_BlockByNumber(t *testing.T) {
 		assert.Equal(t, expectedBlock, block)
 		assert.NoError(t, err)
 
+		mockCtrl.Finish()
+	}
+	{
+		mockCtrl, mockBlockChain, _, api := newCNAPIBackend(t)
+		mockBlockChain.EXPECT().GetBlockByNumber(blockNum).Return(nil).Times(1)
+		api.cn.chainDB.WriteHistoryTail(blockNum + 1)
+
+		block, err := api.BlockByNumber(context.Background(), rpc.BlockNumber(blockNum))
+
+		assert.Nil(t, block)
+		assert.Equal(t, &blockchain.HistoryPrunedError{Tail: blockNum + 1}, err)
+
 		mockCtrl.Finish()
 	}
 }
@@ -48,6 +48,10 @@ import (
 	"github.com/kaiachain/kaia/event"
 	"github.com/kaiachain/kaia/governance"
 	"github.com/kaiachain/kaia/kaiax"
+	kaiabridge_impl "github.com/kaiachain/kaia/kaiax/kaiabridge/impl"
+	randao_impl "github.com/kaiachain/kaia/kaiax/randao/impl"
+	kaiax_reward "github.com/kaiachain/kaia/kaiax/reward"
+	reward_impl "github.com/kaiachain/kaia/kaiax/reward/impl"
 	"github.com/kaiachain/kaia/kaiax/staking"
 	staking_impl "github.com/kaiachain/kaia/kaiax/staking/impl"
 	"github.com/kaiachain/kaia/networks/p2p"
@@ -147,6 +151,7 @@ type CN struct {
 	baseModules    []kaiax.BaseModule
 	jsonRpcModules []kaiax.JsonRpcModule
 	stakingModule  staking.StakingModule // TODO-kaiax: temporary for governance/api.go. Remove it after having kaiax/reward.
+	rewardModule   kaiax_reward.RewardModule
 }
 
 func (s *CN) AddLesServer(ls LesServer) {
@@ -201,6 +206,50 @@ func checkSyncMode(config *Config) error {
 	return nil
 }
 
+// setupStateScheme checks the scheme of the state trie storage given by the config
+// against the scheme stored in the database, and stores it for a new database.
+// The scheme has to be stored before the genesis state is committed.
+func setupStateScheme(chainDB database.DBManager, config *Config) error {
+	stored := chainDB.ReadStateScheme()
+	if stored == "" && !common.EmptyHash(chainDB.ReadHeadBlockHash()) {
+		stored = database.HashScheme // The database was initialized before the scheme was stored.
+	}
+	scheme := config.StateScheme
+	if scheme == "" {
+		scheme = stored
+	}
+	if scheme == "" {
+		scheme = database.HashScheme
+	}
+	if scheme != database.HashScheme && scheme != database.PathScheme {
+		return fmt.Errorf("invalid state scheme %q", scheme)
+	}
+	if stored != "" && stored != scheme {
+		return fmt.Errorf("state scheme %q is incompatible with the scheme %q stored in the database", scheme, stored)
+	}
+	if scheme == database.PathScheme {
+		if config.LivePruning || chainDB.ReadPruningEnabled() {
+			return errors.New("path-based state scheme cannot be used with live pruning")
+		}
+		if config.SnapshotCacheSize > 0 {
+			return errors.New("path-based state scheme cannot be used with state snapshot")
+		}
+		// The path-based scheme keeps only the latest state on the disk, and the state
+		// of the sync modes is written in the hash-based scheme.
+		if config.NoPruning {
+			return errors.New("path-based state scheme cannot be used with archive mode")
+		}
+		if config.SyncMode == downloader.FastSync || config.SyncMode == downloader.SnapSync {
+			return fmt.Errorf("path-based state scheme cannot be used with %s sync", config.SyncMode)
+		}
+	}
+	if chainDB.ReadStateScheme() == "" {
+		chainDB.WriteStateScheme(scheme)
+	}
+	logger.Info("Using the state trie scheme", "scheme", scheme)
+	return nil
+}
+
 func setEngineType(chainConfig *params.ChainConfig) {
 	if chainConfig.Clique != nil {
 		types.EngineType = types.Engine_Clique
@@ -216,8 +265,14 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	if err := checkSyncMode(config); err != nil {
 		return nil, err
 	}
+	if config.HistoryExpiryRetention != 0 && config.HistoryExpiryRetention < blockchain.MinHistoryRetention {
+		return nil, fmt.Errorf("history expiry retention must be at least %d blocks", blockchain.MinHistoryRetention)
+	}
 
 	chainDB := CreateDB(ctx, config, "chaindata")
+	if err := setupStateScheme(chainDB, config); err != nil {
+		return nil, err
+	}
 
 	chainConfig, genesisHash, genesisErr := blockchain.SetupGenesisBlock(chainDB, config.Genesis, config.NetworkId, config.IsPrivate, false)
 	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
@@ -274,6 +329,8 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 			SenderTxHashIndexing: config.SenderTxHashIndexing,
 			SnapshotCacheSize:    config.SnapshotCacheSize,
 			SnapshotAsyncGen:     config.SnapshotAsyncGen,
+			HistoryRetention:     config.HistoryExpiryRetention,
+			HistoryExpiryBlock:   config.HistoryExpiryBlock,
 		}
 	)
 
@@ -438,7 +495,7 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	cn.addComponent(cn.ChainDB())
 	cn.addComponent(cn.engine)
 
-	if err := cn.SetupKaiaxModules(); err != nil {
+	if err := cn.SetupKaiaxModules(ctx); err != nil {
 		logger.Error("Failed to setup kaiax modules", "err", err)
 	}
 
@@ -512,17 +569,25 @@ func (s *CN) SetComponents(component []interface{}) {
 	// do nothing
 }
 
-func (s *CN) SetupKaiaxModules() error {
+func (s *CN) SetupKaiaxModules(ctx *node.ServiceContext) error {
 	// Declare modules
-
 	mStaking := staking_impl.NewStakingModule()
+	mReward := reward_impl.NewRewardModule()
 
 	// Initialize modules
 	err := errors.Join(
 		mStaking.Init(&staking_impl.InitOpts{
-			ChainKv:     s.chainDB.GetMiscDB(),
-			ChainConfig: s.chainConfig,
-			Chain:       s.blockchain,
+			ChainKv:              s.chainDB.GetMiscDB(),
+			ChainConfig:          s.chainConfig,
+			Chain:                s.blockchain,
+			DelegatorsStartBlock: s.config.StakingDelegatorsStartBlock,
+		}),
+		mReward.Init(&reward_impl.InitOpts{
+			ChainKv:       s.chainDB.GetMiscDB(),
+			ChainConfig:   s.chainConfig,
+			Chain:         s.blockchain,
+			GovModule:     s.governance,
+			StakingModule: mStaking,
 		}),
 	)
 	if err != nil {
@@ -530,15 +595,53 @@ func (s *CN) SetupKaiaxModules() error {
 	}
 
 	// Register modules to respective components
-	s.RegisterBaseModules(mStaking)
-	s.RegisterJsonRpcModules(mStaking)
-	s.blockchain.RegisterRewindableModule(mStaking)
+	s.RegisterBaseModules(mStaking, mReward)
+	s.RegisterJsonRpcModules(mStaking, mReward)
+	s.blockchain.RegisterRewindableModule(mStaking, mReward)
+	s.blockchain.RegisterExecutionModule(mStaking, mReward)
 	if engine, ok := s.engine.(consensus.Istanbul); ok {
 		engine.RegisterStakingModule(mStaking)
+		engine.RegisterConsensusModule(mReward)
+
+		// The BLS keys are watched against the Istanbul council.
+		mRandao := randao_impl.NewRandaoModule()
+		if err := mRandao.Init(&randao_impl.InitOpts{
+			ChainConfig:  s.chainConfig,
+			Chain:        s.blockchain,
+			Engine:       engine,
+			NodeId:       crypto.PubkeyToAddress(ctx.NodeKey().PublicKey),
+			BlsSecretKey: ctx.BlsNodeKey(),
+		}); err != nil {
+			return err
+		}
+		s.RegisterBaseModules(mRandao)
+		s.RegisterJsonRpcModules(mRandao)
+		s.blockchain.RegisterRewindableModule(mRandao)
+		s.blockchain.RegisterExecutionModule(mRandao)
 	}
 	s.protocolManager.RegisterStakingModule(mStaking)
+	s.supplyManager.RegisterStakingModule(mStaking)
+
+	// The kaiabridge watcher is only for the bridge operators.
+	if !common.EmptyAddress(s.config.KaiaBridgeAddr) {
+		mKaiaBridge := kaiabridge_impl.NewKaiaBridgeModule()
+		if err := mKaiaBridge.Init(&kaiabridge_impl.InitOpts{
+			ChainKv:      s.chainDB.GetMiscDB(),
+			Chain:        s.blockchain,
+			BridgeAddr:   s.config.KaiaBridgeAddr,
+			OperatorAddr: s.config.KaiaBridgeOperatorAddr,
+			StartBlock:   s.config.KaiaBridgeStartBlock,
+		}); err != nil {
+			return err
+		}
+		s.RegisterBaseModules(mKaiaBridge)
+		s.RegisterJsonRpcModules(mKaiaBridge)
+		s.blockchain.RegisterRewindableModule(mKaiaBridge)
+		s.blockchain.RegisterExecutionModule(mKaiaBridge)
+	}
 
 	s.stakingModule = mStaking
+	s.rewardModule = mReward
 
 	return nil
 }
@@ -661,6 +764,9 @@ func (s *CN) APIs() []rpc.API {
 	publicFilterAPI := filters.NewPublicFilterAPI(s.APIBackend, false)
 	governanceKaiaAPI := governance.NewGovernanceKaiaAPI(s.governance, s.blockchain, s.stakingModule)
 	governanceAPI := governance.NewGovernanceAPI(s.governance, s.stakingModule)
+	if s.rewardModule != nil {
+		governanceAPI.RegisterRewardModule(s.rewardModule)
+	}
 	publicDownloaderAPI := downloader.NewPublicDownloaderAPI(s.protocolManager.Downloader(), s.eventMux)
 	privateDownloaderAPI := downloader.NewPrivateDownloaderAPI(s.protocolManager.Downloader())
 
@@ -27,6 +27,7 @@ import (
 	"github.com/kaiachain/kaia/datasync/downloader"
 	"github.com/kaiachain/kaia/node/cn/mocks"
 	"github.com/kaiachain/kaia/params"
+	"github.com/kaiachain/kaia/storage/database"
 	mocks2 "github.com/kaiachain/kaia/work/mocks"
 	"github.com/stretchr/testify/assert"
 )
@@ -59,6 +60,22 @@ func TestCN_CheckSyncMode(t *testing.T) {
 	assert.Equal(t, errCNLightSync, checkSyncMode(c))
 }
 
+func TestCN_SetupStateScheme(t *testing.T) {
+	for _, c := range []*Config{
+		{StateScheme: database.PathScheme, NoPruning: true},
+		{StateScheme: database.PathScheme, SyncMode: downloader.FastSync},
+		{StateScheme: database.PathScheme, SyncMode: downloader.SnapSync},
+		{StateScheme: database.PathScheme, LivePruning: true},
+	} {
+		assert.Error(t, setupStateScheme(database.NewMemoryDBManager(), c))
+	}
+
+	db := database.NewMemoryDBManager()
+	assert.NoError(t, setupStateScheme(db, &Config{StateScheme: database.PathScheme, SyncMode: downloader.FullSync}))
+	assert.Equal(t, database.PathScheme, db.ReadStateScheme())
+	assert.Error(t, setupStateScheme(db, &Config{StateScheme: database.HashScheme}))
+}
+
 func TestCN_SetEngineType(t *testing.T) {
 	cc := &params.ChainConfig{}
 	originalEngineType := types.EngineType
@@ -70,6 +70,10 @@ func GetDefaultConfig() *Config {
 
 		Istanbul:      *istanbul.DefaultConfig,
 		RPCEVMTimeout: 5 * time.Second,
+
+		TxMsgRateLimit:       DefaultTxMsgRateLimit,
+		BlockMsgRateLimit:    DefaultBlockMsgRateLimit,
+		SyncRequestRateLimit: DefaultSyncRequestRateLimit,
 	}
 }
 
@@ -129,12 +133,19 @@ type Config struct {
 	TriesInMemory        uint64
 	LivePruning          bool
 	LivePruningRetention uint64
+	StateScheme          string // Scheme of the state trie storage, empty for the stored one
 	SenderTxHashIndexing bool
 	ParallelDBWrite      bool
 	TrieNodeCacheConfig  statedb.TrieNodeCacheConfig
 	SnapshotCacheSize    int
 	SnapshotAsyncGen     bool
 
+	// History expiry options. Block bodies and receipts older than
+	// HistoryExpiryRetention blocks or before HistoryExpiryBlock are deleted.
+	// Headers, the canonical index and tx lookup entries are always kept. Zero disables each option.
+	HistoryExpiryRetention uint64
+	HistoryExpiryBlock     uint64
+
 	// Mining-related options
 	ServiceChainSigner common.Address `toml:",omitempty"`
 	ExtraData          []byte         `toml:",omitempty"`
@@ -143,6 +154,14 @@ type Config struct {
 	// Reward
 	Rewardbase common.Address `toml:",omitempty"`
 
+	// Kaiabridge contracts to watch from the start block. Disabled if the bridge is empty.
+	KaiaBridgeAddr         common.Address `toml:",omitempty"`
+	KaiaBridgeOperatorAddr common.Address `toml:",omitempty"`
+	KaiaBridgeStartBlock   uint64         `toml:",omitempty"`
+
+	// The first block whose PublicDelegation delegators are tracked. The head if 0.
+	StakingDelegatorsStartBlock uint64 `toml:",omitempty"`
+
 	// Transaction pool options
 	TxPool blockchain.TxPoolConfig
 
@@ -169,6 +188,15 @@ type Config struct {
 	TxResendCount     int
 	TxResendUseLegacy bool
 
+	// Inbound message rate limits per peer, in messages per second. Each class of
+	// the messages has its own token bucket, and zero disables the limit of the class.
+	// The propagation messages over the limit are dropped, while the sync requests
+	// over the limit are delayed.
+	TxMsgRateLimit        float64
+	BlockMsgRateLimit     float64
+	SyncRequestRateLimit  float64
+	ConsensusMsgRateLimit float64
+
 	// Service Chain
 	NoAccountCreation bool
 
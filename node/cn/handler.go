@@ -77,6 +77,9 @@ const (
 
 	// ExtraNonSnapPeers is the number of non-snap peers allowed to connect more than snap peers.
 	ExtraNonSnapPeers = 5
+
+	// historyTailBroadcastInterval is the interval to check if the history tail is advanced.
+	historyTailBroadcastInterval = time.Minute
 )
 
 // errIncompatibleConfig is returned if the requested protocols and configs are
@@ -101,6 +104,7 @@ type ProtocolManager struct {
 
 	txpool      work.TxPool
 	blockchain  work.BlockChain
+	chainDB     database.DBManager
 	chainconfig *params.ChainConfig
 	maxPeers    int
 
@@ -134,6 +138,9 @@ type ProtocolManager struct {
 	nodetype          common.ConnType
 	txResendUseLegacy bool
 
+	// msgRateLimits is the inbound message rate limits applied to each peer
+	msgRateLimits msgRateLimits
+
 	// syncStop is a flag to stop peer sync
 	syncStop int32
 
@@ -152,6 +159,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 		eventMux:          mux,
 		txpool:            txpool,
 		blockchain:        blockchain,
+		chainDB:           chainDB,
 		chainconfig:       config,
 		peers:             newPeerSet(),
 		newPeerCh:         make(chan Peer),
@@ -162,6 +170,12 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 		engine:            engine,
 		nodetype:          nodetype,
 		txResendUseLegacy: cnconfig.TxResendUseLegacy,
+		msgRateLimits: msgRateLimits{
+			txPropagationClass:    cnconfig.TxMsgRateLimit,
+			blockPropagationClass: cnconfig.BlockMsgRateLimit,
+			syncRequestClass:      cnconfig.SyncRequestRateLimit,
+			consensusClass:        cnconfig.ConsensusMsgRateLimit,
+		},
 	}
 
 	// istanbul BFT
@@ -313,7 +327,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 		if config.Istanbul != nil {
 			proposerPolicy = config.Istanbul.ProposerPolicy
 		}
-		manager.downloader = downloader.New(mode, chainDB, stateBloom, manager.eventMux, blockchain, nil, manager.removePeer, proposerPolicy)
+		manager.downloader = downloader.New(mode, chainDB, stateBloom, manager.eventMux, blockchain, nil, manager.misbehavingPeerDropper(p2p.MisbehaviorTimeout), proposerPolicy)
 	}
 
 	// Create and set fetcher
@@ -335,7 +349,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 			atomic.StoreUint32(&manager.acceptTxs, 1) // Mark initial sync done on any fetcher import
 			return manager.blockchain.InsertChain(blocks)
 		}
-		manager.fetcher = fetcher.New(blockchain.GetBlockByHash, validator, manager.BroadcastBlock, manager.BroadcastBlockHash, heighter, inserter, manager.removePeer)
+		manager.fetcher = fetcher.New(blockchain.GetBlockByHash, validator, manager.BroadcastBlock, manager.BroadcastBlockHash, heighter, inserter, manager.misbehavingPeerDropper(p2p.MisbehaviorInvalidBlock))
 	}
 
 	if manager.useTxResend() {
@@ -379,6 +393,25 @@ func (pm *ProtocolManager) removePeer(id string) {
 	}
 }
 
+// misbehavingPeerDropper returns the function used by the downloader and the
+// fetcher to drop a peer. The peer is penalized for the misbehavior before being
+// removed, so that it is banned if it keeps misbehaving.
+func (pm *ProtocolManager) misbehavingPeerDropper(m p2p.Misbehavior) func(id string) {
+	return func(id string) {
+		if peer := pm.peers.Peer(id); peer != nil {
+			pm.penalizePeer(peer, m)
+		}
+		pm.removePeer(id)
+	}
+}
+
+// penalizePeer lowers the reputation score of the peer for the misbehavior.
+func (pm *ProtocolManager) penalizePeer(p Peer, m p2p.Misbehavior) {
+	if p2pPeer := p.GetP2PPeer(); p2pPeer != nil {
+		p2pPeer.Penalize(m)
+	}
+}
+
 // getChainID returns the current chain id.
 func (pm *ProtocolManager) getChainID() *big.Int {
 	return pm.blockchain.Config().ChainID
@@ -399,6 +432,10 @@ func (pm *ProtocolManager) Start(maxPeers int) {
 	// start sync handlers
 	go pm.syncer()
 	go pm.txsyncLoop()
+
+	// announce the history tail advanced by history expiry
+	pm.wg.Add(1)
+	go pm.historyTailBroadcastLoop()
 }
 
 func (pm *ProtocolManager) Stop() {
@@ -501,7 +538,7 @@ func (pm *ProtocolManager) handle(p Peer) error {
 		td      = pm.blockchain.GetTd(hash, number)
 	)
 
-	if err := p.Handshake(pm.networkId, pm.getChainID(), td, hash, genesis.Hash()); err != nil {
+	if err := p.Handshake(pm.networkId, pm.getChainID(), td, hash, genesis.Hash(), pm.historyTail()); err != nil {
 		p.GetP2PPeer().Log().Debug("Kaia peer handshake failed", "err", err)
 		return err
 	}
@@ -565,6 +602,7 @@ func (pm *ProtocolManager) handle(p Peer) error {
 	for w := 1; w <= concurrentPerPeer; w++ {
 		go pm.processMsg(messageChannel, p, addr, errChannel)
 	}
+	limiter := newMsgLimiter(pm.msgRateLimits)
 
 	// main loop. handle incoming messages.
 	for {
@@ -578,6 +616,18 @@ func (pm *ProtocolManager) handle(p Peer) error {
 			p.GetP2PPeer().Log().Warn("ProtocolManager over max msg size", "err", err)
 			return err
 		}
+		if delay, ok := limiter.allow(msg.Code); !ok {
+			p.GetP2PPeer().Log().Trace("Dropped the message over the rate limit", "code", msg.Code)
+			msg.Discard()
+			continue
+		} else if delay > 0 {
+			p.GetP2PPeer().Log().Trace("Delayed the message over the rate limit", "code", msg.Code, "delay", delay)
+			select {
+			case err := <-errChannel:
+				return err
+			case <-time.After(delay):
+			}
+		}
 
 		select {
 		case err := <-errChannel:
@@ -613,6 +663,7 @@ func (pm *ProtocolManager) processMsg(msgCh <-chan p2p.Msg, p Peer, addr common.
 		for msg := range msgCh {
 			if err := pm.handleMsg(p, addr, msg); err != nil {
 				p.GetP2PPeer().Log().Error("ProtocolManager failed to handle message", "msg", msg, "err", err)
+				pm.penalizePeer(p, p2p.MisbehaviorProtocolViolation)
 				errCh <- err
 				return
 			}
@@ -725,6 +776,11 @@ func (pm *ProtocolManager) handleMsg(p Peer, addr common.Address, msg p2p.Msg) e
 			return err
 		}
 
+	case p.GetVersion() >= kaia66 && msg.Code == HistoryTailMsg:
+		if err := handleHistoryTailMsg(pm, p, msg); err != nil {
+			return err
+		}
+
 	case msg.Code == NewBlockHashesMsg:
 		if err := handleNewBlockHashesMsg(pm, p, msg); err != nil {
 			return err
@@ -1099,6 +1155,16 @@ func handleStakingInfoMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
 	return nil
 }
 
+// handleHistoryTailMsg handles the history tail advanced by the peer.
+func handleHistoryTailMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
+	var tail uint64
+	if err := msg.Decode(&tail); err != nil {
+		return errResp(ErrDecode, "msg %v: %v", msg, err)
+	}
+	p.SetHistoryTail(tail)
+	return nil
+}
+
 // handleNewBlockHashesMsg handles new block hashes message.
 func handleNewBlockHashesMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
 	var (
@@ -1243,6 +1309,7 @@ func handleTxMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
 	}
 	// Only valid txs should be pushed into the pool.
 	validTxs := make(types.Transactions, 0, len(txs))
+	useless := 0
 	var err error
 	for i, tx := range txs {
 		// Validate and mark the remote transaction
@@ -1250,10 +1317,18 @@ func handleTxMsg(pm *ProtocolManager, p Peer, msg p2p.Msg) error {
 			err = errResp(ErrDecode, "transaction %d is nil", i)
 			continue
 		}
+		// The peer knows that we have the transaction, since it has been sent
+		// by the peer or sent to the peer.
+		if p.KnowsTx(tx.Hash()) {
+			useless++
+		}
 		p.AddToKnownTxs(tx.Hash())
 		validTxs = append(validTxs, tx)
 		txReceiveCounter.Inc(1)
 	}
+	if len(txs) > 0 && useless == len(txs) {
+		pm.penalizePeer(p, p2p.MisbehaviorUselessTx)
+	}
 	pm.txpool.HandleTxMsg(validTxs)
 	return err
 }
@@ -1511,22 +1586,63 @@ func (pm *ProtocolManager) useTxResend() bool {
 // known about the host peer.
 type NodeInfo struct {
 	// TODO-Kaia describe predefined network ID below
-	Network    uint64              `json:"network"`    // Kaia network ID
-	BlockScore *big.Int            `json:"blockscore"` // Total blockscore of the host's blockchain
-	Genesis    common.Hash         `json:"genesis"`    // SHA3 hash of the host's genesis block
-	Config     *params.ChainConfig `json:"config"`     // Chain configuration for the fork rules
-	Head       common.Hash         `json:"head"`       // SHA3 hash of the host's best owned block
+	Network     uint64              `json:"network"`               // Kaia network ID
+	BlockScore  *big.Int            `json:"blockscore"`            // Total blockscore of the host's blockchain
+	Genesis     common.Hash         `json:"genesis"`               // SHA3 hash of the host's genesis block
+	Config      *params.ChainConfig `json:"config"`                // Chain configuration for the fork rules
+	Head        common.Hash         `json:"head"`                  // SHA3 hash of the host's best owned block
+	HistoryTail uint64              `json:"historyTail,omitempty"` // Lowest block number whose body and receipts are served
 }
 
 // NodeInfo retrieves some protocol metadata about the running host node.
 func (pm *ProtocolManager) NodeInfo() *NodeInfo {
 	currentBlock := pm.blockchain.CurrentBlock()
 	return &NodeInfo{
-		Network:    pm.networkId,
-		BlockScore: pm.blockchain.GetTd(currentBlock.Hash(), currentBlock.NumberU64()),
-		Genesis:    pm.blockchain.Genesis().Hash(),
-		Config:     pm.blockchain.Config(),
-		Head:       currentBlock.Hash(),
+		Network:     pm.networkId,
+		BlockScore:  pm.blockchain.GetTd(currentBlock.Hash(), currentBlock.NumberU64()),
+		Genesis:     pm.blockchain.Genesis().Hash(),
+		Config:      pm.blockchain.Config(),
+		Head:        currentBlock.Hash(),
+		HistoryTail: pm.historyTail(),
+	}
+}
+
+// historyTail returns the lowest block number whose body and receipts are served to peers.
+func (pm *ProtocolManager) historyTail() uint64 {
+	if pm.chainDB == nil {
+		return 0
+	}
+	return pm.chainDB.ReadHistoryTail()
+}
+
+// historyTailBroadcastLoop announces the history tail to the peers whenever it is advanced,
+// so that they stop requesting the expired bodies and receipts.
+func (pm *ProtocolManager) historyTailBroadcastLoop() {
+	defer pm.wg.Done()
+
+	ticker := time.NewTicker(historyTailBroadcastInterval)
+	defer ticker.Stop()
+
+	announced := pm.historyTail()
+	for {
+		select {
+		case <-ticker.C:
+			tail := pm.historyTail()
+			if tail == announced {
+				continue
+			}
+			announced = tail
+			for _, p := range pm.peers.Peers() {
+				if p.GetVersion() < kaia66 {
+					continue
+				}
+				if err := p.SendHistoryTail(tail); err != nil {
+					logger.Debug("Failed to announce the history tail", "peer", p.GetID(), "err", err)
+				}
+			}
+		case <-pm.quitSync:
+			return
+		}
 	}
 }
 
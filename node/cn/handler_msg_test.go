@@ -276,9 +276,18 @@ func TestHandleTxMsg(t *testing.T) {
 		mockTxPool.EXPECT().HandleTxMsg(gomock.Any()).AnyTimes()
 		pm.txpool = mockTxPool
 
+		mockPeer.EXPECT().KnowsTx(txs[0].Hash()).Return(false).Times(1)
 		mockPeer.EXPECT().AddToKnownTxs(txs[0].Hash()).Times(1)
 		assert.NoError(t, pm.handleMsg(mockPeer, addrs[0], msg))
 	}
+	// If the peer knows that we have all the transactions, the peer is penalized.
+	{
+		p2pPeer := p2p.NewPeer(nodeids[0], "name", []p2p.Cap{})
+		mockPeer.EXPECT().KnowsTx(txs[0].Hash()).Return(true).Times(1)
+		mockPeer.EXPECT().AddToKnownTxs(txs[0].Hash()).Times(1)
+		mockPeer.EXPECT().GetP2PPeer().Return(p2pPeer).Times(1)
+		assert.NoError(t, pm.handleMsg(mockPeer, addrs[0], generateMsg(t, TxMsg, txs)))
+	}
 }
 
 func prepareTestHandleBlockHeaderFetchRequestMsg(t *testing.T) (*gomock.Controller, *MockPeer, *mocks.MockBlockChain, *ProtocolManager) {
@@ -0,0 +1,148 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package cn
+
+import (
+	"math"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+	"golang.org/x/time/rate"
+)
+
+// msgClass is a class of the inbound messages sharing a rate limit.
+type msgClass int
+
+const (
+	txPropagationClass msgClass = iota
+	blockPropagationClass
+	syncRequestClass
+	consensusClass
+	numMsgClasses
+)
+
+var msgClassNames = [numMsgClasses]string{
+	txPropagationClass:    "tx",
+	blockPropagationClass: "block",
+	syncRequestClass:      "sync",
+	consensusClass:        "consensus",
+}
+
+func (c msgClass) String() string {
+	return msgClassNames[c]
+}
+
+// The default inbound message rate limits per peer, in messages per second. They
+// are far above the rates of the honest peers, which send the transactions and the
+// blocks in batches and keep a few sync requests in flight. The consensus messages
+// aren't limited by default, since a dropped consensus message can delay a round.
+const (
+	DefaultTxMsgRateLimit       = 1000
+	DefaultBlockMsgRateLimit    = 50
+	DefaultSyncRequestRateLimit = 200
+)
+
+var (
+	droppedMsgMeters [numMsgClasses]metrics.Meter
+	delayedMsgMeters [numMsgClasses]metrics.Meter
+)
+
+func init() {
+	for c := msgClass(0); c < numMsgClasses; c++ {
+		droppedMsgMeters[c] = metrics.NewRegisteredMeter("klay/ratelimit/"+c.String()+"/dropped", nil)
+		delayedMsgMeters[c] = metrics.NewRegisteredMeter("klay/ratelimit/"+c.String()+"/delayed", nil)
+	}
+}
+
+// msgClassOf returns the class of the message code. The responses of our
+// requests aren't limited.
+func msgClassOf(code uint64) (msgClass, bool) {
+	switch code {
+	case TxMsg:
+		return txPropagationClass, true
+	case NewBlockHashesMsg, NewBlockMsg:
+		return blockPropagationClass, true
+	case BlockHeaderFetchRequestMsg, BlockBodiesFetchRequestMsg, BlockHeadersRequestMsg, BlockBodiesRequestMsg,
+		NodeDataRequestMsg, ReceiptsRequestMsg, StakingInfoRequestMsg:
+		return syncRequestClass, true
+	case Unused11: // IstanbulMsg
+		return consensusClass, true
+	default:
+		return 0, false
+	}
+}
+
+// msgRateLimits is the inbound message rate limits per peer by the message
+// class, in messages per second. Zero disables the limit of the class.
+type msgRateLimits [numMsgClasses]float64
+
+// msgLimiter limits the inbound messages of a peer with a token bucket per
+// message class. Since each class has its own bucket, a flood of a class can't
+// consume the budget of the other classes.
+type msgLimiter struct {
+	limiters [numMsgClasses]*rate.Limiter
+}
+
+// newMsgLimiter returns a msgLimiter. The burst of each class is the messages of
+// a second. It returns nil if no limit is configured.
+func newMsgLimiter(limits msgRateLimits) *msgLimiter {
+	var (
+		l       msgLimiter
+		enabled bool
+	)
+	for c, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+		burst := int(math.Max(1, math.Ceil(limit)))
+		l.limiters[c] = rate.NewLimiter(rate.Limit(limit), burst)
+		enabled = true
+	}
+	if !enabled {
+		return nil
+	}
+	return &l
+}
+
+// allow reports whether the message of the code may be handled, and the time to
+// wait before handling it. The propagation messages over the limit are dropped.
+// The sync requests over the limit are delayed instead, since the requester waits
+// for the responses and a dropped request looks like a timeout to it. As the read
+// loop of the peer waits for the delay, the peer sending too many requests is
+// throttled rather than being served. The message dropped or delayed is counted
+// in the metrics of the class.
+func (l *msgLimiter) allow(code uint64) (time.Duration, bool) {
+	if l == nil {
+		return 0, true
+	}
+	c, ok := msgClassOf(code)
+	if !ok || l.limiters[c] == nil {
+		return 0, true
+	}
+	if c != syncRequestClass {
+		if l.limiters[c].Allow() {
+			return 0, true
+		}
+		droppedMsgMeters[c].Mark(1)
+		return 0, false
+	}
+	delay := l.limiters[c].Reserve().Delay()
+	if delay > 0 {
+		delayedMsgMeters[c].Mark(1)
+	}
+	return delay, true
+}
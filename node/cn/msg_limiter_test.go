@@ -0,0 +1,93 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package cn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// allowed reports whether the message of the code is handled without a delay.
+func allowed(l *msgLimiter, code uint64) bool {
+	delay, ok := l.allow(code)
+	return ok && delay == 0
+}
+
+func TestMsgLimiter(t *testing.T) {
+	// No limiter is made without a limit, and it allows all messages.
+	l := newMsgLimiter(msgRateLimits{})
+	assert.Nil(t, l)
+	assert.True(t, allowed(l, TxMsg))
+
+	l = newMsgLimiter(msgRateLimits{txPropagationClass: 3, syncRequestClass: 0.5})
+
+	// The burst is the messages of a second, and the propagation messages over the
+	// limit are dropped.
+	for i := 0; i < 3; i++ {
+		assert.True(t, allowed(l, TxMsg))
+	}
+	_, ok := l.allow(TxMsg)
+	assert.False(t, ok)
+
+	// The sync requests over the limit are delayed rather than dropped.
+	assert.True(t, allowed(l, BlockHeadersRequestMsg))
+	delay, ok := l.allow(NodeDataRequestMsg)
+	assert.True(t, ok)
+	assert.InDelta(t, 2*time.Second, delay, float64(100*time.Millisecond))
+
+	// A flood of a class doesn't consume the budget of the others.
+	for i := 0; i < 100; i++ {
+		assert.True(t, allowed(l, Unused11)) // IstanbulMsg
+		assert.True(t, allowed(l, NewBlockMsg))
+	}
+
+	// The responses aren't limited.
+	for i := 0; i < 100; i++ {
+		assert.True(t, allowed(l, BlockHeadersMsg))
+	}
+}
+
+func TestMsgLimiter_Defaults(t *testing.T) {
+	cfg := GetDefaultConfig()
+	for _, limit := range []float64{cfg.TxMsgRateLimit, cfg.BlockMsgRateLimit, cfg.SyncRequestRateLimit} {
+		assert.Positive(t, limit)
+	}
+	assert.Zero(t, cfg.ConsensusMsgRateLimit)
+}
+
+func TestMsgClassOf(t *testing.T) {
+	for code := uint64(0); code < MsgCodeEnd; code++ {
+		c, ok := msgClassOf(code)
+		switch code {
+		case TxMsg:
+			assert.Equal(t, txPropagationClass, c)
+		case NewBlockHashesMsg, NewBlockMsg:
+			assert.Equal(t, blockPropagationClass, c)
+		case Unused11:
+			assert.Equal(t, consensusClass, c)
+		case StatusMsg, BlockHeaderFetchResponseMsg, BlockBodiesFetchResponseMsg, BlockHeadersMsg, BlockBodiesMsg,
+			NodeDataMsg, ReceiptsMsg, StakingInfoMsg, HistoryTailMsg, Unused10:
+			assert.False(t, ok, "code %d", code)
+			continue
+		default:
+			assert.Equal(t, syncRequestClass, c, "code %d", code)
+		}
+		assert.True(t, ok, "code %d", code)
+	}
+}
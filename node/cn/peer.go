@@ -76,9 +76,10 @@ const (
 // PeerInfo represents a short summary of the Kaia sub-protocol metadata known
 // about a connected peer.
 type PeerInfo struct {
-	Version    int      `json:"version"`    // Kaia protocol version negotiated
-	BlockScore *big.Int `json:"blockscore"` // Total blockscore of the peer's blockchain
-	Head       string   `json:"head"`       // SHA3 hash of the peer's best owned block
+	Version     int      `json:"version"`               // Kaia protocol version negotiated
+	BlockScore  *big.Int `json:"blockscore"`            // Total blockscore of the peer's blockchain
+	Head        string   `json:"head"`                  // SHA3 hash of the peer's best owned block
+	HistoryTail uint64   `json:"historyTail,omitempty"` // Lowest block number whose body and receipts are served
 }
 
 // propEvent is a block propagation, waiting for its turn in the broadcast queue.
@@ -180,8 +181,17 @@ type Peer interface {
 	FetchBlockBodies(hashes []common.Hash) error
 
 	// Handshake executes the Kaia protocol handshake, negotiating version number,
-	// network IDs, difficulties, head, and genesis blocks and returning error.
-	Handshake(network uint64, chainID, td *big.Int, head common.Hash, genesis common.Hash) error
+	// network IDs, difficulties, head, genesis blocks and history tails and returning error.
+	Handshake(network uint64, chainID, td *big.Int, head common.Hash, genesis common.Hash, historyTail uint64) error
+
+	// HistoryTail returns the lowest block number whose body and receipts the peer serves.
+	HistoryTail() uint64
+
+	// SetHistoryTail updates the history tail of the peer announced by HistoryTailMsg.
+	SetHistoryTail(tail uint64)
+
+	// SendHistoryTail announces the advanced history tail to the peer.
+	SendHistoryTail(tail uint64) error
 
 	// ConnType returns the conntype of the peer.
 	ConnType() common.ConnType
@@ -256,9 +266,10 @@ type basePeer struct {
 	version  int         // Protocol version negotiated
 	forkDrop *time.Timer // Timed connection dropper if forks aren't validated in time
 
-	head common.Hash
-	td   *big.Int
-	lock sync.RWMutex
+	head        common.Hash
+	td          *big.Int
+	historyTail uint64
+	lock        sync.RWMutex
 
 	knownTxsCache    common.Cache              // FIFO cache of transaction hashes known to be known by this peer
 	knownBlocksCache common.Cache              // FIFO cache of block hashes known to be known by this peer
@@ -326,6 +337,9 @@ var ChannelOfMessage = map[uint64]int{
 	// Protocol messages belonging to kaia/65
 	StakingInfoRequestMsg: p2p.ConnDefault,
 	StakingInfoMsg:        p2p.ConnDefault,
+
+	// Protocol messages belonging to kaia/66
+	HistoryTailMsg: p2p.ConnDefault,
 }
 
 var ConcurrentOfChannel = []int{
@@ -410,12 +424,35 @@ func (p *basePeer) Info() *PeerInfo {
 	hash, td := p.Head()
 
 	return &PeerInfo{
-		Version:    p.version,
-		BlockScore: td,
-		Head:       hash.Hex(),
+		Version:     p.version,
+		BlockScore:  td,
+		Head:        hash.Hex(),
+		HistoryTail: p.HistoryTail(),
 	}
 }
 
+// HistoryTail returns the lowest block number whose body and receipts the peer serves.
+// Zero means that the peer serves the whole history.
+func (p *basePeer) HistoryTail() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.historyTail
+}
+
+// SetHistoryTail updates the history tail of the peer announced by HistoryTailMsg.
+func (p *basePeer) SetHistoryTail(tail uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.historyTail = tail
+}
+
+// SendHistoryTail announces the advanced history tail to the peer.
+func (p *basePeer) SendHistoryTail(tail uint64) error {
+	return p2p.Send(p.rw, HistoryTailMsg, tail)
+}
+
 // Head retrieves a copy of the current head hash and total blockscore of the
 // peer.
 func (p *basePeer) Head() (hash common.Hash, td *big.Int) {
@@ -621,12 +658,16 @@ func (p *basePeer) RequestStakingInfo(hashes []common.Hash) error {
 }
 
 // Handshake executes the Kaia protocol handshake, negotiating version number,
-// network IDs, difficulties, head and genesis blocks.
-func (p *basePeer) Handshake(network uint64, chainID, td *big.Int, head common.Hash, genesis common.Hash) error {
+// network IDs, difficulties, head, genesis blocks and history tails.
+func (p *basePeer) Handshake(network uint64, chainID, td *big.Int, head common.Hash, genesis common.Hash, historyTail uint64) error {
 	// Send out own handshake in a new thread
 	errc := make(chan error, 2)
 	var status statusData // safe to read after two values have been received from errc
 
+	// The older peers can't decode the history tail.
+	if p.version < kaia66 {
+		historyTail = 0
+	}
 	go func() {
 		errc <- p2p.Send(p.rw, StatusMsg, &statusData{
 			ProtocolVersion: uint32(p.version),
@@ -635,6 +676,7 @@ func (p *basePeer) Handshake(network uint64, chainID, td *big.Int, head common.H
 			CurrentBlock:    head,
 			GenesisBlock:    genesis,
 			ChainID:         chainID,
+			HistoryTail:     historyTail,
 		})
 	}()
 	go func() {
@@ -653,6 +695,7 @@ func (p *basePeer) Handshake(network uint64, chainID, td *big.Int, head common.H
 		}
 	}
 	p.td, p.head, p.chainID = status.TD, status.CurrentBlock, status.ChainID
+	p.historyTail = status.HistoryTail
 	return nil
 }
 
@@ -793,7 +836,8 @@ type multiChannelPeer struct {
 	*basePeer                     // basePeer is a set of data structures that the peer implementation has in common
 	rws       []p2p.MsgReadWriter // rws is a slice of p2p.MsgReadWriter for peer-to-peer transmission and reception
 
-	chMgr *ChannelManager
+	chMgr   *ChannelManager
+	limiter *msgLimiter // limiter limits the inbound messages if set
 }
 
 // RegisterMsgCode registers the channel id corresponding to msgCode.
@@ -971,6 +1015,11 @@ func (p *multiChannelPeer) RequestReceipts(hashes []common.Hash) error {
 	return p.msgSender(ReceiptsRequestMsg, hashes)
 }
 
+// SendHistoryTail announces the advanced history tail to the peer.
+func (p *multiChannelPeer) SendHistoryTail(tail uint64) error {
+	return p.msgSender(HistoryTailMsg, tail)
+}
+
 // RequestStakingInfo fetches a batch of staking information from a remote node.
 func (p *multiChannelPeer) RequestStakingInfo(hashes []common.Hash) error {
 	p.Log().Debug("Fetching batch of staking infos", "count", len(hashes))
@@ -1052,6 +1101,18 @@ func (p *multiChannelPeer) ReadMsg(rw p2p.MsgReadWriter, connectionOrder int, er
 			errCh <- err
 			return
 		}
+		if delay, ok := p.limiter.allow(msg.Code); !ok {
+			p.GetP2PPeer().Log().Trace("Dropped the message over the rate limit", "code", msg.Code)
+			msg.Discard()
+			continue
+		} else if delay > 0 {
+			p.GetP2PPeer().Log().Trace("Delayed the message over the rate limit", "code", msg.Code, "delay", delay)
+			select {
+			case <-time.After(delay):
+			case <-closed:
+				return
+			}
+		}
 		select {
 		case msgCh <- msg:
 		case <-closed:
@@ -1089,7 +1150,7 @@ func (p *multiChannelPeer) Handle(pm *ProtocolManager) error {
 		td      = pm.blockchain.GetTd(hash, number)
 	)
 
-	if err := p.Handshake(pm.networkId, pm.getChainID(), td, hash, genesis.Hash()); err != nil {
+	if err := p.Handshake(pm.networkId, pm.getChainID(), td, hash, genesis.Hash(), pm.historyTail()); err != nil {
 		p.GetP2PPeer().Log().Debug("Kaia peer handshake failed", "err", err)
 		return err
 	}
@@ -1189,6 +1250,8 @@ func (p *multiChannelPeer) Handle(pm *ProtocolManager) error {
 		}
 	}
 
+	// The connections share the limiter, so that the limits are of the peer.
+	p.limiter = newMsgLimiter(pm.msgRateLimits)
 	for idx, rw := range p.rws {
 		wg.Add(1)
 		go p.ReadMsg(rw, idx, errChannel, &wg, closed)
@@ -43,6 +43,7 @@ import (
 const (
 	kaia63 = 63
 	kaia65 = 65
+	kaia66 = 66
 )
 
 const ProtocolMaxMsgSize = 12 * 1024 * 1024 // Maximum cap on the size of a protocol message
@@ -78,7 +79,10 @@ const (
 	StakingInfoRequestMsg = 0x12
 	StakingInfoMsg        = 0x13
 
-	MsgCodeEnd = 0x14
+	// Protocol messages belonging to kaia/66
+	HistoryTailMsg = 0x14
+
+	MsgCodeEnd = 0x15
 )
 
 type errCode int
@@ -164,6 +168,10 @@ type statusData struct {
 	CurrentBlock    common.Hash
 	GenesisBlock    common.Hash
 	ChainID         *big.Int // ChainID to sign a transaction.
+
+	// HistoryTail is the lowest block number whose body and receipts are served.
+	// It is sent only since kaia/66, as the older peers can't decode it.
+	HistoryTail uint64 `rlp:"optional"`
 }
 
 // newBlockHashesData is the network packet for the block announcements.
@@ -93,6 +93,11 @@ var (
 	// storageConcurrency is the number of chunks to split the a large contract
 	// storage trie into to allow concurrent retrievals.
 	storageConcurrency = 16
+
+	// syncStatusPersistInterval is the interval at which the sync progress is
+	// persisted during the sync cycle, so that a crashed node resumes from the
+	// last persisted account ranges instead of starting over.
+	syncStatusPersistInterval = time.Minute
 )
 
 // ErrCancelled is returned from snap syncing if the operation was prematurely
@@ -455,6 +460,7 @@ type Syncer struct {
 
 	startTime time.Time // Time instance when snapshot sync started
 	logTime   time.Time // Time instance when status was last reported
+	saveTime  time.Time // Time instance when status was last persisted
 
 	pend sync.WaitGroup // Tracks network request goroutines for graceful shutdown
 	lock sync.RWMutex   // Protects fields that can change outside of sync (peers, reqs, root)
@@ -569,6 +575,7 @@ func (s *Syncer) Sync(root common.Hash, cancel chan struct{}) error {
 	}
 	// Retrieve the previous sync status from LevelDB and abort if already synced
 	s.loadSyncStatus()
+	s.saveTime = time.Now()
 	if len(s.tasks) == 0 && s.healer.scheduler.Pending() == 0 {
 		logger.Debug("Snapshot sync already completed")
 		return nil
@@ -695,6 +702,12 @@ func (s *Syncer) Sync(root common.Hash, cancel chan struct{}) error {
 		}
 		// Report stats if something meaningful happened
 		s.report(false)
+
+		// Checkpoint the progress periodically, as the deferred save above only
+		// runs if the sync cycle terminates gracefully
+		if time.Since(s.saveTime) > syncStatusPersistInterval {
+			s.saveSyncStatus()
+		}
 	}
 }
 
@@ -812,6 +825,7 @@ func (s *Syncer) saveSyncStatus() {
 		panic(err) // This can only fail during implementation
 	}
 	s.db.WriteSnapshotSyncStatus(status)
+	s.saveTime = time.Now()
 }
 
 // Progress returns the snap sync status statistics.
@@ -2172,10 +2186,12 @@ func (s *Syncer) forwardAccountTask(task *accountTask) {
 
 	batch := s.db.NewSnapshotDBBatch()
 	defer batch.Release()
+	persisted := 0
 	for i, hash := range res.hashes {
 		if task.needCode[i] || task.needState[i] {
 			break
 		}
+		persisted++
 		serializer := account.NewAccountSerializerWithAccount(res.accounts[i])
 		bytes, err := rlp.EncodeToBytes(serializer)
 		if err != nil {
@@ -2194,7 +2210,9 @@ func (s *Syncer) forwardAccountTask(task *accountTask) {
 	if err := batch.Write(); err != nil {
 		logger.Crit("Failed to persist accounts", "err", err)
 	}
-	s.accountSynced += uint64(len(res.accounts))
+	// Only count the persisted accounts, as the rest will be downloaded again
+	// if the sync is interrupted before they are filled.
+	s.accountSynced += uint64(persisted)
 
 	// Task filling persisted, push it the chunk marker forward to the first
 	// account still missing data.
@@ -26,6 +26,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"sort"
@@ -33,13 +34,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kaiachain/kaia/blockchain/state"
 	"github.com/kaiachain/kaia/blockchain/types/account"
 	"github.com/kaiachain/kaia/blockchain/types/accountkey"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/crypto"
 	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/networks/p2p"
 	"github.com/kaiachain/kaia/params"
 	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/snapshot"
 	"github.com/kaiachain/kaia/storage/database"
 	"github.com/kaiachain/kaia/storage/statedb"
 	"golang.org/x/crypto/sha3"
@@ -1719,3 +1723,161 @@ func TestSlotEstimation(t *testing.T) {
 		}
 	}
 }
+
+// syncerDownloader delivers the snap packets received by a peer handler to a
+// syncer, in the same way as the downloader does.
+type syncerDownloader struct {
+	syncer    *Syncer
+	delivered func(packet Packet) // Invoked after every delivery, if set
+}
+
+func (d *syncerDownloader) DeliverSnapPacket(peer *Peer, packet Packet) error {
+	var err error
+	switch packet := packet.(type) {
+	case *AccountRangePacket:
+		hashes, accounts := packet.Unpack()
+		err = d.syncer.OnAccounts(peer, packet.ID, hashes, accounts, packet.Proof)
+	case *StorageRangesPacket:
+		hashset, slotset := packet.Unpack()
+		err = d.syncer.OnStorage(peer, packet.ID, hashset, slotset, packet.Proof)
+	case *ByteCodesPacket:
+		err = d.syncer.OnByteCodes(peer, packet.ID, packet.Codes)
+	case *TrieNodesPacket:
+		err = d.syncer.OnTrieNodes(peer, packet.ID, packet.Nodes)
+	default:
+		err = fmt.Errorf("unexpected snap packet type: %T", packet)
+	}
+	if d.delivered != nil {
+		d.delivered(packet)
+	}
+	return err
+}
+
+// makeSourceNode creates the state of a serving node which has a few contracts
+// among the externally owned accounts. It returns the snapshot reader of the
+// node, the state root and the number of accounts.
+func makeSourceNode(t *testing.T, eoas, contracts, slots int) (*testSnapshotReader, common.Hash, int) {
+	memdb := database.NewMemoryDBManager()
+	sdb := state.NewDatabase(memdb)
+	statedb, err := state.New(common.Hash{}, sdb, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < eoas; i++ {
+		statedb.SetBalance(common.BigToAddress(big.NewInt(int64(i+1))), big.NewInt(int64(i+1)))
+	}
+	for i := 0; i < contracts; i++ {
+		addr := common.BigToAddress(big.NewInt(int64(eoas + i + 1)))
+		statedb.CreateSmartContractAccount(addr, params.CodeFormatEVM, params.Rules{IsIstanbul: true})
+		statedb.SetCode(addr, getCodeByHash(common.BytesToHash(getCodeHash(uint64(i)))))
+		for j := 0; j < slots; j++ {
+			statedb.SetState(addr, common.BytesToHash(key32(uint64(j))), common.BytesToHash(key32(uint64(i*slots+j+1))))
+		}
+	}
+	root, err := statedb.Commit(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sdb.TrieDB().Commit(root, false, 0); err != nil {
+		t.Fatal(err)
+	}
+	snaps, err := snapshot.New(memdb, sdb.TrieDB(), 256, root, false, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testSnapshotReader{sdb, snaps}, root, eoas + contracts
+}
+
+// connectSnapPeers connects a syncing node to a serving node over an in-memory
+// snap protocol pipe. The returned function disconnects the nodes.
+func connectSnapPeers(source SnapshotReader, dl *syncerDownloader) func() {
+	sourceRW, syncRW := p2p.MsgPipe()
+	sourcePeer := NewFakePeer(1, common.BytesToHash([]byte("sync")).String(), sourceRW)
+	syncPeer := NewFakePeer(1, common.BytesToHash([]byte("source")).String(), syncRW)
+
+	go Handle(source, nil, sourcePeer)
+	go Handle(nil, dl, syncPeer)
+	dl.syncer.Register(syncPeer)
+
+	return func() {
+		dl.syncer.Unregister(syncPeer.ID())
+		sourceRW.Close()
+		syncRW.Close()
+	}
+}
+
+// TestSyncResumeOverSnapProtocol tests that a snap sync between two in-process
+// nodes, interrupted in the middle, resumes from the persisted account ranges
+// after the syncing node restarts.
+func TestSyncResumeOverSnapProtocol(t *testing.T) {
+	defer func(old time.Duration) { syncStatusPersistInterval = old }(syncStatusPersistInterval)
+	syncStatusPersistInterval = 0
+
+	source, root, numAccounts := makeSourceNode(t, 2000, 10, 50)
+	db := database.NewMemoryDBManager()
+
+	// Sync until some accounts are persisted, then stop the syncing node.
+	var (
+		once   sync.Once
+		cancel = make(chan struct{})
+
+		checkpoint SyncProgress
+	)
+	syncer := NewSyncer(db)
+	dl := &syncerDownloader{syncer: syncer}
+	dl.delivered = func(Packet) {
+		if progress, _ := syncer.Progress(); progress.AccountSynced == 0 {
+			return
+		}
+		once.Do(func() {
+			// The progress is already persisted before the sync cycle terminates,
+			// so that it also survives a crash.
+			if err := json.Unmarshal(db.ReadSnapshotSyncStatus(), &checkpoint); err != nil {
+				t.Errorf("failed to decode the sync status: %v", err)
+			}
+			close(cancel)
+		})
+	}
+	disconnect := connectSnapPeers(source, dl)
+	if err := syncer.Sync(root, cancel); err != ErrCancelled {
+		t.Fatalf("sync not cancelled: %v", err)
+	}
+	disconnect()
+
+	if checkpoint.AccountSynced == 0 || len(checkpoint.Tasks) == 0 {
+		t.Fatalf("progress not checkpointed: accounts %d, tasks %d", checkpoint.AccountSynced, len(checkpoint.Tasks))
+	}
+	interrupted, _ := syncer.Progress()
+	if interrupted.AccountSynced >= uint64(numAccounts) {
+		t.Fatalf("sync not interrupted: accounts %d", interrupted.AccountSynced)
+	}
+
+	// Restart the syncing node on the same database and finish the sync.
+	var resumedAccounts int
+	syncer = NewSyncer(db)
+	dl = &syncerDownloader{syncer: syncer, delivered: func(packet Packet) {
+		if packet, ok := packet.(*AccountRangePacket); ok {
+			resumedAccounts += len(packet.Accounts)
+		}
+	}}
+	disconnect = connectSnapPeers(source, dl)
+	defer disconnect()
+	if err := syncer.Sync(root, make(chan struct{})); err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+	verifyTrie(db, root, t)
+
+	progress, pending := syncer.Progress()
+	if progress.AccountSynced != uint64(numAccounts) {
+		t.Errorf("synced accounts mismatch: have %d, want %d", progress.AccountSynced, numAccounts)
+	}
+	if progress.StorageSynced == 0 || progress.BytecodeSynced == 0 {
+		t.Errorf("storage or bytecodes not synced: slots %d, codes %d", progress.StorageSynced, progress.BytecodeSynced)
+	}
+	if pending.TrienodeHeal != 0 || pending.BytecodeHeal != 0 {
+		t.Errorf("healing not finished: trienodes %d, bytecodes %d", pending.TrienodeHeal, pending.BytecodeHeal)
+	}
+	if resumedAccounts+int(interrupted.AccountSynced) > numAccounts {
+		t.Errorf("sync restarted from scratch: resumed %d, interrupted %d, total %d", resumedAccounts, interrupted.AccountSynced, numAccounts)
+	}
+}
@@ -49,6 +49,7 @@ const (
 	datadirStaticNodes     = "static-nodes.json"  // Path within the datadir to the static node list
 	datadirTrustedNodes    = "trusted-nodes.json" // Path within the datadir to the trusted node list
 	datadirNodeDatabase    = "nodes"              // Path within the datadir to store the node infos
+	datadirBannedNodes     = "banned-nodes.json"  // Path within the datadir to the banned node list
 )
 
 // Config represents a small collection of configuration values to fine tune the
@@ -226,6 +227,14 @@ func (c *Config) NodeDB() string {
 	return c.ResolvePath(datadirNodeDatabase)
 }
 
+// BannedNodesFile returns the path to the file persisting the banned nodes.
+func (c *Config) BannedNodesFile() string {
+	if c.DataDir == "" {
+		return "" // ephemeral
+	}
+	return c.ResolvePath(datadirBannedNodes)
+}
+
 func DefaultIPCEndpoint(clientIdentifier string) string {
 	if clientIdentifier == "" {
 		clientIdentifier = strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
@@ -195,6 +195,9 @@ func (n *Node) Start() error {
 	if n.serverConfig.NodeDatabase == "" {
 		n.serverConfig.NodeDatabase = n.config.NodeDB()
 	}
+	if n.serverConfig.BannedNodesFile == "" {
+		n.serverConfig.BannedNodesFile = n.config.BannedNodesFile()
+	}
 
 	p2pServer := p2p.NewServer(n.serverConfig)
 	n.logger.Info("Starting peer-to-peer node", "instance", n.serverConfig.Name)
@@ -233,6 +233,10 @@ type ChainConfig struct {
 	RandaoCompatibleBlock *big.Int        `json:"randaoCompatibleBlock,omitempty"` // RandaoCompatible activate block (nil = no fork)
 	RandaoRegistry        *RegistryConfig `json:"randaoRegistry,omitempty"`        // Registry initial states
 
+	// SystemContractUpgrades are the system contract installs and proxy upgrades scheduled at specific blocks.
+	// They are applied after the other hardfork changes of the block, and registered to the Registry if named.
+	SystemContractUpgrades []*SystemContractUpgrade `json:"systemContractUpgrades,omitempty"`
+
 	// Various consensus engines
 	Gxhash   *GxhashConfig   `json:"gxhash,omitempty"` // (deprecated) not supported engine
 	Clique   *CliqueConfig   `json:"clique,omitempty"`
@@ -520,7 +524,7 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
-	return nil
+	return c.checkSystemContractUpgrades()
 }
 
 func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *ConfigCompatError {
@@ -556,6 +560,9 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock, head) {
 		return newCompatError("Prague Block", c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock)
 	}
+	if err := checkSystemContractUpgradesCompatible(c.SystemContractUpgrades, newcfg.SystemContractUpgrades, head); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -418,6 +418,41 @@ func NewGovParamSetChainConfig(config *ChainConfig) (*GovParamSet, error) {
 	return NewGovParamSetIntMap(items)
 }
 
+// EncodeGovParamBytes validates the value of the named parameter and encodes it
+// as stored in the GovParam contract, which NewGovParamSetBytesMap decodes.
+// The value is also returned in the canonical type.
+func EncodeGovParamBytes(name string, value interface{}) (interface{}, []byte, error) {
+	key, ok := govParamNames[name]
+	if !ok {
+		return nil, nil, errUnknownGovParamName
+	}
+	parsed, ok := govParamTypes[key].ParseValue(value)
+	if !ok {
+		return nil, nil, errBadGovParamValue
+	}
+
+	switch v := parsed.(type) {
+	case string:
+		return parsed, []byte(v), nil
+	case common.Address:
+		return parsed, v.Bytes(), nil
+	case uint64:
+		// The contract does not accept an empty value, hence at least one byte.
+		b := new(big.Int).SetUint64(v).Bytes()
+		if len(b) == 0 {
+			b = []byte{0x00}
+		}
+		return parsed, b, nil
+	case bool:
+		if v {
+			return parsed, []byte{0x01}, nil
+		}
+		return parsed, []byte{0x00}, nil
+	default:
+		return nil, nil, errBadGovParamValue
+	}
+}
+
 func (p *GovParamSet) set(key int, value interface{}) error {
 	ty, ok := govParamTypes[key]
 	if !ok {
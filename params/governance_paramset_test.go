@@ -259,6 +259,41 @@ func TestGovParamSet_New(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestEncodeGovParamBytes(t *testing.T) {
+	testcases := []struct {
+		name  string
+		value interface{}
+		bytes []byte
+	}{
+		{"governance.governancemode", "single", []byte("single")},
+		{"governance.governingnode", "0x0000000000000000000000000000000000000abc", common.HexToAddress("0xabc").Bytes()},
+		{"istanbul.epoch", 0x1234, []byte{0x12, 0x34}},
+		{"istanbul.committeesize", float64(0), []byte{0x00}},
+		{"reward.mintingamount", "9600000000000000000", []byte("9600000000000000000")},
+		{"reward.ratio", "50/20/30", []byte("50/20/30")},
+		{"reward.useginicoeff", true, []byte{0x01}},
+		{"reward.deferredtxfee", false, []byte{0x00}},
+	}
+	for _, tc := range testcases {
+		value, b, err := EncodeGovParamBytes(tc.name, tc.value)
+		assert.Nil(t, err, tc.name)
+		assert.Equal(t, tc.bytes, b, tc.name)
+
+		// Decoded back to the same value
+		p, err := NewGovParamSetBytesMap(map[string][]byte{tc.name: b})
+		assert.Nil(t, err, tc.name)
+		assert.Equal(t, value, p.StrMap()[tc.name], tc.name)
+	}
+
+	// Error cases
+	_, _, err := EncodeGovParamBytes("nonexistent-param", 1)
+	assert.Equal(t, errUnknownGovParamName, err)
+	_, _, err = EncodeGovParamBytes("reward.ratio", "50/20/20")
+	assert.Equal(t, errBadGovParamValue, err)
+	_, _, err = EncodeGovParamBytes("istanbul.epoch", -1)
+	assert.Equal(t, errBadGovParamValue, err)
+}
+
 func TestGovParamSet_Merged(t *testing.T) {
 	base, err := NewGovParamSetStrMap(map[string]interface{}{
 		"istanbul.epoch":         123456,
@@ -26,6 +26,8 @@ import (
 	"fmt"
 	"math/big"
 	"time"
+
+	"github.com/kaiachain/kaia/common"
 )
 
 var TargetGasLimit = GenesisGasLimit // The artificial target
@@ -212,6 +214,9 @@ var (
 	ZeroRandomReveal = make([]byte, 96)
 	ZeroMixHash      = make([]byte, 32)
 
+	// AddressBookAddr is the address of the AddressBook system contract allocated in the genesis block.
+	AddressBookAddr = common.HexToAddress("0x0000000000000000000000000000000000000400")
+
 	TxGasHumanReadable uint64 = 4000000000 // NOTE: HumanReadable related functions are inactivated now
 
 	// TODO-Kaia Change the variables used in GXhash to more appropriate values for Kaia network
@@ -0,0 +1,142 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/common/hexutil"
+)
+
+// SystemContractUpgrade is a system contract change scheduled at a block.
+// At the end of the block `Block`, the code and storage of the account `Address` are overwritten,
+// the ERC-1967 implementation slot is pointed to `Implementation` if given,
+// and the account is registered to the KIP-149 Registry under `Name` if given.
+//
+// At least one of Code, Storage and Implementation must be specified.
+type SystemContractUpgrade struct {
+	Block          *big.Int                    `json:"block"`
+	Name           string                      `json:"name,omitempty"`           // Registry record name. Not registered if empty.
+	Address        common.Address              `json:"address"`                  // The account to install or upgrade.
+	Code           hexutil.Bytes               `json:"code,omitempty"`           // Runtime bytecode. The current code is kept if empty.
+	Storage        map[common.Hash]common.Hash `json:"storage,omitempty"`        // Storage slots to overwrite.
+	Implementation *common.Address             `json:"implementation,omitempty"` // New ERC-1967 implementation of the proxy at Address.
+}
+
+// SystemContractUpgradesAt returns the system contract upgrades scheduled at the block num, in the configured order.
+func (c *ChainConfig) SystemContractUpgradesAt(num *big.Int) []*SystemContractUpgrade {
+	var upgrades []*SystemContractUpgrade
+	for _, upgrade := range c.SystemContractUpgrades {
+		if isForkBlock(upgrade.Block, num) {
+			upgrades = append(upgrades, upgrade)
+		}
+	}
+	return upgrades
+}
+
+// SortedSystemContractUpgrades returns the system contract upgrades sorted by block number.
+// Upgrades at the same block keep the configured order.
+func (c *ChainConfig) SortedSystemContractUpgrades() []*SystemContractUpgrade {
+	upgrades := make([]*SystemContractUpgrade, len(c.SystemContractUpgrades))
+	copy(upgrades, c.SystemContractUpgrades)
+	sort.SliceStable(upgrades, func(i, j int) bool {
+		return upgrades[i].Block.Cmp(upgrades[j].Block) < 0
+	})
+	return upgrades
+}
+
+// checkSystemContractUpgrades validates the scheduled system contract upgrades.
+func (c *ChainConfig) checkSystemContractUpgrades() error {
+	type upgradeKey struct {
+		block uint64
+		addr  common.Address
+	}
+	seen := make(map[upgradeKey]bool)
+
+	for i, upgrade := range c.SystemContractUpgrades {
+		if upgrade == nil || upgrade.Block == nil {
+			return fmt.Errorf("system contract upgrade #%d: block not specified", i)
+		}
+		// Block 0 states must be given in the genesis alloc, since the hardfork changes are applied at the end of a block.
+		if upgrade.Block.Sign() <= 0 {
+			return fmt.Errorf("system contract upgrade #%d: block must be positive", i)
+		}
+		if common.EmptyAddress(upgrade.Address) {
+			return fmt.Errorf("system contract upgrade #%d: address not specified", i)
+		}
+		if len(upgrade.Code) == 0 && len(upgrade.Storage) == 0 && upgrade.Implementation == nil {
+			return fmt.Errorf("system contract upgrade #%d: nothing to change at %s", i, upgrade.Address.Hex())
+		}
+		if upgrade.Implementation != nil && common.EmptyAddress(*upgrade.Implementation) {
+			return fmt.Errorf("system contract upgrade #%d: empty implementation address", i)
+		}
+		// The Registry is installed at the Randao hardfork block, before the upgrades of the same block.
+		if upgrade.Name != "" && !isForked(c.RandaoCompatibleBlock, upgrade.Block) {
+			return fmt.Errorf("system contract upgrade #%d: cannot register %q at block %v before the Registry is installed", i, upgrade.Name, upgrade.Block)
+		}
+
+		key := upgradeKey{upgrade.Block.Uint64(), upgrade.Address}
+		if seen[key] {
+			return fmt.Errorf("system contract upgrade #%d: duplicate upgrade of %s at block %v", i, upgrade.Address.Hex(), upgrade.Block)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// checkSystemContractUpgradesCompatible returns an error if the upgrades already applied by the head block differ.
+func checkSystemContractUpgradesCompatible(stored, newcfg []*SystemContractUpgrade, head *big.Int) *ConfigCompatError {
+	storedByBlock := groupSystemContractUpgrades(stored)
+	newByBlock := groupSystemContractUpgrades(newcfg)
+
+	var diff *big.Int
+	for num, s := range storedByBlock {
+		if n := newByBlock[num]; !bytes.Equal(s, n) && (diff == nil || num < diff.Uint64()) {
+			diff = new(big.Int).SetUint64(num)
+		}
+	}
+	for num := range newByBlock {
+		if _, ok := storedByBlock[num]; !ok && (diff == nil || num < diff.Uint64()) {
+			diff = new(big.Int).SetUint64(num)
+		}
+	}
+	if isForked(diff, head) {
+		return newCompatError("System Contract Upgrades", diff, diff)
+	}
+	return nil
+}
+
+// groupSystemContractUpgrades encodes the upgrades of each block for comparison.
+func groupSystemContractUpgrades(upgrades []*SystemContractUpgrade) map[uint64][]byte {
+	byBlock := make(map[uint64][]*SystemContractUpgrade)
+	for _, upgrade := range upgrades {
+		if upgrade != nil && upgrade.Block != nil {
+			num := upgrade.Block.Uint64()
+			byBlock[num] = append(byBlock[num], upgrade)
+		}
+	}
+	encoded := make(map[uint64][]byte)
+	for num, list := range byBlock {
+		encoded[num], _ = json.Marshal(list)
+	}
+	return encoded
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package params
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainConfig_CheckSystemContractUpgrades(t *testing.T) {
+	var (
+		addr = common.HexToAddress("0xaaaa")
+		impl = common.HexToAddress("0xbbbb")
+		code = []byte{0x60, 0x00}
+	)
+	testcases := []struct {
+		upgrade *SystemContractUpgrade
+		ok      bool
+	}{
+		{&SystemContractUpgrade{Block: big.NewInt(10), Address: addr, Code: code}, true},
+		{&SystemContractUpgrade{Block: big.NewInt(10), Address: addr, Implementation: &impl}, true},
+		{&SystemContractUpgrade{Block: big.NewInt(10), Address: addr, Storage: map[common.Hash]common.Hash{{}: {}}}, true},
+		{&SystemContractUpgrade{Block: big.NewInt(10), Name: "Test", Address: addr, Code: code}, true},
+
+		{nil, false},
+		{&SystemContractUpgrade{Address: addr, Code: code}, false},                                               // no block
+		{&SystemContractUpgrade{Block: big.NewInt(0), Address: addr, Code: code}, false},                         // genesis
+		{&SystemContractUpgrade{Block: big.NewInt(10), Code: code}, false},                                       // no address
+		{&SystemContractUpgrade{Block: big.NewInt(10), Address: addr}, false},                                    // nothing to change
+		{&SystemContractUpgrade{Block: big.NewInt(10), Address: addr, Implementation: &common.Address{}}, false}, // empty implementation
+		{&SystemContractUpgrade{Block: big.NewInt(4), Name: "Test", Address: addr, Code: code}, false},           // before registry
+	}
+	for i, tc := range testcases {
+		config := &ChainConfig{
+			RandaoCompatibleBlock:  big.NewInt(5),
+			SystemContractUpgrades: []*SystemContractUpgrade{tc.upgrade},
+		}
+		err := config.checkSystemContractUpgrades()
+		if tc.ok {
+			assert.NoError(t, err, i)
+		} else {
+			assert.Error(t, err, i)
+		}
+	}
+
+	// Duplicate upgrades of the same address at the same block
+	config := &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{
+		{Block: big.NewInt(10), Address: addr, Code: code},
+		{Block: big.NewInt(10), Address: addr, Implementation: &impl},
+	}}
+	assert.Error(t, config.checkSystemContractUpgrades())
+}
+
+func TestChainConfig_SystemContractUpgradesAt(t *testing.T) {
+	config := &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{
+		{Block: big.NewInt(20), Address: common.HexToAddress("0x1")},
+		{Block: big.NewInt(10), Address: common.HexToAddress("0x2")},
+		{Block: big.NewInt(20), Address: common.HexToAddress("0x3")},
+	}}
+
+	assert.Empty(t, config.SystemContractUpgradesAt(big.NewInt(15)))
+	assert.Equal(t, []*SystemContractUpgrade{config.SystemContractUpgrades[0], config.SystemContractUpgrades[2]},
+		config.SystemContractUpgradesAt(big.NewInt(20)))
+
+	sorted := config.SortedSystemContractUpgrades()
+	assert.Equal(t, []common.Address{common.HexToAddress("0x2"), common.HexToAddress("0x1"), common.HexToAddress("0x3")},
+		[]common.Address{sorted[0].Address, sorted[1].Address, sorted[2].Address})
+}
+
+func TestChainConfig_CheckCompatible_SystemContractUpgrades(t *testing.T) {
+	var (
+		addr     = common.HexToAddress("0xaaaa")
+		upgrade1 = &SystemContractUpgrade{Block: big.NewInt(10), Address: addr, Code: []byte{0x1}}
+		upgrade2 = &SystemContractUpgrade{Block: big.NewInt(20), Address: addr, Code: []byte{0x2}}
+		changed  = &SystemContractUpgrade{Block: big.NewInt(20), Address: addr, Code: []byte{0x3}}
+
+		stored = &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{upgrade1, upgrade2}}
+	)
+
+	// Changing a future upgrade is allowed.
+	newcfg := &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{upgrade1, changed}}
+	assert.Nil(t, stored.CheckCompatible(newcfg, 19))
+
+	// Changing or removing an applied upgrade requires a rewind.
+	err := stored.CheckCompatible(newcfg, 20)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, uint64(19), err.RewindTo)
+	}
+	newcfg = &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{upgrade2}}
+	err = stored.CheckCompatible(newcfg, 15)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, uint64(9), err.RewindTo)
+	}
+
+	// Adding a future upgrade is allowed.
+	newcfg = &ChainConfig{SystemContractUpgrades: []*SystemContractUpgrade{upgrade1, upgrade2, {Block: big.NewInt(30), Address: addr, Code: []byte{0x4}}}}
+	assert.Nil(t, stored.CheckCompatible(newcfg, 25))
+}
@@ -74,5 +74,24 @@ Last, distribute reward to each address (proposer, KIF, KEF).
 	related struct
 	- RewardDistributor
 	- rewardConfigCache
+
+# Tracking Total Supply
+
+The SupplyManager accumulates the minted and burnt amounts of every block and stores them as SupplyCheckpoints.
+The total supply is the accumulated minted amount (including the genesis supply) minus the burnt fees,
+the balances of 0x0 and 0xdead, and the amounts burnt by the KIP103 and KIP160 treasury rebalances.
+
+The tracked numbers can be audited against the state, which must satisfy
+
+	genesis + minted - (burntFee + kip103Burn + kip160Burn) == sum of all account balances
+
+The audit walks the entire account trie, so it is only exposed via the debug namespace (debug_auditTotalSupply),
+and via the `db audit-supply` command on a stopped node. It stops when the request is canceled.
+The minted amount can also be broken down by the reward recipient categories (debug_getMintBreakdown, `db mint-breakdown`).
+
+	related struct
+	- supplyManager
+	- SupplyAudit
+	- MintBreakdown
 */
 package reward
@@ -273,35 +273,42 @@ func GetBlockReward(header *types.Header, txs []*types.Transaction, receipts []*
 		}
 	}
 
-	// Compensate the difference between CalcDeferredReward() and actual payment.
-	// If not DeferredTxFee, CalcDeferredReward() assumes 0 total_fee, but
-	// some non-zero fee already has been paid to the proposer.
-	if !pset.DeferredTxFee() {
-		if rules.IsMagma {
-			txFee := GetTotalTxFee(header, txs, receipts, rules, pset)
-			txFeeBurn := getBurnAmountMagma(txFee)
-			txFeeRemained := new(big.Int).Sub(txFee, txFeeBurn)
-			spec.BurntFee = txFeeBurn
-
-			spec.Proposer = spec.Proposer.Add(spec.Proposer, txFeeRemained)
-			spec.TotalFee = spec.TotalFee.Add(spec.TotalFee, txFee)
-			incrementRewardsMap(spec.Rewards, header.Rewardbase, txFeeRemained)
-		} else {
-			txFee := GetTotalTxFee(header, nil, nil, rules, pset)
-			spec.Proposer = spec.Proposer.Add(spec.Proposer, txFee)
-			spec.TotalFee = spec.TotalFee.Add(spec.TotalFee, txFee)
-			// get the proposer of this block.
-			proposer, err := ecrecover(header)
-			if err != nil {
-				return nil, err
-			}
-			incrementRewardsMap(spec.Rewards, proposer, txFee)
-		}
+	if err := AddPaidTxFee(spec, header, txs, receipts, rules, pset); err != nil {
+		return nil, err
 	}
-
 	return spec, nil
 }
 
+// AddPaidTxFee compensates the difference between CalcDeferredReward() and actual payment.
+// If not DeferredTxFee, CalcDeferredReward() assumes 0 total_fee, but
+// some non-zero fee already has been paid to the proposer.
+func AddPaidTxFee(spec *RewardSpec, header *types.Header, txs []*types.Transaction, receipts []*types.Receipt, rules params.Rules, pset *params.GovParamSet) error {
+	if pset.DeferredTxFee() {
+		return nil
+	}
+	if rules.IsMagma {
+		txFee := GetTotalTxFee(header, txs, receipts, rules, pset)
+		txFeeBurn := getBurnAmountMagma(txFee)
+		txFeeRemained := new(big.Int).Sub(txFee, txFeeBurn)
+		spec.BurntFee = txFeeBurn
+
+		spec.Proposer = spec.Proposer.Add(spec.Proposer, txFeeRemained)
+		spec.TotalFee = spec.TotalFee.Add(spec.TotalFee, txFee)
+		incrementRewardsMap(spec.Rewards, header.Rewardbase, txFeeRemained)
+	} else {
+		txFee := GetTotalTxFee(header, nil, nil, rules, pset)
+		spec.Proposer = spec.Proposer.Add(spec.Proposer, txFee)
+		spec.TotalFee = spec.TotalFee.Add(spec.TotalFee, txFee)
+		// get the proposer of this block.
+		proposer, err := ecrecover(header)
+		if err != nil {
+			return err
+		}
+		incrementRewardsMap(spec.Rewards, proposer, txFee)
+	}
+	return nil
+}
+
 // CalcDeferredRewardSimple distributes rewards to proposer after optional fee burning
 // this behaves similar to the previous MintKAIA
 // MintKAIA has been superseded because we need to split reward distribution
@@ -378,6 +385,43 @@ func CalcDeferredReward(header *types.Header, txs []*types.Transaction, receipts
 
 	minted := rc.mintingAmount
 	totalFee, rewardFee, burntFee := calcDeferredFee(rc)
+
+	spec := calcDeferredSplit(rc, header, stakingInfo, minted, rewardFee)
+	spec.Minted = minted
+	spec.TotalFee = totalFee
+	spec.BurntFee = burntFee
+
+	logger.Debug("CalcDeferredReward() returns", "spec", spec)
+
+	return spec, nil
+}
+
+// CalcMintedReward calculates how the newly minted amount of a block is distributed,
+// excluding the transaction fees. The sum of Proposer, Stakers, KIF and KEF equals Minted.
+// Used for breaking down the total supply by the reward recipient categories.
+func CalcMintedReward(header *types.Header, txs []*types.Transaction, receipts []*types.Receipt, rules params.Rules, pset *params.GovParamSet, stakingInfo *StakingInfo) (*RewardSpec, error) {
+	rc, err := NewRewardConfig(header, txs, receipts, rules, pset)
+	if err != nil {
+		return nil, err
+	}
+
+	minted := rc.mintingAmount
+	if IsRewardSimple(pset) {
+		spec := NewRewardSpec()
+		spec.Minted = minted
+		spec.Proposer = new(big.Int).Set(minted)
+		incrementRewardsMap(spec.Rewards, header.Rewardbase, spec.Proposer)
+		return spec, nil
+	}
+
+	spec := calcDeferredSplit(rc, header, stakingInfo, minted, big.NewInt(0))
+	spec.Minted = minted
+	return spec, nil
+}
+
+// calcDeferredSplit distributes (minted + rewardFee) to the proposer, stakers, KIF and KEF.
+// The returned spec only has the allocated amounts and the Rewards map filled.
+func calcDeferredSplit(rc *rewardConfig, header *types.Header, stakingInfo *StakingInfo, minted, rewardFee *big.Int) *RewardSpec {
 	proposer, stakers, kif, kef, splitRem := calcSplit(rc, minted, rewardFee)
 	shares, shareRem := calcShares(stakingInfo, stakers, rc.minimumStake.Uint64())
 
@@ -401,9 +445,6 @@ func CalcDeferredReward(header *types.Header, txs []*types.Transaction, receipts
 	}
 
 	spec := NewRewardSpec()
-	spec.Minted = minted
-	spec.TotalFee = totalFee
-	spec.BurntFee = burntFee
 	spec.Proposer = proposer
 	spec.Stakers = stakers
 	spec.KIF = kif
@@ -421,9 +462,7 @@ func CalcDeferredReward(header *types.Header, txs []*types.Transaction, receipts
 	for rewardAddr, rewardAmount := range shares {
 		incrementRewardsMap(spec.Rewards, rewardAddr, rewardAmount)
 	}
-	logger.Debug("CalcDeferredReward() returns", "spec", spec)
-
-	return spec, nil
+	return spec
 }
 
 // calcDeferredFee splits fee into (total, reward, burnt)
@@ -0,0 +1,234 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package reward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/kaiachain/kaia/blockchain/types/account"
+	"github.com/kaiachain/kaia/kaiax/staking"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/statedb"
+)
+
+// MaxMintBreakdownRange is the maximum number of blocks GetMintBreakdown can process at once.
+const MaxMintBreakdownRange = uint64(10000)
+
+var (
+	errNoStakingModule     = errors.New("staking module not registered")
+	errMintBreakdownRange  = fmt.Errorf("block range must be within %d blocks", MaxMintBreakdownRange)
+	errIncompleteSupplyNum = errors.New("total supply components are not available")
+)
+
+// SupplyAudit is the result of checking the supply invariant at a block against the state:
+//
+//	GenesisSupply + Minted - (BurntFee + Kip103Burn + Kip160Burn) == sum of all account balances
+//
+// ZeroBurn and DeadBurn are part of the account balances, so they appear in both sides.
+type SupplyAudit struct {
+	Number        uint64   `json:"number"`
+	GenesisSupply *big.Int `json:"genesisSupply"` // Sum of the balances at the genesis block.
+	Minted        *big.Int `json:"minted"`        // Accumulated block rewards minted since the genesis block.
+	BurntFee      *big.Int `json:"burntFee"`
+	Kip103Burn    *big.Int `json:"kip103Burn"`
+	Kip160Burn    *big.Int `json:"kip160Burn"`
+	ZeroBurn      *big.Int `json:"zeroBurn"`
+	DeadBurn      *big.Int `json:"deadBurn"`
+	TotalSupply   *big.Int `json:"totalSupply"` // The total supply reported by GetTotalSupply.
+
+	Expected    *big.Int `json:"expected"`    // The sum of balances expected from the tracked numbers.
+	StateTotal  *big.Int `json:"stateTotal"`  // The sum of balances actually found in the state.
+	Accounts    uint64   `json:"accounts"`    // The number of accounts walked in the state.
+	Discrepancy *big.Int `json:"discrepancy"` // StateTotal - Expected. Zero if the invariant holds.
+	Consistent  bool     `json:"consistent"`
+}
+
+// MintBreakdown is the amount minted in the blocks [From, To], broken down by the reward recipient categories.
+// The sum of Proposer, Stakers, KIF and KEF equals Minted.
+type MintBreakdown struct {
+	From     uint64   `json:"from"`
+	To       uint64   `json:"to"`
+	Minted   *big.Int `json:"minted"`
+	Proposer *big.Int `json:"proposer"`
+	Stakers  *big.Int `json:"stakers"`
+	KIF      *big.Int `json:"kif"`
+	KEF      *big.Int `json:"kef"`
+}
+
+// RegisterStakingModule sets the staking info source used by GetMintBreakdown.
+func (sm *supplyManager) RegisterStakingModule(stakingModule staking.StakingModule) {
+	sm.stakingModule = stakingModule
+}
+
+// AuditTotalSupply verifies the supply invariant at the given block by walking the entire state trie.
+// This is extremely expensive and requires the state of the block. The walk stops when ctx is done.
+func (sm *supplyManager) AuditTotalSupply(ctx context.Context, num uint64) (*SupplyAudit, error) {
+	ts, err := sm.GetTotalSupply(num)
+	if err != nil {
+		return nil, err
+	}
+	if ts.ZeroBurn == nil || ts.DeadBurn == nil || ts.Kip103Burn == nil || ts.Kip160Burn == nil {
+		return nil, errIncompleteSupplyNum
+	}
+	genesis, err := sm.GetCheckpoint(0)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &SupplyAudit{
+		Number:        num,
+		GenesisSupply: new(big.Int).Set(genesis.Minted),
+		Minted:        new(big.Int).Sub(ts.TotalMinted, genesis.Minted),
+		BurntFee:      ts.BurntFee,
+		Kip103Burn:    ts.Kip103Burn,
+		Kip160Burn:    ts.Kip160Burn,
+		ZeroBurn:      ts.ZeroBurn,
+		DeadBurn:      ts.DeadBurn,
+		TotalSupply:   ts.TotalSupply,
+	}
+
+	// The checkpoint Minted already includes the genesis supply.
+	audit.Expected = new(big.Int).Set(ts.TotalMinted)
+	audit.Expected.Sub(audit.Expected, ts.BurntFee)
+	audit.Expected.Sub(audit.Expected, ts.Kip103Burn)
+	audit.Expected.Sub(audit.Expected, ts.Kip160Burn)
+
+	audit.StateTotal, audit.Accounts, err = sm.sumBalancesFromState(ctx, num)
+	if err != nil {
+		return nil, err
+	}
+	audit.Discrepancy = new(big.Int).Sub(audit.StateTotal, audit.Expected)
+	audit.Consistent = audit.Discrepancy.Sign() == 0
+	if !audit.Consistent {
+		logger.Warn("Total supply discrepancy found", "number", num, "expected", audit.Expected, "state", audit.StateTotal, "discrepancy", audit.Discrepancy)
+	}
+	return audit, nil
+}
+
+// GetMintBreakdown returns the amount minted in the blocks [from, to], broken down by the reward recipient categories.
+// It stops when ctx is done.
+func (sm *supplyManager) GetMintBreakdown(ctx context.Context, from, to uint64) (*MintBreakdown, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d > to %d", from, to)
+	}
+	if to-from >= MaxMintBreakdownRange {
+		return nil, errMintBreakdownRange
+	}
+
+	breakdown := &MintBreakdown{
+		From:     from,
+		To:       to,
+		Minted:   big.NewInt(0),
+		Proposer: big.NewInt(0),
+		Stakers:  big.NewInt(0),
+		KIF:      big.NewInt(0),
+		KEF:      big.NewInt(0),
+	}
+	for num := from; num <= to; num++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		spec, err := sm.getMintedReward(num)
+		if err != nil {
+			return nil, err
+		}
+		breakdown.Minted.Add(breakdown.Minted, spec.Minted)
+		breakdown.Proposer.Add(breakdown.Proposer, spec.Proposer)
+		breakdown.Stakers.Add(breakdown.Stakers, spec.Stakers)
+		breakdown.KIF.Add(breakdown.KIF, spec.KIF)
+		breakdown.KEF.Add(breakdown.KEF, spec.KEF)
+	}
+	return breakdown, nil
+}
+
+// getMintedReward returns the distribution of the amount minted in the block.
+// Uses the same parameters as accumulateReward so that the breakdown adds up to the checkpoints.
+func (sm *supplyManager) getMintedReward(num uint64) (*RewardSpec, error) {
+	// The genesis block does not mint.
+	if num == 0 {
+		return NewRewardSpec(), nil
+	}
+
+	header := sm.chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, errNoBlock
+	}
+	block := sm.chain.GetBlock(header.Hash(), num)
+	if block == nil {
+		return nil, errNoBlock
+	}
+	receipts := sm.chain.GetReceiptsByBlockHash(header.Hash())
+	if receipts == nil {
+		return nil, errNoBlock
+	}
+
+	rules := sm.chain.Config().Rules(header.Number)
+	pset, err := sm.gov.EffectiveParams(num)
+	if err != nil {
+		return nil, err
+	}
+
+	var stakingInfo *StakingInfo
+	if !IsRewardSimple(pset) {
+		if sm.stakingModule == nil {
+			return nil, errNoStakingModule
+		}
+		si, err := sm.stakingModule.GetStakingInfo(num)
+		if err != nil {
+			return nil, err
+		}
+		stakingInfo = FromKaiax(si)
+	}
+	return CalcMintedReward(header, block.Transactions(), receipts, rules, pset, stakingInfo)
+}
+
+// sumBalancesFromState walks the account trie at the given block and returns the sum of all balances
+// and the number of accounts. It reads the trie directly so that it does not depend on the preimages.
+func (sm *supplyManager) sumBalancesFromState(ctx context.Context, num uint64) (*big.Int, uint64, error) {
+	header := sm.chain.GetHeaderByNumber(num)
+	if header == nil {
+		return nil, 0, errors.New("header not found")
+	}
+	trie, err := sm.chain.StateCache().OpenTrie(header.Root, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		total    = new(big.Int)
+		accounts = uint64(0)
+		it       = statedb.NewIterator(trie.NodeIterator(nil))
+	)
+	for it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		serializer := account.NewAccountSerializer()
+		if err := rlp.DecodeBytes(it.Value, serializer); err != nil {
+			return nil, 0, err
+		}
+		total.Add(total, serializer.GetAccount().GetBalance())
+		accounts++
+	}
+	if it.Err != nil {
+		return nil, 0, it.Err
+	}
+	return total, accounts, nil
+}
@@ -19,6 +19,7 @@
 package reward
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -34,6 +35,7 @@ import (
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/contracts/contracts/system_contracts/rebalance"
 	"github.com/kaiachain/kaia/event"
+	"github.com/kaiachain/kaia/kaiax/staking"
 	"github.com/kaiachain/kaia/params"
 	"github.com/kaiachain/kaia/storage/database"
 )
@@ -72,6 +74,17 @@ type SupplyManager interface {
 	// GetTotalSupply returns the total supply amounts at the given block number,
 	// broken down by minted amount and burnt amounts of each methods.
 	GetTotalSupply(num uint64) (*TotalSupply, error)
+
+	// AuditTotalSupply verifies that the tracked total supply matches the sum of all account balances
+	// at the given block number by walking the state trie.
+	AuditTotalSupply(ctx context.Context, num uint64) (*SupplyAudit, error)
+
+	// GetMintBreakdown returns the amount minted in the given block range,
+	// broken down by the reward recipient categories.
+	GetMintBreakdown(ctx context.Context, from, to uint64) (*MintBreakdown, error)
+
+	// RegisterStakingModule sets the staking info source used by GetMintBreakdown.
+	RegisterStakingModule(stakingModule staking.StakingModule)
 }
 
 type TotalSupply struct {
@@ -93,6 +106,7 @@ type supplyManager struct {
 	gov                governanceHelper
 	db                 database.DBManager
 	checkpointInterval uint64
+	stakingModule      staking.StakingModule // Optional. Only used by GetMintBreakdown.
 
 	// Internal data structures
 	checkpointCache *lru.ARCCache  // Cache (number uint64) -> (checkpoint *database.SupplyCheckpoint)
@@ -354,27 +368,10 @@ func (sm *supplyManager) catchup() {
 }
 
 // totalSupplyFromState calculates the ground truth total supply by iterating over all accounts.
-// This is extremely inefficient and should only be used for the genesis block and testing.
+// This is extremely inefficient and should only be used for the genesis block, auditing and testing.
 func (sm *supplyManager) totalSupplyFromState(num uint64) (*big.Int, error) {
-	header := sm.chain.GetHeaderByNumber(num)
-	if header == nil {
-		return nil, errors.New("header not found")
-	}
-	stateDB, err := sm.chain.StateAt(header.Root)
-	if err != nil {
-		return nil, err
-	}
-	dump := stateDB.RawDump() // Extremely inefficient but okay for genesis block.
-
-	totalSupply := new(big.Int)
-	for _, account := range dump.Accounts {
-		balance, ok := new(big.Int).SetString(account.Balance, 10)
-		if !ok {
-			return nil, errors.New("malformed state dump")
-		}
-		totalSupply.Add(totalSupply, balance)
-	}
-	return totalSupply, nil
+	totalSupply, _, err := sm.sumBalancesFromState(context.Background(), num)
+	return totalSupply, err
 }
 
 func (sm *supplyManager) getCheckpointUncached(num uint64) (*database.SupplyCheckpoint, error) {
@@ -19,6 +19,7 @@
 package reward
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -26,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/kaiachain/kaia/accounts/abi/bind"
 	"github.com/kaiachain/kaia/accounts/abi/bind/backends"
 	"github.com/kaiachain/kaia/blockchain"
@@ -38,6 +40,8 @@ import (
 	"github.com/kaiachain/kaia/consensus/istanbul"
 	"github.com/kaiachain/kaia/contracts/contracts/testing/system_contracts"
 	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/kaiax/staking"
+	staking_mock "github.com/kaiachain/kaia/kaiax/staking/mock"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/networks/rpc"
 	"github.com/kaiachain/kaia/params"
@@ -286,6 +290,83 @@ func (s *SupplyTestSuite) TestTotalSupply() {
 	}
 }
 
+// Test that the audit finds no discrepancy between the tracked total supply and the state.
+func (s *SupplyTestSuite) TestAuditTotalSupply() {
+	t := s.T()
+	s.insertBlocks()
+	s.sm.Start()
+	defer s.sm.Stop()
+	s.waitCatchup()
+
+	testcases := s.testcases()
+	for _, tc := range testcases {
+		audit, err := s.sm.AuditTotalSupply(context.Background(), tc.number)
+		require.NoError(t, err, tc.number)
+
+		assert.True(t, audit.Consistent, tc.number)
+		bigEqual(t, big.NewInt(0), audit.Discrepancy, tc.number)
+		bigEqual(t, tc.expectFromState, audit.StateTotal, tc.number)
+		bigEqual(t, tc.expectFromState, audit.Expected, tc.number)
+		bigEqual(t, tc.expectTotalSupply.TotalSupply, audit.TotalSupply, tc.number)
+		bigEqual(t, tc.expectTotalSupply.TotalMinted, bigAdd(audit.GenesisSupply, audit.Minted), tc.number)
+	}
+
+	// The state walk stops when the context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.sm.AuditTotalSupply(ctx, testcases[len(testcases)-1].number)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// Test that the minted amounts are broken down by the reward recipient categories.
+func (s *SupplyTestSuite) TestMintBreakdown() {
+	t := s.T()
+	s.insertBlocks()
+	s.sm.Start()
+	defer s.sm.Stop()
+	s.waitCatchup()
+
+	// Staking module is required for the non-simple reward.
+	_, err := s.sm.GetMintBreakdown(context.Background(), 1, 400)
+	assert.ErrorIs(t, err, errNoStakingModule)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mStaking := staking_mock.NewMockStakingModule(mockCtrl)
+	mStaking.EXPECT().GetStakingInfo(gomock.Any()).Return(&staking.StakingInfo{
+		KIFAddr: addrFund1,
+		KEFAddr: addrFund2,
+	}, nil).AnyTimes()
+	s.sm.RegisterStakingModule(mStaking)
+
+	breakdown, err := s.sm.GetMintBreakdown(context.Background(), 1, 400)
+	require.NoError(t, err)
+
+	// The breakdown adds up to the accumulated minted amount.
+	genesis, err := s.sm.GetCheckpoint(0)
+	require.NoError(t, err)
+	last, err := s.sm.GetCheckpoint(400)
+	require.NoError(t, err)
+	bigEqual(t, bigSub(last.Minted, genesis.Minted), breakdown.Minted)
+	bigEqual(t, breakdown.Minted, bigAdd(breakdown.Proposer, breakdown.Stakers, breakdown.KIF, breakdown.KEF))
+
+	// Ratio 34/54/12, 50/40/10, 50/20/30, 50/25/25 for blocks [1,99], [100,199], [200,299], [300,400] respectively.
+	// No council in the staking info, so the stakers' portion goes to the proposer.
+	var (
+		expectedKIF, _ = new(big.Int).SetString("1099216000000000000000", 10) // 99*9.6*0.54 + 100*6.4*0.40 + 100*6.4*0.20 + 101*8*0.25
+		expectedKEF, _ = new(big.Int).SetString("572048000000000000000", 10)  // 99*9.6*0.12 + 100*6.4*0.10 + 100*6.4*0.30 + 101*8*0.25
+	)
+	bigEqual(t, expectedKIF, breakdown.KIF)
+	bigEqual(t, expectedKEF, breakdown.KEF)
+	bigEqual(t, big.NewInt(0), breakdown.Stakers)
+
+	// Invalid ranges
+	_, err = s.sm.GetMintBreakdown(context.Background(), 2, 1)
+	assert.Error(t, err)
+	_, err = s.sm.GetMintBreakdown(context.Background(), 0, MaxMintBreakdownRange)
+	assert.ErrorIs(t, err, errMintBreakdownRange)
+}
+
 // Test that when some data are missing, GetTotalSupply leaves some fields nil and returns an error.
 func (s *SupplyTestSuite) TestPartialInfo() {
 	t := s.T()
@@ -183,6 +183,21 @@ type DBManager interface {
 	WriteLastPrunedBlockNumber(blockNumber uint64)
 	ReadLastPrunedBlockNumber() (uint64, error)
 
+	// State scheme and path-based state trie related operations
+	ReadStateScheme() string
+	WriteStateScheme(scheme string)
+	ReadAccountTrieNode(path []byte) []byte
+	ReadStorageTrieNode(owner common.Hash, path []byte) []byte
+	ReadStateHistory(id uint64) []byte
+	ReadPersistentStateID() uint64
+	ReadStateID(root common.Hash) *uint64
+	NewPathStateDBBatch() PathStateDBBatch
+
+	// History expiry
+	ReadHistoryTail() uint64
+	WriteHistoryTail(number uint64)
+	DeleteBlockHistory(hash common.Hash, number uint64)
+
 	// from accessors_indexes.go
 	ReadTxLookupEntry(hash common.Hash) (common.Hash, uint64, uint64)
 	WriteTxLookupEntries(block *types.Block)
@@ -332,6 +347,7 @@ const (
 	TxLookUpEntryDB
 	bridgeServiceDB
 	SnapshotDB
+	PathStateDB
 	// databaseEntryTypeSize should be the last item in this list!!
 	databaseEntryTypeSize
 )
@@ -373,6 +389,7 @@ var dbBaseDirs = [databaseEntryTypeSize]string{
 	"txlookup",
 	"bridgeservice",
 	"snapshot",
+	"pathstate",
 }
 
 // Sum of dbConfigRatio should be 100.
@@ -383,10 +400,11 @@ var dbConfigRatio = [databaseEntryTypeSize]int{
 	5,  // BodyDB
 	5,  // ReceiptsDB
 	40, // StateTrieDB
-	37, // StateTrieMigrationDB
+	34, // StateTrieMigrationDB
 	2,  // TXLookUpEntryDB
 	1,  // bridgeServiceDB
 	3,  // SnapshotDB
+	3,  // PathStateDB
 }
 
 // checkDBEntryConfigRatio checks if sum of dbConfigRatio is 100.
@@ -2096,6 +2114,92 @@ func (dbm *databaseManager) ReadLastPrunedBlockNumber() (uint64, error) {
 	return binary.LittleEndian.Uint64(lastPruned), nil
 }
 
+// ReadStateScheme returns the scheme of the state trie storage. An empty string is
+// returned if the scheme has never been written.
+func (dbm *databaseManager) ReadStateScheme() string {
+	data, _ := dbm.getDatabase(MiscDB).Get(stateSchemeKey)
+	return string(data)
+}
+
+// WriteStateScheme stores the scheme of the state trie storage.
+func (dbm *databaseManager) WriteStateScheme(scheme string) {
+	if err := dbm.getDatabase(MiscDB).Put(stateSchemeKey, []byte(scheme)); err != nil {
+		logger.Crit("Failed to store the state scheme", "err", err)
+	}
+}
+
+// ReadAccountTrieNode retrieves the account trie node at the given path stored in the
+// path-based scheme.
+func (dbm *databaseManager) ReadAccountTrieNode(path []byte) []byte {
+	data, _ := dbm.getDatabase(PathStateDB).Get(AccountTrieNodeKey(path))
+	return data
+}
+
+// ReadStorageTrieNode retrieves the storage trie node of the given owner (account hash)
+// at the given path stored in the path-based scheme.
+func (dbm *databaseManager) ReadStorageTrieNode(owner common.Hash, path []byte) []byte {
+	data, _ := dbm.getDatabase(PathStateDB).Get(StorageTrieNodeKey(owner, path))
+	return data
+}
+
+// ReadStateHistory retrieves the reverse diff of the state transition with the given id.
+func (dbm *databaseManager) ReadStateHistory(id uint64) []byte {
+	data, _ := dbm.getDatabase(PathStateDB).Get(StateHistoryKey(id))
+	return data
+}
+
+// ReadPersistentStateID retrieves the id of the state persisted in the path-based scheme.
+// Zero means that no state transition has been persisted.
+func (dbm *databaseManager) ReadPersistentStateID() uint64 {
+	data, _ := dbm.getDatabase(PathStateDB).Get(persistentStateIDKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// ReadStateID retrieves the id of the path-based state with the given root, or nil if
+// the state is unknown.
+func (dbm *databaseManager) ReadStateID(root common.Hash) *uint64 {
+	data, _ := dbm.getDatabase(PathStateDB).Get(StateIDKey(root))
+	if len(data) != 8 {
+		return nil
+	}
+	id := binary.BigEndian.Uint64(data)
+	return &id
+}
+
+// ReadHistoryTail returns the lowest block number whose body and receipts are retained.
+// Zero means that the history has never been expired.
+func (dbm *databaseManager) ReadHistoryTail() uint64 {
+	db := dbm.getDatabase(MiscDB)
+	data, _ := db.Get(historyTailKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// WriteHistoryTail stores the lowest block number whose body and receipts are retained.
+func (dbm *databaseManager) WriteHistoryTail(number uint64) {
+	db := dbm.getDatabase(MiscDB)
+	if err := db.Put(historyTailKey, common.Int64ToByteBigEndian(number)); err != nil {
+		logger.Crit("Failed to store the history tail", "err", err)
+	}
+}
+
+// DeleteBlockHistory removes the body, the receipts and the tx lookup entries of a
+// block. The header and the canonical hash of the block are left untouched.
+func (dbm *databaseManager) DeleteBlockHistory(hash common.Hash, number uint64) {
+	if body := dbm.ReadBody(hash, number); body != nil {
+		for _, tx := range body.Transactions {
+			dbm.DeleteTxLookupEntry(tx.Hash())
+		}
+	}
+	dbm.DeleteReceipts(hash, number)
+	dbm.DeleteBody(hash, number)
+}
+
 // ReadTxLookupEntry retrieves the positional metadata associated with a transaction
 // hash to allow retrieving the transaction or receipt by hash.
 func (dbm *databaseManager) ReadTxLookupEntry(hash common.Hash) (common.Hash, uint64, uint64) {
@@ -2977,6 +3081,88 @@ func (dbm *databaseManager) NewSnapshotDBBatch() SnapshotDBBatch {
 	return &snapshotDBBatch{dbm.NewBatch(SnapshotDB)}
 }
 
+func (dbm *databaseManager) NewPathStateDBBatch() PathStateDBBatch {
+	return &pathStateDBBatch{dbm.NewBatch(PathStateDB)}
+}
+
+// PathStateDBBatch is a batch of the path-based state trie, so that the trie nodes,
+// the state history and the persistent state id are written atomically.
+type PathStateDBBatch interface {
+	Batch
+
+	WriteAccountTrieNode(path []byte, node []byte)
+	DeleteAccountTrieNode(path []byte)
+
+	WriteStorageTrieNode(owner common.Hash, path []byte, node []byte)
+	DeleteStorageTrieNode(owner common.Hash, path []byte)
+
+	WriteStateHistory(id uint64, history []byte)
+	DeleteStateHistory(id uint64)
+
+	WritePersistentStateID(id uint64)
+
+	WriteStateID(root common.Hash, id uint64)
+	DeleteStateID(root common.Hash)
+}
+
+type pathStateDBBatch struct {
+	Batch
+}
+
+func (batch *pathStateDBBatch) WriteAccountTrieNode(path []byte, node []byte) {
+	if err := batch.Put(AccountTrieNodeKey(path), node); err != nil {
+		logger.Crit("Failed to store account trie node", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) DeleteAccountTrieNode(path []byte) {
+	if err := batch.Delete(AccountTrieNodeKey(path)); err != nil {
+		logger.Crit("Failed to delete account trie node", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) WriteStorageTrieNode(owner common.Hash, path []byte, node []byte) {
+	if err := batch.Put(StorageTrieNodeKey(owner, path), node); err != nil {
+		logger.Crit("Failed to store storage trie node", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) DeleteStorageTrieNode(owner common.Hash, path []byte) {
+	if err := batch.Delete(StorageTrieNodeKey(owner, path)); err != nil {
+		logger.Crit("Failed to delete storage trie node", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) WriteStateHistory(id uint64, history []byte) {
+	if err := batch.Put(StateHistoryKey(id), history); err != nil {
+		logger.Crit("Failed to store state history", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) DeleteStateHistory(id uint64) {
+	if err := batch.Delete(StateHistoryKey(id)); err != nil {
+		logger.Crit("Failed to delete state history", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) WritePersistentStateID(id uint64) {
+	if err := batch.Put(persistentStateIDKey, common.Int64ToByteBigEndian(id)); err != nil {
+		logger.Crit("Failed to store persistent state id", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) WriteStateID(root common.Hash, id uint64) {
+	if err := batch.Put(StateIDKey(root), common.Int64ToByteBigEndian(id)); err != nil {
+		logger.Crit("Failed to store state id", "err", err)
+	}
+}
+
+func (batch *pathStateDBBatch) DeleteStateID(root common.Hash) {
+	if err := batch.Delete(StateIDKey(root)); err != nil {
+		logger.Crit("Failed to delete state id", "err", err)
+	}
+}
+
 type SnapshotDBBatch interface {
 	Batch
 
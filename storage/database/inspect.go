@@ -0,0 +1,204 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+// unaccountedCategory is the category of the keys which match no known schema.
+const unaccountedCategory = "Unaccounted"
+
+// InspectStat is the number and the total size of the entries in a key category.
+type InspectStat struct {
+	Database string // Name of the logical database, or "single" if all share one
+	Category string
+	Count    uint64
+	Size     common.StorageSize // Sum of the key and value sizes
+}
+
+// keyCategory classifies a key into a category of the schema.
+type keyCategory struct {
+	name  string
+	match func(key []byte) bool
+}
+
+// hasPrefixAndLen returns a matcher for the keys with the given prefix and total length.
+func hasPrefixAndLen(prefix []byte, length int) func([]byte) bool {
+	return func(key []byte) bool {
+		return len(key) == length && bytes.HasPrefix(key, prefix)
+	}
+}
+
+// hasPrefix returns a matcher for the keys with the given prefix.
+func hasPrefix(prefix []byte) func([]byte) bool {
+	return func(key []byte) bool {
+		return bytes.HasPrefix(key, prefix)
+	}
+}
+
+// isTrieNodePath returns a matcher for the path-based trie node keys, which are the
+// given prefix, an owner of the given length, and a path of nibbles.
+func isTrieNodePath(prefix []byte, ownerLen int) func([]byte) bool {
+	return func(key []byte) bool {
+		if !bytes.HasPrefix(key, prefix) || len(key) < len(prefix)+ownerLen {
+			return false
+		}
+		for _, b := range key[len(prefix)+ownerLen:] {
+			if b > 0x10 { // nibbles and the terminator
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// metadataKeys are the single keys of the chain metadata.
+var metadataKeys = [][]byte{
+	databaseVerisionKey, headHeaderKey, headBlockKey, headBlockBackupKey, headFastBlockKey,
+	headFastBlockBackupKey, fastTrieProgressKey, validSectionKey, snapshotJournalKey,
+	SnapshotGeneratorKey, snapshotDisabledKey, snapshotRecoveryKey, snapshotSyncStatusKey,
+	snapshotRootKey, badBlockKey, pruningEnabledKey, lastPrunedBlockNumberKey, historyTailKey,
+	persistentStateIDKey, stateSchemeKey, governanceHistoryKey, governanceStateKey, migrationStatusKey,
+	lastSupplyCheckpointNumberKey, chaindatafetcherCheckpointKey, lastServiceChainTxReceiptKey,
+	lastIndexedBlockKey,
+}
+
+// keyCategories are matched in order, so the specific schemas come before the generic ones.
+var keyCategories = []keyCategory{
+	{"Headers", hasPrefixAndLen(headerPrefix, len(headerPrefix)+8+common.HashLength)},
+	{"Total difficulties", func(key []byte) bool {
+		return hasPrefixAndLen(headerPrefix, len(headerPrefix)+8+common.HashLength+len(headerTDSuffix))(key) &&
+			bytes.HasSuffix(key, headerTDSuffix)
+	}},
+	{"Canonical hashes", func(key []byte) bool {
+		return hasPrefixAndLen(headerPrefix, len(headerPrefix)+8+len(headerHashSuffix))(key) &&
+			bytes.HasSuffix(key, headerHashSuffix)
+	}},
+	{"Header numbers", hasPrefixAndLen(headerNumberPrefix, len(headerNumberPrefix)+common.HashLength)},
+	{"Bodies", hasPrefixAndLen(blockBodyPrefix, len(blockBodyPrefix)+8+common.HashLength)},
+	{"Receipts", hasPrefixAndLen(blockReceiptsPrefix, len(blockReceiptsPrefix)+8+common.HashLength)},
+	{"Tx lookups", hasPrefixAndLen(txLookupPrefix, len(txLookupPrefix)+common.HashLength)},
+	{"Contract codes", hasPrefixAndLen(codePrefix, len(codePrefix)+common.HashLength)},
+	{"Snapshot accounts", hasPrefixAndLen(SnapshotAccountPrefix, len(SnapshotAccountPrefix)+common.HashLength)},
+	{"Snapshot storage", hasPrefixAndLen(SnapshotStoragePrefix, len(SnapshotStoragePrefix)+2*common.HashLength)},
+	{"Bloom bits", hasPrefixAndLen(bloomBitsPrefix, len(bloomBitsPrefix)+2+8+common.HashLength)},
+	{"Bloom bits index", hasPrefix(BloomBitsIndexPrefix)},
+	{"Preimages", hasPrefixAndLen(preimagePrefix, len(preimagePrefix)+common.HashLength)},
+	{"Pruning marks", hasPrefixAndLen(pruningMarkPrefix, pruningMarkKeyLen)},
+	{"State histories", hasPrefixAndLen(stateHistoryPrefix, len(stateHistoryPrefix)+8)},
+	{"State ids", hasPrefixAndLen(stateIDPrefix, len(stateIDPrefix)+common.HashLength)},
+	{"Path-based account trie nodes", isTrieNodePath(TrieNodeAccountPrefix, 0)},
+	{"Path-based storage trie nodes", isTrieNodePath(TrieNodeStoragePrefix, common.HashLength)},
+	{"Hash-based trie nodes", func(key []byte) bool {
+		return len(key) == common.HashLength || len(key) == common.ExtHashLength
+	}},
+	{"Governance", func(key []byte) bool {
+		return bytes.HasPrefix(key, governancePrefix) && !bytes.Equal(key, governanceHistoryKey) &&
+			!bytes.Equal(key, governanceStateKey)
+	}},
+	{"Staking info", hasPrefix(stakingInfoPrefix)},
+	{"Supply checkpoints", func(key []byte) bool {
+		return bytes.HasPrefix(key, supplyCheckpointPrefix) && !bytes.Equal(key, lastSupplyCheckpointNumberKey)
+	}},
+	{"Clique snapshots", hasPrefix(snapshotKeyPrefix)},
+	{"Sender tx hashes", hasPrefix(senderTxHashToTxHashPrefix)},
+	{"Service chain", func(key []byte) bool {
+		return bytes.HasPrefix(key, childChainTxHashPrefix) || bytes.HasPrefix(key, receiptFromParentChainKeyPrefix) ||
+			bytes.HasPrefix(key, valueTransferTxHashPrefix) || bytes.HasPrefix(key, parentOperatorFeePayerPrefix) ||
+			bytes.HasPrefix(key, childOperatorFeePayerPrefix)
+	}},
+	{"Chain indexer", hasPrefix(sectionHeadKeyPrefix)},
+	{"Database config", func(key []byte) bool {
+		return bytes.HasPrefix(key, configPrefix) || bytes.HasPrefix(key, databaseDirPrefix)
+	}},
+	{"Metadata", func(key []byte) bool {
+		for _, meta := range metadataKeys {
+			if bytes.Equal(key, meta) {
+				return true
+			}
+		}
+		return false
+	}},
+}
+
+// categorizeKey returns the category of the given key in the schema.
+func categorizeKey(key []byte) string {
+	for _, category := range keyCategories {
+		if category.match(key) {
+			return category.name
+		}
+	}
+	return unaccountedCategory
+}
+
+// InspectDatabase iterates all the entries of the logical databases, and returns the
+// number and the size of the entries by key category. If the logical databases share
+// one physical database, the entries are reported once under the name "single".
+func InspectDatabase(dbm DBManager) ([]*InspectStat, error) {
+	var (
+		stats   []*InspectStat
+		visited = make(map[Database]bool)
+		start   = time.Now()
+		logged  = time.Now()
+		total   uint64
+	)
+	for et := MiscDB; et < databaseEntryTypeSize; et++ {
+		if et == StateTrieMigrationDB && !dbm.InMigration() {
+			continue
+		}
+		db := dbm.getDatabase(et)
+		if db == nil || visited[db] {
+			continue
+		}
+		visited[db] = true
+
+		name := et.String()
+		if dbm.IsSingle() || dbm.GetDBConfig().DBType == MemoryDB {
+			name = "single"
+		}
+		categories := make(map[string]*InspectStat)
+		it := db.NewIterator(nil, nil)
+		for it.Next() {
+			key := it.Key()
+			category := categorizeKey(key)
+			stat, ok := categories[category]
+			if !ok {
+				stat = &InspectStat{Database: name, Category: category}
+				categories[category] = stat
+				stats = append(stats, stat)
+			}
+			stat.Count++
+			stat.Size += common.StorageSize(len(key) + len(it.Value()))
+
+			if total++; time.Since(logged) > 8*time.Second {
+				logger.Info("Inspecting database", "database", name, "count", total,
+					"elapsed", common.PrettyDuration(time.Since(start)))
+				logged = time.Now()
+			}
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
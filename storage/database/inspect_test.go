@@ -0,0 +1,139 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestChain writes the canonical chain data of the given number of blocks.
+// Every block except the genesis block has a transaction.
+func writeTestChain(t *testing.T, dbm DBManager, n int) []*types.Block {
+	var blocks []*types.Block
+	parent := common.Hash{}
+	for i := 0; i < n; i++ {
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(int64(i)), ParentHash: parent})
+		var receipts types.Receipts
+		if i > 0 {
+			tx, err := genTransaction(uint64(i))
+			require.NoError(t, err)
+			block = block.WithBody(types.Transactions{tx})
+			receipts = types.Receipts{genReceipt(i)}
+		}
+		dbm.WriteHeader(block.Header())
+		dbm.WriteBody(block.Hash(), block.NumberU64(), block.Body())
+		dbm.WriteReceipts(block.Hash(), block.NumberU64(), receipts)
+		dbm.WriteCanonicalHash(block.Hash(), block.NumberU64())
+		dbm.WriteTxLookupEntries(block)
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	dbm.WriteHeadBlockHash(parent)
+	return blocks
+}
+
+func TestCategorizeKey(t *testing.T) {
+	hash := common.HexToHash("0xdeadbeef")
+	testcases := []struct {
+		key      []byte
+		expected string
+	}{
+		{headerKey(1, hash), "Headers"},
+		{headerTDKey(1, hash), "Total difficulties"},
+		{headerHashKey(1), "Canonical hashes"},
+		{headerNumberKey(hash), "Header numbers"},
+		{blockBodyKey(1, hash), "Bodies"},
+		{blockReceiptsKey(1, hash), "Receipts"},
+		{TxLookupKey(hash), "Tx lookups"},
+		{CodeKey(hash), "Contract codes"},
+		{AccountSnapshotKey(hash), "Snapshot accounts"},
+		{StorageSnapshotKey(hash, hash), "Snapshot storage"},
+		{AccountTrieNodeKey([]byte{0x1, 0x2}), "Path-based account trie nodes"},
+		{StorageTrieNodeKey(hash, nil), "Path-based storage trie nodes"},
+		{StateHistoryKey(1), "State histories"},
+		{StateIDKey(hash), "State ids"},
+		{hash.Bytes(), "Hash-based trie nodes"},
+		{hash.ExtendZero().Bytes(), "Hash-based trie nodes"},
+		{headBlockKey, "Metadata"},
+		{historyTailKey, "Metadata"},
+		{[]byte("unknown-key"), unaccountedCategory},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, categorizeKey(tc.key), "%x", tc.key)
+	}
+}
+
+func TestInspectDatabase(t *testing.T) {
+	dbm := NewMemoryDBManager()
+	writeTestChain(t, dbm, 4)
+	dbm.WriteCode(common.HexToHash("0x01"), []byte{0x60})
+
+	stats, err := InspectDatabase(dbm)
+	require.NoError(t, err)
+
+	counts := make(map[string]uint64)
+	for _, stat := range stats {
+		assert.Equal(t, "single", stat.Database)
+		assert.NotZero(t, stat.Size)
+		counts[stat.Category] = stat.Count
+	}
+	assert.Equal(t, uint64(4), counts["Headers"])
+	assert.Equal(t, uint64(4), counts["Canonical hashes"])
+	assert.Equal(t, uint64(4), counts["Header numbers"])
+	assert.Equal(t, uint64(4), counts["Bodies"])
+	assert.Equal(t, uint64(4), counts["Receipts"])
+	assert.Equal(t, uint64(3), counts["Tx lookups"])
+	assert.Equal(t, uint64(1), counts["Contract codes"])
+	assert.Zero(t, counts[unaccountedCategory])
+}
+
+func TestVerifyChain(t *testing.T) {
+	dbm := NewMemoryDBManager()
+	blocks := writeTestChain(t, dbm, 6)
+	assert.Empty(t, VerifyChain(dbm, 0, 5, 0))
+
+	// Break the chain data of blocks 2, 3 and 4.
+	dbm.DeleteReceipts(blocks[2].Hash(), 2)
+	dbm.DeleteTxLookupEntry(blocks[3].Transactions()[0].Hash())
+	dbm.DeleteBody(blocks[4].Hash(), 4)
+
+	issues := VerifyChain(dbm, 0, 5, 0)
+	require.Len(t, issues, 3)
+	for i, issue := range issues {
+		assert.Equal(t, uint64(i+2), issue.Number)
+		assert.Equal(t, blocks[i+2].Hash(), issue.Hash)
+	}
+	assert.Len(t, VerifyChain(dbm, 0, 5, 1), 1)
+	assert.Empty(t, VerifyChain(dbm, 5, 5, 0))
+
+	// The bodies and receipts behind the history tail are not checked.
+	dbm.WriteHistoryTail(5)
+	assert.Empty(t, VerifyChain(dbm, 0, 5, 0))
+
+	// A broken link of the canonical chain.
+	dbm.DeleteCanonicalHash(1)
+	issues = VerifyChain(dbm, 0, 5, 0)
+	require.Len(t, issues, 2)
+	assert.Equal(t, "missing canonical hash", issues[0].Reason)
+	assert.Equal(t, uint64(2), issues[1].Number)
+}
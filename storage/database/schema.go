@@ -102,6 +102,17 @@ var (
 	pruningMarkKeyLen        = len(pruningMarkPrefix) + 8 + common.ExtHashLength // prefix + num (uint64) + node hash
 	lastPrunedBlockNumberKey = []byte("lastPrunedBlockNumber")
 
+	historyTailKey = []byte("HistoryTail") // The lowest block number whose body and receipts are retained
+
+	stateSchemeKey = []byte("StateScheme") // The scheme of the state trie storage
+
+	// Path-based state trie scheme, stored in PathStateDB
+	TrieNodeAccountPrefix = []byte("A")             // TrieNodeAccountPrefix + hexPath -> account trie node
+	TrieNodeStoragePrefix = []byte("O")             // TrieNodeStoragePrefix + owner + hexPath -> storage trie node
+	stateHistoryPrefix    = []byte("StateHistory-") // stateHistoryPrefix + id (uint64 big endian) -> reverse diff
+	stateIDPrefix         = []byte("StateID-")      // stateIDPrefix + root -> id (uint64 big endian)
+	persistentStateIDKey  = []byte("PersistentStateID")
+
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
 	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 
@@ -138,6 +149,12 @@ var (
 	chaindatafetcherCheckpointKey = []byte("chaindatafetcherCheckpoint")
 )
 
+// The schemes of the state trie storage.
+const (
+	HashScheme = "hash" // Trie nodes are keyed by their hashes in StateTrieDB
+	PathScheme = "path" // Trie nodes are keyed by their owners and paths in PathStateDB
+)
+
 // TxLookupEntry is a positional metadata to help looking up the data content of
 // a transaction or receipt given only its hash.
 type TxLookupEntry struct {
@@ -181,6 +198,26 @@ func TxLookupKey(hash common.Hash) []byte {
 	return append(txLookupPrefix, hash.Bytes()...)
 }
 
+// AccountTrieNodeKey = TrieNodeAccountPrefix + hexPath
+func AccountTrieNodeKey(path []byte) []byte {
+	return append(common.CopyBytes(TrieNodeAccountPrefix), path...)
+}
+
+// StorageTrieNodeKey = TrieNodeStoragePrefix + owner + hexPath
+func StorageTrieNodeKey(owner common.Hash, path []byte) []byte {
+	return append(append(common.CopyBytes(TrieNodeStoragePrefix), owner.Bytes()...), path...)
+}
+
+// StateIDKey = stateIDPrefix + root
+func StateIDKey(root common.Hash) []byte {
+	return append(common.CopyBytes(stateIDPrefix), root.Bytes()...)
+}
+
+// StateHistoryKey = stateHistoryPrefix + id (uint64 big endian)
+func StateHistoryKey(id uint64) []byte {
+	return append(common.CopyBytes(stateHistoryPrefix), common.Int64ToByteBigEndian(id)...)
+}
+
 // AccountSnapshotKey = SnapshotAccountPrefix + hash
 func AccountSnapshotKey(hash common.Hash) []byte {
 	return append(SnapshotAccountPrefix, hash.Bytes()...)
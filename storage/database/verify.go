@@ -0,0 +1,99 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+// ChainIssue is an inconsistency of the chain data of a canonical block.
+type ChainIssue struct {
+	Number uint64
+	Hash   common.Hash
+	Reason string
+}
+
+func (issue *ChainIssue) String() string {
+	return fmt.Sprintf("block %d (%x): %s", issue.Number, issue.Hash, issue.Reason)
+}
+
+// VerifyChain checks that the canonical hashes, headers, bodies, receipts and tx lookup
+// entries of the blocks in [from, to] agree with each other. The bodies and receipts
+// behind the history tail are not checked, as they are deleted by history expiry.
+// At most limit issues are returned if limit is not zero.
+func VerifyChain(dbm DBManager, from, to uint64, limit int) []*ChainIssue {
+	var (
+		issues []*ChainIssue
+		tail   = dbm.ReadHistoryTail()
+		start  = time.Now()
+		logged = time.Now()
+	)
+	report := func(num uint64, hash common.Hash, format string, args ...interface{}) {
+		issues = append(issues, &ChainIssue{Number: num, Hash: hash, Reason: fmt.Sprintf(format, args...)})
+	}
+	for num := from; num <= to && (limit == 0 || len(issues) < limit); num++ {
+		if time.Since(logged) > 8*time.Second {
+			logger.Info("Verifying chain data", "number", num, "to", to, "issues", len(issues),
+				"elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+		hash := dbm.ReadCanonicalHash(num)
+		if hash == (common.Hash{}) {
+			report(num, hash, "missing canonical hash")
+			continue
+		}
+		header := dbm.ReadHeader(hash, num)
+		if header == nil {
+			report(num, hash, "missing header")
+			continue
+		}
+		if header.Hash() != hash {
+			report(num, hash, "header hash mismatch: have %x", header.Hash())
+		}
+		if number := dbm.ReadHeaderNumber(hash); number == nil || *number != num {
+			report(num, hash, "missing or wrong header number index")
+		}
+		if num > 0 {
+			if parent := dbm.ReadCanonicalHash(num - 1); parent != header.ParentHash {
+				report(num, hash, "parent hash mismatch: header %x, canonical %x", header.ParentHash, parent)
+			}
+		}
+		if num != 0 && num < tail {
+			continue // The body and receipts have been expired.
+		}
+
+		body := dbm.ReadBody(hash, num)
+		if body == nil {
+			report(num, hash, "missing body")
+			continue
+		}
+		if receipts := dbm.ReadReceipts(hash, num); len(receipts) != len(body.Transactions) {
+			report(num, hash, "receipts mismatch: %d receipts for %d txs", len(receipts), len(body.Transactions))
+		}
+		for i, tx := range body.Transactions {
+			blockHash, blockIndex, index := dbm.ReadTxLookupEntry(tx.Hash())
+			if blockHash != hash || blockIndex != num || index != uint64(i) {
+				report(num, hash, "tx lookup mismatch: tx %x at %d", tx.Hash(), i)
+				break
+			}
+		}
+	}
+	return issues
+}
@@ -0,0 +1,276 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package pathdb implements the path-based scheme of the state trie storage.
+//
+// In the path-based scheme, a trie node is keyed by its owner and its path from the
+// trie root instead of its hash. A path holds only the latest node, so the stale nodes
+// are overwritten in place and no pruning is needed. The recent state transitions are
+// kept in memory as diff layers on top of the disk layer, and every transition
+// flattened into the disk leaves a reverse diff (state history) to roll it back.
+//
+// The state of the path-based scheme is stored in the PathStateDB, apart from the
+// hash-based nodes in the StateTrieDB. It backs statedb.Database if the database is
+// set to the path-based scheme.
+package pathdb
+
+import (
+	"sync"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/log"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+var logger = log.NewModuleLogger(log.StoragePathDB)
+
+// DefaultStateHistory is the default number of recent state histories kept on the disk.
+const DefaultStateHistory = 90000
+
+// Config is the configuration of the path-based state database.
+type Config struct {
+	StateHistory uint64 // Number of recent state histories kept on the disk, 0 keeps all
+}
+
+// Database is the path-based state database. It manages a tree of diff layers
+// on top of a single disk layer.
+type Database struct {
+	diskdb database.DBManager
+	config *Config
+
+	layers map[common.Hash]layer
+	lock   sync.RWMutex
+}
+
+// New opens the path-based state persisted in the given database.
+func New(diskdb database.DBManager, config *Config) *Database {
+	if config == nil {
+		config = &Config{StateHistory: DefaultStateHistory}
+	}
+	db := &Database{diskdb: diskdb, config: config}
+	disk := newDiskLayer(diskRoot(diskdb), diskdb.ReadPersistentStateID(), db)
+	db.layers = map[common.Hash]layer{disk.root: disk}
+	return db
+}
+
+// diskRoot returns the state root of the path-based state persisted on the disk.
+func diskRoot(diskdb database.DBManager) common.Hash {
+	blob := diskdb.ReadAccountTrieNode(nil)
+	if len(blob) == 0 {
+		return types.EmptyRootHashOriginal
+	}
+	return crypto.Keccak256Hash(blob)
+}
+
+// disk returns the disk layer. The caller must hold the lock.
+func (db *Database) disk() *diskLayer {
+	for _, l := range db.layers {
+		for l.parentLayer() != nil {
+			l = l.parentLayer()
+		}
+		return l.(*diskLayer)
+	}
+	return nil
+}
+
+// DiskRoot returns the state root persisted on the disk.
+func (db *Database) DiskRoot() common.Hash {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return db.disk().root
+}
+
+// Reader returns a reader of the state with the given root.
+func (db *Database) Reader(root common.Hash) (*Reader, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	l, ok := db.layers[root]
+	if !ok {
+		return nil, errMissingLayer
+	}
+	return &Reader{layer: l}, nil
+}
+
+// Update adds a diff layer of the state transition from parentRoot to root.
+func (db *Database) Update(root, parentRoot common.Hash, block uint64, nodes NodeSet) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if root == parentRoot {
+		return nil // Empty transition, nothing to add.
+	}
+	if _, ok := db.layers[root]; ok {
+		return errLayerExists
+	}
+	parent, ok := db.layers[parentRoot]
+	if !ok {
+		return errMissingLayer
+	}
+	db.layers[root] = newDiffLayer(parent, root, parent.stateID()+1, block, nodes)
+	return nil
+}
+
+// Cap flattens the diff layers below the given root into the disk, so that at most
+// the given number of diff layers are left in memory. The layers which no longer
+// descend from the disk layer are dropped.
+func (db *Database) Cap(root common.Hash, layers int) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	l, ok := db.layers[root]
+	if !ok {
+		return errMissingLayer
+	}
+	var chain []*diffLayer // From the given root down to the disk layer
+	for {
+		diff, ok := l.(*diffLayer)
+		if !ok {
+			break
+		}
+		chain = append(chain, diff)
+		l = diff.parentLayer()
+	}
+	if len(chain) <= layers {
+		return nil
+	}
+	base := l.(*diskLayer)
+	for i := len(chain) - 1; i >= layers; i-- {
+		var err error
+		if base, err = base.commit(chain[i]); err != nil {
+			return err
+		}
+	}
+	db.rebuild(base)
+	return nil
+}
+
+// Commit flattens all the diff layers below the given root into the disk.
+func (db *Database) Commit(root common.Hash) error {
+	return db.Cap(root, 0)
+}
+
+// rebuild resets the layer tree on the given disk layer. The diff layers on the
+// flattened states are moved onto the disk layer, and the others are dropped.
+// The caller must hold the lock.
+func (db *Database) rebuild(base *diskLayer) {
+	children := make(map[common.Hash][]*diffLayer)
+	for _, l := range db.layers {
+		if diff, ok := l.(*diffLayer); ok {
+			parent := diff.parentLayer().rootHash()
+			children[parent] = append(children[parent], diff)
+		}
+	}
+	db.layers = map[common.Hash]layer{base.root: base}
+
+	queue := []layer{base}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range children[parent.rootHash()] {
+			if child.root == base.root {
+				continue // Flattened into the disk layer.
+			}
+			if parent == layer(base) {
+				child.setParent(base)
+			}
+			db.layers[child.root] = child
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Recoverable returns true if the disk state can be rolled back to the given root.
+func (db *Database) Recoverable(root common.Hash) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	id := db.diskdb.ReadStateID(root)
+	if id == nil || *id >= db.disk().id {
+		return false
+	}
+	// The histories are contiguous, so it's enough to check the oldest one needed.
+	return len(db.diskdb.ReadStateHistory(*id+1)) != 0
+}
+
+// Recover rolls the disk state back to the given root with the reverse diffs.
+// All the diff layers are dropped, as they descend from the reverted state.
+func (db *Database) Recover(root common.Hash) error {
+	if !db.Recoverable(root) {
+		return errUnrecoverable
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	disk := db.disk()
+	for disk.root != root {
+		h, err := readHistory(db.diskdb, disk.id)
+		if err != nil {
+			return err
+		}
+		if disk, err = disk.revert(h); err != nil {
+			return err
+		}
+		logger.Debug("Reverted a state transition", "root", h.Root, "parent", h.Parent, "block", h.Block)
+	}
+	db.layers = map[common.Hash]layer{disk.root: disk}
+	logger.Info("Recovered the path-based state", "root", root, "id", disk.id)
+	return nil
+}
+
+// Size returns the number of diff layers and the memory size of their nodes.
+func (db *Database) Size() (int, common.StorageSize) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	var (
+		count int
+		size  common.StorageSize
+	)
+	for _, l := range db.layers {
+		if diff, ok := l.(*diffLayer); ok {
+			_, s := diff.nodes.Size()
+			count, size = count+1, size+s
+		}
+	}
+	return count, size
+}
+
+// Node returns the blob of the node at the given owner and path persisted on the disk.
+// An error is returned if the node doesn't have the given hash, i.e. the path holds
+// the node of another state. Unlike Reader, it doesn't depend on the layers of this
+// instance, so it always reflects the latest disk state.
+func (db *Database) Node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	blob := readNode(db.diskdb, owner, path)
+	if have := crypto.Keccak256Hash(blob); have != hash {
+		return nil, newUnexpectedNodeError("disk", owner, path, have, hash)
+	}
+	return blob, nil
+}
+
+// Reader reads the trie nodes of a state.
+type Reader struct {
+	layer layer
+}
+
+// Node returns the blob of the node at the given owner and path. The owner is the
+// zero hash for the account trie and the account hash for a storage trie. An error is
+// returned if the node doesn't have the given hash.
+func (r *Reader) Node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	return r.layer.node(owner, path, hash)
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testOwner = common.HexToHash("0x01")
+	testPath  = []byte{0x1, 0x2}
+)
+
+// makeTestNodes returns the nodes of a test state transition and its root.
+// The account trie root and a storage node are changed in every transition,
+// and testPath in the account trie is deleted if del is true.
+func makeTestNodes(name string, del bool) (common.Hash, NodeSet) {
+	nodes := NewNodeSet()
+	root := NewNode([]byte("root-" + name))
+	nodes.Add(common.Hash{}, nil, root)
+	nodes.Add(testOwner, nil, NewNode([]byte("storage-"+name)))
+	if del {
+		nodes.Add(common.Hash{}, testPath, NewDeletedNode())
+	} else {
+		nodes.Add(common.Hash{}, testPath, NewNode([]byte("account-"+name)))
+	}
+	return root.Hash, nodes
+}
+
+func hashOf(s string) common.Hash {
+	return crypto.Keccak256Hash([]byte(s))
+}
+
+func TestDatabase_UpdateCapRecover(t *testing.T) {
+	diskdb := database.NewMemoryDBManager()
+	db := New(diskdb, nil)
+	assert.Equal(t, types.EmptyRootHashOriginal, db.DiskRoot())
+
+	roots := []common.Hash{types.EmptyRootHashOriginal}
+	for i := 1; i <= 4; i++ {
+		root, nodes := makeTestNodes(fmt.Sprint(i), i == 3)
+		require.NoError(t, db.Update(root, roots[i-1], uint64(i), nodes))
+		roots = append(roots, root)
+	}
+	assert.ErrorIs(t, db.Update(roots[4], roots[3], 4, NewNodeSet()), errLayerExists)
+	assert.ErrorIs(t, db.Update(common.HexToHash("0xff"), common.HexToHash("0xfe"), 5, NewNodeSet()), errMissingLayer)
+
+	// Read the nodes from the diff layers.
+	reader, err := db.Reader(roots[2])
+	require.NoError(t, err)
+	blob, err := reader.Node(common.Hash{}, testPath, hashOf("account-2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("account-2"), blob)
+	blob, err = reader.Node(testOwner, nil, hashOf("storage-2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("storage-2"), blob)
+	_, err = reader.Node(common.Hash{}, testPath, hashOf("account-1"))
+	assert.ErrorIs(t, err, errUnexpectedNode)
+
+	reader, err = db.Reader(roots[3])
+	require.NoError(t, err)
+	_, err = reader.Node(common.Hash{}, testPath, hashOf("account-2"))
+	assert.ErrorIs(t, err, errUnexpectedNode) // deleted by the 3rd transition
+
+	// Flatten the bottom two layers into the disk.
+	require.NoError(t, db.Cap(roots[4], 2))
+	assert.Equal(t, roots[2], db.DiskRoot())
+	count, _ := db.Size()
+	assert.Equal(t, 2, count)
+	assert.Equal(t, uint64(2), diskdb.ReadPersistentStateID())
+	assert.Equal(t, []byte("account-2"), diskdb.ReadAccountTrieNode(testPath))
+	_, err = db.Reader(roots[1])
+	assert.ErrorIs(t, err, errMissingLayer)
+
+	reader, err = db.Reader(roots[4])
+	require.NoError(t, err)
+	blob, err = reader.Node(common.Hash{}, nil, roots[4])
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("root-4"), blob)
+
+	// Flatten the rest.
+	require.NoError(t, db.Commit(roots[4]))
+	assert.Equal(t, roots[4], db.DiskRoot())
+	assert.Equal(t, []byte("account-4"), diskdb.ReadAccountTrieNode(testPath))
+	assert.Equal(t, []byte("storage-4"), diskdb.ReadStorageTrieNode(testOwner, nil))
+
+	// Roll the disk state back with the reverse diffs.
+	assert.False(t, db.Recoverable(roots[4]))
+	assert.True(t, db.Recoverable(roots[1]))
+	assert.True(t, db.Recoverable(roots[3]))
+	assert.True(t, db.Recoverable(types.EmptyRootHashOriginal))
+	assert.False(t, db.Recoverable(common.HexToHash("0xff")))
+	assert.ErrorIs(t, db.Recover(common.HexToHash("0xff")), errUnrecoverable)
+
+	require.NoError(t, db.Recover(roots[3]))
+	assert.Nil(t, diskdb.ReadAccountTrieNode(testPath)) // deleted by the 3rd transition
+
+	require.NoError(t, db.Recover(roots[1]))
+	assert.Equal(t, roots[1], db.DiskRoot())
+	assert.Equal(t, uint64(1), diskdb.ReadPersistentStateID())
+	assert.Equal(t, []byte("account-1"), diskdb.ReadAccountTrieNode(testPath))
+	assert.Equal(t, []byte("storage-1"), diskdb.ReadStorageTrieNode(testOwner, nil))
+	assert.Nil(t, diskdb.ReadStateHistory(2))
+	assert.NotNil(t, diskdb.ReadStateHistory(1))
+
+	// The persisted state is loaded on reopen.
+	db = New(diskdb, nil)
+	assert.Equal(t, roots[1], db.DiskRoot())
+	require.NoError(t, db.Recover(types.EmptyRootHashOriginal))
+	assert.Nil(t, diskdb.ReadAccountTrieNode(nil))
+	assert.Nil(t, diskdb.ReadStorageTrieNode(testOwner, nil))
+}
+
+func TestDatabase_CapDropsOrphans(t *testing.T) {
+	db := New(database.NewMemoryDBManager(), nil)
+	base := db.DiskRoot()
+
+	a1, nodes := makeTestNodes("a1", false)
+	require.NoError(t, db.Update(a1, base, 1, nodes))
+	a2, nodes := makeTestNodes("a2", false)
+	require.NoError(t, db.Update(a2, a1, 2, nodes))
+	a2b, nodes := makeTestNodes("a2b", false)
+	require.NoError(t, db.Update(a2b, a1, 2, nodes))
+	b1, nodes := makeTestNodes("b1", false)
+	require.NoError(t, db.Update(b1, base, 1, nodes))
+
+	// The siblings on the flattened layer are kept, while the other branch is dropped.
+	require.NoError(t, db.Cap(a2, 1))
+	assert.Equal(t, a1, db.DiskRoot())
+	_, err := db.Reader(b1)
+	assert.ErrorIs(t, err, errMissingLayer)
+
+	for _, name := range []string{"a2", "a2b"} {
+		root := NewNode([]byte("root-" + name)).Hash
+		reader, err := db.Reader(root)
+		require.NoError(t, err, name)
+		blob, err := reader.Node(testOwner, nil, hashOf("storage-"+name))
+		assert.NoError(t, err, name)
+		assert.Equal(t, []byte("storage-"+name), blob)
+	}
+	// The nodes which are not in the diff layers are read from the new disk layer.
+	reader, err := db.Reader(a2b)
+	require.NoError(t, err)
+	_, err = reader.Node(common.Hash{}, []byte{0xf}, hashOf("missing"))
+	assert.ErrorIs(t, err, errUnexpectedNode)
+}
+
+func TestDatabase_StateHistoryLimit(t *testing.T) {
+	diskdb := database.NewMemoryDBManager()
+	db := New(diskdb, &Config{StateHistory: 2})
+
+	roots := []common.Hash{db.DiskRoot()}
+	for i := 1; i <= 4; i++ {
+		root, nodes := makeTestNodes(fmt.Sprint(i), false)
+		require.NoError(t, db.Update(root, roots[i-1], uint64(i), nodes))
+		require.NoError(t, db.Commit(root))
+		roots = append(roots, root)
+	}
+	assert.Nil(t, diskdb.ReadStateHistory(1))
+	assert.Nil(t, diskdb.ReadStateHistory(2))
+	assert.NotNil(t, diskdb.ReadStateHistory(3))
+	assert.NotNil(t, diskdb.ReadStateHistory(4))
+
+	assert.False(t, db.Recoverable(roots[1]))
+	assert.True(t, db.Recoverable(roots[2]))
+}
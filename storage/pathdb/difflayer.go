@@ -0,0 +1,86 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"sync"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+// layer is a state in the layer tree, either a diff layer in memory or the disk layer.
+type layer interface {
+	// rootHash returns the state root of the layer.
+	rootHash() common.Hash
+
+	// stateID returns the id of the state transition which created the layer.
+	stateID() uint64
+
+	// parentLayer returns the parent layer, or nil for the disk layer.
+	parentLayer() layer
+
+	// node returns the blob of the node at the given owner and path, which must
+	// have the given hash.
+	node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error)
+}
+
+// diffLayer is a state transition kept in memory on top of its parent layer.
+type diffLayer struct {
+	root  common.Hash
+	id    uint64
+	block uint64
+	nodes NodeSet
+
+	parent layer
+	lock   sync.RWMutex
+}
+
+func newDiffLayer(parent layer, root common.Hash, id, block uint64, nodes NodeSet) *diffLayer {
+	return &diffLayer{root: root, id: id, block: block, nodes: nodes, parent: parent}
+}
+
+func (dl *diffLayer) rootHash() common.Hash {
+	return dl.root
+}
+
+func (dl *diffLayer) stateID() uint64 {
+	return dl.id
+}
+
+func (dl *diffLayer) parentLayer() layer {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+	return dl.parent
+}
+
+// setParent replaces the parent with a layer of the same state, after the
+// parent has been flattened into the disk layer.
+func (dl *diffLayer) setParent(parent layer) {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+	dl.parent = parent
+}
+
+func (dl *diffLayer) node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	if n, ok := dl.nodes.node(owner, path); ok {
+		if n.Hash != hash {
+			return nil, newUnexpectedNodeError("diff", owner, path, n.Hash, hash)
+		}
+		return n.Blob, nil
+	}
+	return dl.parentLayer().node(owner, path, hash)
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/rlp"
+)
+
+// diskLayer is the state persisted on the disk. There is only one live disk layer
+// at a time; the previous one becomes stale when a diff layer is flattened into it.
+type diskLayer struct {
+	root common.Hash
+	id   uint64
+	db   *Database
+
+	stale bool
+	lock  sync.RWMutex
+}
+
+func newDiskLayer(root common.Hash, id uint64, db *Database) *diskLayer {
+	return &diskLayer{root: root, id: id, db: db}
+}
+
+func (dl *diskLayer) rootHash() common.Hash {
+	return dl.root
+}
+
+func (dl *diskLayer) stateID() uint64 {
+	return dl.id
+}
+
+func (dl *diskLayer) parentLayer() layer {
+	return nil
+}
+
+func (dl *diskLayer) node(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	dl.lock.RLock()
+	defer dl.lock.RUnlock()
+
+	if dl.stale {
+		return nil, errStaleLayer
+	}
+	blob := readNode(dl.db.diskdb, owner, path)
+	if have := crypto.Keccak256Hash(blob); have != hash {
+		return nil, newUnexpectedNodeError("disk", owner, path, have, hash)
+	}
+	return blob, nil
+}
+
+// commit flattens the given diff layer, whose parent must be this layer, into the disk.
+// The nodes, the reverse diff and the new state id are written in a single batch,
+// so the disk state is always consistent with the latest history.
+func (dl *diskLayer) commit(bottom *diffLayer) (*diskLayer, error) {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+
+	if dl.stale {
+		return nil, errStaleLayer
+	}
+	if bottom.id != dl.id+1 {
+		return nil, fmt.Errorf("non-sequential state id: disk %d, diff %d", dl.id, bottom.id)
+	}
+	diskdb := dl.db.diskdb
+	enc, err := rlp.EncodeToBytes(newHistory(diskdb, dl.root, bottom.root, bottom.block, bottom.nodes))
+	if err != nil {
+		return nil, err
+	}
+
+	batch := diskdb.NewPathStateDBBatch()
+	defer batch.Release()
+
+	batch.WriteStateHistory(bottom.id, enc)
+	writeNodes(batch, bottom.nodes)
+	batch.WritePersistentStateID(bottom.id)
+	batch.WriteStateID(dl.root, dl.id)
+	batch.WriteStateID(bottom.root, bottom.id)
+
+	// Prune the histories beyond the retention. The older ones have been pruned
+	// by the previous commits unless the retention has been shortened. The parent
+	// state of a pruned history is no longer recoverable.
+	if limit := dl.db.config.StateHistory; limit != 0 && bottom.id > limit {
+		for id := bottom.id - limit; id > 0; id-- {
+			h, err := readHistory(diskdb, id)
+			if err != nil {
+				break
+			}
+			batch.DeleteStateHistory(id)
+			if prev := diskdb.ReadStateID(h.Parent); prev != nil && *prev == id-1 {
+				batch.DeleteStateID(h.Parent)
+			}
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	dl.stale = true
+
+	count, size := bottom.nodes.Size()
+	logger.Debug("Flattened a diff layer into the disk", "root", bottom.root, "id", bottom.id,
+		"block", bottom.block, "nodes", count, "size", size)
+	return newDiskLayer(bottom.root, bottom.id, dl.db), nil
+}
+
+// revert rolls the disk state back to the parent of the given history, which must be
+// the history of this layer. The history is deleted together with the reverted nodes.
+func (dl *diskLayer) revert(h *history) (*diskLayer, error) {
+	dl.lock.Lock()
+	defer dl.lock.Unlock()
+
+	if dl.stale {
+		return nil, errStaleLayer
+	}
+	if h.Root != dl.root {
+		return nil, fmt.Errorf("%w: history root %x, disk root %x", errUnrecoverable, h.Root, dl.root)
+	}
+	batch := dl.db.diskdb.NewPathStateDBBatch()
+	defer batch.Release()
+
+	writeNodes(batch, h.nodeSet())
+	batch.DeleteStateHistory(dl.id)
+	batch.WritePersistentStateID(dl.id - 1)
+	if id := dl.db.diskdb.ReadStateID(dl.root); id != nil && *id == dl.id {
+		batch.DeleteStateID(dl.root)
+	}
+
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	dl.stale = true
+	return newDiskLayer(h.Parent, dl.id-1, dl.db), nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+var (
+	// errStaleLayer is returned when a layer is read after it has been flattened
+	// into the disk layer or dropped as an orphan.
+	errStaleLayer = errors.New("layer stale")
+
+	// errMissingLayer is returned when no layer exists for the requested root.
+	errMissingLayer = errors.New("layer not found")
+
+	// errLayerExists is returned when a layer for the root has already been added.
+	errLayerExists = errors.New("layer already exists")
+
+	// errMissingHistory is returned when the reverse diff of a state transition is missing.
+	errMissingHistory = errors.New("state history not found")
+
+	// errUnrecoverable is returned when the disk state cannot be rolled back to the
+	// requested root with the stored reverse diffs.
+	errUnrecoverable = errors.New("state is unrecoverable")
+
+	// errUnexpectedNode is returned when the node at the requested path has an
+	// unexpected hash, i.e. the path has been overwritten by another state.
+	errUnexpectedNode = errors.New("unexpected node")
+)
+
+// newUnexpectedNodeError returns an errUnexpectedNode with the details of the node.
+func newUnexpectedNodeError(loc string, owner common.Hash, path []byte, have, want common.Hash) error {
+	return fmt.Errorf("%w: %s, owner %x, path %x, have %x, want %x", errUnexpectedNode, loc, owner, path, have, want)
+}
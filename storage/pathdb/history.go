@@ -0,0 +1,93 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+// history is the reverse diff of a state transition persisted on the disk.
+// It holds the previous blobs of the nodes changed by the transition,
+// so that the disk state can be rolled back to the parent root.
+type history struct {
+	Parent common.Hash // The state root before the transition
+	Root   common.Hash // The state root after the transition
+	Block  uint64      // The number of the block of the transition
+	Nodes  []historyNode
+}
+
+// historyNode is the previous blob of a changed node. An empty blob means
+// that the node didn't exist before the transition.
+type historyNode struct {
+	Owner common.Hash
+	Path  []byte
+	Blob  []byte
+}
+
+// newHistory creates the reverse diff of applying the nodes on the current disk state.
+// The nodes are sorted to make the encoding deterministic.
+func newHistory(db database.DBManager, parent, root common.Hash, block uint64, nodes NodeSet) *history {
+	h := &history{Parent: parent, Root: root, Block: block}
+	for owner, subset := range nodes {
+		for path := range subset {
+			h.Nodes = append(h.Nodes, historyNode{
+				Owner: owner,
+				Path:  []byte(path),
+				Blob:  readNode(db, owner, []byte(path)),
+			})
+		}
+	}
+	sort.Slice(h.Nodes, func(i, j int) bool {
+		if c := bytes.Compare(h.Nodes[i].Owner[:], h.Nodes[j].Owner[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(h.Nodes[i].Path, h.Nodes[j].Path) < 0
+	})
+	return h
+}
+
+// readHistory reads the reverse diff of the state transition with the given id.
+func readHistory(db database.DBManager, id uint64) (*history, error) {
+	blob := db.ReadStateHistory(id)
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("%w: id %d", errMissingHistory, id)
+	}
+	h := new(history)
+	if err := rlp.DecodeBytes(blob, h); err != nil {
+		return nil, fmt.Errorf("invalid state history %d: %w", id, err)
+	}
+	return h, nil
+}
+
+// nodeSet returns the changes reverting the transition as a NodeSet.
+func (h *history) nodeSet() NodeSet {
+	nodes := NewNodeSet()
+	for _, n := range h.Nodes {
+		if len(n.Blob) == 0 {
+			nodes.Add(n.Owner, n.Path, NewDeletedNode())
+		} else {
+			nodes.Add(n.Owner, n.Path, NewNode(n.Blob))
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package pathdb
+
+import (
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+// Node is a trie node identified by its owner and path.
+// A node with an empty blob represents a deleted node.
+type Node struct {
+	Hash common.Hash
+	Blob []byte
+}
+
+// NewNode creates a node with the given blob. The hash is derived from the blob.
+func NewNode(blob []byte) *Node {
+	return &Node{Hash: crypto.Keccak256Hash(blob), Blob: blob}
+}
+
+// NewDeletedNode creates a node marking the deletion of the node at its path.
+func NewDeletedNode() *Node {
+	return &Node{}
+}
+
+// IsDeleted returns true if the node marks a deletion.
+func (n *Node) IsDeleted() bool {
+	return len(n.Blob) == 0
+}
+
+// NodeSet is a set of the trie nodes changed by a state transition, grouped by owner.
+// The owner is the zero hash for the account trie, and the account hash for a storage trie.
+type NodeSet map[common.Hash]map[string]*Node
+
+// NewNodeSet creates an empty NodeSet.
+func NewNodeSet() NodeSet {
+	return make(NodeSet)
+}
+
+// Add inserts the node at the given owner and path, overwriting the previous one.
+func (s NodeSet) Add(owner common.Hash, path []byte, node *Node) {
+	subset, ok := s[owner]
+	if !ok {
+		subset = make(map[string]*Node)
+		s[owner] = subset
+	}
+	subset[string(path)] = node
+}
+
+// node returns the node at the given owner and path if it is in the set.
+func (s NodeSet) node(owner common.Hash, path []byte) (*Node, bool) {
+	subset, ok := s[owner]
+	if !ok {
+		return nil, false
+	}
+	n, ok := subset[string(path)]
+	return n, ok
+}
+
+// Size returns the number of nodes and the approximate memory size of the set.
+func (s NodeSet) Size() (int, common.StorageSize) {
+	var (
+		count int
+		size  common.StorageSize
+	)
+	for _, subset := range s {
+		for path, n := range subset {
+			count++
+			size += common.StorageSize(common.HashLength + len(path) + len(n.Blob))
+		}
+	}
+	return count, size
+}
+
+// readNode reads the node at the given owner and path from the disk.
+func readNode(db database.DBManager, owner common.Hash, path []byte) []byte {
+	if owner == (common.Hash{}) {
+		return db.ReadAccountTrieNode(path)
+	}
+	return db.ReadStorageTrieNode(owner, path)
+}
+
+// writeNodes writes the nodes into the given batch, deleting the removed ones.
+func writeNodes(batch database.PathStateDBBatch, nodes NodeSet) {
+	for owner, subset := range nodes {
+		for path, n := range subset {
+			switch {
+			case owner == (common.Hash{}) && n.IsDeleted():
+				batch.DeleteAccountTrieNode([]byte(path))
+			case owner == (common.Hash{}):
+				batch.WriteAccountTrieNode([]byte(path), n.Blob)
+			case n.IsDeleted():
+				batch.DeleteStorageTrieNode(owner, []byte(path))
+			default:
+				batch.WriteStorageTrieNode(owner, []byte(path), n.Blob)
+			}
+		}
+	}
+}
@@ -40,6 +40,8 @@ type TrieNodeCacheConfig struct {
 	RedisClusterEnable        bool          // Enable cluster-enabled mode of redis cache
 	RedisPublishBlockEnable   bool          // Enable publishing every inserted block to the redis server
 	RedisSubscribeBlockEnable bool          // Enable subscribing blocks from the redis server
+
+	Backend TrieNodeCacheBackend // External key/value store used by BackendCache
 }
 
 func (c *TrieNodeCacheConfig) DumpPeriodically() bool {
@@ -58,6 +60,7 @@ type TrieNodeCache interface {
 	Has(k []byte) ([]byte, bool)
 	UpdateStats() interface{}
 	SaveToFile(filePath string, concurrency int) error
+	LoadFromFile(filePath string) error
 	Close() error
 }
 
@@ -69,14 +72,16 @@ type BlockPubSub interface {
 
 const (
 	// Available trie node cache types
-	CacheTypeLocal  TrieNodeCacheType = "LocalCache"
-	CacheTypeRedis                    = "RemoteCache"
-	CacheTypeHybrid                   = "HybridCache"
+	CacheTypeLocal   TrieNodeCacheType = "LocalCache"
+	CacheTypeRedis                     = "RemoteCache"
+	CacheTypeHybrid                    = "HybridCache"
+	CacheTypeBackend                   = "BackendCache"
 )
 
 var (
-	errNotSupportedCacheType  = errors.New("not supported stateDB TrieNodeCache type")
-	errNilTrieNodeCacheConfig = errors.New("TrieNodeCacheConfig is nil")
+	errNotSupportedCacheType   = errors.New("not supported stateDB TrieNodeCache type")
+	errNilTrieNodeCacheConfig  = errors.New("TrieNodeCacheConfig is nil")
+	errNilTrieNodeCacheBackend = errors.New("TrieNodeCacheBackend is nil")
 )
 
 func (cacheType TrieNodeCacheType) ToValid() TrieNodeCacheType {
@@ -104,6 +109,8 @@ func NewTrieNodeCache(config *TrieNodeCacheConfig) (TrieNodeCache, error) {
 	case CacheTypeHybrid:
 		logger.Info("Set hybrid trie node cache using both of localCache (fastCache) and redisCache")
 		return newHybridCache(config)
+	case CacheTypeBackend:
+		return newBackendCache(config)
 	default:
 	}
 	logger.Error("Invalid trie node cache type", "cacheType", config.CacheType)
@@ -0,0 +1,148 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/rcrowley/go-metrics"
+)
+
+var (
+	memcacheBackendHits   = metrics.NewRegisteredGauge("trie/memcache/backend/hits", nil)
+	memcacheBackendMisses = metrics.NewRegisteredGauge("trie/memcache/backend/misses", nil)
+	memcacheBackendErrors = metrics.NewRegisteredGauge("trie/memcache/backend/errors", nil)
+)
+
+// TrieNodeCacheBackend is a key/value store which can be shared by multiple nodes,
+// such as an external cache service. It is plugged into the trie node cache through
+// TrieNodeCacheConfig.Backend with CacheTypeBackend.
+type TrieNodeCacheBackend interface {
+	// Get returns the value of the given key. It returns (nil, nil) if the key doesn't exist.
+	Get(k []byte) ([]byte, error)
+	// Set stores the given key/value pair.
+	Set(k, v []byte) error
+	// Close releases the resources of the backend.
+	Close() error
+}
+
+// BackendCacheStats is the statistics of BackendCache.
+type BackendCacheStats struct {
+	Hits   uint64
+	Misses uint64
+	Errors uint64
+}
+
+// BackendCache is a TrieNodeCache on top of a TrieNodeCacheBackend.
+// Failures of the backend are counted and treated as cache misses, as the trie
+// nodes can always be read from the database.
+type BackendCache struct {
+	backend TrieNodeCacheBackend
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	errors atomic.Uint64
+}
+
+func newBackendCache(config *TrieNodeCacheConfig) (TrieNodeCache, error) {
+	if config.Backend == nil {
+		return nil, errNilTrieNodeCacheBackend
+	}
+	logger.Info("Initializing trie node cache with an external backend")
+	return &BackendCache{backend: config.Backend}, nil
+}
+
+func (cache *BackendCache) Get(k []byte) []byte {
+	v, err := cache.backend.Get(k)
+	if err != nil {
+		cache.errors.Add(1)
+		logger.Trace("Failed to get a trie node from the cache backend", "key", k, "err", err)
+		return nil
+	}
+	if v == nil {
+		cache.misses.Add(1)
+	} else {
+		cache.hits.Add(1)
+	}
+	return v
+}
+
+func (cache *BackendCache) Set(k, v []byte) {
+	if err := cache.backend.Set(k, v); err != nil {
+		cache.errors.Add(1)
+		logger.Trace("Failed to set a trie node to the cache backend", "key", k, "err", err)
+	}
+}
+
+func (cache *BackendCache) Has(k []byte) ([]byte, bool) {
+	v := cache.Get(k)
+	return v, v != nil
+}
+
+func (cache *BackendCache) UpdateStats() interface{} {
+	stats := BackendCacheStats{
+		Hits:   cache.hits.Load(),
+		Misses: cache.misses.Load(),
+		Errors: cache.errors.Load(),
+	}
+	memcacheBackendHits.Update(int64(stats.Hits))
+	memcacheBackendMisses.Update(int64(stats.Misses))
+	memcacheBackendErrors.Update(int64(stats.Errors))
+	return stats
+}
+
+func (cache *BackendCache) SaveToFile(filePath string, concurrency int) error {
+	return errNotSupportedTrieNodeCacheDump
+}
+
+func (cache *BackendCache) LoadFromFile(filePath string) error {
+	return errNotSupportedTrieNodeCacheDump
+}
+
+func (cache *BackendCache) Close() error {
+	return cache.backend.Close()
+}
+
+// MemoryCacheBackend is an in-process TrieNodeCacheBackend. It stands in for an
+// external cache service in tests.
+type MemoryCacheBackend struct {
+	items map[string][]byte
+	lock  sync.RWMutex
+}
+
+// NewMemoryCacheBackend creates an empty MemoryCacheBackend.
+func NewMemoryCacheBackend() *MemoryCacheBackend {
+	return &MemoryCacheBackend{items: make(map[string][]byte)}
+}
+
+func (b *MemoryCacheBackend) Get(k []byte) ([]byte, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.items[string(k)], nil
+}
+
+func (b *MemoryCacheBackend) Set(k, v []byte) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.items[string(k)] = append([]byte(nil), v...)
+	return nil
+}
+
+func (b *MemoryCacheBackend) Close() error {
+	return nil
+}
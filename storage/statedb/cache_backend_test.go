@@ -0,0 +1,77 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestBackendConfig() *TrieNodeCacheConfig {
+	return &TrieNodeCacheConfig{
+		CacheType: CacheTypeBackend,
+		Backend:   NewMemoryCacheBackend(),
+	}
+}
+
+// failingCacheBackend is a TrieNodeCacheBackend which is not reachable.
+type failingCacheBackend struct{}
+
+func (failingCacheBackend) Get(k []byte) ([]byte, error) { return nil, errors.New("unreachable") }
+func (failingCacheBackend) Set(k, v []byte) error        { return errors.New("unreachable") }
+func (failingCacheBackend) Close() error                 { return nil }
+
+func TestBackendCache(t *testing.T) {
+	config := getTestBackendConfig()
+	cache, err := newBackendCache(config)
+	assert.NoError(t, err)
+
+	key, value := common.MakeRandomBytes(32), common.MakeRandomBytes(128)
+	assert.Nil(t, cache.Get(key))
+
+	cache.Set(key, value)
+	assert.Equal(t, value, cache.Get(key))
+	ret, has := cache.Has(key)
+	assert.True(t, has)
+	assert.Equal(t, value, ret)
+
+	// Another cache on the same backend shares the entries
+	shared, err := newBackendCache(config)
+	assert.NoError(t, err)
+	assert.Equal(t, value, shared.Get(key))
+
+	assert.Equal(t, BackendCacheStats{Hits: 2, Misses: 1}, cache.UpdateStats())
+	assert.ErrorIs(t, cache.SaveToFile("", 1), errNotSupportedTrieNodeCacheDump)
+	assert.ErrorIs(t, cache.LoadFromFile(""), errNotSupportedTrieNodeCacheDump)
+	assert.NoError(t, cache.Close())
+}
+
+func TestBackendCache_Failure(t *testing.T) {
+	cache, err := newBackendCache(&TrieNodeCacheConfig{CacheType: CacheTypeBackend, Backend: failingCacheBackend{}})
+	assert.NoError(t, err)
+
+	key := common.MakeRandomBytes(32)
+	cache.Set(key, common.MakeRandomBytes(128))
+	ret, has := cache.Has(key)
+	assert.False(t, has)
+	assert.Nil(t, ret)
+
+	assert.Equal(t, BackendCacheStats{Errors: 2}, cache.UpdateStats())
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/kaiachain/kaia/common"
+)
+
+const (
+	// trieNodeCacheDumpVersion is the version of the trie node cache dump format.
+	// It must be bumped whenever the layout of the dumped files changes.
+	trieNodeCacheDumpVersion = 1
+
+	// trieNodeCacheManifestName is the name of the file describing a cache dump.
+	// It is written next to the data files of fastcache.
+	trieNodeCacheManifestName = "manifest.json"
+)
+
+var (
+	errNoTrieNodeCacheManifest       = errors.New("trie node cache dump has no manifest")
+	errTrieNodeCacheDumpVersion      = errors.New("unsupported trie node cache dump version")
+	errTrieNodeCacheDumpChecksum     = errors.New("trie node cache dump checksum mismatch")
+	errTrieNodeCacheDumpSize         = errors.New("trie node cache dump size mismatch")
+	errNotSupportedTrieNodeCacheDump = errors.New("trie node cache dump is not supported by the cache type")
+)
+
+// trieNodeCacheManifest describes a trie node cache dump, so that a dump exported
+// by another node can be validated before it replaces the local cache.
+type trieNodeCacheManifest struct {
+	Version  uint64      `json:"version"`
+	Checksum common.Hash `json:"checksum"` // SHA-256 of the names and contents of the data files
+}
+
+// trieNodeCacheDumpChecksum returns the checksum of all files in the dump
+// directory except the manifest. The files are hashed in the order of their names.
+func trieNodeCacheDumpChecksum(dir string) (common.Hash, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == trieNodeCacheManifestName {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	hasher := sha256.New()
+	for _, name := range names {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			return common.Hash{}, err
+		}
+		hasher.Write([]byte(name))
+		_, err = io.Copy(hasher, f)
+		f.Close()
+		if err != nil {
+			return common.Hash{}, err
+		}
+	}
+	return common.BytesToHash(hasher.Sum(nil)), nil
+}
+
+// writeTrieNodeCacheManifest writes the manifest of the cache dump in the given directory.
+func writeTrieNodeCacheManifest(dir string) error {
+	checksum, err := trieNodeCacheDumpChecksum(dir)
+	if err != nil {
+		return err
+	}
+	manifest, err := json.Marshal(&trieNodeCacheManifest{Version: trieNodeCacheDumpVersion, Checksum: checksum})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, trieNodeCacheManifestName), manifest, 0o644)
+}
+
+// verifyTrieNodeCacheDump checks the version and the checksum of the cache dump
+// in the given directory. It returns errNoTrieNodeCacheManifest for the dumps
+// written before the manifest was introduced.
+func verifyTrieNodeCacheDump(dir string) error {
+	blob, err := os.ReadFile(filepath.Join(dir, trieNodeCacheManifestName))
+	if errors.Is(err, os.ErrNotExist) {
+		return errNoTrieNodeCacheManifest
+	} else if err != nil {
+		return err
+	}
+	var manifest trieNodeCacheManifest
+	if err := json.Unmarshal(blob, &manifest); err != nil {
+		return fmt.Errorf("invalid trie node cache manifest: %w", err)
+	}
+	if manifest.Version != trieNodeCacheDumpVersion {
+		return fmt.Errorf("%w: have %d, want %d", errTrieNodeCacheDumpVersion, manifest.Version, trieNodeCacheDumpVersion)
+	}
+	checksum, err := trieNodeCacheDumpChecksum(dir)
+	if err != nil {
+		return err
+	}
+	if checksum != manifest.Checksum {
+		return fmt.Errorf("%w: have %s, want %s", errTrieNodeCacheDumpChecksum, checksum.Hex(), manifest.Checksum.Hex())
+	}
+	return nil
+}
@@ -19,6 +19,9 @@
 package statedb
 
 import (
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/VictoriaMetrics/fastcache"
@@ -42,7 +45,7 @@ var (
 )
 
 type FastCache struct {
-	fast *fastcache.Cache
+	fast atomic.Pointer[fastcache.Cache] // Replaced when the cache is warmed up from a dump
 }
 
 // newFastCache creates a FastCache with given cache size.
@@ -61,7 +64,8 @@ func newFastCache(config *TrieNodeCacheConfig) TrieNodeCache {
 		"MaxMiB", config.LocalCacheSizeMiB, "FilePath", config.FastCacheFileDir)
 
 	start := time.Now()
-	fc := &FastCache{fast: fastcache.LoadFromFileOrNew(config.FastCacheFileDir, config.LocalCacheSizeMiB*int(units.MiB))}
+	fc := &FastCache{}
+	fc.fast.Store(loadFastCacheOrNew(config.FastCacheFileDir, config.LocalCacheSizeMiB*int(units.MiB)))
 	stats := fc.UpdateStats().(fastcache.Stats)
 
 	logger.Info("Initialized local trie node cache (fastCache)",
@@ -70,21 +74,34 @@ func newFastCache(config *TrieNodeCacheConfig) TrieNodeCache {
 	return fc
 }
 
+// loadFastCacheOrNew loads the fastcache saved in the given directory. A new cache is
+// created if the saved cache doesn't exist, has a different size or fails the dump
+// verification. The caches saved without a manifest are loaded without verification.
+func loadFastCacheOrNew(dir string, maxBytes int) *fastcache.Cache {
+	if dir != "" {
+		if err := verifyTrieNodeCacheDump(dir); err != nil && !errors.Is(err, errNoTrieNodeCacheManifest) {
+			logger.Warn("Ignoring the saved trie node cache", "dir", dir, "err", err)
+			return fastcache.New(maxBytes)
+		}
+	}
+	return fastcache.LoadFromFileOrNew(dir, maxBytes)
+}
+
 func (cache *FastCache) Get(k []byte) []byte {
-	return cache.fast.Get(nil, k)
+	return cache.fast.Load().Get(nil, k)
 }
 
 func (cache *FastCache) Set(k, v []byte) {
-	cache.fast.Set(k, v)
+	cache.fast.Load().Set(k, v)
 }
 
 func (cache *FastCache) Has(k []byte) ([]byte, bool) {
-	return cache.fast.HasGet(nil, k)
+	return cache.fast.Load().HasGet(nil, k)
 }
 
 func (cache *FastCache) UpdateStats() interface{} {
 	var stats fastcache.Stats
-	cache.fast.UpdateStats(&stats)
+	cache.fast.Load().UpdateStats(&stats)
 
 	memcacheFastMisses.Update(int64(stats.Misses))
 	memcacheFastCollisions.Update(int64(stats.Collisions))
@@ -101,8 +118,35 @@ func (cache *FastCache) UpdateStats() interface{} {
 	return stats
 }
 
+// SaveToFile saves the cache to the given directory along with the manifest
+// holding the version and the checksum of the dump.
 func (cache *FastCache) SaveToFile(filePath string, concurrency int) error {
-	return cache.fast.SaveToFileConcurrent(filePath, concurrency)
+	if err := cache.fast.Load().SaveToFileConcurrent(filePath, concurrency); err != nil {
+		return err
+	}
+	return writeTrieNodeCacheManifest(filePath)
+}
+
+// LoadFromFile replaces the cached entries with a cache dump, which may have been
+// exported by another node. The dump must have a valid manifest and the same size
+// as the current cache.
+func (cache *FastCache) LoadFromFile(filePath string) error {
+	if err := verifyTrieNodeCacheDump(filePath); err != nil {
+		return err
+	}
+	loaded, err := fastcache.LoadFromFile(filePath)
+	if err != nil {
+		return err
+	}
+	var have, want fastcache.Stats
+	loaded.UpdateStats(&have)
+	cache.fast.Load().UpdateStats(&want)
+	if have.MaxBytesSize != want.MaxBytesSize {
+		loaded.Reset()
+		return fmt.Errorf("%w: have %d bytes, want %d bytes", errTrieNodeCacheDumpSize, have.MaxBytesSize, want.MaxBytesSize)
+	}
+	cache.fast.Swap(loaded).Reset()
+	return nil
 }
 
 func (cache *FastCache) Close() error {
@@ -91,6 +91,11 @@ func (cache *HybridCache) SaveToFile(filePath string, concurrency int) error {
 	return nil
 }
 
+// LoadFromFile warms up the local cache from a cache dump.
+func (cache *HybridCache) LoadFromFile(filePath string) error {
+	return cache.local.LoadFromFile(filePath)
+}
+
 func (cache *HybridCache) PublishBlock(msg string) error {
 	return cache.remote.PublishBlock(msg)
 }
@@ -179,6 +179,10 @@ func (cache *RedisCache) SaveToFile(filePath string, concurrency int) error {
 	return nil
 }
 
+func (cache *RedisCache) LoadFromFile(filePath string) error {
+	return errNotSupportedTrieNodeCacheDump
+}
+
 func (cache *RedisCache) Close() error {
 	cache.pubSub.Close()
 	close(cache.setItemCh)
@@ -19,7 +19,9 @@
 package statedb
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"testing"
@@ -38,6 +40,8 @@ func TestNewTrieNodeCache(t *testing.T) {
 		{getTestFastCacheConfig(), reflect.TypeOf(&FastCache{}), nil},
 		{getTestRedisConfig(), reflect.TypeOf(&RedisCache{}), nil},
 		{getTestHybridConfig(), reflect.TypeOf(&HybridCache{}), nil},
+		{getTestBackendConfig(), reflect.TypeOf(&BackendCache{}), nil},
+		{&TrieNodeCacheConfig{CacheType: CacheTypeBackend}, nil, errNilTrieNodeCacheBackend},
 		{nil, nil, errNilTrieNodeCacheConfig},
 	}
 
@@ -83,3 +87,67 @@ func TestFastCache_SaveAndLoad(t *testing.T) {
 		assert.Equal(t, fastCacheFromFile.Get(key), vals[idx])
 	}
 }
+
+func TestFastCache_WarmStart(t *testing.T) {
+	dirName, err := os.MkdirTemp(os.TempDir(), "fastcache_warmstart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirName)
+
+	var keys, vals [][]byte
+	for i := 0; i < 10; i++ {
+		keys = append(keys, common.MakeRandomBytes(128))
+		vals = append(vals, common.MakeRandomBytes(128))
+	}
+
+	// Export the cache of a node
+	config := getTestFastCacheConfig()
+	config.FastCacheFileDir = ""
+	source := newFastCache(config)
+	for idx, key := range keys {
+		source.Set(key, vals[idx])
+	}
+	assert.NoError(t, source.SaveToFile(dirName, runtime.NumCPU()))
+	assert.NoError(t, verifyTrieNodeCacheDump(dirName))
+
+	// Warm up the cache of another node
+	target := newFastCache(config)
+	target.Set([]byte("stale"), []byte("entry"))
+	assert.NoError(t, target.LoadFromFile(dirName))
+	for idx, key := range keys {
+		assert.Equal(t, vals[idx], target.Get(key))
+	}
+	assert.Nil(t, target.Get([]byte("stale")))
+
+	// A cache of a different size can't be warmed up with the dump
+	smallConfig := getTestFastCacheConfig()
+	smallConfig.FastCacheFileDir = ""
+	smallConfig.LocalCacheSizeMiB = 64
+	assert.ErrorIs(t, newFastCache(smallConfig).LoadFromFile(dirName), errTrieNodeCacheDumpSize)
+
+	// A dump of another version is rejected
+	manifestPath := filepath.Join(dirName, trieNodeCacheManifestName)
+	blob, err := os.ReadFile(manifestPath)
+	assert.NoError(t, err)
+	var manifest trieNodeCacheManifest
+	assert.NoError(t, json.Unmarshal(blob, &manifest))
+	manifest.Version++
+	wrongVersion, _ := json.Marshal(&manifest)
+	assert.NoError(t, os.WriteFile(manifestPath, wrongVersion, 0o644))
+	assert.ErrorIs(t, target.LoadFromFile(dirName), errTrieNodeCacheDumpVersion)
+	assert.NoError(t, os.WriteFile(manifestPath, blob, 0o644))
+
+	// A corrupted dump is rejected
+	assert.NoError(t, os.WriteFile(filepath.Join(dirName, "metadata.bin"), []byte("corrupted"), 0o644))
+	assert.ErrorIs(t, target.LoadFromFile(dirName), errTrieNodeCacheDumpChecksum)
+
+	// A dump without the manifest is rejected
+	assert.NoError(t, os.Remove(manifestPath))
+	assert.ErrorIs(t, target.LoadFromFile(dirName), errNoTrieNodeCacheManifest)
+
+	// The entries remain after the failed attempts
+	for idx, key := range keys {
+		assert.Equal(t, vals[idx], target.Get(key))
+	}
+}
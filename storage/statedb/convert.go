@@ -0,0 +1,139 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/kaiachain/kaia/blockchain/types"
+	"github.com/kaiachain/kaia/blockchain/types/account"
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/rlp"
+	"github.com/kaiachain/kaia/storage/database"
+)
+
+// ConvertStats is the statistics of a conversion from the hash-based scheme.
+type ConvertStats struct {
+	Accounts     uint64             // Number of accounts
+	StorageTries uint64             // Number of non-empty storage tries
+	Nodes        uint64             // Number of trie nodes written
+	Size         common.StorageSize // Total size of the trie nodes written
+}
+
+// ConvertToPathScheme writes the state of the given root, stored in the hash-based
+// scheme, in the path-based scheme, and switches the database to the path-based scheme.
+// The hash-based nodes are left untouched, and the converted state becomes the disk
+// state of the path-based scheme without histories.
+func ConvertToPathScheme(diskdb database.DBManager, root common.Hash) (*ConvertStats, error) {
+	if diskdb.ReadPruningEnabled() {
+		return nil, ErrPathSchemeLivePruning
+	}
+	if diskdb.ReadStateScheme() == database.PathScheme || len(diskdb.ReadAccountTrieNode(nil)) != 0 {
+		return nil, ErrAlreadyPathScheme
+	}
+	var (
+		triedb = NewDatabase(diskdb)
+		stats  = new(ConvertStats)
+		batch  = diskdb.NewPathStateDBBatch()
+		start  = time.Now()
+		logged = time.Now()
+
+		rootBlob []byte
+	)
+	defer batch.Release()
+
+	write := func(owner common.Hash) NodeWalkFn {
+		return func(path []byte, hash common.Hash, blob []byte) error {
+			// The account trie root is written last, as it marks the conversion done.
+			if owner == (common.Hash{}) && len(path) == 0 {
+				rootBlob = common.CopyBytes(blob)
+				return nil
+			}
+			if owner == (common.Hash{}) {
+				batch.WriteAccountTrieNode(path, blob)
+			} else {
+				batch.WriteStorageTrieNode(owner, path, blob)
+			}
+			stats.Nodes++
+			stats.Size += common.StorageSize(len(blob))
+			if batch.ValueSize() < database.IdealBatchSize {
+				return nil
+			}
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+			return nil
+		}
+	}
+	if err := triedb.WalkNodes(root.ExtendZero(), write(common.Hash{})); err != nil {
+		return nil, err
+	}
+
+	accTrie, err := NewSecureTrie(root, triedb, nil)
+	if err != nil {
+		return nil, err
+	}
+	it := accTrie.NodeIterator(nil)
+	for it.Next(true) {
+		if !it.Leaf() {
+			continue
+		}
+		stats.Accounts++
+
+		serializer := account.NewAccountSerializer()
+		if err := rlp.Decode(bytes.NewReader(it.LeafBlob()), serializer); err != nil {
+			return nil, err
+		}
+		pa := account.GetProgramAccount(serializer.GetAccount())
+		if pa == nil {
+			continue
+		}
+		storageRoot := pa.GetStorageRoot()
+		if common.EmptyExtHash(storageRoot) || storageRoot.Unextend() == types.EmptyRootHashOriginal {
+			continue
+		}
+		stats.StorageTries++
+		if err := triedb.WalkNodes(storageRoot, write(common.BytesToHash(it.LeafKey()))); err != nil {
+			return nil, err
+		}
+		if time.Since(logged) > 8*time.Second {
+			logger.Info("Converting state to the path-based scheme", "accounts", stats.Accounts,
+				"nodes", stats.Nodes, "size", stats.Size, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if it.Error() != nil {
+		return nil, it.Error()
+	}
+	if rootBlob != nil {
+		batch.WriteAccountTrieNode(nil, rootBlob)
+		stats.Nodes++
+		stats.Size += common.StorageSize(len(rootBlob))
+	}
+	batch.WritePersistentStateID(0)
+	batch.WriteStateID(root, 0)
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	diskdb.WriteStateScheme(database.PathScheme)
+	logger.Info("Converted state to the path-based scheme", "root", root, "accounts", stats.Accounts,
+		"storageTries", stats.StorageTries, "nodes", stats.Nodes, "size", stats.Size,
+		"elapsed", common.PrettyDuration(time.Since(start)))
+	return stats, nil
+}
@@ -30,10 +30,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kaiachain/kaia/blockchain/types/account"
 	"github.com/kaiachain/kaia/common"
 	"github.com/kaiachain/kaia/log"
 	"github.com/kaiachain/kaia/rlp"
 	"github.com/kaiachain/kaia/storage/database"
+	"github.com/kaiachain/kaia/storage/pathdb"
 	"github.com/pbnjay/memory"
 	"github.com/rcrowley/go-metrics"
 )
@@ -88,6 +90,7 @@ type DatabaseReader interface {
 // periodically flush a couple tries to disk, garbage collecting the remainder.
 type Database struct {
 	diskDB database.DBManager // Persistent storage for matured trie nodes
+	pathDB *pathdb.Database   // Persistent storage in the path-based scheme, nil in the hash-based scheme
 
 	nodes  map[common.ExtHash]*cachedNode // Data and references relationships of a trie node
 	oldest common.ExtHash                 // Oldest tracked node, flush-list head
@@ -312,6 +315,7 @@ func NewDatabaseWithNewCache(diskDB database.DBManager, cacheConfig *TrieNodeCac
 
 	return &Database{
 		diskDB:              diskDB,
+		pathDB:              newPathDB(diskDB),
 		nodes:               map[common.ExtHash]*cachedNode{{}: {}},
 		preimages:           make(map[common.Hash][]byte),
 		trieNodeCache:       trieNodeCache,
@@ -325,12 +329,21 @@ func NewDatabaseWithNewCache(diskDB database.DBManager, cacheConfig *TrieNodeCac
 func NewDatabaseWithExistingCache(diskDB database.DBManager, cache TrieNodeCache) *Database {
 	return &Database{
 		diskDB:        diskDB,
+		pathDB:        newPathDB(diskDB),
 		nodes:         map[common.ExtHash]*cachedNode{{}: {}},
 		preimages:     make(map[common.Hash][]byte),
 		trieNodeCache: cache,
 	}
 }
 
+// newPathDB opens the path-based state if the database is in the path-based scheme.
+func newPathDB(diskDB database.DBManager) *pathdb.Database {
+	if diskDB.ReadStateScheme() != database.PathScheme {
+		return nil
+	}
+	return pathdb.New(diskDB, nil)
+}
+
 func getTrieNodeCacheSizeMiB() int {
 	totalPhysicalMemMiB := float64(memory.TotalMemory() / 1024 / 1024)
 
@@ -345,6 +358,14 @@ func getTrieNodeCacheSizeMiB() int {
 	}
 }
 
+// Scheme returns the scheme of the persistent trie node storage.
+func (db *Database) Scheme() string {
+	if db.pathDB != nil {
+		return database.PathScheme
+	}
+	return database.HashScheme
+}
+
 // DiskDB retrieves the persistent database backing the trie database.
 func (db *Database) DiskDB() database.DBManager {
 	return db.diskDB
@@ -497,6 +518,13 @@ func recordTrieCacheMiss() {
 // node retrieves a cached trie node from memory, or returns nil if node can be
 // found in the memory cache.
 func (db *Database) node(hash common.ExtHash) (n node, fromDB bool) {
+	return db.nodeAt(hash.Unextend(), common.Hash{}, nil, hash)
+}
+
+// nodeAt retrieves a trie node like node, locating the persisted node by the given
+// state root, owner and path in the path-based scheme. The owner is the zero hash for
+// the account trie and the account hash for a storage trie.
+func (db *Database) nodeAt(stateRoot, owner common.Hash, path []byte, hash common.ExtHash) (n node, fromDB bool) {
 	// Retrieve the node from the trie node cache if available
 	if enc := db.getCachedNode(hash); enc != nil {
 		if dec, err := decodeNode(hash[:], enc); err == nil {
@@ -515,7 +543,7 @@ func (db *Database) node(hash common.ExtHash) (n node, fromDB bool) {
 	}
 
 	// Content unavailable in memory, attempt to retrieve from disk
-	enc, err := db.diskDB.ReadTrieNode(hash)
+	enc, err := db.readNode(stateRoot, owner, path, hash)
 	if err != nil || enc == nil {
 		return nil, true
 	}
@@ -524,9 +552,32 @@ func (db *Database) node(hash common.ExtHash) (n node, fromDB bool) {
 	return mustDecodeNode(hash[:], enc), true
 }
 
+// readNode reads an encoded trie node from the persistent database. In the path-based
+// scheme, the node is located by the given owner and path, and read through the layer of
+// the given state root, or from the disk if the layer has been flattened or dropped. An
+// error is returned if the path holds the node of another state. So a node which isn't a
+// state root can only be read with its owner and path.
+func (db *Database) readNode(stateRoot, owner common.Hash, path []byte, hash common.ExtHash) ([]byte, error) {
+	if db.pathDB != nil {
+		if reader, err := db.pathDB.Reader(stateRoot); err == nil {
+			if blob, err := reader.Node(owner, path, hash.Unextend()); err == nil {
+				return blob, nil
+			}
+		}
+		return db.pathDB.Node(owner, path, hash.Unextend())
+	}
+	return db.diskDB.ReadTrieNode(hash)
+}
+
 // Node retrieves an encoded cached trie node from memory. If it cannot be found
 // cached, the method queries the persistent database for the content.
 func (db *Database) Node(hash common.ExtHash) ([]byte, error) {
+	return db.nodeBlobAt(hash.Unextend(), common.Hash{}, nil, hash)
+}
+
+// nodeBlobAt retrieves an encoded trie node like Node, locating the persisted node by
+// the given state root, owner and path in the path-based scheme.
+func (db *Database) nodeBlobAt(stateRoot, owner common.Hash, path []byte, hash common.ExtHash) ([]byte, error) {
 	if common.EmptyExtHash(hash) {
 		return nil, ErrZeroHashNode
 	}
@@ -544,7 +595,7 @@ func (db *Database) Node(hash common.ExtHash) ([]byte, error) {
 		return node.rlp(), nil
 	}
 	// Content unavailable in memory, attempt to retrieve from disk
-	enc, err := db.diskDB.ReadTrieNode(hash)
+	enc, err := db.readNode(stateRoot, owner, path, hash)
 	if err == nil && enc != nil {
 		db.setCachedNode(hash, enc)
 		recordTrieCacheMiss()
@@ -590,6 +641,7 @@ func (db *Database) DoesExistCachedNode(hash common.ExtHash) bool {
 }
 
 // DoesExistNodeInPersistent returns if the node exists on the persistent database or its cache.
+// In the path-based scheme, only a state root can be found on the persistent database.
 func (db *Database) DoesExistNodeInPersistent(hash common.ExtHash) bool {
 	// Retrieve the node from DB cache if available
 	if enc := db.getCachedNode(hash); enc != nil {
@@ -597,7 +649,7 @@ func (db *Database) DoesExistNodeInPersistent(hash common.ExtHash) bool {
 	}
 
 	// Content unavailable in DB cache, attempt to retrieve from disk
-	enc, err := db.diskDB.ReadTrieNode(hash)
+	enc, err := db.readNode(hash.Unextend(), common.Hash{}, nil, hash)
 	if err == nil && enc != nil {
 		return true
 	}
@@ -745,6 +797,11 @@ func (db *Database) dereference(child common.ExtHash, parent common.ExtHash) {
 // Cap iteratively flushes old but still referenced trie nodes until the total
 // memory usage goes below the given threshold.
 func (db *Database) Cap(limit common.StorageSize) error {
+	// The path-based scheme persists nodes only by flattening the diff layers
+	// at Commit, as the paths of the nodes in the flush-list are unknown.
+	if db.pathDB != nil {
+		return nil
+	}
 	// Create a database batch to flush persistent data out. It is important that
 	// outside code doesn't see an inconsistent state (referenced data removed from
 	// memory cache during commit but not yet in persistent database). This is ensured
@@ -918,7 +975,13 @@ func (db *Database) Commit(root common.Hash, report bool, blockNum uint64) error
 
 	// Move the trie itself into the batch, flushing if enough data is accumulated
 	numNodes, nodesSize := len(db.nodes), db.nodesSize
-	if err := db.writeBatchNodes(hash); err != nil {
+	var err error
+	if db.pathDB != nil {
+		err = db.writePathNodes(root, blockNum)
+	} else {
+		err = db.writeBatchNodes(hash)
+	}
+	if err != nil {
 		db.lock.RUnlock()
 		return err
 	}
@@ -957,6 +1020,237 @@ func (db *Database) Commit(root common.Hash, report bool, blockNum uint64) error
 	return nil
 }
 
+// Update adds the state transition from parentRoot to root as a diff layer in the
+// path-based scheme, which is persisted by a later Commit. It must be called once per
+// block, after the state of the block is committed into the memory database. It's a
+// no-op in the hash-based scheme.
+func (db *Database) Update(root, parentRoot common.Hash, blockNum uint64) error {
+	if db.pathDB == nil {
+		return nil
+	}
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return db.updatePath(root, parentRoot, blockNum)
+}
+
+// updatePath adds a diff layer of the nodes changed from parentRoot to root.
+// The caller must hold the lock.
+func (db *Database) updatePath(root, parentRoot common.Hash, blockNum uint64) error {
+	if root == parentRoot {
+		return nil
+	}
+	parent, err := db.pathDB.Reader(parentRoot)
+	if err != nil {
+		return err
+	}
+	d := &pathDiff{
+		db:         db,
+		parent:     parent,
+		nodes:      pathdb.NewNodeSet(),
+		oldStorage: make(map[common.Hash]common.ExtHash),
+		newStorage: make(map[common.Hash]common.ExtHash),
+	}
+	if err := d.diff(common.Hash{}, nil, parentRoot.ExtendZero(), root.ExtendZero()); err != nil {
+		return err
+	}
+	// The storage tries are compared by their owners, as the leaf of an account
+	// can be moved to another path without changing the storage trie.
+	for owner, oldRoot := range d.oldStorage {
+		if err := d.diff(owner, nil, oldRoot, d.newStorage[owner]); err != nil {
+			return err
+		}
+	}
+	for owner, newRoot := range d.newStorage {
+		if _, ok := d.oldStorage[owner]; !ok {
+			if err := d.diff(owner, nil, common.ExtHash{}, newRoot); err != nil {
+				return err
+			}
+		}
+	}
+	return db.pathDB.Update(root, parentRoot, blockNum, d.nodes)
+}
+
+// writePathNodes persists the state of the given root in the path-based scheme by
+// flattening its diff layer and the ones below into the disk. A state committed
+// without Update, like the genesis state, is added as a transition from the disk state.
+func (db *Database) writePathNodes(root common.Hash, blockNum uint64) error {
+	if _, err := db.pathDB.Reader(root); err != nil {
+		if err := db.updatePath(root, db.pathDB.DiskRoot(), blockNum); err != nil {
+			return err
+		}
+	}
+	return db.pathDB.Commit(root)
+}
+
+// pathDiff collects the nodes changed between two states in the path-based scheme.
+// The nodes are compared by their paths from the roots, and a subtrie is skipped if
+// its root is unchanged. The paths which hold a node only in the parent state are
+// collected as deleted.
+type pathDiff struct {
+	db     *Database
+	parent *pathdb.Reader
+	nodes  pathdb.NodeSet
+
+	// The storage roots of the account leaves in the changed nodes of each state
+	oldStorage map[common.Hash]common.ExtHash
+	newStorage map[common.Hash]common.ExtHash
+}
+
+// diff collects the changes of the subtrie at the given owner and path from oldHash to newHash.
+func (d *pathDiff) diff(owner common.Hash, path []byte, oldHash, newHash common.ExtHash) error {
+	if isEmptyPathNode(oldHash) && isEmptyPathNode(newHash) || oldHash.Unextend() == newHash.Unextend() {
+		return nil
+	}
+	oldNode, _, err := d.resolve(owner, path, oldHash)
+	if err != nil {
+		return err
+	}
+	newNode, enc, err := d.resolve(owner, path, newHash)
+	if err != nil {
+		return err
+	}
+	if newNode != nil {
+		d.nodes.Add(owner, path, pathdb.NewNode(enc))
+		d.db.setCachedNode(newHash, enc)
+	} else {
+		d.nodes.Add(owner, path, pathdb.NewDeletedNode())
+	}
+	oldChildren, err := d.children(owner, path, oldNode, d.oldStorage)
+	if err != nil {
+		return err
+	}
+	newChildren, err := d.children(owner, path, newNode, d.newStorage)
+	if err != nil {
+		return err
+	}
+	for childPath, oldChild := range oldChildren {
+		if err := d.diff(owner, []byte(childPath), oldChild, newChildren[childPath]); err != nil {
+			return err
+		}
+	}
+	for childPath, newChild := range newChildren {
+		if _, ok := oldChildren[childPath]; !ok {
+			if err := d.diff(owner, []byte(childPath), common.ExtHash{}, newChild); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolve returns the node of the given hash and its encoding from the memory, or
+// from the parent state. A nil node is returned for an empty hash.
+func (d *pathDiff) resolve(owner common.Hash, path []byte, hash common.ExtHash) (node, []byte, error) {
+	if isEmptyPathNode(hash) {
+		return nil, nil, nil
+	}
+	if cached, ok := d.db.nodes[hash]; ok {
+		return cached.obj(hash), cached.rlp(), nil
+	}
+	enc := d.db.getCachedNode(hash)
+	if enc == nil {
+		var err error
+		if enc, err = d.parent.Node(owner, path, hash.Unextend()); err != nil {
+			return nil, nil, err
+		}
+	}
+	n, err := decodeNode(hash[:], enc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return n, enc, nil
+}
+
+// children returns the hashed children of a node by their paths. The nodes embedded
+// in the node are descended, and the storage roots of the account leaves are stored
+// in the given map.
+func (d *pathDiff) children(owner common.Hash, path []byte, n node, storage map[common.Hash]common.ExtHash) (map[string]common.ExtHash, error) {
+	children := make(map[string]common.ExtHash)
+
+	var walk func(path []byte, n node) error
+	walk = func(path []byte, n node) error {
+		switch n := n.(type) {
+		case *shortNode:
+			path = concat(path, n.Key...)
+			switch child := n.Val.(type) {
+			case hashNode:
+				children[string(path)] = common.BytesToExtHash(child)
+			case valueNode:
+				if owner != (common.Hash{}) {
+					return nil
+				}
+				root, err := storageRootOf(child)
+				if err != nil {
+					return err
+				}
+				if !isEmptyPathNode(root) {
+					storage[common.BytesToHash(hexToKeybytes(path))] = root
+				}
+			default:
+				return walk(path, child)
+			}
+		case *fullNode:
+			for i := 0; i < 16; i++ {
+				switch child := n.Children[i].(type) {
+				case nil:
+				case hashNode:
+					children[string(concat(path, byte(i)))] = common.BytesToExtHash(child)
+				default:
+					if err := walk(concat(path, byte(i)), child); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(path, n); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+// storageRootOf returns the storage root of an encoded account, or the empty hash if
+// the account has no storage.
+func storageRootOf(enc []byte) (common.ExtHash, error) {
+	serializer := account.NewAccountSerializer()
+	if err := rlp.DecodeBytes(enc, serializer); err != nil {
+		return common.ExtHash{}, err
+	}
+	if pa := account.GetProgramAccount(serializer.GetAccount()); pa != nil {
+		return pa.GetStorageRoot(), nil
+	}
+	return common.ExtHash{}, nil
+}
+
+// isEmptyPathNode returns true if the hash stands for no node.
+func isEmptyPathNode(hash common.ExtHash) bool {
+	return common.EmptyExtHash(hash) || hash.Unextend() == emptyRoot || hash.Unextend() == emptyState
+}
+
+// DiskRoot returns the root of the state persisted in the path-based scheme.
+// The zero hash is returned in the hash-based scheme.
+func (db *Database) DiskRoot() common.Hash {
+	if db.pathDB == nil {
+		return common.Hash{}
+	}
+	return db.pathDB.DiskRoot()
+}
+
+// Recoverable returns true if the persisted state can be rolled back to the given root.
+// Only the path-based scheme is recoverable.
+func (db *Database) Recoverable(root common.Hash) bool {
+	return db.pathDB != nil && db.pathDB.Recoverable(root)
+}
+
+// Recover rolls the persisted state back to the given root in the path-based scheme.
+func (db *Database) Recover(root common.Hash) error {
+	if db.pathDB == nil {
+		return ErrRecoverHashScheme
+	}
+	return db.pathDB.Recover(root)
+}
+
 // commit iteratively encodes nodes from parents to child nodes.
 func (db *Database) commit(hash common.ExtHash, resultCh chan<- commitResult) {
 	node, ok := db.nodes[hash]
@@ -1098,7 +1392,7 @@ func (db *Database) UpdateMetricNodes() {
 }
 
 var (
-	errDisabledTrieNodeCache         = errors.New("trie node cache is disabled, nothing to save to file")
+	errDisabledTrieNodeCache         = errors.New("trie node cache is disabled")
 	errSavingTrieNodeCacheInProgress = errors.New("saving trie node cache has been triggered already")
 )
 
@@ -1128,6 +1422,27 @@ func (db *Database) SaveTrieNodeCacheToFile(filePath string, concurrency int) {
 	db.savingTrieNodeCacheTriggered = false
 }
 
+// LoadTrieNodeCacheFromFile replaces the cached trie nodes with a cache dump saved by
+// SaveTrieNodeCacheToFile, possibly on another node, to warm up the trie node cache.
+func (db *Database) LoadTrieNodeCacheFromFile(filePath string) error {
+	if db.trieNodeCache == nil {
+		return errDisabledTrieNodeCache
+	}
+	if db.savingTrieNodeCacheTriggered {
+		return errSavingTrieNodeCacheInProgress
+	}
+	start := time.Now()
+	logger.Info("start loading cache from file", "filePath", filePath)
+	if err := db.trieNodeCache.LoadFromFile(filePath); err != nil {
+		logger.Error("failed to load cache from file",
+			"filePath", filePath, "elapsed", time.Since(start), "err", err)
+		return err
+	}
+	logger.Info("successfully loaded cache from file",
+		"filePath", filePath, "elapsed", time.Since(start))
+	return nil
+}
+
 // DumpPeriodically atomically saves fast cache data to the given dir with the specified interval.
 func (db *Database) SaveCachePeriodically(c *TrieNodeCacheConfig, stopCh <-chan struct{}) {
 	randomVal := 0.5 + rand.Float64()/2.0 // 0.5 <= randomVal < 1.0
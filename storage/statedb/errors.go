@@ -44,4 +44,14 @@ func (err *MissingNodeError) Error() string {
 var (
 	ErrZeroHashNode    = errors.New("cannot retrieve a node which has 0x00 hash value")
 	ErrPruningDisabled = errors.New("pruning is disabled on database")
+
+	// ErrPathSchemeLivePruning is returned when the path-based scheme is used with live
+	// pruning, whose trie nodes reference their children by extended hashes.
+	ErrPathSchemeLivePruning = errors.New("path-based scheme is not supported with live pruning")
+
+	// ErrRecoverHashScheme is returned when rolling back the persisted state in the hash-based scheme.
+	ErrRecoverHashScheme = errors.New("persisted state cannot be rolled back in the hash-based scheme")
+
+	// ErrAlreadyPathScheme is returned when converting a database already in the path-based scheme.
+	ErrAlreadyPathScheme = errors.New("database is already in the path-based scheme")
 )
@@ -76,6 +76,20 @@ func (mr *MockTrieNodeCacheMockRecorder) Has(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockTrieNodeCache)(nil).Has), arg0)
 }
 
+// LoadFromFile mocks base method
+func (m *MockTrieNodeCache) LoadFromFile(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadFromFile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadFromFile indicates an expected call of LoadFromFile
+func (mr *MockTrieNodeCacheMockRecorder) LoadFromFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadFromFile", reflect.TypeOf((*MockTrieNodeCache)(nil).LoadFromFile), arg0)
+}
+
 // SaveToFile mocks base method
 func (m *MockTrieNodeCache) SaveToFile(arg0 string, arg1 int) error {
 	m.ctrl.T.Helper()
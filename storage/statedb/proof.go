@@ -50,6 +50,7 @@ type ProofDBReader interface {
 func (t *Trie) Prove(key []byte, fromLevel uint, proofDB ProofDBWriter) error {
 	// Collect all nodes on the path to key.
 	key = keybytesToHex(key)
+	hexKey := key
 	nodes := []node{}
 	tn := t.root
 	for len(key) > 0 && tn != nil {
@@ -69,7 +70,7 @@ func (t *Trie) Prove(key []byte, fromLevel uint, proofDB ProofDBWriter) error {
 			nodes = append(nodes, n)
 		case hashNode:
 			var err error
-			tn, err = t.resolveHash(n, nil)
+			tn, err = t.resolveHash(n, hexKey[:len(hexKey)-len(key)])
 			if err != nil {
 				logger.Error(fmt.Sprintf("Unhandled trie error: %v", err))
 				return err
@@ -47,6 +47,15 @@ type TrieOpts struct {
 	// will schedule obsolete nodes to be pruned when the given block number becomes obsolete.
 	// This option is only viable when the pruning is enabled on database.
 	PruningBlockNumber uint64
+
+	// Owner is the hash of the account owning a storage trie, and the zero hash for the
+	// account trie. It locates the persisted nodes in the path-based scheme.
+	Owner common.Hash
+
+	// StateRoot is the root of the state which a storage trie belongs to. It selects the
+	// state layer to read the nodes from in the path-based scheme. The root of the trie
+	// is used for the account trie if not given.
+	StateRoot common.Hash
 }
 
 // LeafCallback is a callback type invoked when a trie operation reaches a leaf
@@ -237,7 +246,7 @@ func (t *Trie) tryGetNode(origNode node, path []byte, pos int) (item []byte, new
 		if hash == nil {
 			return nil, origNode, 0, errors.New("non-consensus node")
 		}
-		blob, err := t.db.Node(common.BytesToExtHash(hash))
+		blob, err := t.db.nodeBlobAt(t.stateRoot(), t.Owner, path, common.BytesToExtHash(hash))
 		return blob, origNode, 1, err
 	}
 	// Path still needs to be traversed, descend into children
@@ -543,9 +552,17 @@ func (t *Trie) resolve(n node, prefix []byte) (node, error) {
 	return n, nil
 }
 
+// stateRoot returns the root of the state which the trie belongs to.
+func (t *Trie) stateRoot() common.Hash {
+	if t.StateRoot == (common.Hash{}) && t.Owner == (common.Hash{}) {
+		return t.originalRoot.Unextend()
+	}
+	return t.StateRoot
+}
+
 func (t *Trie) resolveHash(n hashNode, prefix []byte) (node, error) {
 	hash := common.BytesToExtHash(n)
-	node, fromDB := t.db.node(hash)
+	node, fromDB := t.db.nodeAt(t.stateRoot(), t.Owner, prefix, hash)
 	if t.Prefetching && fromDB {
 		memcacheCleanPrefetchMissMeter.Mark(1)
 	}
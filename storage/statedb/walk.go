@@ -0,0 +1,111 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"github.com/kaiachain/kaia/common"
+)
+
+// NodeWalkFn is called for every trie node stored in the database, with the hex
+// path of the node from the trie root, the hash of the node and its encoded blob.
+type NodeWalkFn func(path []byte, hash common.Hash, blob []byte) error
+
+// MissingNodeFn is called for every trie node which is referenced by its parent
+// but missing in the database, with the hex path of the node and its hash.
+type MissingNodeFn func(path []byte, hash common.Hash) error
+
+// WalkNodes traverses the trie of the given root in depth-first order, and calls fn
+// for every node which is stored in the database by itself. The nodes embedded in
+// their parents are not visited, as they are a part of the parent's blob.
+// It stops at the first missing node and returns a MissingNodeError.
+func (db *Database) WalkNodes(root common.ExtHash, fn NodeWalkFn) error {
+	w := &nodeWalker{db: db, fn: fn}
+	return w.walk(root)
+}
+
+// FindMissingNodes traverses the trie of the given owner and root, and calls fn for
+// every node missing in the database. Unlike WalkNodes, the traversal continues with the
+// siblings of a missing node, so that all the missing nodes in the trie are reported.
+// The owner is the zero hash for the account trie and the account hash for a storage
+// trie, which locates the nodes in the path-based scheme.
+func (db *Database) FindMissingNodes(owner common.Hash, root common.ExtHash, fn MissingNodeFn) error {
+	w := &nodeWalker{db: db, owner: owner, missing: fn}
+	return w.walk(root)
+}
+
+// nodeWalker traverses the nodes of a trie stored in the database.
+type nodeWalker struct {
+	db      *Database
+	owner   common.Hash   // Owner of the trie, used in the path-based scheme
+	root    common.Hash   // State root of the account trie, used in the path-based scheme
+	fn      NodeWalkFn    // Called for every stored node if not nil
+	missing MissingNodeFn // Called for every missing node if not nil, otherwise the walk stops
+}
+
+func (w *nodeWalker) walk(root common.ExtHash) error {
+	if root.Unextend() == emptyRoot || common.EmptyExtHash(root) {
+		return nil
+	}
+	if w.owner == (common.Hash{}) {
+		w.root = root.Unextend()
+	}
+	return w.walkHashNode(hashNode(root.Bytes()), nil)
+}
+
+func (w *nodeWalker) walkHashNode(n hashNode, path []byte) error {
+	hash := common.BytesToExtHash(n)
+	blob, err := w.db.nodeBlobAt(w.root, w.owner, path, hash)
+	if err != nil {
+		if w.missing != nil {
+			return w.missing(path, hash.Unextend())
+		}
+		return &MissingNodeError{NodeHash: hash.Unextend(), Path: path}
+	}
+	if w.fn != nil {
+		if err := w.fn(path, hash.Unextend(), blob); err != nil {
+			return err
+		}
+	}
+	decoded, err := decodeNode(n, blob)
+	if err != nil {
+		return err
+	}
+	return w.walkNode(decoded, path)
+}
+
+func (w *nodeWalker) walkNode(n node, path []byte) error {
+	switch n := n.(type) {
+	case hashNode:
+		return w.walkHashNode(n, common.CopyBytes(path))
+	case *shortNode:
+		if hasTerm(n.Key) {
+			return nil // leaf node, the value is not a trie node
+		}
+		return w.walkNode(n.Val, append(path, n.Key...))
+	case *fullNode:
+		for i := 0; i < 16; i++ {
+			if n.Children[i] == nil {
+				continue
+			}
+			if err := w.walkNode(n.Children[i], append(path, byte(i))); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 The Kaia Authors
+// This file is part of the Kaia library.
+//
+// The Kaia library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Kaia library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Kaia library. If not, see <http://www.gnu.org/licenses/>.
+
+package statedb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kaiachain/kaia/common"
+	"github.com/kaiachain/kaia/crypto"
+	"github.com/kaiachain/kaia/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkNodes(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	triedb := NewDatabase(dbm)
+
+	trie, _ := NewTrie(common.Hash{}, triedb, nil)
+	for i := 0; i < 1000; i++ {
+		trie.Update(crypto.Keccak256([]byte(fmt.Sprint(i))), []byte(fmt.Sprintf("value-%032d", i)))
+	}
+	root, _ := trie.Commit(nil)
+	require.NoError(t, triedb.Commit(root, false, 0))
+
+	// All the stored nodes are visited with their paths.
+	paths := make(map[common.Hash][]byte)
+	require.NoError(t, triedb.WalkNodes(root.ExtendZero(), func(path []byte, hash common.Hash, blob []byte) error {
+		paths[hash] = path
+		return nil
+	}))
+	assert.Equal(t, []byte(nil), paths[root])
+	assert.Greater(t, len(paths), 1)
+	assert.NoError(t, triedb.FindMissingNodes(common.Hash{}, root.ExtendZero(), func(path []byte, hash common.Hash) error {
+		t.Errorf("unexpected missing node %x", hash)
+		return nil
+	}))
+
+	// Delete two children of the root, and find them.
+	var deleted []common.Hash
+	for hash, path := range paths {
+		if len(path) == 1 && len(deleted) < 2 {
+			dbm.DeleteTrieNode(hash.ExtendZero())
+			deleted = append(deleted, hash)
+		}
+	}
+	require.Len(t, deleted, 2)
+	triedb = NewDatabase(dbm)
+
+	var missing []common.Hash
+	require.NoError(t, triedb.FindMissingNodes(common.Hash{}, root.ExtendZero(), func(path []byte, hash common.Hash) error {
+		assert.Equal(t, paths[hash], path)
+		missing = append(missing, hash)
+		return nil
+	}))
+	assert.ElementsMatch(t, deleted, missing)
+
+	err := triedb.WalkNodes(root.ExtendZero(), func(path []byte, hash common.Hash, blob []byte) error { return nil })
+	assert.IsType(t, &MissingNodeError{}, err)
+}
@@ -651,6 +651,20 @@ func (mr *MockBlockChainMockRecorder) IsSenderTxHashIndexingEnabled() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSenderTxHashIndexingEnabled", reflect.TypeOf((*MockBlockChain)(nil).IsSenderTxHashIndexingEnabled))
 }
 
+// LoadTrieNodeCacheFromDisk mocks base method.
+func (m *MockBlockChain) LoadTrieNodeCacheFromDisk(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadTrieNodeCacheFromDisk", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadTrieNodeCacheFromDisk indicates an expected call of LoadTrieNodeCacheFromDisk.
+func (mr *MockBlockChainMockRecorder) LoadTrieNodeCacheFromDisk(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTrieNodeCacheFromDisk", reflect.TypeOf((*MockBlockChain)(nil).LoadTrieNodeCacheFromDisk), arg0)
+}
+
 // PostChainEvents mocks base method.
 func (m *MockBlockChain) PostChainEvents(arg0 []interface{}, arg1 []*types.Log) {
 	m.ctrl.T.Helper()
@@ -706,6 +720,22 @@ func (mr *MockBlockChainMockRecorder) PrunableStateAt(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrunableStateAt", reflect.TypeOf((*MockBlockChain)(nil).PrunableStateAt), arg0, arg1)
 }
 
+// RegisterExecutionModule mocks base method.
+func (m *MockBlockChain) RegisterExecutionModule(arg0 ...kaiax.ExecutionModule) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "RegisterExecutionModule", varargs...)
+}
+
+// RegisterExecutionModule indicates an expected call of RegisterExecutionModule.
+func (mr *MockBlockChainMockRecorder) RegisterExecutionModule(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterExecutionModule", reflect.TypeOf((*MockBlockChain)(nil).RegisterExecutionModule), arg0...)
+}
+
 // RegisterRewindableModule mocks base method.
 func (m *MockBlockChain) RegisterRewindableModule(arg0 ...kaiax.RewindableModule) {
 	m.ctrl.T.Helper()
@@ -312,6 +312,7 @@ type BlockChain interface {
 
 	// Save trie node cache to this
 	SaveTrieNodeCacheToDisk() error
+	LoadTrieNodeCacheFromDisk(filePath string) error
 
 	// KES
 	BlockSubscriptionLoop(pool *blockchain.TxPool)
@@ -325,4 +326,5 @@ type BlockChain interface {
 
 	// kaiax module host
 	kaiax.RewindableModuleHost
+	kaiax.ExecutionModuleHost
 }